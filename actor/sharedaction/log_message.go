@@ -0,0 +1,69 @@
+package sharedaction
+
+import (
+	"time"
+
+	"github.com/cloudfoundry/sonde-go/events"
+)
+
+// LogMessage represents a log message emitted by an application, one of its
+// tasks, or the platform on their behalf.
+type LogMessage struct {
+	message        string
+	messageType    events.LogMessage_MessageType
+	timestamp      time.Time
+	sourceType     string
+	sourceInstance string
+}
+
+// NewLogMessage returns a log message with the given fields.
+func NewLogMessage(message string, messageType int, timestamp time.Time, sourceType string, sourceInstance string) *LogMessage {
+	return &LogMessage{
+		message:        message,
+		messageType:    events.LogMessage_MessageType(messageType),
+		timestamp:      timestamp,
+		sourceType:     sourceType,
+		sourceInstance: sourceInstance,
+	}
+}
+
+// NewLogMessageFromNOAA returns the log message received from the logging
+// system as message.
+func NewLogMessageFromNOAA(message *events.LogMessage) *LogMessage {
+	return &LogMessage{
+		message:        string(message.GetMessage()),
+		messageType:    message.GetMessageType(),
+		timestamp:      time.Unix(0, message.GetTimestamp()),
+		sourceType:     message.GetSourceType(),
+		sourceInstance: message.GetSourceInstance(),
+	}
+}
+
+// Message returns the text of the log message.
+func (log LogMessage) Message() string {
+	return log.message
+}
+
+// Type returns OUT or ERR depending on the stream the message was written to.
+func (log LogMessage) Type() string {
+	if log.messageType == events.LogMessage_ERR {
+		return "ERR"
+	}
+	return "OUT"
+}
+
+// Timestamp returns the time the message was emitted.
+func (log LogMessage) Timestamp() time.Time {
+	return log.timestamp
+}
+
+// SourceType returns the component that emitted the message, e.g. APP,
+// APP/TASK/migrate or CELL.
+func (log LogMessage) SourceType() string {
+	return log.sourceType
+}
+
+// SourceInstance returns the index of the instance that emitted the message.
+func (log LogMessage) SourceInstance() string {
+	return log.sourceInstance
+}
@@ -0,0 +1,35 @@
+package sharedaction_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/sharedaction"
+	"github.com/cloudfoundry/sonde-go/events"
+	"github.com/gogo/protobuf/proto"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LogMessage", func() {
+	It("returns the fields of the message", func() {
+		message := NewLogMessage("some-message", int(events.LogMessage_ERR), time.Unix(0, 42), "APP/TASK/migrate", "1")
+		Expect(message.Message()).To(Equal("some-message"))
+		Expect(message.Type()).To(Equal("ERR"))
+		Expect(message.Timestamp()).To(Equal(time.Unix(0, 42)))
+		Expect(message.SourceType()).To(Equal("APP/TASK/migrate"))
+		Expect(message.SourceInstance()).To(Equal("1"))
+	})
+
+	Describe("NewLogMessageFromNOAA", func() {
+		It("copies the fields of the NOAA message", func() {
+			message := NewLogMessageFromNOAA(&events.LogMessage{
+				Message:        []byte("some-message"),
+				MessageType:    events.LogMessage_OUT.Enum(),
+				Timestamp:      proto.Int64(42),
+				SourceType:     proto.String("APP"),
+				SourceInstance: proto.String("0"),
+			})
+			Expect(message).To(Equal(NewLogMessage("some-message", int(events.LogMessage_OUT), time.Unix(0, 42), "APP", "0")))
+		})
+	})
+})
@@ -18,6 +18,11 @@ func (application *Application) CalculatedBuildpack() string {
 	return application.DetectedBuildpack
 }
 
+// Started returns true when the application is desired to be running.
+func (application Application) Started() bool {
+	return application.State == ccv2.ApplicationStarted
+}
+
 // ApplicationNotFoundError is returned when a requested application is not
 // found.
 type ApplicationNotFoundError struct {
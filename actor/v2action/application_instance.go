@@ -38,3 +38,10 @@ func (actor Actor) GetApplicationInstancesByApplication(guid string) ([]Applicat
 
 	return appInstances, Warnings(warnings), err
 }
+
+// RestartApplicationInstance stops the instance of the application at the
+// given index so that Cloud Controller replaces it with a new one.
+func (actor Actor) RestartApplicationInstance(appGUID string, index int) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.DeleteApplicationInstance(appGUID, index)
+	return Warnings(warnings), err
+}
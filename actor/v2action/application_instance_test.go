@@ -98,4 +98,19 @@ var _ = Describe("Application Instance Actions", func() {
 			})
 		})
 	})
+
+	Describe("RestartApplicationInstance", func() {
+		It("deletes the instance and returns the warnings", func() {
+			fakeCloudControllerClient.DeleteApplicationInstanceReturns(ccv2.Warnings{"delete-warning"}, errors.New("banana"))
+
+			warnings, err := actor.RestartApplicationInstance("some-app-guid", 2)
+			Expect(err).To(MatchError("banana"))
+			Expect(warnings).To(ConsistOf("delete-warning"))
+
+			Expect(fakeCloudControllerClient.DeleteApplicationInstanceCallCount()).To(Equal(1))
+			appGUID, index := fakeCloudControllerClient.DeleteApplicationInstanceArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(index).To(Equal(2))
+		})
+	})
 })
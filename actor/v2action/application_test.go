@@ -56,6 +56,16 @@ var _ = Describe("Application Actions", func() {
 				})
 			})
 		})
+
+		Describe("Started", func() {
+			It("returns true only when the app is started", func() {
+				app.State = ccv2.ApplicationStarted
+				Expect(app.Started()).To(BeTrue())
+
+				app.State = ccv2.ApplicationStopped
+				Expect(app.Started()).To(BeFalse())
+			})
+		})
 	})
 
 	Describe("GetApplicationBySpace", func() {
@@ -1,17 +1,24 @@
 package v2action
 
-import "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+import (
+	"io"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
 
 //go:generate counterfeiter . CloudControllerClient
 
 // CloudControllerClient is a Cloud Controller V2 client.
 type CloudControllerClient interface {
+	DeleteApplicationInstance(appGUID string, index int) (ccv2.Warnings, error)
+	DownloadApplicationDroplet(appGUID string, droplet io.Writer) (ccv2.Warnings, error)
 	DeleteOrganization(orgGUID string) (ccv2.Job, ccv2.Warnings, error)
 	DeleteRoute(routeGUID string) (ccv2.Warnings, error)
 	DeleteServiceBinding(serviceBindingGUID string) (ccv2.Warnings, error)
 	GetApplicationInstanceStatusesByApplication(guid string) ([]ccv2.ApplicationInstanceStatus, ccv2.Warnings, error)
 	GetApplicationRoutes(appGUID string, queries []ccv2.Query) ([]ccv2.Route, ccv2.Warnings, error)
 	GetApplications(queries []ccv2.Query) ([]ccv2.Application, ccv2.Warnings, error)
+	GetEvents(queries []ccv2.Query) ([]ccv2.Event, ccv2.Warnings, error)
 	GetJob(jobGUID string) (ccv2.Job, ccv2.Warnings, error)
 	GetOrganizations(queries []ccv2.Query) ([]ccv2.Organization, ccv2.Warnings, error)
 	GetPrivateDomain(domainGUID string) (ccv2.Domain, ccv2.Warnings, error)
@@ -27,6 +34,7 @@ type CloudControllerClient interface {
 	PollJob(job ccv2.Job) (ccv2.Warnings, error)
 	TargetCF(settings ccv2.TargetSettings) (ccv2.Warnings, error)
 	UpdateApplication(app ccv2.Application) (ccv2.Application, ccv2.Warnings, error)
+	UploadApplicationDroplet(appGUID string, droplet io.Reader) (ccv2.Job, ccv2.Warnings, error)
 
 	API() string
 	APIVersion() string
@@ -3,6 +3,14 @@ package v2action
 //go:generate counterfeiter . Config
 
 type Config interface {
+	AccessToken() string
+	CACertFile() string
+	ClientCertFile() string
+	ClientKeyFile() string
+	RefreshToken() string
+	SetAccessToken(token string)
+	SetCertificateInformation(caCertFile string, clientCertFile string, clientKeyFile string)
+	SetRefreshToken(token string)
 	UnsetOrganizationInformation()
 	UnsetSpaceInformation()
 	SetTargetInformation(api string, apiVersion string, auth string, minCLIVersion string, doppler string, uaa string, routing string, skipSSLValidation bool)
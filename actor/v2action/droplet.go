@@ -0,0 +1,27 @@
+package v2action
+
+import "io"
+
+// DownloadApplicationDroplet writes the droplet of the application, a gzipped
+// tarball, to droplet.
+func (actor Actor) DownloadApplicationDroplet(appGUID string, droplet io.Writer) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.DownloadApplicationDroplet(appGUID, droplet)
+	return Warnings(warnings), err
+}
+
+// UploadApplicationDroplet uploads droplet, a gzipped tarball of a staged
+// application, as the droplet of the application and waits for the upload to
+// be processed.
+func (actor Actor) UploadApplicationDroplet(appGUID string, droplet io.Reader) (Warnings, error) {
+	var allWarnings Warnings
+
+	job, warnings, err := actor.CloudControllerClient.UploadApplicationDroplet(appGUID, droplet)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	warnings, err = actor.CloudControllerClient.PollJob(job)
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
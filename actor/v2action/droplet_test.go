@@ -0,0 +1,125 @@
+package v2action_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Droplet Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+	})
+
+	Describe("DownloadApplicationDroplet", func() {
+		Context("when the download succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DownloadApplicationDropletStub = func(_ string, droplet io.Writer) (ccv2.Warnings, error) {
+					_, err := droplet.Write([]byte("some-droplet"))
+					return ccv2.Warnings{"download-warning"}, err
+				}
+			})
+
+			It("writes the droplet and returns all warnings", func() {
+				droplet := &bytes.Buffer{}
+				warnings, err := actor.DownloadApplicationDroplet("some-app-guid", droplet)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(droplet.String()).To(Equal("some-droplet"))
+				Expect(warnings).To(ConsistOf("download-warning"))
+
+				Expect(fakeCloudControllerClient.DownloadApplicationDropletCallCount()).To(Equal(1))
+				appGUID, _ := fakeCloudControllerClient.DownloadApplicationDropletArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+			})
+		})
+
+		Context("when the download fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("download failed")
+				fakeCloudControllerClient.DownloadApplicationDropletReturns(ccv2.Warnings{"download-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				warnings, err := actor.DownloadApplicationDroplet("some-app-guid", ioutil.Discard)
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("download-warning"))
+			})
+		})
+	})
+
+	Describe("UploadApplicationDroplet", func() {
+		var droplet io.Reader
+
+		BeforeEach(func() {
+			droplet = bytes.NewBufferString("some-droplet")
+		})
+
+		Context("when the upload succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UploadApplicationDropletReturns(ccv2.Job{GUID: "some-job-guid"}, ccv2.Warnings{"upload-warning"}, nil)
+				fakeCloudControllerClient.PollJobReturns(ccv2.Warnings{"job-warning"}, nil)
+			})
+
+			It("uploads the droplet, waits for the job and returns all warnings", func() {
+				warnings, err := actor.UploadApplicationDroplet("some-app-guid", droplet)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("upload-warning", "job-warning"))
+
+				Expect(fakeCloudControllerClient.UploadApplicationDropletCallCount()).To(Equal(1))
+				appGUID, uploaded := fakeCloudControllerClient.UploadApplicationDropletArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(uploaded).To(Equal(droplet))
+
+				Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.PollJobArgsForCall(0)).To(Equal(ccv2.Job{GUID: "some-job-guid"}))
+			})
+		})
+
+		Context("when the upload fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("upload failed")
+				fakeCloudControllerClient.UploadApplicationDropletReturns(ccv2.Job{}, ccv2.Warnings{"upload-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings without polling", func() {
+				warnings, err := actor.UploadApplicationDroplet("some-app-guid", droplet)
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("upload-warning"))
+				Expect(fakeCloudControllerClient.PollJobCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the job fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("job failed")
+				fakeCloudControllerClient.PollJobReturns(ccv2.Warnings{"job-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				warnings, err := actor.UploadApplicationDroplet("some-app-guid", droplet)
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("job-warning"))
+			})
+		})
+	})
+})
@@ -0,0 +1,93 @@
+package v2action
+
+import (
+	"sort"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+// ApplicationCrashEventType is the type of the event recorded when an
+// application instance exits unexpectedly.
+const ApplicationCrashEventType = "app.crash"
+
+// ApplicationCrash represents an unexpected exit of an application instance.
+type ApplicationCrash struct {
+	// GUID is the GUID of the app.crash event.
+	GUID string
+
+	// Timestamp is the time the instance crashed.
+	Timestamp time.Time
+
+	// InstanceIndex is the index of the instance that crashed.
+	InstanceIndex int
+
+	// ExitStatus is the exit status of the instance's process.
+	ExitStatus int
+
+	// ExitDescription describes why the instance exited.
+	ExitDescription string
+
+	// Reason is the reason reported by the backend, e.g. CRASHED.
+	Reason string
+}
+
+// GetApplicationCrashes returns the crashes of the application that occurred
+// at or after since, newest first.
+func (actor Actor) GetApplicationCrashes(appGUID string, since time.Time) ([]ApplicationCrash, Warnings, error) {
+	ccEvents, warnings, err := actor.CloudControllerClient.GetEvents([]ccv2.Query{
+		{
+			Filter:   ccv2.ActeeFilter,
+			Operator: ccv2.EqualOperator,
+			Value:    appGUID,
+		},
+		{
+			Filter:   ccv2.TypeFilter,
+			Operator: ccv2.EqualOperator,
+			Value:    ApplicationCrashEventType,
+		},
+		{
+			Filter:   ccv2.TimestampFilter,
+			Operator: ccv2.GreaterThanOrEqualOperator,
+			Value:    since.UTC().Format(time.RFC3339),
+		},
+	})
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	crashes := make([]ApplicationCrash, 0, len(ccEvents))
+	for _, event := range ccEvents {
+		crashes = append(crashes, ApplicationCrash{
+			GUID:            event.GUID,
+			Timestamp:       event.Timestamp,
+			InstanceIndex:   metadataInt(event.Metadata, "index"),
+			ExitStatus:      metadataInt(event.Metadata, "exit_status"),
+			ExitDescription: metadataString(event.Metadata, "exit_description"),
+			Reason:          metadataString(event.Metadata, "reason"),
+		})
+	}
+	sort.Stable(crashesByNewest(crashes))
+
+	return crashes, Warnings(warnings), nil
+}
+
+func metadataInt(metadata map[string]interface{}, key string) int {
+	if value, ok := metadata[key].(float64); ok {
+		return int(value)
+	}
+	return 0
+}
+
+func metadataString(metadata map[string]interface{}, key string) string {
+	if value, ok := metadata[key].(string); ok {
+		return value
+	}
+	return ""
+}
+
+type crashesByNewest []ApplicationCrash
+
+func (c crashesByNewest) Len() int           { return len(c) }
+func (c crashesByNewest) Swap(i, j int)      { c[i], c[j] = c[j], c[i] }
+func (c crashesByNewest) Less(i, j int) bool { return c[i].Timestamp.After(c[j].Timestamp) }
@@ -0,0 +1,110 @@
+package v2action_test
+
+import (
+	"errors"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Event Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+	})
+
+	Describe("GetApplicationCrashes", func() {
+		var (
+			since    time.Time
+			crashes  []ApplicationCrash
+			warnings Warnings
+			err      error
+		)
+
+		BeforeEach(func() {
+			since = time.Date(2017, 5, 4, 17, 0, 0, 0, time.FixedZone("CEST", 2*60*60))
+		})
+
+		JustBeforeEach(func() {
+			crashes, warnings, err = actor.GetApplicationCrashes("some-app-guid", since)
+		})
+
+		Context("when the CC API client does not return any errors", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetEventsReturns(
+					[]ccv2.Event{
+						{
+							GUID:      "event-guid-1",
+							Type:      "app.crash",
+							Timestamp: time.Date(2017, 5, 4, 15, 4, 5, 0, time.UTC),
+							Metadata: map[string]interface{}{
+								"index":            float64(1),
+								"exit_status":      float64(137),
+								"exit_description": "out of memory",
+								"reason":           "CRASHED",
+							},
+						},
+						{
+							GUID:      "event-guid-2",
+							Type:      "app.crash",
+							Timestamp: time.Date(2017, 5, 4, 16, 4, 5, 0, time.UTC),
+						},
+					},
+					ccv2.Warnings{"events-warning"},
+					nil,
+				)
+			})
+
+			It("queries the crash events of the application since the given time", func() {
+				Expect(fakeCloudControllerClient.GetEventsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetEventsArgsForCall(0)).To(ConsistOf(
+					ccv2.Query{Filter: ccv2.ActeeFilter, Operator: ccv2.EqualOperator, Value: "some-app-guid"},
+					ccv2.Query{Filter: ccv2.TypeFilter, Operator: ccv2.EqualOperator, Value: "app.crash"},
+					ccv2.Query{Filter: ccv2.TimestampFilter, Operator: ccv2.GreaterThanOrEqualOperator, Value: "2017-05-04T15:00:00Z"},
+				))
+			})
+
+			It("returns the crashes newest first and all warnings", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("events-warning"))
+				Expect(crashes).To(Equal([]ApplicationCrash{
+					{
+						GUID:      "event-guid-2",
+						Timestamp: time.Date(2017, 5, 4, 16, 4, 5, 0, time.UTC),
+					},
+					{
+						GUID:            "event-guid-1",
+						Timestamp:       time.Date(2017, 5, 4, 15, 4, 5, 0, time.UTC),
+						InstanceIndex:   1,
+						ExitStatus:      137,
+						ExitDescription: "out of memory",
+						Reason:          "CRASHED",
+					},
+				}))
+			})
+		})
+
+		Context("when the CC API client returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("events error")
+				fakeCloudControllerClient.GetEventsReturns(nil, ccv2.Warnings{"events-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("events-warning"))
+			})
+		})
+	})
+})
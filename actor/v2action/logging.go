@@ -0,0 +1,38 @@
+package v2action
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"github.com/cloudfoundry/noaa"
+	noaaErrors "github.com/cloudfoundry/noaa/errors"
+)
+
+// LogMessage represents a log message emitted by an application or the
+// platform on its behalf.
+type LogMessage = sharedaction.LogMessage
+
+// GetRecentLogsForApplication returns the log messages of the application
+// that are still buffered by the logging system, oldest first. If the access
+// token has expired it is refreshed once.
+func (actor Actor) GetRecentLogsForApplication(appGUID string, client NOAAClient, config Config) ([]LogMessage, error) {
+	noaaMessages, err := client.RecentLogs(appGUID, config.AccessToken())
+	if _, ok := err.(*noaaErrors.UnauthorizedError); ok {
+		var token string
+		token, err = actor.RefreshAccessToken(config)
+		if err != nil {
+			return nil, err
+		}
+		noaaMessages, err = client.RecentLogs(appGUID, token)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	noaaMessages = noaa.SortRecent(noaaMessages)
+
+	logMessages := make([]LogMessage, 0, len(noaaMessages))
+	for _, message := range noaaMessages {
+		logMessages = append(logMessages, *sharedaction.NewLogMessageFromNOAA(message))
+	}
+
+	return logMessages, nil
+}
@@ -0,0 +1,126 @@
+package v2action_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/uaa"
+	noaaErrors "github.com/cloudfoundry/noaa/errors"
+	"github.com/cloudfoundry/sonde-go/events"
+	"github.com/gogo/protobuf/proto"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Logging Actions", func() {
+	var (
+		actor          Actor
+		fakeUAAClient  *v2actionfakes.FakeUAAClient
+		fakeNOAAClient *v2actionfakes.FakeNOAAClient
+		fakeConfig     *v2actionfakes.FakeConfig
+	)
+
+	BeforeEach(func() {
+		fakeUAAClient = new(v2actionfakes.FakeUAAClient)
+		fakeNOAAClient = new(v2actionfakes.FakeNOAAClient)
+		fakeConfig = new(v2actionfakes.FakeConfig)
+		fakeConfig.AccessTokenReturns("bearer some-access-token")
+		fakeConfig.RefreshTokenReturns("some-refresh-token")
+		actor = NewActor(nil, fakeUAAClient)
+	})
+
+	Describe("GetRecentLogsForApplication", func() {
+		var (
+			messages []LogMessage
+			err      error
+		)
+
+		logMessage := func(message string, timestamp int64) *events.LogMessage {
+			messageType := events.LogMessage_OUT
+			return &events.LogMessage{
+				Message:        []byte(message),
+				MessageType:    &messageType,
+				Timestamp:      proto.Int64(timestamp),
+				SourceType:     proto.String("APP"),
+				SourceInstance: proto.String("0"),
+			}
+		}
+
+		JustBeforeEach(func() {
+			messages, err = actor.GetRecentLogsForApplication("some-app-guid", fakeNOAAClient, fakeConfig)
+		})
+
+		Context("when the logs can be fetched", func() {
+			BeforeEach(func() {
+				fakeNOAAClient.RecentLogsReturns([]*events.LogMessage{
+					logMessage("second", 200),
+					logMessage("first", 100),
+				}, nil)
+			})
+
+			It("returns the messages oldest first", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(messages).To(Equal([]LogMessage{
+					*sharedaction.NewLogMessage("first", int(events.LogMessage_OUT), time.Unix(0, 100), "APP", "0"),
+					*sharedaction.NewLogMessage("second", int(events.LogMessage_OUT), time.Unix(0, 200), "APP", "0"),
+				}))
+
+				appGUID, token := fakeNOAAClient.RecentLogsArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(token).To(Equal("bearer some-access-token"))
+			})
+		})
+
+		Context("when the access token has expired", func() {
+			BeforeEach(func() {
+				fakeNOAAClient.RecentLogsStub = func(appGUID string, authToken string) ([]*events.LogMessage, error) {
+					if authToken == "bearer some-access-token" {
+						return nil, noaaErrors.NewUnauthorizedError("expired")
+					}
+					return []*events.LogMessage{logMessage("hello", 100)}, nil
+				}
+				fakeUAAClient.RefreshAccessTokenReturns(uaa.RefreshToken{
+					AccessToken:  "new-access-token",
+					RefreshToken: "new-refresh-token",
+					Type:         "bearer",
+				}, nil)
+			})
+
+			It("refreshes the token, stores it and tries again", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(messages).To(HaveLen(1))
+
+				Expect(fakeUAAClient.RefreshAccessTokenArgsForCall(0)).To(Equal("some-refresh-token"))
+				Expect(fakeConfig.SetAccessTokenArgsForCall(0)).To(Equal("bearer new-access-token"))
+				Expect(fakeConfig.SetRefreshTokenArgsForCall(0)).To(Equal("new-refresh-token"))
+				Expect(fakeNOAAClient.RecentLogsCallCount()).To(Equal(2))
+				_, token := fakeNOAAClient.RecentLogsArgsForCall(1)
+				Expect(token).To(Equal("bearer new-access-token"))
+			})
+
+			Context("when the token cannot be refreshed", func() {
+				BeforeEach(func() {
+					fakeUAAClient.RefreshAccessTokenReturns(uaa.RefreshToken{}, errors.New("refresh failed"))
+				})
+
+				It("returns the error", func() {
+					Expect(err).To(MatchError("refresh failed"))
+				})
+			})
+		})
+
+		Context("when the logs cannot be fetched", func() {
+			BeforeEach(func() {
+				fakeNOAAClient.RecentLogsReturns(nil, errors.New("connection refused"))
+			})
+
+			It("returns the error", func() {
+				Expect(err).To(MatchError("connection refused"))
+				Expect(fakeUAAClient.RefreshAccessTokenCallCount()).To(BeZero())
+			})
+		})
+	})
+})
@@ -0,0 +1,10 @@
+package v2action
+
+import "github.com/cloudfoundry/sonde-go/events"
+
+//go:generate counterfeiter . NOAAClient
+
+// NOAAClient is a client for getting logs.
+type NOAAClient interface {
+	RecentLogs(appGUID string, authToken string) ([]*events.LogMessage, error)
+}
@@ -0,0 +1,24 @@
+package v2action
+
+import "code.cloudfoundry.org/cli/api/uaa"
+
+// AccessToken represents the decoded access token of the current session.
+type AccessToken uaa.AccessToken
+
+// HasScope returns true if the token was granted scope.
+func (token AccessToken) HasScope(scope string) bool {
+	return uaa.AccessToken(token).HasScope(scope)
+}
+
+// RefreshAccessToken gets a new access token from the UAA with the refresh
+// token in config, stores the new tokens in config and returns the access
+// token.
+func (actor Actor) RefreshAccessToken(config Config) (string, error) {
+	return uaa.RefreshStoredAccessToken(actor.UAAClient, config)
+}
+
+// DecodeAccessToken decodes the claims of the access token.
+func (actor Actor) DecodeAccessToken(accessToken string) (AccessToken, error) {
+	token, err := uaa.DecodeAccessToken(accessToken)
+	return AccessToken(token), err
+}
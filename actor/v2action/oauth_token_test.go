@@ -0,0 +1,81 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/uaa"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("OAuth Token Actions", func() {
+	var (
+		actor         Actor
+		fakeUAAClient *v2actionfakes.FakeUAAClient
+		fakeConfig    *v2actionfakes.FakeConfig
+	)
+
+	BeforeEach(func() {
+		fakeUAAClient = new(v2actionfakes.FakeUAAClient)
+		fakeConfig = new(v2actionfakes.FakeConfig)
+		actor = NewActor(nil, fakeUAAClient)
+	})
+
+	Describe("RefreshAccessToken", func() {
+		BeforeEach(func() {
+			fakeConfig.RefreshTokenReturns("some-refresh-token")
+		})
+
+		Context("when the refresh succeeds", func() {
+			BeforeEach(func() {
+				fakeUAAClient.RefreshAccessTokenReturns(uaa.RefreshToken{
+					AccessToken:  "some-access-token",
+					RefreshToken: "new-refresh-token",
+					Type:         "bearer",
+				}, nil)
+			})
+
+			It("stores and returns the new access token", func() {
+				token, err := actor.RefreshAccessToken(fakeConfig)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(token).To(Equal("bearer some-access-token"))
+
+				Expect(fakeUAAClient.RefreshAccessTokenArgsForCall(0)).To(Equal("some-refresh-token"))
+				Expect(fakeConfig.SetAccessTokenArgsForCall(0)).To(Equal("bearer some-access-token"))
+				Expect(fakeConfig.SetRefreshTokenArgsForCall(0)).To(Equal("new-refresh-token"))
+			})
+		})
+
+		Context("when the refresh fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("refresh failed")
+				fakeUAAClient.RefreshAccessTokenReturns(uaa.RefreshToken{}, expectedErr)
+			})
+
+			It("returns the error", func() {
+				_, err := actor.RefreshAccessToken(fakeConfig)
+				Expect(err).To(MatchError(expectedErr))
+				Expect(fakeConfig.SetAccessTokenCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("DecodeAccessToken", func() {
+		It("returns an error when the token is not a JWT", func() {
+			_, err := actor.DecodeAccessToken("bearer some-opaque-token")
+			Expect(err).To(MatchError(uaa.InvalidAccessTokenError{}))
+		})
+	})
+
+	Describe("AccessToken", func() {
+		It("has the scopes it was granted", func() {
+			token := AccessToken{Scopes: []string{"openid", "cloud_controller.admin"}}
+			Expect(token.HasScope("cloud_controller.admin")).To(BeTrue())
+			Expect(token.HasScope("uaa.admin")).To(BeFalse())
+		})
+	})
+})
@@ -7,7 +7,11 @@ type TargetSettings ccv2.TargetSettings
 // SetTarget targets the Cloud Controller using the client and sets target
 // information in the actor based on the response.
 func (actor Actor) SetTarget(config Config, settings TargetSettings) (Warnings, error) {
-	if config.Target() == settings.URL && config.SkipSSLValidation() == settings.SkipSSLValidation {
+	if config.Target() == settings.URL &&
+		config.SkipSSLValidation() == settings.SkipSSLValidation &&
+		config.CACertFile() == settings.CACertFile &&
+		config.ClientCertFile() == settings.ClientCertFile &&
+		config.ClientKeyFile() == settings.ClientKeyFile {
 		return nil, nil
 	}
 
@@ -26,6 +30,7 @@ func (actor Actor) SetTarget(config Config, settings TargetSettings) (Warnings,
 		actor.CloudControllerClient.RoutingEndpoint(),
 		settings.SkipSSLValidation,
 	)
+	config.SetCertificateInformation(settings.CACertFile, settings.ClientCertFile, settings.ClientKeyFile)
 	config.SetTokenInformation("", "", "")
 
 	return Warnings(warnings), nil
@@ -34,6 +39,7 @@ func (actor Actor) SetTarget(config Config, settings TargetSettings) (Warnings,
 // ClearTarget clears target information from the actor.
 func (actor Actor) ClearTarget(config Config) {
 	config.SetTargetInformation("", "", "", "", "", "", "", false)
+	config.SetCertificateInformation("", "", "")
 	config.SetTokenInformation("", "", "")
 }
 
@@ -78,6 +78,22 @@ var _ = Describe("Targgeting", func() {
 			Expect(sslDisabled).To(Equal(skipSSLValidation))
 		})
 
+		It("sets the certificate files", func() {
+			settings.CACertFile = "/some/ca.pem"
+			settings.ClientCertFile = "/some/cert.pem"
+			settings.ClientKeyFile = "/some/key.pem"
+
+			_, err := actor.SetTarget(fakeConfig, settings)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeCloudControllerClient.TargetCFArgsForCall(0).CACertFile).To(Equal("/some/ca.pem"))
+			Expect(fakeConfig.SetCertificateInformationCallCount()).To(Equal(1))
+			caCertFile, clientCertFile, clientKeyFile := fakeConfig.SetCertificateInformationArgsForCall(0)
+			Expect(caCertFile).To(Equal("/some/ca.pem"))
+			Expect(clientCertFile).To(Equal("/some/cert.pem"))
+			Expect(clientKeyFile).To(Equal("/some/key.pem"))
+		})
+
 		It("clears all the token information", func() {
 			_, err := actor.SetTarget(fakeConfig, settings)
 			Expect(err).ToNot(HaveOccurred())
@@ -107,6 +123,19 @@ var _ = Describe("Targgeting", func() {
 
 				Expect(fakeCloudControllerClient.TargetCFCallCount()).To(BeZero())
 			})
+
+			Context("when the certificate files are different", func() {
+				BeforeEach(func() {
+					settings.CACertFile = "/some/ca.pem"
+				})
+
+				It("targets the API again", func() {
+					_, err := actor.SetTarget(fakeConfig, settings)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeCloudControllerClient.TargetCFCallCount()).To(Equal(1))
+				})
+			})
 		})
 	})
 
@@ -127,6 +156,16 @@ var _ = Describe("Targgeting", func() {
 			Expect(sslDisabled).To(BeFalse())
 		})
 
+		It("clears the certificate files", func() {
+			actor.ClearTarget(fakeConfig)
+
+			Expect(fakeConfig.SetCertificateInformationCallCount()).To(Equal(1))
+			caCertFile, clientCertFile, clientKeyFile := fakeConfig.SetCertificateInformationArgsForCall(0)
+			Expect(caCertFile).To(BeEmpty())
+			Expect(clientCertFile).To(BeEmpty())
+			Expect(clientKeyFile).To(BeEmpty())
+		})
+
 		It("clears all the token information", func() {
 			actor.ClearTarget(fakeConfig)
 
@@ -5,5 +5,12 @@ import "code.cloudfoundry.org/cli/api/uaa"
 //go:generate counterfeiter . UAAClient
 
 type UAAClient interface {
+	AddGroupMember(groupID string, userID string, origin string) error
+	GetGroupsByName(name string) ([]uaa.Group, error)
+	GetUsers(filter string) ([]uaa.User, error)
 	NewUser(username string, password string, origin string) (uaa.User, error)
+	RefreshAccessToken(refreshToken string) (uaa.RefreshToken, error)
+	RemoveGroupMember(groupID string, userID string) error
+	SetUserActive(userID string, active bool) (uaa.User, error)
+	SetUserPassword(userID string, password string) error
 }
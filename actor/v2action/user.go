@@ -1,10 +1,135 @@
 package v2action
 
-import "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/uaa"
+)
+
+// generatedPasswordBytes is the number of random bytes in a generated
+// password.
+const generatedPasswordBytes = 18
 
 // User represents a CLI user.
 type User ccv2.User
 
+// UAAUser represents a user account in the UAA.
+type UAAUser uaa.User
+
+// GroupNames returns the names of the groups the user is a member of.
+func (user UAAUser) GroupNames() []string {
+	var names []string
+	for _, group := range user.Groups {
+		names = append(names, group.Name)
+	}
+	return names
+}
+
+// IsDirectMemberOf returns true if the user was added to the group, rather
+// than being a member through another group.
+func (user UAAUser) IsDirectMemberOf(groupName string) bool {
+	for _, group := range user.Groups {
+		if group.Name == groupName && group.Type != uaa.IndirectMembership {
+			return true
+		}
+	}
+	return false
+}
+
+// IsIndirectMemberOf returns true if the user is a member of the group
+// through another group.
+func (user UAAUser) IsIndirectMemberOf(groupName string) bool {
+	for _, group := range user.Groups {
+		if group.Name == groupName && group.Type == uaa.IndirectMembership {
+			return true
+		}
+	}
+	return false
+}
+
+// UserFilter selects the UAA users returned by GetUAAUsers. Empty fields
+// select all users.
+type UserFilter struct {
+	// Search matches users whose username contains it.
+	Search string
+
+	// Origin matches users from the identity provider.
+	Origin string
+
+	// SCIMFilter is a SCIM filter expression that users must match.
+	SCIMFilter string
+}
+
+// UserNotFoundError is returned when a requested user is not found.
+type UserNotFoundError struct {
+	Username string
+	Origin   string
+}
+
+func (e UserNotFoundError) Error() string {
+	if e.Origin == "" {
+		return fmt.Sprintf("User '%s' not found.", e.Username)
+	}
+	return fmt.Sprintf("User '%s' with origin '%s' not found.", e.Username, e.Origin)
+}
+
+// MultipleUsersFoundError is returned when a username exists in several
+// origins and no origin was given to choose between them.
+type MultipleUsersFoundError struct {
+	Username string
+	Origins  []string
+}
+
+func (e MultipleUsersFoundError) Error() string {
+	return fmt.Sprintf("User '%s' exists in several origins: %s", e.Username, strings.Join(e.Origins, ", "))
+}
+
+// GroupNotFoundError is returned when a requested group is not found.
+type GroupNotFoundError struct {
+	Name string
+}
+
+func (e GroupNotFoundError) Error() string {
+	return fmt.Sprintf("Group '%s' not found.", e.Name)
+}
+
+// UserAlreadyInGroupError is returned when a user is added to a group it is
+// already a member of.
+type UserAlreadyInGroupError struct {
+	Username string
+	Group    string
+}
+
+func (e UserAlreadyInGroupError) Error() string {
+	return fmt.Sprintf("User '%s' is already a member of group '%s'.", e.Username, e.Group)
+}
+
+// UserInheritsGroupError is returned when a user is removed from a group it
+// is only a member of through another group.
+type UserInheritsGroupError struct {
+	Username string
+	Group    string
+}
+
+func (e UserInheritsGroupError) Error() string {
+	return fmt.Sprintf("User '%s' is a member of group '%s' through another group.", e.Username, e.Group)
+}
+
+// UserNotInGroupError is returned when a user is removed from a group it is
+// not a member of.
+type UserNotInGroupError struct {
+	Username string
+	Group    string
+}
+
+func (e UserNotInGroupError) Error() string {
+	return fmt.Sprintf("User '%s' is not a member of group '%s'.", e.Username, e.Group)
+}
+
 // NewUser creates a new user in UAA and registers it with cloud controller.
 func (actor Actor) NewUser(username string, password string, origin string) (User, Warnings, error) {
 	uaaUser, err := actor.UAAClient.NewUser(username, password, origin)
@@ -16,3 +141,135 @@ func (actor Actor) NewUser(username string, password string, origin string) (Use
 
 	return User(ccUser), Warnings(ccWarnings), err
 }
+
+// GetUAAUsers returns the UAA users that match filter, sorted by username.
+func (actor Actor) GetUAAUsers(filter UserFilter) ([]UAAUser, error) {
+	var search, origin string
+	if filter.Search != "" {
+		search = uaa.FilterContains("userName", filter.Search)
+	}
+	if filter.Origin != "" {
+		origin = uaa.FilterEqual("origin", filter.Origin)
+	}
+
+	uaaUsers, err := actor.UAAClient.GetUsers(uaa.FilterAnd(search, origin, filter.SCIMFilter))
+	if err != nil {
+		return nil, err
+	}
+
+	var users []UAAUser
+	for _, uaaUser := range uaaUsers {
+		users = append(users, UAAUser(uaaUser))
+	}
+	return users, nil
+}
+
+// GetUAAUserByName returns the UAA user with the username. If origin is
+// empty, the username must exist in only one origin.
+func (actor Actor) GetUAAUserByName(username string, origin string) (UAAUser, error) {
+	filter := uaa.FilterEqual("userName", username)
+	if origin != "" {
+		filter = uaa.FilterAnd(filter, uaa.FilterEqual("origin", origin))
+	}
+
+	uaaUsers, err := actor.UAAClient.GetUsers(filter)
+	if err != nil {
+		return UAAUser{}, err
+	}
+
+	switch len(uaaUsers) {
+	case 0:
+		return UAAUser{}, UserNotFoundError{Username: username, Origin: origin}
+	case 1:
+		return UAAUser(uaaUsers[0]), nil
+	default:
+		var origins []string
+		for _, uaaUser := range uaaUsers {
+			origins = append(origins, uaaUser.Origin)
+		}
+		return UAAUser{}, MultipleUsersFoundError{Username: username, Origins: origins}
+	}
+}
+
+// SetUAAUserActive activates or deactivates the user. A deactivated user
+// cannot log in.
+func (actor Actor) SetUAAUserActive(user UAAUser, active bool) (UAAUser, error) {
+	uaaUser, err := actor.UAAClient.SetUserActive(user.ID, active)
+	return UAAUser(uaaUser), err
+}
+
+// SetUAAUserPassword sets the password of the user.
+func (actor Actor) SetUAAUserPassword(user UAAUser, password string) error {
+	return actor.UAAClient.SetUserPassword(user.ID, password)
+}
+
+// ResetUAAUserPassword sets the password of the user to a randomly generated
+// one, and returns it.
+func (actor Actor) ResetUAAUserPassword(user UAAUser) (string, error) {
+	randomBytes := make([]byte, generatedPasswordBytes)
+	_, err := rand.Read(randomBytes)
+	if err != nil {
+		return "", err
+	}
+	password := base64.RawURLEncoding.EncodeToString(randomBytes)
+
+	err = actor.UAAClient.SetUserPassword(user.ID, password)
+	if err != nil {
+		return "", err
+	}
+	return password, nil
+}
+
+// AddUAAUserToGroup adds the user to the group, which grants the user the
+// scope of the same name. A user that is only a member of the group through
+// another group is added to it directly.
+func (actor Actor) AddUAAUserToGroup(user UAAUser, groupName string) error {
+	if user.IsDirectMemberOf(groupName) {
+		return UserAlreadyInGroupError{Username: user.Username, Group: groupName}
+	}
+
+	group, err := actor.getGroupByName(groupName)
+	if err != nil {
+		return err
+	}
+
+	err = actor.UAAClient.AddGroupMember(group.ID, user.ID, user.Origin)
+	if _, ok := err.(uaa.ConflictError); ok {
+		return UserAlreadyInGroupError{Username: user.Username, Group: groupName}
+	}
+	return err
+}
+
+// RemoveUAAUserFromGroup removes the user from the group. Only the user's
+// direct membership can be removed; a user that is a member of the group
+// through another group stays a member until it is removed from that group.
+func (actor Actor) RemoveUAAUserFromGroup(user UAAUser, groupName string) error {
+	if !user.IsDirectMemberOf(groupName) {
+		if user.IsIndirectMemberOf(groupName) {
+			return UserInheritsGroupError{Username: user.Username, Group: groupName}
+		}
+		return UserNotInGroupError{Username: user.Username, Group: groupName}
+	}
+
+	group, err := actor.getGroupByName(groupName)
+	if err != nil {
+		return err
+	}
+
+	err = actor.UAAClient.RemoveGroupMember(group.ID, user.ID)
+	if _, ok := err.(uaa.ResourceNotFoundError); ok {
+		return UserNotInGroupError{Username: user.Username, Group: groupName}
+	}
+	return err
+}
+
+func (actor Actor) getGroupByName(groupName string) (uaa.Group, error) {
+	groups, err := actor.UAAClient.GetGroupsByName(groupName)
+	if err != nil {
+		return uaa.Group{}, err
+	}
+	if len(groups) == 0 {
+		return uaa.Group{}, GroupNotFoundError{Name: groupName}
+	}
+	return groups[0], nil
+}
@@ -121,4 +121,226 @@ var _ = Describe("User Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetUAAUsers", func() {
+		BeforeEach(func() {
+			fakeUAAClient.GetUsersReturns([]uaa.User{
+				{ID: "user-id-1", Username: "alice@example.com"},
+				{ID: "user-id-2", Username: "alicia@example.com"},
+			}, nil)
+		})
+
+		It("combines the filters and returns the users", func() {
+			users, err := actor.GetUAAUsers(UserFilter{
+				Search:     "ali",
+				Origin:     "uaa",
+				SCIMFilter: "active eq true",
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(users).To(Equal([]UAAUser{
+				{ID: "user-id-1", Username: "alice@example.com"},
+				{ID: "user-id-2", Username: "alicia@example.com"},
+			}))
+
+			Expect(fakeUAAClient.GetUsersCallCount()).To(Equal(1))
+			Expect(fakeUAAClient.GetUsersArgsForCall(0)).To(Equal(`(userName co "ali") and (origin eq "uaa") and (active eq true)`))
+		})
+
+		Context("when no filter is given", func() {
+			It("returns all users", func() {
+				_, err := actor.GetUAAUsers(UserFilter{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeUAAClient.GetUsersArgsForCall(0)).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("GetUAAUserByName", func() {
+		Context("when the user exists in one origin", func() {
+			BeforeEach(func() {
+				fakeUAAClient.GetUsersReturns([]uaa.User{
+					{ID: "user-id", Username: "alice", Origin: "ldap"},
+				}, nil)
+			})
+
+			It("returns the user", func() {
+				user, err := actor.GetUAAUserByName("alice", "ldap")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(user.ID).To(Equal("user-id"))
+				Expect(fakeUAAClient.GetUsersArgsForCall(0)).To(Equal(`(userName eq "alice") and (origin eq "ldap")`))
+			})
+		})
+
+		Context("when the user does not exist", func() {
+			BeforeEach(func() {
+				fakeUAAClient.GetUsersReturns(nil, nil)
+			})
+
+			It("returns a UserNotFoundError", func() {
+				_, err := actor.GetUAAUserByName("alice", "")
+				Expect(err).To(MatchError(UserNotFoundError{Username: "alice"}))
+				Expect(fakeUAAClient.GetUsersArgsForCall(0)).To(Equal(`userName eq "alice"`))
+			})
+		})
+
+		Context("when the user exists in several origins", func() {
+			BeforeEach(func() {
+				fakeUAAClient.GetUsersReturns([]uaa.User{
+					{ID: "user-id-1", Username: "alice", Origin: "uaa"},
+					{ID: "user-id-2", Username: "alice", Origin: "ldap"},
+				}, nil)
+			})
+
+			It("returns a MultipleUsersFoundError", func() {
+				_, err := actor.GetUAAUserByName("alice", "")
+				Expect(err).To(MatchError(MultipleUsersFoundError{
+					Username: "alice",
+					Origins:  []string{"uaa", "ldap"},
+				}))
+			})
+		})
+	})
+
+	Describe("ResetUAAUserPassword", func() {
+		It("sets a generated password and returns it", func() {
+			password, err := actor.ResetUAAUserPassword(UAAUser{ID: "user-id"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(password).To(HaveLen(24))
+
+			Expect(fakeUAAClient.SetUserPasswordCallCount()).To(Equal(1))
+			userID, setPassword := fakeUAAClient.SetUserPasswordArgsForCall(0)
+			Expect(userID).To(Equal("user-id"))
+			Expect(setPassword).To(Equal(password))
+		})
+
+		Context("when setting the password fails", func() {
+			BeforeEach(func() {
+				fakeUAAClient.SetUserPasswordReturns(uaa.InsufficientScopeError{Message: "Insufficient scope for this resource"})
+			})
+
+			It("returns the error", func() {
+				_, err := actor.ResetUAAUserPassword(UAAUser{ID: "user-id"})
+				Expect(err).To(MatchError(uaa.InsufficientScopeError{Message: "Insufficient scope for this resource"}))
+			})
+		})
+	})
+
+	Describe("AddUAAUserToGroup", func() {
+		var user UAAUser
+
+		BeforeEach(func() {
+			user = UAAUser{
+				ID:       "user-id",
+				Username: "alice",
+				Origin:   "ldap",
+				Groups: []uaa.UserGroup{
+					{ID: "group-id-1", Name: "openid", Type: uaa.DirectMembership},
+					{ID: "group-id-2", Name: "scim.read", Type: uaa.IndirectMembership},
+				},
+			}
+		})
+
+		Context("when the group exists", func() {
+			BeforeEach(func() {
+				fakeUAAClient.GetGroupsByNameReturns([]uaa.Group{{ID: "group-id-2", Name: "scim.read"}}, nil)
+			})
+
+			It("adds the user to the group", func() {
+				err := actor.AddUAAUserToGroup(user, "scim.read")
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(fakeUAAClient.GetGroupsByNameArgsForCall(0)).To(Equal("scim.read"))
+				Expect(fakeUAAClient.AddGroupMemberCallCount()).To(Equal(1))
+				groupID, userID, origin := fakeUAAClient.AddGroupMemberArgsForCall(0)
+				Expect(groupID).To(Equal("group-id-2"))
+				Expect(userID).To(Equal("user-id"))
+				Expect(origin).To(Equal("ldap"))
+			})
+		})
+
+		Context("when the group does not exist", func() {
+			BeforeEach(func() {
+				fakeUAAClient.GetGroupsByNameReturns(nil, nil)
+			})
+
+			It("returns a GroupNotFoundError", func() {
+				err := actor.AddUAAUserToGroup(user, "scim.read")
+				Expect(err).To(MatchError(GroupNotFoundError{Name: "scim.read"}))
+				Expect(fakeUAAClient.AddGroupMemberCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the user is already a member of the group", func() {
+			It("returns a UserAlreadyInGroupError", func() {
+				err := actor.AddUAAUserToGroup(user, "openid")
+				Expect(err).To(MatchError(UserAlreadyInGroupError{Username: "alice", Group: "openid"}))
+				Expect(fakeUAAClient.AddGroupMemberCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the user is only a member of the group through another group", func() {
+			BeforeEach(func() {
+				fakeUAAClient.GetGroupsByNameReturns([]uaa.Group{{ID: "group-id-2", Name: "scim.read"}}, nil)
+			})
+
+			It("adds the user to the group directly", func() {
+				err := actor.AddUAAUserToGroup(user, "scim.read")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeUAAClient.AddGroupMemberCallCount()).To(Equal(1))
+			})
+		})
+	})
+
+	Describe("RemoveUAAUserFromGroup", func() {
+		var user UAAUser
+
+		BeforeEach(func() {
+			user = UAAUser{
+				ID:       "user-id",
+				Username: "alice",
+				Groups: []uaa.UserGroup{
+					{ID: "group-id", Name: "scim.read", Type: uaa.DirectMembership},
+					{ID: "other-group-id", Name: "scim.write", Type: uaa.IndirectMembership},
+				},
+			}
+			fakeUAAClient.GetGroupsByNameReturns([]uaa.Group{{ID: "group-id", Name: "scim.read"}}, nil)
+		})
+
+		It("removes the user from the group", func() {
+			err := actor.RemoveUAAUserFromGroup(user, "scim.read")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeUAAClient.RemoveGroupMemberCallCount()).To(Equal(1))
+			groupID, userID := fakeUAAClient.RemoveGroupMemberArgsForCall(0)
+			Expect(groupID).To(Equal("group-id"))
+			Expect(userID).To(Equal("user-id"))
+		})
+
+		Context("when the user is not a member of the group", func() {
+			It("returns a UserNotInGroupError", func() {
+				err := actor.RemoveUAAUserFromGroup(user, "openid")
+				Expect(err).To(MatchError(UserNotInGroupError{Username: "alice", Group: "openid"}))
+				Expect(fakeUAAClient.RemoveGroupMemberCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the user is only a member of the group through another group", func() {
+			It("returns a UserInheritsGroupError", func() {
+				err := actor.RemoveUAAUserFromGroup(user, "scim.write")
+				Expect(err).To(MatchError(UserInheritsGroupError{Username: "alice", Group: "scim.write"}))
+				Expect(fakeUAAClient.RemoveGroupMemberCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the membership no longer exists", func() {
+			BeforeEach(func() {
+				fakeUAAClient.RemoveGroupMemberReturns(uaa.ResourceNotFoundError{Message: "Member user-id does not exist in group group-id"})
+			})
+
+			It("returns a UserNotInGroupError", func() {
+				err := actor.RemoveUAAUserFromGroup(user, "scim.read")
+				Expect(err).To(MatchError(UserNotInGroupError{Username: "alice", Group: "scim.read"}))
+			})
+		})
+	})
 })
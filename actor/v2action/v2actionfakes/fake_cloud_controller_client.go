@@ -2,6 +2,7 @@
 package v2actionfakes
 
 import (
+	"io"
 	"sync"
 
 	"code.cloudfoundry.org/cli/actor/v2action"
@@ -9,6 +10,26 @@ import (
 )
 
 type FakeCloudControllerClient struct {
+	DeleteApplicationInstanceStub        func(appGUID string, index int) (ccv2.Warnings, error)
+	deleteApplicationInstanceMutex       sync.RWMutex
+	deleteApplicationInstanceArgsForCall []struct {
+		appGUID string
+		index   int
+	}
+	deleteApplicationInstanceReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	DownloadApplicationDropletStub        func(appGUID string, droplet io.Writer) (ccv2.Warnings, error)
+	downloadApplicationDropletMutex       sync.RWMutex
+	downloadApplicationDropletArgsForCall []struct {
+		appGUID string
+		droplet io.Writer
+	}
+	downloadApplicationDropletReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	DeleteOrganizationStub        func(orgGUID string) (ccv2.Job, ccv2.Warnings, error)
 	deleteOrganizationMutex       sync.RWMutex
 	deleteOrganizationArgsForCall []struct {
@@ -68,6 +89,16 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetEventsStub        func(queries []ccv2.Query) ([]ccv2.Event, ccv2.Warnings, error)
+	getEventsMutex       sync.RWMutex
+	getEventsArgsForCall []struct {
+		queries []ccv2.Query
+	}
+	getEventsReturns struct {
+		result1 []ccv2.Event
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetJobStub        func(jobGUID string) (ccv2.Job, ccv2.Warnings, error)
 	getJobMutex       sync.RWMutex
 	getJobArgsForCall []struct {
@@ -220,6 +251,17 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	UploadApplicationDropletStub        func(appGUID string, droplet io.Reader) (ccv2.Job, ccv2.Warnings, error)
+	uploadApplicationDropletMutex       sync.RWMutex
+	uploadApplicationDropletArgsForCall []struct {
+		appGUID string
+		droplet io.Reader
+	}
+	uploadApplicationDropletReturns struct {
+		result1 ccv2.Job
+		result2 ccv2.Warnings
+		result3 error
+	}
 	APIStub        func() string
 	aPIMutex       sync.RWMutex
 	aPIArgsForCall []struct{}
@@ -266,6 +308,76 @@ type FakeCloudControllerClient struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeCloudControllerClient) DeleteApplicationInstance(appGUID string, index int) (ccv2.Warnings, error) {
+	fake.deleteApplicationInstanceMutex.Lock()
+	fake.deleteApplicationInstanceArgsForCall = append(fake.deleteApplicationInstanceArgsForCall, struct {
+		appGUID string
+		index   int
+	}{appGUID, index})
+	fake.recordInvocation("DeleteApplicationInstance", []interface{}{appGUID, index})
+	fake.deleteApplicationInstanceMutex.Unlock()
+	if fake.DeleteApplicationInstanceStub != nil {
+		return fake.DeleteApplicationInstanceStub(appGUID, index)
+	} else {
+		return fake.deleteApplicationInstanceReturns.result1, fake.deleteApplicationInstanceReturns.result2
+	}
+}
+
+func (fake *FakeCloudControllerClient) DeleteApplicationInstanceCallCount() int {
+	fake.deleteApplicationInstanceMutex.RLock()
+	defer fake.deleteApplicationInstanceMutex.RUnlock()
+	return len(fake.deleteApplicationInstanceArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteApplicationInstanceArgsForCall(i int) (string, int) {
+	fake.deleteApplicationInstanceMutex.RLock()
+	defer fake.deleteApplicationInstanceMutex.RUnlock()
+	return fake.deleteApplicationInstanceArgsForCall[i].appGUID, fake.deleteApplicationInstanceArgsForCall[i].index
+}
+
+func (fake *FakeCloudControllerClient) DeleteApplicationInstanceReturns(result1 ccv2.Warnings, result2 error) {
+	fake.DeleteApplicationInstanceStub = nil
+	fake.deleteApplicationInstanceReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DownloadApplicationDroplet(appGUID string, droplet io.Writer) (ccv2.Warnings, error) {
+	fake.downloadApplicationDropletMutex.Lock()
+	fake.downloadApplicationDropletArgsForCall = append(fake.downloadApplicationDropletArgsForCall, struct {
+		appGUID string
+		droplet io.Writer
+	}{appGUID, droplet})
+	fake.recordInvocation("DownloadApplicationDroplet", []interface{}{appGUID, droplet})
+	fake.downloadApplicationDropletMutex.Unlock()
+	if fake.DownloadApplicationDropletStub != nil {
+		return fake.DownloadApplicationDropletStub(appGUID, droplet)
+	} else {
+		return fake.downloadApplicationDropletReturns.result1, fake.downloadApplicationDropletReturns.result2
+	}
+}
+
+func (fake *FakeCloudControllerClient) DownloadApplicationDropletCallCount() int {
+	fake.downloadApplicationDropletMutex.RLock()
+	defer fake.downloadApplicationDropletMutex.RUnlock()
+	return len(fake.downloadApplicationDropletArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DownloadApplicationDropletArgsForCall(i int) (string, io.Writer) {
+	fake.downloadApplicationDropletMutex.RLock()
+	defer fake.downloadApplicationDropletMutex.RUnlock()
+	return fake.downloadApplicationDropletArgsForCall[i].appGUID, fake.downloadApplicationDropletArgsForCall[i].droplet
+}
+
+func (fake *FakeCloudControllerClient) DownloadApplicationDropletReturns(result1 ccv2.Warnings, result2 error) {
+	fake.DownloadApplicationDropletStub = nil
+	fake.downloadApplicationDropletReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) DeleteOrganization(orgGUID string) (ccv2.Job, ccv2.Warnings, error) {
 	fake.deleteOrganizationMutex.Lock()
 	fake.deleteOrganizationArgsForCall = append(fake.deleteOrganizationArgsForCall, struct {
@@ -485,6 +597,46 @@ func (fake *FakeCloudControllerClient) GetApplicationsReturns(result1 []ccv2.App
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetEvents(queries []ccv2.Query) ([]ccv2.Event, ccv2.Warnings, error) {
+	var queriesCopy []ccv2.Query
+	if queries != nil {
+		queriesCopy = make([]ccv2.Query, len(queries))
+		copy(queriesCopy, queries)
+	}
+	fake.getEventsMutex.Lock()
+	fake.getEventsArgsForCall = append(fake.getEventsArgsForCall, struct {
+		queries []ccv2.Query
+	}{queriesCopy})
+	fake.recordInvocation("GetEvents", []interface{}{queriesCopy})
+	fake.getEventsMutex.Unlock()
+	if fake.GetEventsStub != nil {
+		return fake.GetEventsStub(queries)
+	} else {
+		return fake.getEventsReturns.result1, fake.getEventsReturns.result2, fake.getEventsReturns.result3
+	}
+}
+
+func (fake *FakeCloudControllerClient) GetEventsCallCount() int {
+	fake.getEventsMutex.RLock()
+	defer fake.getEventsMutex.RUnlock()
+	return len(fake.getEventsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetEventsArgsForCall(i int) []ccv2.Query {
+	fake.getEventsMutex.RLock()
+	defer fake.getEventsMutex.RUnlock()
+	return fake.getEventsArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetEventsReturns(result1 []ccv2.Event, result2 ccv2.Warnings, result3 error) {
+	fake.GetEventsStub = nil
+	fake.getEventsReturns = struct {
+		result1 []ccv2.Event
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetJob(jobGUID string) (ccv2.Job, ccv2.Warnings, error) {
 	fake.getJobMutex.Lock()
 	fake.getJobArgsForCall = append(fake.getJobArgsForCall, struct {
@@ -1047,6 +1199,42 @@ func (fake *FakeCloudControllerClient) UpdateApplicationReturns(result1 ccv2.App
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) UploadApplicationDroplet(appGUID string, droplet io.Reader) (ccv2.Job, ccv2.Warnings, error) {
+	fake.uploadApplicationDropletMutex.Lock()
+	fake.uploadApplicationDropletArgsForCall = append(fake.uploadApplicationDropletArgsForCall, struct {
+		appGUID string
+		droplet io.Reader
+	}{appGUID, droplet})
+	fake.recordInvocation("UploadApplicationDroplet", []interface{}{appGUID, droplet})
+	fake.uploadApplicationDropletMutex.Unlock()
+	if fake.UploadApplicationDropletStub != nil {
+		return fake.UploadApplicationDropletStub(appGUID, droplet)
+	} else {
+		return fake.uploadApplicationDropletReturns.result1, fake.uploadApplicationDropletReturns.result2, fake.uploadApplicationDropletReturns.result3
+	}
+}
+
+func (fake *FakeCloudControllerClient) UploadApplicationDropletCallCount() int {
+	fake.uploadApplicationDropletMutex.RLock()
+	defer fake.uploadApplicationDropletMutex.RUnlock()
+	return len(fake.uploadApplicationDropletArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UploadApplicationDropletArgsForCall(i int) (string, io.Reader) {
+	fake.uploadApplicationDropletMutex.RLock()
+	defer fake.uploadApplicationDropletMutex.RUnlock()
+	return fake.uploadApplicationDropletArgsForCall[i].appGUID, fake.uploadApplicationDropletArgsForCall[i].droplet
+}
+
+func (fake *FakeCloudControllerClient) UploadApplicationDropletReturns(result1 ccv2.Job, result2 ccv2.Warnings, result3 error) {
+	fake.UploadApplicationDropletStub = nil
+	fake.uploadApplicationDropletReturns = struct {
+		result1 ccv2.Job
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) API() string {
 	fake.aPIMutex.Lock()
 	fake.aPIArgsForCall = append(fake.aPIArgsForCall, struct{}{})
@@ -1225,6 +1413,10 @@ func (fake *FakeCloudControllerClient) TokenEndpointReturns(result1 string) {
 func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.deleteApplicationInstanceMutex.RLock()
+	defer fake.deleteApplicationInstanceMutex.RUnlock()
+	fake.downloadApplicationDropletMutex.RLock()
+	defer fake.downloadApplicationDropletMutex.RUnlock()
 	fake.deleteOrganizationMutex.RLock()
 	defer fake.deleteOrganizationMutex.RUnlock()
 	fake.deleteRouteMutex.RLock()
@@ -1237,6 +1429,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getApplicationRoutesMutex.RUnlock()
 	fake.getApplicationsMutex.RLock()
 	defer fake.getApplicationsMutex.RUnlock()
+	fake.getEventsMutex.RLock()
+	defer fake.getEventsMutex.RUnlock()
 	fake.getJobMutex.RLock()
 	defer fake.getJobMutex.RUnlock()
 	fake.getOrganizationsMutex.RLock()
@@ -1267,6 +1461,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.targetCFMutex.RUnlock()
 	fake.updateApplicationMutex.RLock()
 	defer fake.updateApplicationMutex.RUnlock()
+	fake.uploadApplicationDropletMutex.RLock()
+	defer fake.uploadApplicationDropletMutex.RUnlock()
 	fake.aPIMutex.RLock()
 	defer fake.aPIMutex.RUnlock()
 	fake.aPIVersionMutex.RLock()
@@ -0,0 +1,81 @@
+// This file was generated by counterfeiter
+package v2actionfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"github.com/cloudfoundry/sonde-go/events"
+)
+
+type FakeNOAAClient struct {
+	RecentLogsStub        func(appGUID string, authToken string) ([]*events.LogMessage, error)
+	recentLogsMutex       sync.RWMutex
+	recentLogsArgsForCall []struct {
+		appGUID   string
+		authToken string
+	}
+	recentLogsReturns struct {
+		result1 []*events.LogMessage
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeNOAAClient) RecentLogs(appGUID string, authToken string) ([]*events.LogMessage, error) {
+	fake.recentLogsMutex.Lock()
+	fake.recentLogsArgsForCall = append(fake.recentLogsArgsForCall, struct {
+		appGUID   string
+		authToken string
+	}{appGUID, authToken})
+	fake.recordInvocation("RecentLogs", []interface{}{appGUID, authToken})
+	fake.recentLogsMutex.Unlock()
+	if fake.RecentLogsStub != nil {
+		return fake.RecentLogsStub(appGUID, authToken)
+	} else {
+		return fake.recentLogsReturns.result1, fake.recentLogsReturns.result2
+	}
+}
+
+func (fake *FakeNOAAClient) RecentLogsCallCount() int {
+	fake.recentLogsMutex.RLock()
+	defer fake.recentLogsMutex.RUnlock()
+	return len(fake.recentLogsArgsForCall)
+}
+
+func (fake *FakeNOAAClient) RecentLogsArgsForCall(i int) (string, string) {
+	fake.recentLogsMutex.RLock()
+	defer fake.recentLogsMutex.RUnlock()
+	return fake.recentLogsArgsForCall[i].appGUID, fake.recentLogsArgsForCall[i].authToken
+}
+
+func (fake *FakeNOAAClient) RecentLogsReturns(result1 []*events.LogMessage, result2 error) {
+	fake.RecentLogsStub = nil
+	fake.recentLogsReturns = struct {
+		result1 []*events.LogMessage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNOAAClient) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.recentLogsMutex.RLock()
+	defer fake.recentLogsMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeNOAAClient) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2action.NOAAClient = new(FakeNOAAClient)
@@ -9,6 +9,34 @@ import (
 )
 
 type FakeUAAClient struct {
+	AddGroupMemberStub        func(groupID string, userID string, origin string) error
+	addGroupMemberMutex       sync.RWMutex
+	addGroupMemberArgsForCall []struct {
+		groupID string
+		userID  string
+		origin  string
+	}
+	addGroupMemberReturns struct {
+		result1 error
+	}
+	GetGroupsByNameStub        func(name string) ([]uaa.Group, error)
+	getGroupsByNameMutex       sync.RWMutex
+	getGroupsByNameArgsForCall []struct {
+		name string
+	}
+	getGroupsByNameReturns struct {
+		result1 []uaa.Group
+		result2 error
+	}
+	GetUsersStub        func(filter string) ([]uaa.User, error)
+	getUsersMutex       sync.RWMutex
+	getUsersArgsForCall []struct {
+		filter string
+	}
+	getUsersReturns struct {
+		result1 []uaa.User
+		result2 error
+	}
 	NewUserStub        func(username string, password string, origin string) (uaa.User, error)
 	newUserMutex       sync.RWMutex
 	newUserArgsForCall []struct {
@@ -20,10 +48,150 @@ type FakeUAAClient struct {
 		result1 uaa.User
 		result2 error
 	}
+	RefreshAccessTokenStub        func(refreshToken string) (uaa.RefreshToken, error)
+	refreshAccessTokenMutex       sync.RWMutex
+	refreshAccessTokenArgsForCall []struct {
+		refreshToken string
+	}
+	refreshAccessTokenReturns struct {
+		result1 uaa.RefreshToken
+		result2 error
+	}
+	RemoveGroupMemberStub        func(groupID string, userID string) error
+	removeGroupMemberMutex       sync.RWMutex
+	removeGroupMemberArgsForCall []struct {
+		groupID string
+		userID  string
+	}
+	removeGroupMemberReturns struct {
+		result1 error
+	}
+	SetUserActiveStub        func(userID string, active bool) (uaa.User, error)
+	setUserActiveMutex       sync.RWMutex
+	setUserActiveArgsForCall []struct {
+		userID string
+		active bool
+	}
+	setUserActiveReturns struct {
+		result1 uaa.User
+		result2 error
+	}
+	SetUserPasswordStub        func(userID string, password string) error
+	setUserPasswordMutex       sync.RWMutex
+	setUserPasswordArgsForCall []struct {
+		userID   string
+		password string
+	}
+	setUserPasswordReturns struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeUAAClient) AddGroupMember(groupID string, userID string, origin string) error {
+	fake.addGroupMemberMutex.Lock()
+	fake.addGroupMemberArgsForCall = append(fake.addGroupMemberArgsForCall, struct {
+		groupID string
+		userID  string
+		origin  string
+	}{groupID, userID, origin})
+	fake.recordInvocation("AddGroupMember", []interface{}{groupID, userID, origin})
+	fake.addGroupMemberMutex.Unlock()
+	if fake.AddGroupMemberStub != nil {
+		return fake.AddGroupMemberStub(groupID, userID, origin)
+	} else {
+		return fake.addGroupMemberReturns.result1
+	}
+}
+
+func (fake *FakeUAAClient) AddGroupMemberCallCount() int {
+	fake.addGroupMemberMutex.RLock()
+	defer fake.addGroupMemberMutex.RUnlock()
+	return len(fake.addGroupMemberArgsForCall)
+}
+
+func (fake *FakeUAAClient) AddGroupMemberArgsForCall(i int) (string, string, string) {
+	fake.addGroupMemberMutex.RLock()
+	defer fake.addGroupMemberMutex.RUnlock()
+	return fake.addGroupMemberArgsForCall[i].groupID, fake.addGroupMemberArgsForCall[i].userID, fake.addGroupMemberArgsForCall[i].origin
+}
+
+func (fake *FakeUAAClient) AddGroupMemberReturns(result1 error) {
+	fake.AddGroupMemberStub = nil
+	fake.addGroupMemberReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUAAClient) GetGroupsByName(name string) ([]uaa.Group, error) {
+	fake.getGroupsByNameMutex.Lock()
+	fake.getGroupsByNameArgsForCall = append(fake.getGroupsByNameArgsForCall, struct {
+		name string
+	}{name})
+	fake.recordInvocation("GetGroupsByName", []interface{}{name})
+	fake.getGroupsByNameMutex.Unlock()
+	if fake.GetGroupsByNameStub != nil {
+		return fake.GetGroupsByNameStub(name)
+	} else {
+		return fake.getGroupsByNameReturns.result1, fake.getGroupsByNameReturns.result2
+	}
+}
+
+func (fake *FakeUAAClient) GetGroupsByNameCallCount() int {
+	fake.getGroupsByNameMutex.RLock()
+	defer fake.getGroupsByNameMutex.RUnlock()
+	return len(fake.getGroupsByNameArgsForCall)
+}
+
+func (fake *FakeUAAClient) GetGroupsByNameArgsForCall(i int) string {
+	fake.getGroupsByNameMutex.RLock()
+	defer fake.getGroupsByNameMutex.RUnlock()
+	return fake.getGroupsByNameArgsForCall[i].name
+}
+
+func (fake *FakeUAAClient) GetGroupsByNameReturns(result1 []uaa.Group, result2 error) {
+	fake.GetGroupsByNameStub = nil
+	fake.getGroupsByNameReturns = struct {
+		result1 []uaa.Group
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUAAClient) GetUsers(filter string) ([]uaa.User, error) {
+	fake.getUsersMutex.Lock()
+	fake.getUsersArgsForCall = append(fake.getUsersArgsForCall, struct {
+		filter string
+	}{filter})
+	fake.recordInvocation("GetUsers", []interface{}{filter})
+	fake.getUsersMutex.Unlock()
+	if fake.GetUsersStub != nil {
+		return fake.GetUsersStub(filter)
+	} else {
+		return fake.getUsersReturns.result1, fake.getUsersReturns.result2
+	}
+}
+
+func (fake *FakeUAAClient) GetUsersCallCount() int {
+	fake.getUsersMutex.RLock()
+	defer fake.getUsersMutex.RUnlock()
+	return len(fake.getUsersArgsForCall)
+}
+
+func (fake *FakeUAAClient) GetUsersArgsForCall(i int) string {
+	fake.getUsersMutex.RLock()
+	defer fake.getUsersMutex.RUnlock()
+	return fake.getUsersArgsForCall[i].filter
+}
+
+func (fake *FakeUAAClient) GetUsersReturns(result1 []uaa.User, result2 error) {
+	fake.GetUsersStub = nil
+	fake.getUsersReturns = struct {
+		result1 []uaa.User
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeUAAClient) NewUser(username string, password string, origin string) (uaa.User, error) {
 	fake.newUserMutex.Lock()
 	fake.newUserArgsForCall = append(fake.newUserArgsForCall, struct {
@@ -60,11 +228,162 @@ func (fake *FakeUAAClient) NewUserReturns(result1 uaa.User, result2 error) {
 	}{result1, result2}
 }
 
+func (fake *FakeUAAClient) RefreshAccessToken(refreshToken string) (uaa.RefreshToken, error) {
+	fake.refreshAccessTokenMutex.Lock()
+	fake.refreshAccessTokenArgsForCall = append(fake.refreshAccessTokenArgsForCall, struct {
+		refreshToken string
+	}{refreshToken})
+	fake.recordInvocation("RefreshAccessToken", []interface{}{refreshToken})
+	fake.refreshAccessTokenMutex.Unlock()
+	if fake.RefreshAccessTokenStub != nil {
+		return fake.RefreshAccessTokenStub(refreshToken)
+	} else {
+		return fake.refreshAccessTokenReturns.result1, fake.refreshAccessTokenReturns.result2
+	}
+}
+
+func (fake *FakeUAAClient) RefreshAccessTokenCallCount() int {
+	fake.refreshAccessTokenMutex.RLock()
+	defer fake.refreshAccessTokenMutex.RUnlock()
+	return len(fake.refreshAccessTokenArgsForCall)
+}
+
+func (fake *FakeUAAClient) RefreshAccessTokenArgsForCall(i int) string {
+	fake.refreshAccessTokenMutex.RLock()
+	defer fake.refreshAccessTokenMutex.RUnlock()
+	return fake.refreshAccessTokenArgsForCall[i].refreshToken
+}
+
+func (fake *FakeUAAClient) RefreshAccessTokenReturns(result1 uaa.RefreshToken, result2 error) {
+	fake.RefreshAccessTokenStub = nil
+	fake.refreshAccessTokenReturns = struct {
+		result1 uaa.RefreshToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUAAClient) RemoveGroupMember(groupID string, userID string) error {
+	fake.removeGroupMemberMutex.Lock()
+	fake.removeGroupMemberArgsForCall = append(fake.removeGroupMemberArgsForCall, struct {
+		groupID string
+		userID  string
+	}{groupID, userID})
+	fake.recordInvocation("RemoveGroupMember", []interface{}{groupID, userID})
+	fake.removeGroupMemberMutex.Unlock()
+	if fake.RemoveGroupMemberStub != nil {
+		return fake.RemoveGroupMemberStub(groupID, userID)
+	} else {
+		return fake.removeGroupMemberReturns.result1
+	}
+}
+
+func (fake *FakeUAAClient) RemoveGroupMemberCallCount() int {
+	fake.removeGroupMemberMutex.RLock()
+	defer fake.removeGroupMemberMutex.RUnlock()
+	return len(fake.removeGroupMemberArgsForCall)
+}
+
+func (fake *FakeUAAClient) RemoveGroupMemberArgsForCall(i int) (string, string) {
+	fake.removeGroupMemberMutex.RLock()
+	defer fake.removeGroupMemberMutex.RUnlock()
+	return fake.removeGroupMemberArgsForCall[i].groupID, fake.removeGroupMemberArgsForCall[i].userID
+}
+
+func (fake *FakeUAAClient) RemoveGroupMemberReturns(result1 error) {
+	fake.RemoveGroupMemberStub = nil
+	fake.removeGroupMemberReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUAAClient) SetUserActive(userID string, active bool) (uaa.User, error) {
+	fake.setUserActiveMutex.Lock()
+	fake.setUserActiveArgsForCall = append(fake.setUserActiveArgsForCall, struct {
+		userID string
+		active bool
+	}{userID, active})
+	fake.recordInvocation("SetUserActive", []interface{}{userID, active})
+	fake.setUserActiveMutex.Unlock()
+	if fake.SetUserActiveStub != nil {
+		return fake.SetUserActiveStub(userID, active)
+	} else {
+		return fake.setUserActiveReturns.result1, fake.setUserActiveReturns.result2
+	}
+}
+
+func (fake *FakeUAAClient) SetUserActiveCallCount() int {
+	fake.setUserActiveMutex.RLock()
+	defer fake.setUserActiveMutex.RUnlock()
+	return len(fake.setUserActiveArgsForCall)
+}
+
+func (fake *FakeUAAClient) SetUserActiveArgsForCall(i int) (string, bool) {
+	fake.setUserActiveMutex.RLock()
+	defer fake.setUserActiveMutex.RUnlock()
+	return fake.setUserActiveArgsForCall[i].userID, fake.setUserActiveArgsForCall[i].active
+}
+
+func (fake *FakeUAAClient) SetUserActiveReturns(result1 uaa.User, result2 error) {
+	fake.SetUserActiveStub = nil
+	fake.setUserActiveReturns = struct {
+		result1 uaa.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUAAClient) SetUserPassword(userID string, password string) error {
+	fake.setUserPasswordMutex.Lock()
+	fake.setUserPasswordArgsForCall = append(fake.setUserPasswordArgsForCall, struct {
+		userID   string
+		password string
+	}{userID, password})
+	fake.recordInvocation("SetUserPassword", []interface{}{userID, password})
+	fake.setUserPasswordMutex.Unlock()
+	if fake.SetUserPasswordStub != nil {
+		return fake.SetUserPasswordStub(userID, password)
+	} else {
+		return fake.setUserPasswordReturns.result1
+	}
+}
+
+func (fake *FakeUAAClient) SetUserPasswordCallCount() int {
+	fake.setUserPasswordMutex.RLock()
+	defer fake.setUserPasswordMutex.RUnlock()
+	return len(fake.setUserPasswordArgsForCall)
+}
+
+func (fake *FakeUAAClient) SetUserPasswordArgsForCall(i int) (string, string) {
+	fake.setUserPasswordMutex.RLock()
+	defer fake.setUserPasswordMutex.RUnlock()
+	return fake.setUserPasswordArgsForCall[i].userID, fake.setUserPasswordArgsForCall[i].password
+}
+
+func (fake *FakeUAAClient) SetUserPasswordReturns(result1 error) {
+	fake.SetUserPasswordStub = nil
+	fake.setUserPasswordReturns = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeUAAClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.addGroupMemberMutex.RLock()
+	defer fake.addGroupMemberMutex.RUnlock()
+	fake.getGroupsByNameMutex.RLock()
+	defer fake.getGroupsByNameMutex.RUnlock()
+	fake.getUsersMutex.RLock()
+	defer fake.getUsersMutex.RUnlock()
 	fake.newUserMutex.RLock()
 	defer fake.newUserMutex.RUnlock()
+	fake.refreshAccessTokenMutex.RLock()
+	defer fake.refreshAccessTokenMutex.RUnlock()
+	fake.removeGroupMemberMutex.RLock()
+	defer fake.removeGroupMemberMutex.RUnlock()
+	fake.setUserActiveMutex.RLock()
+	defer fake.setUserActiveMutex.RUnlock()
+	fake.setUserPasswordMutex.RLock()
+	defer fake.setUserPasswordMutex.RUnlock()
 	return fake.invocations
 }
 
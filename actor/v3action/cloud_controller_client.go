@@ -11,8 +11,9 @@ import (
 // CloudControllerClient is the interface to the cloud controller V3 API.
 type CloudControllerClient interface {
 	CloudControllerAPIVersion() string
-	GetApplicationTasks(appGUID string, query url.Values) ([]ccv3.Task, ccv3.Warnings, error)
+	GetApplicationTasks(appGUID string, query url.Values, limit int) ([]ccv3.Task, ccv3.Warnings, error)
 	GetApplications(query url.Values) ([]ccv3.Application, ccv3.Warnings, error)
-	NewTask(appGUID string, command string, name string) (ccv3.Task, ccv3.Warnings, error)
+	GetTask(taskGUID string) (ccv3.Task, ccv3.Warnings, error)
+	NewTask(appGUID string, task ccv3.Task) (ccv3.Task, ccv3.Warnings, error)
 	UpdateTask(taskGUID string) (ccv3.Task, ccv3.Warnings, error)
 }
@@ -0,0 +1,7 @@
+package v3action
+
+//go:generate counterfeiter . Config
+
+type Config interface {
+	AccessToken() string
+}
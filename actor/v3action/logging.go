@@ -0,0 +1,55 @@
+package v3action
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+)
+
+// LogMessage represents a log message emitted by an application, one of its
+// tasks, or the platform on their behalf.
+type LogMessage = sharedaction.LogMessage
+
+// TaskSourceType returns the source type of the log messages emitted by the
+// task with the provided name.
+func TaskSourceType(taskName string) string {
+	return "APP/TASK/" + taskName
+}
+
+// GetStreamingLogsForTasks streams the log messages emitted by the tasks of
+// the app. Messages and errors are sent until client is closed, so streaming
+// can start before a task is created.
+func (actor Actor) GetStreamingLogsForTasks(appGUID string, client NOAAClient, config Config) (<-chan LogMessage, <-chan error) {
+	messages := make(chan LogMessage)
+	errs := make(chan error)
+
+	noaaMessages, noaaErrs := client.TailingLogs(appGUID, config.AccessToken())
+
+	go func() {
+		defer close(messages)
+		defer close(errs)
+
+		sourceTypePrefix := TaskSourceType("")
+		for noaaMessages != nil || noaaErrs != nil {
+			select {
+			case message, ok := <-noaaMessages:
+				if !ok {
+					noaaMessages = nil
+					continue
+				}
+				if !strings.HasPrefix(message.GetSourceType(), sourceTypePrefix) {
+					continue
+				}
+				messages <- *sharedaction.NewLogMessageFromNOAA(message)
+			case err, ok := <-noaaErrs:
+				if !ok {
+					noaaErrs = nil
+					continue
+				}
+				errs <- err
+			}
+		}
+	}()
+
+	return messages, errs
+}
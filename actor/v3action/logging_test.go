@@ -0,0 +1,93 @@
+package v3action_test
+
+import (
+	"errors"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"github.com/cloudfoundry/sonde-go/events"
+	"github.com/gogo/protobuf/proto"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Logging Actions", func() {
+	var (
+		actor          Actor
+		fakeNOAAClient *v3actionfakes.FakeNOAAClient
+		fakeConfig     *v3actionfakes.FakeConfig
+	)
+
+	BeforeEach(func() {
+		fakeNOAAClient = new(v3actionfakes.FakeNOAAClient)
+		fakeConfig = new(v3actionfakes.FakeConfig)
+		fakeConfig.AccessTokenReturns("bearer some-access-token")
+		actor = NewActor(nil)
+	})
+
+	Describe("GetStreamingLogsForTasks", func() {
+		var (
+			noaaMessages chan *events.LogMessage
+			noaaErrs     chan error
+			messages     <-chan LogMessage
+			errs         <-chan error
+		)
+
+		logMessage := func(message string, sourceType string) *events.LogMessage {
+			messageType := events.LogMessage_OUT
+			return &events.LogMessage{
+				Message:        []byte(message),
+				MessageType:    &messageType,
+				Timestamp:      proto.Int64(42),
+				SourceType:     proto.String(sourceType),
+				SourceInstance: proto.String("0"),
+			}
+		}
+
+		BeforeEach(func() {
+			noaaMessages = make(chan *events.LogMessage)
+			noaaErrs = make(chan error)
+			fakeNOAAClient.TailingLogsReturns(noaaMessages, noaaErrs)
+		})
+
+		JustBeforeEach(func() {
+			messages, errs = actor.GetStreamingLogsForTasks("some-app-guid", fakeNOAAClient, fakeConfig)
+		})
+
+		It("only sends the messages of the app's tasks", func() {
+			appGUID, token := fakeNOAAClient.TailingLogsArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(token).To(Equal("bearer some-access-token"))
+
+			go func() {
+				noaaMessages <- logMessage("app message", "APP")
+				noaaMessages <- logMessage("other task message", "APP/TASK/seed")
+				noaaMessages <- logMessage("task message", "APP/TASK/migrate")
+				close(noaaMessages)
+				close(noaaErrs)
+			}()
+
+			var message LogMessage
+			Eventually(messages).Should(Receive(&message))
+			Expect(message.Message()).To(Equal("other task message"))
+			Expect(message.SourceType()).To(Equal("APP/TASK/seed"))
+
+			Eventually(messages).Should(Receive(&message))
+			Expect(message.Message()).To(Equal("task message"))
+			Expect(message.SourceType()).To(Equal("APP/TASK/migrate"))
+			Expect(message.Timestamp()).To(Equal(time.Unix(0, 42)))
+
+			Eventually(messages).Should(BeClosed())
+			Eventually(errs).Should(BeClosed())
+		})
+
+		It("sends the errors of the client", func() {
+			go func() {
+				noaaErrs <- errors.New("websocket closed")
+			}()
+
+			Eventually(errs).Should(Receive(MatchError("websocket closed")))
+		})
+	})
+})
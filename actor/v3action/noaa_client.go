@@ -0,0 +1,11 @@
+package v3action
+
+import "github.com/cloudfoundry/sonde-go/events"
+
+//go:generate counterfeiter . NOAAClient
+
+// NOAAClient is a client for getting logs.
+type NOAAClient interface {
+	TailingLogs(appGUID string, authToken string) (<-chan *events.LogMessage, <-chan error)
+	Close() error
+}
@@ -4,11 +4,22 @@ import (
 	"fmt"
 	"net/url"
 	"strconv"
+	"strings"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 )
 
+const (
+	// TaskSucceeded is the state of a task that exited successfully.
+	TaskSucceeded = ccv3.TaskSucceeded
+
+	// TaskFailed is the state of a task that exited unsuccessfully or was
+	// cancelled.
+	TaskFailed = ccv3.TaskFailed
+)
+
 // Task represents a V3 actor Task.
 type Task ccv3.Task
 
@@ -31,28 +42,60 @@ func (e TaskNotFoundError) Error() string {
 	return fmt.Sprintf("Task sequence ID %d not found.", e.SequenceID)
 }
 
-// RunTask runs the provided command in the application environment associated
-// with the provided application GUID.
-func (actor Actor) RunTask(appGUID string, command string, name string) (Task, Warnings, error) {
-	task, warnings, err := actor.CloudControllerClient.NewTask(appGUID, command, name)
+// RunTask runs the provided task's command in the application environment
+// associated with the provided application GUID.
+func (actor Actor) RunTask(appGUID string, task Task) (Task, Warnings, error) {
+	createdTask, warnings, err := actor.CloudControllerClient.NewTask(appGUID, ccv3.Task(task))
 	if err != nil {
 		if e, ok := err.(cloudcontroller.TaskWorkersUnavailableError); ok {
 			return Task{}, Warnings(warnings), TaskWorkersUnavailableError{Message: e.Error()}
 		}
 	}
 
+	return Task(createdTask), Warnings(warnings), err
+}
+
+// GetTask returns the task with the provided GUID, including its current
+// state.
+func (actor Actor) GetTask(taskGUID string) (Task, Warnings, error) {
+	task, warnings, err := actor.CloudControllerClient.GetTask(taskGUID)
 	return Task(task), Warnings(warnings), err
 }
 
+// TaskFilter narrows down the tasks returned by GetApplicationTasks. Empty
+// fields do not filter.
+type TaskFilter struct {
+	// States are the states the tasks can be in, e.g. RUNNING or FAILED.
+	States []string
+
+	// Names are the names the tasks can have.
+	Names []string
+
+	// Since is the earliest time the tasks can have been created at.
+	Since time.Time
+
+	// Limit is the maximum number of tasks returned.
+	Limit int
+}
+
 // GetApplicationTasks returns a list of tasks associated with the provided
-// appplication GUID.
-func (actor Actor) GetApplicationTasks(appGUID string, sortOrder SortOrder) ([]Task, Warnings, error) {
+// appplication GUID that match the filter.
+func (actor Actor) GetApplicationTasks(appGUID string, sortOrder SortOrder, filter TaskFilter) ([]Task, Warnings, error) {
 	query := url.Values{}
 	if sortOrder == Descending {
 		query.Add("order_by", "-created_at")
 	}
+	if len(filter.States) > 0 {
+		query.Add("states", strings.Join(filter.States, ","))
+	}
+	if len(filter.Names) > 0 {
+		query.Add("names", strings.Join(filter.Names, ","))
+	}
+	if !filter.Since.IsZero() {
+		query.Add("created_ats[gte]", filter.Since.UTC().Format(time.RFC3339))
+	}
 
-	tasks, warnings, err := actor.CloudControllerClient.GetApplicationTasks(appGUID, query)
+	tasks, warnings, err := actor.CloudControllerClient.GetApplicationTasks(appGUID, query, filter.Limit)
 	actorWarnings := Warnings(warnings)
 	if err != nil {
 		return nil, actorWarnings, err
@@ -71,7 +114,7 @@ func (actor Actor) GetTaskBySequenceIDAndApplication(sequenceID int, appGUID str
 		"sequence_ids": []string{strconv.Itoa(sequenceID)},
 	}
 
-	tasks, warnings, err := actor.CloudControllerClient.GetApplicationTasks(appGUID, query)
+	tasks, warnings, err := actor.CloudControllerClient.GetApplicationTasks(appGUID, query, 0)
 	if err != nil {
 		return Task{}, Warnings(warnings), err
 	}
@@ -0,0 +1,130 @@
+package v3action
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/cloudfoundry/bytefmt"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// TaskTemplateNotFoundError is returned when the manifest does not define a
+// task template with the given name for the application.
+type TaskTemplateNotFoundError struct {
+	TemplateName string
+	AppName      string
+	ManifestPath string
+}
+
+func (e TaskTemplateNotFoundError) Error() string {
+	return fmt.Sprintf("Task template %s not found for app %s in manifest %s", e.TemplateName, e.AppName, e.ManifestPath)
+}
+
+type manifestTaskTemplate struct {
+	Name      string `yaml:"name"`
+	Command   string `yaml:"command"`
+	Memory    string `yaml:"memory"`
+	DiskQuota string `yaml:"disk_quota"`
+}
+
+type manifestApplication struct {
+	Name  string                 `yaml:"name"`
+	Tasks []manifestTaskTemplate `yaml:"tasks"`
+}
+
+type taskTemplateManifest struct {
+	Applications        []manifestApplication `yaml:"applications"`
+	manifestApplication `yaml:",inline"`
+}
+
+// GetTaskTemplate returns the task template with the given name that the
+// manifest defines for the application. manifestPath is either the manifest
+// itself or a directory containing a manifest.yml or manifest.yaml.
+// Applications without a name in the manifest match any application, and a
+// manifest without an applications list describes a single application.
+func (actor Actor) GetTaskTemplate(manifestPath string, appName string, templateName string) (Task, error) {
+	path, err := findManifest(manifestPath)
+	if err != nil {
+		return Task{}, err
+	}
+
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return Task{}, err
+	}
+
+	var manifest taskTemplateManifest
+	err = yaml.Unmarshal(raw, &manifest)
+	if err != nil {
+		return Task{}, err
+	}
+
+	apps := manifest.Applications
+	if len(apps) == 0 {
+		apps = []manifestApplication{manifest.manifestApplication}
+	}
+
+	for _, app := range apps {
+		if app.Name != "" && app.Name != appName {
+			continue
+		}
+
+		for _, template := range app.Tasks {
+			if template.Name == templateName {
+				return taskFromTemplate(template)
+			}
+		}
+	}
+
+	return Task{}, TaskTemplateNotFoundError{
+		TemplateName: templateName,
+		AppName:      appName,
+		ManifestPath: path,
+	}
+}
+
+// findManifest returns the path of the manifest in the given directory, or
+// the given path if it is not a directory.
+func findManifest(path string) (string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", err
+	}
+
+	if !info.IsDir() {
+		return path, nil
+	}
+
+	for _, name := range []string{"manifest.yml", "manifest.yaml"} {
+		manifestPath := filepath.Join(path, name)
+		if _, err = os.Stat(manifestPath); err == nil {
+			return manifestPath, nil
+		}
+	}
+	return "", err
+}
+
+func taskFromTemplate(template manifestTaskTemplate) (Task, error) {
+	task := Task{
+		Name:    template.Name,
+		Command: template.Command,
+	}
+
+	var err error
+	if template.Memory != "" {
+		task.MemoryInMB, err = bytefmt.ToMegabytes(template.Memory)
+		if err != nil {
+			return Task{}, err
+		}
+	}
+	if template.DiskQuota != "" {
+		task.DiskInMB, err = bytefmt.ToMegabytes(template.DiskQuota)
+		if err != nil {
+			return Task{}, err
+		}
+	}
+
+	return task, nil
+}
@@ -0,0 +1,131 @@
+package v3action_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Task Template Actions", func() {
+	var (
+		actor        Actor
+		manifestDir  string
+		manifestPath string
+	)
+
+	BeforeEach(func() {
+		actor = NewActor(new(v3actionfakes.FakeCloudControllerClient))
+
+		var err error
+		manifestDir, err = ioutil.TempDir("", "task-template")
+		Expect(err).ToNot(HaveOccurred())
+		manifestPath = filepath.Join(manifestDir, "manifest.yml")
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(manifestDir)).To(Succeed())
+	})
+
+	writeManifest := func(manifest string) {
+		Expect(ioutil.WriteFile(manifestPath, []byte(manifest), 0600)).To(Succeed())
+	}
+
+	Describe("GetTaskTemplate", func() {
+		Context("when the manifest lists applications", func() {
+			BeforeEach(func() {
+				writeManifest(`---
+applications:
+- name: other-app
+  tasks:
+  - name: migrate
+    command: other migrate
+- name: some-app
+  tasks:
+  - name: seed
+    command: bundle exec rake db:seed
+  - name: migrate
+    command: bundle exec rake db:migrate
+    memory: 1G
+    disk_quota: 512M
+`)
+			})
+
+			It("returns the application's template", func() {
+				task, err := actor.GetTaskTemplate(manifestPath, "some-app", "migrate")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(task).To(Equal(Task{
+					Name:       "migrate",
+					Command:    "bundle exec rake db:migrate",
+					MemoryInMB: 1024,
+					DiskInMB:   512,
+				}))
+			})
+
+			It("looks up manifest.yml in a directory", func() {
+				task, err := actor.GetTaskTemplate(manifestDir, "some-app", "seed")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(task).To(Equal(Task{
+					Name:    "seed",
+					Command: "bundle exec rake db:seed",
+				}))
+			})
+
+			Context("when the application has no such template", func() {
+				It("returns a TaskTemplateNotFoundError", func() {
+					_, err := actor.GetTaskTemplate(manifestPath, "other-app", "seed")
+					Expect(err).To(MatchError(TaskTemplateNotFoundError{
+						TemplateName: "seed",
+						AppName:      "other-app",
+						ManifestPath: manifestPath,
+					}))
+				})
+			})
+		})
+
+		Context("when the manifest describes a single application without a name", func() {
+			BeforeEach(func() {
+				writeManifest(`---
+tasks:
+- name: migrate
+  command: bundle exec rake db:migrate
+`)
+			})
+
+			It("returns the template for any application", func() {
+				task, err := actor.GetTaskTemplate(manifestPath, "some-app", "migrate")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(task.Command).To(Equal("bundle exec rake db:migrate"))
+			})
+		})
+
+		Context("when the template has an invalid memory", func() {
+			BeforeEach(func() {
+				writeManifest(`---
+applications:
+- name: some-app
+  tasks:
+  - name: migrate
+    command: bundle exec rake db:migrate
+    memory: lots
+`)
+			})
+
+			It("returns the error", func() {
+				_, err := actor.GetTaskTemplate(manifestPath, "some-app", "migrate")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
+		Context("when the manifest does not exist", func() {
+			It("returns the error", func() {
+				_, err := actor.GetTaskTemplate(filepath.Join(manifestDir, "missing.yml"), "some-app", "migrate")
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+		})
+	})
+})
@@ -3,6 +3,7 @@ package v3action_test
 import (
 	"errors"
 	"net/url"
+	"time"
 
 	. "code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
@@ -38,40 +39,29 @@ var _ = Describe("Task Actions", func() {
 				)
 			})
 
-			Context("when the task name is empty", func() {
-				It("creates and returns the task and all warnings", func() {
-					task, warnings, err := actor.RunTask("some-app-guid", "some command", "")
-					Expect(err).ToNot(HaveOccurred())
-
-					Expect(task).To(Equal(Task{
-						SequenceID: 3,
-					}))
-					Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
-
-					Expect(fakeCloudControllerClient.NewTaskCallCount()).To(Equal(1))
-					appGUIDArg, commandArg, name := fakeCloudControllerClient.NewTaskArgsForCall(0)
-					Expect(appGUIDArg).To(Equal("some-app-guid"))
-					Expect(commandArg).To(Equal("some command"))
-					Expect(name).To(Equal(""))
+			It("creates and returns the task and all warnings", func() {
+				task, warnings, err := actor.RunTask("some-app-guid", Task{
+					Command:    "some command",
+					Name:       "some-task-name",
+					MemoryInMB: 512,
+					DiskInMB:   1024,
 				})
-			})
+				Expect(err).ToNot(HaveOccurred())
 
-			Context("when the task name is not empty", func() {
-				It("creates and returns the task and all warnings", func() {
-					task, warnings, err := actor.RunTask("some-app-guid", "some command", "some-task-name")
-					Expect(err).ToNot(HaveOccurred())
-
-					Expect(task).To(Equal(Task{
-						SequenceID: 3,
-					}))
-					Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+				Expect(task).To(Equal(Task{
+					SequenceID: 3,
+				}))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
 
-					Expect(fakeCloudControllerClient.NewTaskCallCount()).To(Equal(1))
-					appGUIDArg, commandArg, name := fakeCloudControllerClient.NewTaskArgsForCall(0)
-					Expect(appGUIDArg).To(Equal("some-app-guid"))
-					Expect(commandArg).To(Equal("some command"))
-					Expect(name).To(Equal("some-task-name"))
-				})
+				Expect(fakeCloudControllerClient.NewTaskCallCount()).To(Equal(1))
+				appGUIDArg, taskArg := fakeCloudControllerClient.NewTaskArgsForCall(0)
+				Expect(appGUIDArg).To(Equal("some-app-guid"))
+				Expect(taskArg).To(Equal(ccv3.Task{
+					Command:    "some command",
+					Name:       "some-task-name",
+					MemoryInMB: 512,
+					DiskInMB:   1024,
+				}))
 			})
 		})
 
@@ -86,7 +76,7 @@ var _ = Describe("Task Actions", func() {
 				})
 
 				It("returns a TaskWorkersUnavailableError", func() {
-					_, _, err := actor.RunTask("some-app-guid", "some command", "")
+					_, _, err := actor.RunTask("some-app-guid", Task{Command: "some command"})
 					Expect(err).To(MatchError(TaskWorkersUnavailableError{Message: "banana babans"}))
 				})
 			})
@@ -104,7 +94,7 @@ var _ = Describe("Task Actions", func() {
 				})
 
 				It("returns the same error and all warnings", func() {
-					_, warnings, err := actor.RunTask("some-app-guid", "some command", "")
+					_, warnings, err := actor.RunTask("some-app-guid", Task{Command: "some command"})
 					Expect(err).To(MatchError(expectedErr))
 					Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
 				})
@@ -112,6 +102,50 @@ var _ = Describe("Task Actions", func() {
 		})
 	})
 
+	Describe("GetTask", func() {
+		Context("when the task exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetTaskReturns(
+					ccv3.Task{
+						GUID:   "task-guid",
+						State:  ccv3.TaskFailed,
+						Result: ccv3.TaskResult{FailureReason: "Exited with status 1"},
+					},
+					ccv3.Warnings{"get-task-warning"},
+					nil,
+				)
+			})
+
+			It("returns the task and all warnings", func() {
+				task, warnings, err := actor.GetTask("task-guid")
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(task).To(Equal(Task{
+					GUID:   "task-guid",
+					State:  ccv3.TaskFailed,
+					Result: ccv3.TaskResult{FailureReason: "Exited with status 1"},
+				}))
+				Expect(warnings).To(ConsistOf("get-task-warning"))
+				Expect(fakeCloudControllerClient.GetTaskArgsForCall(0)).To(Equal("task-guid"))
+			})
+		})
+
+		Context("when the cloud controller client returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get task error")
+				fakeCloudControllerClient.GetTaskReturns(ccv3.Task{}, ccv3.Warnings{"get-task-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.GetTask("task-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-task-warning"))
+			})
+		})
+	})
+
 	Describe("GetApplicationTasks", func() {
 		Context("when the application exists", func() {
 			Context("when there are associated tasks", func() {
@@ -154,20 +188,43 @@ var _ = Describe("Task Actions", func() {
 				})
 
 				It("returns all tasks associated with the application and all warnings", func() {
-					tasks, warnings, err := actor.GetApplicationTasks("some-app-guid", Descending)
+					tasks, warnings, err := actor.GetApplicationTasks("some-app-guid", Descending, TaskFilter{})
 					Expect(err).ToNot(HaveOccurred())
 
 					Expect(tasks).To(ConsistOf(Task(task1), Task(task2), Task(task3)))
 					Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
 
 					Expect(fakeCloudControllerClient.GetApplicationTasksCallCount()).To(Equal(1))
-					appGUID, query := fakeCloudControllerClient.GetApplicationTasksArgsForCall(0)
+					appGUID, query, limit := fakeCloudControllerClient.GetApplicationTasksArgsForCall(0)
 					Expect(appGUID).To(Equal("some-app-guid"))
 					Expect(query).To(Equal(
 						url.Values{
 							"order_by": []string{"-created_at"},
 						},
 					))
+					Expect(limit).To(BeZero())
+				})
+			})
+
+			Context("when a filter is given", func() {
+				It("passes the filter to the cloud controller client", func() {
+					_, _, err := actor.GetApplicationTasks("some-app-guid", Ascending, TaskFilter{
+						States: []string{"RUNNING", "FAILED"},
+						Names:  []string{"migrate"},
+						Since:  time.Date(2017, 5, 4, 15, 4, 5, 0, time.FixedZone("CEST", 2*60*60)),
+						Limit:  10,
+					})
+					Expect(err).ToNot(HaveOccurred())
+
+					_, query, limit := fakeCloudControllerClient.GetApplicationTasksArgsForCall(0)
+					Expect(query).To(Equal(
+						url.Values{
+							"states":           []string{"RUNNING,FAILED"},
+							"names":            []string{"migrate"},
+							"created_ats[gte]": []string{"2017-05-04T13:04:05Z"},
+						},
+					))
+					Expect(limit).To(Equal(10))
 				})
 			})
 
@@ -181,7 +238,7 @@ var _ = Describe("Task Actions", func() {
 				})
 
 				It("returns an empty list of tasks", func() {
-					tasks, _, err := actor.GetApplicationTasks("some-app-guid", Descending)
+					tasks, _, err := actor.GetApplicationTasks("some-app-guid", Descending, TaskFilter{})
 					Expect(err).ToNot(HaveOccurred())
 					Expect(tasks).To(BeEmpty())
 				})
@@ -201,7 +258,7 @@ var _ = Describe("Task Actions", func() {
 			})
 
 			It("returns the same error and all warnings", func() {
-				_, warnings, err := actor.GetApplicationTasks("some-app-guid", Descending)
+				_, warnings, err := actor.GetApplicationTasks("some-app-guid", Descending, TaskFilter{})
 				Expect(err).To(MatchError(expectedErr))
 				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
 			})
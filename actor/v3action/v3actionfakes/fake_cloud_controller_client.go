@@ -16,11 +16,12 @@ type FakeCloudControllerClient struct {
 	cloudControllerAPIVersionReturns     struct {
 		result1 string
 	}
-	GetApplicationTasksStub        func(appGUID string, query url.Values) ([]ccv3.Task, ccv3.Warnings, error)
+	GetApplicationTasksStub        func(appGUID string, query url.Values, limit int) ([]ccv3.Task, ccv3.Warnings, error)
 	getApplicationTasksMutex       sync.RWMutex
 	getApplicationTasksArgsForCall []struct {
 		appGUID string
 		query   url.Values
+		limit   int
 	}
 	getApplicationTasksReturns struct {
 		result1 []ccv3.Task
@@ -37,12 +38,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
-	NewTaskStub        func(appGUID string, command string, name string) (ccv3.Task, ccv3.Warnings, error)
+	GetTaskStub        func(taskGUID string) (ccv3.Task, ccv3.Warnings, error)
+	getTaskMutex       sync.RWMutex
+	getTaskArgsForCall []struct {
+		taskGUID string
+	}
+	getTaskReturns struct {
+		result1 ccv3.Task
+		result2 ccv3.Warnings
+		result3 error
+	}
+	NewTaskStub        func(appGUID string, task ccv3.Task) (ccv3.Task, ccv3.Warnings, error)
 	newTaskMutex       sync.RWMutex
 	newTaskArgsForCall []struct {
 		appGUID string
-		command string
-		name    string
+		task    ccv3.Task
 	}
 	newTaskReturns struct {
 		result1 ccv3.Task
@@ -88,16 +98,17 @@ func (fake *FakeCloudControllerClient) CloudControllerAPIVersionReturns(result1
 	}{result1}
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationTasks(appGUID string, query url.Values) ([]ccv3.Task, ccv3.Warnings, error) {
+func (fake *FakeCloudControllerClient) GetApplicationTasks(appGUID string, query url.Values, limit int) ([]ccv3.Task, ccv3.Warnings, error) {
 	fake.getApplicationTasksMutex.Lock()
 	fake.getApplicationTasksArgsForCall = append(fake.getApplicationTasksArgsForCall, struct {
 		appGUID string
 		query   url.Values
-	}{appGUID, query})
-	fake.recordInvocation("GetApplicationTasks", []interface{}{appGUID, query})
+		limit   int
+	}{appGUID, query, limit})
+	fake.recordInvocation("GetApplicationTasks", []interface{}{appGUID, query, limit})
 	fake.getApplicationTasksMutex.Unlock()
 	if fake.GetApplicationTasksStub != nil {
-		return fake.GetApplicationTasksStub(appGUID, query)
+		return fake.GetApplicationTasksStub(appGUID, query, limit)
 	} else {
 		return fake.getApplicationTasksReturns.result1, fake.getApplicationTasksReturns.result2, fake.getApplicationTasksReturns.result3
 	}
@@ -109,10 +120,10 @@ func (fake *FakeCloudControllerClient) GetApplicationTasksCallCount() int {
 	return len(fake.getApplicationTasksArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetApplicationTasksArgsForCall(i int) (string, url.Values) {
+func (fake *FakeCloudControllerClient) GetApplicationTasksArgsForCall(i int) (string, url.Values, int) {
 	fake.getApplicationTasksMutex.RLock()
 	defer fake.getApplicationTasksMutex.RUnlock()
-	return fake.getApplicationTasksArgsForCall[i].appGUID, fake.getApplicationTasksArgsForCall[i].query
+	return fake.getApplicationTasksArgsForCall[i].appGUID, fake.getApplicationTasksArgsForCall[i].query, fake.getApplicationTasksArgsForCall[i].limit
 }
 
 func (fake *FakeCloudControllerClient) GetApplicationTasksReturns(result1 []ccv3.Task, result2 ccv3.Warnings, result3 error) {
@@ -159,17 +170,51 @@ func (fake *FakeCloudControllerClient) GetApplicationsReturns(result1 []ccv3.App
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) NewTask(appGUID string, command string, name string) (ccv3.Task, ccv3.Warnings, error) {
+func (fake *FakeCloudControllerClient) GetTask(taskGUID string) (ccv3.Task, ccv3.Warnings, error) {
+	fake.getTaskMutex.Lock()
+	fake.getTaskArgsForCall = append(fake.getTaskArgsForCall, struct {
+		taskGUID string
+	}{taskGUID})
+	fake.recordInvocation("GetTask", []interface{}{taskGUID})
+	fake.getTaskMutex.Unlock()
+	if fake.GetTaskStub != nil {
+		return fake.GetTaskStub(taskGUID)
+	} else {
+		return fake.getTaskReturns.result1, fake.getTaskReturns.result2, fake.getTaskReturns.result3
+	}
+}
+
+func (fake *FakeCloudControllerClient) GetTaskCallCount() int {
+	fake.getTaskMutex.RLock()
+	defer fake.getTaskMutex.RUnlock()
+	return len(fake.getTaskArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetTaskArgsForCall(i int) string {
+	fake.getTaskMutex.RLock()
+	defer fake.getTaskMutex.RUnlock()
+	return fake.getTaskArgsForCall[i].taskGUID
+}
+
+func (fake *FakeCloudControllerClient) GetTaskReturns(result1 ccv3.Task, result2 ccv3.Warnings, result3 error) {
+	fake.GetTaskStub = nil
+	fake.getTaskReturns = struct {
+		result1 ccv3.Task
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) NewTask(appGUID string, task ccv3.Task) (ccv3.Task, ccv3.Warnings, error) {
 	fake.newTaskMutex.Lock()
 	fake.newTaskArgsForCall = append(fake.newTaskArgsForCall, struct {
 		appGUID string
-		command string
-		name    string
-	}{appGUID, command, name})
-	fake.recordInvocation("NewTask", []interface{}{appGUID, command, name})
+		task    ccv3.Task
+	}{appGUID, task})
+	fake.recordInvocation("NewTask", []interface{}{appGUID, task})
 	fake.newTaskMutex.Unlock()
 	if fake.NewTaskStub != nil {
-		return fake.NewTaskStub(appGUID, command, name)
+		return fake.NewTaskStub(appGUID, task)
 	} else {
 		return fake.newTaskReturns.result1, fake.newTaskReturns.result2, fake.newTaskReturns.result3
 	}
@@ -181,10 +226,10 @@ func (fake *FakeCloudControllerClient) NewTaskCallCount() int {
 	return len(fake.newTaskArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) NewTaskArgsForCall(i int) (string, string, string) {
+func (fake *FakeCloudControllerClient) NewTaskArgsForCall(i int) (string, ccv3.Task) {
 	fake.newTaskMutex.RLock()
 	defer fake.newTaskMutex.RUnlock()
-	return fake.newTaskArgsForCall[i].appGUID, fake.newTaskArgsForCall[i].command, fake.newTaskArgsForCall[i].name
+	return fake.newTaskArgsForCall[i].appGUID, fake.newTaskArgsForCall[i].task
 }
 
 func (fake *FakeCloudControllerClient) NewTaskReturns(result1 ccv3.Task, result2 ccv3.Warnings, result3 error) {
@@ -240,6 +285,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getApplicationTasksMutex.RUnlock()
 	fake.getApplicationsMutex.RLock()
 	defer fake.getApplicationsMutex.RUnlock()
+	fake.getTaskMutex.RLock()
+	defer fake.getTaskMutex.RUnlock()
 	fake.newTaskMutex.RLock()
 	defer fake.newTaskMutex.RUnlock()
 	fake.updateTaskMutex.RLock()
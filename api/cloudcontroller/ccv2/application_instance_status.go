@@ -116,6 +116,22 @@ func (client *Client) GetApplicationInstanceStatusesByApplication(guid string) (
 	return sortedInstances, response.Warnings, err
 }
 
+// DeleteApplicationInstance stops the instance of the application at the
+// given index. Cloud Controller replaces it with a new instance.
+func (client *Client) DeleteApplicationInstance(appGUID string, index int) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteAppInstanceRequest,
+		URIParams:   Params{"app_guid": appGUID, "index": strconv.Itoa(index)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
 func (client *Client) sortedInstanceKeys(instances map[string]ApplicationInstanceStatus) ([]int, error) {
 	var keys []int
 	for key, _ := range instances {
@@ -0,0 +1,56 @@
+package ccv2
+
+import (
+	"io"
+	"net/url"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// DownloadApplicationDroplet writes the droplet of the application, a
+// gzipped tarball of the staged application, to droplet as it is received.
+func (client *Client) DownloadApplicationDroplet(appGUID string, droplet io.Writer) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.AppDropletDownloadRequest,
+		URIParams:   Params{"app_guid": appGUID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := cloudcontroller.Response{
+		Writer: droplet,
+	}
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
+// UploadApplicationDroplet uploads droplet, a gzipped tarball of a staged
+// application, as the droplet of the application. The droplet is streamed to
+// the Cloud Controller as it is read. It returns the job processing the
+// upload.
+func (client *Client) UploadApplicationDroplet(appGUID string, droplet io.Reader) (Job, Warnings, error) {
+	body, contentType := cloudcontroller.NewMultipartFile("droplet", "droplet.tgz", droplet)
+	defer body.Close()
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.AppDropletUploadRequest,
+		URIParams:   Params{"app_guid": appGUID},
+		Query:       url.Values{"async": {"true"}},
+		Body:        body,
+	})
+	if err != nil {
+		return Job{}, nil, err
+	}
+	request.Header.Set("Content-Type", contentType)
+	request = cloudcontroller.MarkStreaming(request)
+
+	var job Job
+	response := cloudcontroller.Response{
+		Result: &job,
+	}
+
+	err = client.connection.Make(request, &response)
+	return job, response.Warnings, err
+}
@@ -0,0 +1,131 @@
+package ccv2_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Droplet", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("DownloadApplicationDroplet", func() {
+		Context("when the app has a droplet", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/apps/some-app-guid/droplet/download"),
+						RespondWith(http.StatusOK, "some-droplet-bits", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("writes the droplet and returns all warnings", func() {
+				droplet := &bytes.Buffer{}
+				warnings, err := client.DownloadApplicationDroplet("some-app-guid", droplet)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(droplet.String()).To(Equal("some-droplet-bits"))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the client returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 10010,
+					"description": "Droplet not found",
+					"error_code": "CF-NotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/apps/some-app-guid/droplet/download"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				droplet := &bytes.Buffer{}
+				warnings, err := client.DownloadApplicationDroplet("some-app-guid", droplet)
+				Expect(err).To(MatchError(cloudcontroller.ResourceNotFoundError{
+					Message: "Droplet not found",
+				}))
+				Expect(droplet.Len()).To(BeZero())
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
+	Describe("UploadApplicationDroplet", func() {
+		Context("when the upload is accepted", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-job-guid"
+					},
+					"entity": {
+						"guid": "some-job-guid",
+						"status": "queued"
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/apps/some-app-guid/droplet/upload", "async=true"),
+						func(_ http.ResponseWriter, request *http.Request) {
+							Expect(request.Header.Get("Content-Type")).To(HavePrefix("multipart/form-data; boundary="))
+
+							file, _, err := request.FormFile("droplet")
+							Expect(err).NotTo(HaveOccurred())
+							contents, err := ioutil.ReadAll(file)
+							Expect(err).NotTo(HaveOccurred())
+							Expect(string(contents)).To(Equal("some-droplet-bits"))
+						},
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("uploads the droplet and returns the job and all warnings", func() {
+				job, warnings, err := client.UploadApplicationDroplet("some-app-guid", strings.NewReader("some-droplet-bits"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(job).To(Equal(Job{GUID: "some-job-guid", Status: JobStatusQueued}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the client returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 100004,
+					"description": "The app could not be found: some-app-guid",
+					"error_code": "CF-AppNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/apps/some-app-guid/droplet/upload", "async=true"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.UploadApplicationDroplet("some-app-guid", strings.NewReader("some-droplet-bits"))
+				Expect(err).To(MatchError(cloudcontroller.ResourceNotFoundError{
+					Message: "The app could not be found: some-app-guid",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+})
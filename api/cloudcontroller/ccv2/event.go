@@ -0,0 +1,86 @@
+package ccv2
+
+import (
+	"encoding/json"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// Event represents a Cloud Controller Event.
+type Event struct {
+	// GUID is the unique event identifier.
+	GUID string
+
+	// Type is the type of event, e.g. app.crash or audit.app.update.
+	Type string
+
+	// Timestamp is the time the event occurred.
+	Timestamp time.Time
+
+	// ActorGUID is the GUID of the user or process that caused the event.
+	ActorGUID string
+
+	// ActorName is the name of the user or process that caused the event.
+	ActorName string
+
+	// ActeeGUID is the GUID of the resource the event applies to.
+	ActeeGUID string
+
+	// Metadata contains the type specific details of the event.
+	Metadata map[string]interface{}
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Event response.
+func (event *Event) UnmarshalJSON(data []byte) error {
+	var ccEvent struct {
+		Metadata internal.Metadata `json:"metadata"`
+		Entity   struct {
+			Type      string                 `json:"type"`
+			Timestamp time.Time              `json:"timestamp"`
+			Actor     string                 `json:"actor"`
+			ActorName string                 `json:"actor_name"`
+			Actee     string                 `json:"actee"`
+			Metadata  map[string]interface{} `json:"metadata"`
+		} `json:"entity"`
+	}
+	if err := json.Unmarshal(data, &ccEvent); err != nil {
+		return err
+	}
+
+	event.GUID = ccEvent.Metadata.GUID
+	event.Type = ccEvent.Entity.Type
+	event.Timestamp = ccEvent.Entity.Timestamp
+	event.ActorGUID = ccEvent.Entity.Actor
+	event.ActorName = ccEvent.Entity.ActorName
+	event.ActeeGUID = ccEvent.Entity.Actee
+	event.Metadata = ccEvent.Entity.Metadata
+	return nil
+}
+
+// GetEvents returns back a list of Events based off of the provided queries.
+func (client *Client) GetEvents(queries []Query) ([]Event, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.EventsRequest,
+		Query:       FormatQueryParameters(queries),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullEventsList []Event
+	warnings, err := client.paginate(request, Event{}, func(item interface{}) error {
+		if event, ok := item.(Event); ok {
+			fullEventsList = append(fullEventsList, event)
+		} else {
+			return cloudcontroller.UnknownObjectInListError{
+				Expected:   Event{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullEventsList, warnings, err
+}
@@ -0,0 +1,155 @@
+package ccv2_test
+
+import (
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Event", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetEvents", func() {
+		var (
+			events   []Event
+			warnings Warnings
+			err      error
+		)
+
+		JustBeforeEach(func() {
+			events, warnings, err = client.GetEvents([]Query{
+				{
+					Filter:   ActeeFilter,
+					Operator: EqualOperator,
+					Value:    "some-app-guid",
+				},
+				{
+					Filter:   TypeFilter,
+					Operator: EqualOperator,
+					Value:    "app.crash",
+				},
+				{
+					Filter:   TimestampFilter,
+					Operator: GreaterThanOrEqualOperator,
+					Value:    "2017-05-04T00:00:00Z",
+				},
+			})
+		})
+
+		Context("when events exist", func() {
+			BeforeEach(func() {
+				response1 := `{
+					"next_url": "/v2/events?q=actee:some-app-guid&q=type:app.crash&q=timestamp>=2017-05-04T00:00:00Z&page=2",
+					"resources": [
+						{
+							"metadata": {
+								"guid": "event-guid-1"
+							},
+							"entity": {
+								"type": "app.crash",
+								"actor": "some-app-guid",
+								"actor_name": "some-app",
+								"actee": "some-app-guid",
+								"timestamp": "2017-05-04T15:04:05Z",
+								"metadata": {
+									"index": 1,
+									"exit_status": 137,
+									"exit_description": "out of memory"
+								}
+							}
+						}
+					]
+				}`
+				response2 := `{
+					"next_url": null,
+					"resources": [
+						{
+							"metadata": {
+								"guid": "event-guid-2"
+							},
+							"entity": {
+								"type": "app.crash",
+								"actor": "some-app-guid",
+								"actor_name": "some-app",
+								"actee": "some-app-guid",
+								"timestamp": "2017-05-04T16:04:05Z",
+								"metadata": {}
+							}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/events", "q=actee:some-app-guid&q=type:app.crash&q=timestamp>=2017-05-04T00:00:00Z"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/events", "q=actee:some-app-guid&q=type:app.crash&q=timestamp>=2017-05-04T00:00:00Z&page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"this is another warning"}}),
+					),
+				)
+			})
+
+			It("returns all the queried events and all warnings", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(events).To(Equal([]Event{
+					{
+						GUID:      "event-guid-1",
+						Type:      "app.crash",
+						Timestamp: time.Date(2017, 5, 4, 15, 4, 5, 0, time.UTC),
+						ActorGUID: "some-app-guid",
+						ActorName: "some-app",
+						ActeeGUID: "some-app-guid",
+						Metadata: map[string]interface{}{
+							"index":            float64(1),
+							"exit_status":      float64(137),
+							"exit_description": "out of memory",
+						},
+					},
+					{
+						GUID:      "event-guid-2",
+						Type:      "app.crash",
+						Timestamp: time.Date(2017, 5, 4, 16, 4, 5, 0, time.UTC),
+						ActorGUID: "some-app-guid",
+						ActorName: "some-app",
+						ActeeGUID: "some-app-guid",
+						Metadata:  map[string]interface{}{},
+					},
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning", "this is another warning"))
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 10003,
+					"description": "You are not authorized to perform the requested action",
+					"error_code": "CF-NotAuthorized"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/events"),
+						RespondWith(http.StatusForbidden, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(err).To(MatchError(cloudcontroller.ForbiddenError{
+					Message: "You are not authorized to perform the requested action",
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+})
@@ -7,12 +7,16 @@ import (
 )
 
 const (
+	AppDropletDownloadRequest     = "AppDropletDownload"
+	AppDropletUploadRequest       = "AppDropletUpload"
 	AppInstanceStats              = "AppInstanceStats"
 	AppsFromRouteRequest          = "AppsFromRoute"
 	AppsRequest                   = "Apps"
+	DeleteAppInstanceRequest      = "DeleteAppInstance"
 	DeleteOrganizationRequest     = "DeleteOrganization"
 	DeleteRouteRequest            = "DeleteRoute"
 	DeleteServiceBindingRequest   = "DeleteServiceBinding"
+	EventsRequest                 = "Events"
 	InfoRequest                   = "Info"
 	JobRequest                    = "Job"
 	OrganizationsRequest          = "Organizations"
@@ -35,8 +39,12 @@ const (
 var APIRoutes = rata.Routes{
 	{Path: "/v2/apps", Method: http.MethodGet, Name: AppsRequest},
 	{Path: "/v2/apps/:app_guid", Method: http.MethodPut, Name: UpdateAppRequest},
+	{Path: "/v2/apps/:app_guid/droplet/download", Method: http.MethodGet, Name: AppDropletDownloadRequest},
+	{Path: "/v2/apps/:app_guid/droplet/upload", Method: http.MethodPut, Name: AppDropletUploadRequest},
+	{Path: "/v2/apps/:app_guid/instances/:index", Method: http.MethodDelete, Name: DeleteAppInstanceRequest},
 	{Path: "/v2/apps/:app_guid/routes", Method: http.MethodGet, Name: RoutesFromApplicationRequest},
 	{Path: "/v2/apps/:app_guid/stats", Method: http.MethodGet, Name: AppInstanceStats},
+	{Path: "/v2/events", Method: http.MethodGet, Name: EventsRequest},
 	{Path: "/v2/info", Method: http.MethodGet, Name: InfoRequest},
 	{Path: "/v2/jobs/:job_guid", Method: http.MethodGet, Name: JobRequest},
 	{Path: "/v2/organizations", Method: http.MethodGet, Name: OrganizationsRequest},
@@ -12,6 +12,8 @@ type QueryFilter string
 type QueryOperator string
 
 const (
+	// ActeeFilter is the name of the actee filter.
+	ActeeFilter QueryFilter = "actee"
 	// AppGUIDFilter is the name of the App GUID filter.
 	AppGUIDFilter QueryFilter = "app_guid"
 	// OrganizationGUIDFilter is the name of the organization GUID filter.
@@ -25,11 +27,17 @@ const (
 
 	// NameFilter is the name of the name filter.
 	NameFilter QueryFilter = "name"
+	// TimestampFilter is the name of the timestamp filter.
+	TimestampFilter QueryFilter = "timestamp"
+	// TypeFilter is the name of the type filter.
+	TypeFilter QueryFilter = "type"
 )
 
 const (
 	// EqualOperator is the query equal operator.
 	EqualOperator QueryOperator = ":"
+	// GreaterThanOrEqualOperator is the query greater than or equal operator.
+	GreaterThanOrEqualOperator QueryOperator = ">="
 )
 
 // Query is a type of filter that can be passed to specific request to narrow
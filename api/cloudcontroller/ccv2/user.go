@@ -47,6 +47,9 @@ func (client *Client) NewUser(uaaUserID string) (User, Warnings, error) {
 	if err != nil {
 		return User{}, nil, err
 	}
+	// The user's GUID is the UAA user ID, so repeating the request cannot
+	// register a second user.
+	request = cloudcontroller.MarkRetrySafe(request)
 
 	var user User
 	response := cloudcontroller.Response{
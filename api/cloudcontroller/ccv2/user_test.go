@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/ghttp"
@@ -57,6 +58,39 @@ var _ = Describe("User", func() {
 			})
 		})
 
+		Context("when the request is retried", func() {
+			BeforeEach(func() {
+				retry := wrapper.NewRetryRequest(1)
+				retry.BaseDelay = 0
+				client.WrapConnection(retry)
+
+				response := `{
+					 "metadata": {
+							"guid": "some-guid"
+					 }
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/users"),
+						RespondWith(http.StatusBadGateway, `{}`),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/users"),
+						VerifyJSON(`{"guid":"some-uaa-guid"}`),
+						RespondWith(http.StatusCreated, response),
+					),
+				)
+			})
+
+			It("is marked as safe to retry", func() {
+				user, _, err := client.NewUser("some-uaa-guid")
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(user).To(Equal(User{GUID: "some-guid"}))
+				Expect(server.ReceivedRequests()).To(HaveLen(3))
+			})
+		})
+
 		Context("when cloud controller returns an error and warnings", func() {
 			BeforeEach(func() {
 				response := `{
@@ -0,0 +1,130 @@
+package ccv3
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+// Droplet represents a Cloud Controller V3 Droplet, the result of staging an
+// application.
+type Droplet struct {
+	GUID  string `json:"guid"`
+	State string `json:"state"`
+}
+
+// newDropletBody represents the body of the request to create a Droplet.
+type newDropletBody struct {
+	Relationships struct {
+		App struct {
+			Data struct {
+				GUID string `json:"guid"`
+			} `json:"data"`
+		} `json:"app"`
+	} `json:"relationships"`
+}
+
+// GetApplicationCurrentDroplet returns the droplet the application runs.
+func (client *Client) GetApplicationCurrentDroplet(appGUID string) (Droplet, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetAppCurrentDropletRequest,
+		URIParams: internal.Params{
+			"guid": appGUID,
+		},
+	})
+	if err != nil {
+		return Droplet{}, nil, err
+	}
+
+	var droplet Droplet
+	response := cloudcontroller.Response{
+		Result: &droplet,
+	}
+
+	err = client.connection.Make(request, &response)
+	if err != nil {
+		return Droplet{}, response.Warnings, err
+	}
+
+	return droplet, response.Warnings, nil
+}
+
+// DownloadDroplet writes the bits of the droplet, a gzipped tarball of the
+// staged application, to bits as they are received.
+func (client *Client) DownloadDroplet(dropletGUID string, bits io.Writer) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetDropletBitsRequest,
+		URIParams: internal.Params{
+			"guid": dropletGUID,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := cloudcontroller.Response{
+		Writer: bits,
+	}
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
+// NewDroplet creates a droplet for the application that awaits the upload of
+// its bits.
+func (client *Client) NewDroplet(appGUID string) (Droplet, Warnings, error) {
+	var body newDropletBody
+	body.Relationships.App.Data.GUID = appGUID
+
+	bodyBytes, err := json.Marshal(body)
+	if err != nil {
+		return Droplet{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.NewDropletRequest,
+		Body:        bytes.NewBuffer(bodyBytes),
+	})
+	if err != nil {
+		return Droplet{}, nil, err
+	}
+
+	var droplet Droplet
+	response := cloudcontroller.Response{
+		Result: &droplet,
+	}
+
+	err = client.connection.Make(request, &response)
+	if err != nil {
+		return Droplet{}, response.Warnings, err
+	}
+
+	return droplet, response.Warnings, nil
+}
+
+// UploadDropletBits uploads bits, a gzipped tarball of a staged application,
+// as the bits of a droplet created with NewDroplet. The bits are streamed to
+// the Cloud Controller as they are read.
+func (client *Client) UploadDropletBits(dropletGUID string, bits io.Reader) (Warnings, error) {
+	body, contentType := cloudcontroller.NewMultipartFile("bits", "droplet.tgz", bits)
+	defer body.Close()
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.UploadDropletBitsRequest,
+		URIParams: internal.Params{
+			"guid": dropletGUID,
+		},
+		Body: body,
+	})
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", contentType)
+	request = cloudcontroller.MarkStreaming(request)
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
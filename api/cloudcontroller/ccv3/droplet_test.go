@@ -0,0 +1,139 @@
+package ccv3_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Droplet", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetApplicationCurrentDroplet", func() {
+		Context("when the application has a current droplet", func() {
+			BeforeEach(func() {
+				response := `{
+  "guid": "some-droplet-guid",
+  "state": "STAGED"
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/droplets/current"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning"}}),
+					),
+				)
+			})
+
+			It("returns the droplet and all warnings", func() {
+				droplet, warnings, err := client.GetApplicationCurrentDroplet("some-app-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(droplet).To(Equal(Droplet{GUID: "some-droplet-guid", State: "STAGED"}))
+				Expect(warnings).To(ConsistOf("warning"))
+			})
+		})
+
+		Context("when the application does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+  "errors": [
+    {
+      "code": 10010,
+      "detail": "App not found",
+      "title": "CF-ResourceNotFound"
+    }
+  ]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/droplets/current"),
+						RespondWith(http.StatusNotFound, response),
+					),
+				)
+			})
+
+			It("returns a ResourceNotFoundError", func() {
+				_, _, err := client.GetApplicationCurrentDroplet("some-app-guid")
+				Expect(err).To(MatchError(cloudcontroller.ResourceNotFoundError{Message: "App not found"}))
+			})
+		})
+	})
+
+	Describe("DownloadDroplet", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v3/droplets/some-droplet-guid/download"),
+					RespondWith(http.StatusOK, "some-droplet-bits", http.Header{"X-Cf-Warnings": {"warning"}}),
+				),
+			)
+		})
+
+		It("writes the bits of the droplet and returns all warnings", func() {
+			bits := &bytes.Buffer{}
+			warnings, err := client.DownloadDroplet("some-droplet-guid", bits)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bits.String()).To(Equal("some-droplet-bits"))
+			Expect(warnings).To(ConsistOf("warning"))
+		})
+	})
+
+	Describe("NewDroplet", func() {
+		BeforeEach(func() {
+			response := `{
+  "guid": "some-droplet-guid",
+  "state": "AWAITING_UPLOAD"
+}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/v3/droplets"),
+					VerifyJSON(`{"relationships":{"app":{"data":{"guid":"some-app-guid"}}}}`),
+					RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"warning"}}),
+				),
+			)
+		})
+
+		It("creates a droplet for the application", func() {
+			droplet, warnings, err := client.NewDroplet("some-app-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(droplet).To(Equal(Droplet{GUID: "some-droplet-guid", State: "AWAITING_UPLOAD"}))
+			Expect(warnings).To(ConsistOf("warning"))
+		})
+	})
+
+	Describe("UploadDropletBits", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/v3/droplets/some-droplet-guid/upload"),
+					func(_ http.ResponseWriter, request *http.Request) {
+						Expect(request.Header.Get("Content-Type")).To(HavePrefix("multipart/form-data; boundary="))
+
+						file, _, err := request.FormFile("bits")
+						Expect(err).NotTo(HaveOccurred())
+						contents, err := ioutil.ReadAll(file)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(string(contents)).To(Equal("some-droplet-bits"))
+					},
+					RespondWith(http.StatusAccepted, "{}", http.Header{"X-Cf-Warnings": {"warning"}}),
+				),
+			)
+		})
+
+		It("uploads the bits and returns all warnings", func() {
+			warnings, err := client.UploadDropletBits("some-droplet-guid", strings.NewReader("some-droplet-bits"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("warning"))
+		})
+	})
+})
@@ -17,9 +17,17 @@ type APIInfo struct {
 
 		// UAA is the link to the UAA API
 		UAA APILink `json:"uaa"`
+
+		// Logging is the link to the Loggregator Traffic Controller
+		Logging APILink `json:"logging"`
 	} `json:"links"`
 }
 
+// Logging returns the HREF for the Loggregator Traffic Controller.
+func (info APIInfo) Logging() string {
+	return info.Links.Logging.HREF
+}
+
 // UAA return the HREF for the UAA.
 func (info APIInfo) UAA() string {
 	return info.Links.UAA.HREF
@@ -56,6 +56,9 @@ var _ = Describe("Info", func() {
 					},
 					"uaa": {
 						"href": "https://uaa.bosh-lite.com"
+					},
+					"logging": {
+						"href": "wss://doppler.bosh-lite.com:443"
 					}
 				}
 			}`, "SERVER_URL", server.URL(), -1)
@@ -89,6 +92,7 @@ var _ = Describe("Info", func() {
 			apis, _, _, err := client.Info()
 			Expect(err).NotTo(HaveOccurred())
 			Expect(apis.UAA()).To(Equal("https://uaa.bosh-lite.com"))
+			Expect(apis.Logging()).To(Equal("wss://doppler.bosh-lite.com:443"))
 		})
 
 		It("returns back the resource links", func() {
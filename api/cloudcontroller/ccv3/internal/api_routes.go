@@ -3,14 +3,20 @@ package internal
 import "net/http"
 
 const (
-	GetAppTasksRequest = "AppTasks"
-	GetAppsRequest     = "Apps"
-	NewAppTaskRequest  = "NewAppTask"
+	GetAppCurrentDropletRequest = "AppCurrentDroplet"
+	GetAppTasksRequest          = "AppTasks"
+	GetAppsRequest              = "Apps"
+	GetDropletBitsRequest       = "DropletBits"
+	GetTaskRequest              = "Task"
+	NewAppTaskRequest           = "NewAppTask"
+	NewDropletRequest           = "NewDroplet"
+	UploadDropletBitsRequest    = "UploadDropletBits"
 )
 
 const (
-	AppsResource  = "apps"
-	TasksResource = "tasks"
+	AppsResource     = "apps"
+	DropletsResource = "droplets"
+	TasksResource    = "tasks"
 )
 
 // APIRoutes is a list of routes used by the router to construct request URLs.
@@ -18,4 +24,9 @@ var APIRoutes = []Route{
 	{Path: "/", Method: http.MethodGet, Name: GetAppsRequest, Resource: AppsResource},
 	{Path: "/:guid/tasks", Method: http.MethodGet, Name: GetAppTasksRequest, Resource: AppsResource},
 	{Path: "/:guid/tasks", Method: http.MethodPost, Name: NewAppTaskRequest, Resource: AppsResource},
+	{Path: "/:guid/droplets/current", Method: http.MethodGet, Name: GetAppCurrentDropletRequest, Resource: AppsResource},
+	{Path: "/", Method: http.MethodPost, Name: NewDropletRequest, Resource: DropletsResource},
+	{Path: "/:guid/download", Method: http.MethodGet, Name: GetDropletBitsRequest, Resource: DropletsResource},
+	{Path: "/:guid/upload", Method: http.MethodPost, Name: UploadDropletBitsRequest, Resource: DropletsResource},
+	{Path: "/:guid", Method: http.MethodGet, Name: GetTaskRequest, Resource: TasksResource},
 }
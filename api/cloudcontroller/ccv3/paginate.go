@@ -1,11 +1,16 @@
 package ccv3
 
 import (
+	"errors"
 	"net/http"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 )
 
+// errStopPaginating is returned by appendToExternalList to stop paginate from
+// requesting more pages, e.g. once enough resources have been collected.
+var errStopPaginating = errors.New("stop paginating")
+
 func (client Client) paginate(request *http.Request, obj interface{}, appendToExternalList func(interface{}) error) (Warnings, error) {
 	fullWarningsList := Warnings{}
 
@@ -28,6 +33,9 @@ func (client Client) paginate(request *http.Request, obj interface{}, appendToEx
 
 		for _, item := range list {
 			err = appendToExternalList(item)
+			if err == errStopPaginating {
+				return fullWarningsList, nil
+			}
 			if err != nil {
 				return fullWarningsList, err
 			}
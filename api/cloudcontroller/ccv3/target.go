@@ -5,6 +5,7 @@ import (
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+	"code.cloudfoundry.org/cli/util/proxy"
 )
 
 // TargetSettings represents configuration for establishing a connection to the
@@ -23,8 +24,26 @@ type TargetSettings struct {
 	// be used only for testing.
 	SkipSSLValidation bool
 
+	// CACertFile is the path to a PEM encoded bundle of certificate
+	// authorities that are trusted in addition to the system's.
+	CACertFile string
+
+	// ClientCertFile and ClientKeyFile are the paths to the PEM encoded
+	// certificate and key presented to a Cloud Controller that requires a
+	// client certificate.
+	ClientCertFile string
+	ClientKeyFile  string
+
+	// Proxy decides which proxy connections go through. If nil, the proxy
+	// settings in the environment are used.
+	Proxy *proxy.Resolver
+
 	// URL is a fully qualified URL to the Cloud Controller API.
 	URL string
+
+	// Wrappers are applied to the connection before any other wrapper, in the
+	// order given, so they also see the requests made while targeting.
+	Wrappers []ConnectionWrapper
 }
 
 // TargetCF sets the client to use the Cloud Controller specified in the
@@ -32,10 +51,21 @@ type TargetSettings struct {
 func (client *Client) TargetCF(settings TargetSettings) (Warnings, error) {
 	client.cloudControllerURL = settings.URL
 
-	client.connection = cloudcontroller.NewConnection(cloudcontroller.Config{
+	connection, err := cloudcontroller.NewConnection(cloudcontroller.Config{
 		DialTimeout:       settings.DialTimeout,
 		SkipSSLValidation: settings.SkipSSLValidation,
+		CACertFile:        settings.CACertFile,
+		ClientCertFile:    settings.ClientCertFile,
+		ClientKeyFile:     settings.ClientKeyFile,
+		Proxy:             settings.Proxy,
 	})
+	if err != nil {
+		return nil, err
+	}
+	client.connection = connection
+	for _, wrapper := range settings.Wrappers {
+		client.WrapConnection(wrapper)
+	}
 	client.WrapConnection(newErrorWrapper()) //Pretty Sneaky, Sis..
 
 	apiInfo, resourceLinks, warnings, err := client.Info()
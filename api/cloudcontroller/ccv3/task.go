@@ -6,33 +6,57 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strconv"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
 )
 
+const (
+	// TaskSucceeded is the state of a task that exited successfully.
+	TaskSucceeded = "SUCCEEDED"
+
+	// TaskFailed is the state of a task that exited unsuccessfully or was
+	// cancelled.
+	TaskFailed = "FAILED"
+)
+
 // Task represents a Cloud Controller V3 Task.
 type Task struct {
-	GUID       string `json:"guid"`
-	SequenceID int    `json:"sequence_id"`
-	Name       string `json:"name"`
-	Command    string `json:"command"`
-	State      string `json:"state"`
-	CreatedAt  string `json:"created_at"`
+	GUID       string     `json:"guid"`
+	SequenceID int        `json:"sequence_id"`
+	Name       string     `json:"name"`
+	Command    string     `json:"command"`
+	State      string     `json:"state"`
+	CreatedAt  string     `json:"created_at"`
+	MemoryInMB uint64     `json:"memory_in_mb"`
+	DiskInMB   uint64     `json:"disk_in_mb"`
+	Result     TaskResult `json:"result"`
+}
+
+// TaskResult represents the outcome of a Task.
+type TaskResult struct {
+	// FailureReason explains why a task in the FAILED state failed.
+	FailureReason string `json:"failure_reason"`
 }
 
 // NewTaskBody represents the body of the request to create a Task.
 type NewTaskBody struct {
-	Command string `json:"command"`
-	Name    string `json:"name,omitempty"`
+	Command    string `json:"command"`
+	Name       string `json:"name,omitempty"`
+	MemoryInMB uint64 `json:"memory_in_mb,omitempty"`
+	DiskInMB   uint64 `json:"disk_in_mb,omitempty"`
 }
 
-// NewTask runs a command in the Application environment associated with the
-// provided Application GUID.
-func (client *Client) NewTask(appGUID string, command string, name string) (Task, Warnings, error) {
+// NewTask runs the task's command in the Application environment associated
+// with the provided Application GUID. The task's name and memory and disk
+// limits are optional.
+func (client *Client) NewTask(appGUID string, task Task) (Task, Warnings, error) {
 	bodyBytes, err := json.Marshal(NewTaskBody{
-		Command: command,
-		Name:    name,
+		Command:    task.Command,
+		Name:       task.Name,
+		MemoryInMB: task.MemoryInMB,
+		DiskInMB:   task.DiskInMB,
 	})
 	if err != nil {
 		return Task{}, nil, err
@@ -49,6 +73,31 @@ func (client *Client) NewTask(appGUID string, command string, name string) (Task
 		return Task{}, nil, err
 	}
 
+	var createdTask Task
+	response := cloudcontroller.Response{
+		Result: &createdTask,
+	}
+
+	err = client.connection.Make(request, &response)
+	if err != nil {
+		return Task{}, response.Warnings, err
+	}
+
+	return createdTask, response.Warnings, nil
+}
+
+// GetTask returns the task with the provided GUID.
+func (client *Client) GetTask(taskGUID string) (Task, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetTaskRequest,
+		URIParams: internal.Params{
+			"guid": taskGUID,
+		},
+	})
+	if err != nil {
+		return Task{}, nil, err
+	}
+
 	var task Task
 	response := cloudcontroller.Response{
 		Result: &task,
@@ -62,9 +111,23 @@ func (client *Client) NewTask(appGUID string, command string, name string) (Task
 	return task, response.Warnings, nil
 }
 
+// maxPerPage is the largest page size accepted by the Cloud Controller.
+const maxPerPage = 5000
+
 // GetApplicationTasks returns a list of tasks associated with the provided
-// application GUID. Results can be filtered by providing URL queries.
-func (client *Client) GetApplicationTasks(appGUID string, query url.Values) ([]Task, Warnings, error) {
+// application GUID. Results can be filtered by providing URL queries. When
+// limit is positive at most limit tasks are returned, and no more pages are
+// requested than needed for them.
+func (client *Client) GetApplicationTasks(appGUID string, query url.Values, limit int) ([]Task, Warnings, error) {
+	if limit > 0 && query.Get("per_page") == "" {
+		perPage := limit
+		if perPage > maxPerPage {
+			perPage = maxPerPage
+		}
+		query = copyQuery(query)
+		query.Set("per_page", strconv.Itoa(perPage))
+	}
+
 	request, err := client.newHTTPRequest(requestOptions{
 		RequestName: internal.GetAppTasksRequest,
 		URIParams: internal.Params{
@@ -80,6 +143,9 @@ func (client *Client) GetApplicationTasks(appGUID string, query url.Values) ([]T
 	warnings, err := client.paginate(request, Task{}, func(item interface{}) error {
 		if task, ok := item.(Task); ok {
 			fullTasksList = append(fullTasksList, task)
+			if limit > 0 && len(fullTasksList) == limit {
+				return errStopPaginating
+			}
 		} else {
 			return cloudcontroller.UnknownObjectInListError{
 				Expected:   Task{},
@@ -114,3 +180,13 @@ func (client *Client) UpdateTask(taskGUID string) (Task, Warnings, error) {
 
 	return task, response.Warnings, nil
 }
+
+// copyQuery returns a copy of query that can be modified without affecting
+// the caller.
+func copyQuery(query url.Values) url.Values {
+	copied := url.Values{}
+	for key, values := range query {
+		copied[key] = append([]string{}, values...)
+	}
+	return copied
+}
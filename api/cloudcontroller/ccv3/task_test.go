@@ -42,7 +42,7 @@ var _ = Describe("Task", func() {
 				})
 
 				It("creates and returns the task and all warnings", func() {
-					task, warnings, err := client.NewTask("some-app-guid", "some command", "")
+					task, warnings, err := client.NewTask("some-app-guid", Task{Command: "some command"})
 					Expect(err).ToNot(HaveOccurred())
 
 					Expect(task).To(Equal(Task{SequenceID: 3}))
@@ -62,7 +62,27 @@ var _ = Describe("Task", func() {
 				})
 
 				It("creates and returns the task and all warnings", func() {
-					task, warnings, err := client.NewTask("some-app-guid", "some command", "some-task-name")
+					task, warnings, err := client.NewTask("some-app-guid", Task{Command: "some command", Name: "some-task-name"})
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(task).To(Equal(Task{SequenceID: 3}))
+					Expect(warnings).To(ConsistOf("warning"))
+				})
+			})
+
+			Context("when memory and disk limits are given", func() {
+				BeforeEach(func() {
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodPost, "/v3/apps/some-app-guid/tasks"),
+							VerifyJSON(`{"command":"some command", "memory_in_mb":512, "disk_in_mb":1024}`),
+							RespondWith(http.StatusAccepted, response, http.Header{"X-Cf-Warnings": {"warning"}}),
+						),
+					)
+				})
+
+				It("creates the task with the limits", func() {
+					task, warnings, err := client.NewTask("some-app-guid", Task{Command: "some command", MemoryInMB: 512, DiskInMB: 1024})
 					Expect(err).ToNot(HaveOccurred())
 
 					Expect(task).To(Equal(Task{SequenceID: 3}))
@@ -91,7 +111,7 @@ var _ = Describe("Task", func() {
 			})
 
 			It("returns a ResourceNotFoundError", func() {
-				_, _, err := client.NewTask("some-app-guid", "some command", "")
+				_, _, err := client.NewTask("some-app-guid", Task{Command: "some command"})
 				Expect(err).To(MatchError(cloudcontroller.ResourceNotFoundError{Message: "App not found"}))
 			})
 		})
@@ -121,7 +141,7 @@ var _ = Describe("Task", func() {
 			})
 
 			It("returns the errors and all warnings", func() {
-				_, warnings, err := client.NewTask("some-app-guid", "some command", "")
+				_, warnings, err := client.NewTask("some-app-guid", Task{Command: "some command"})
 				Expect(err).To(MatchError(UnexpectedResponseError{
 					ResponseCode: http.StatusTeapot,
 					CCErrorResponse: CCErrorResponse{
@@ -202,7 +222,7 @@ var _ = Describe("Task", func() {
 			})
 
 			It("returns a list of tasks associated with the application and all warnings", func() {
-				tasks, warnings, err := client.GetApplicationTasks("some-app-guid", url.Values{"per_page": []string{"2"}})
+				tasks, warnings, err := client.GetApplicationTasks("some-app-guid", url.Values{"per_page": []string{"2"}}, 0)
 				Expect(err).ToNot(HaveOccurred())
 
 				Expect(tasks).To(ConsistOf(
@@ -235,6 +255,49 @@ var _ = Describe("Task", func() {
 			})
 		})
 
+		Context("when a limit is given", func() {
+			BeforeEach(func() {
+				response := fmt.Sprintf(`{
+  "pagination": {
+    "next": {
+      "href": "%s/v3/apps/some-app-guid/tasks?states=RUNNING&per_page=2&page=2"
+    }
+  },
+  "resources": [
+    {
+      "guid": "task-1-guid",
+      "sequence_id": 1,
+      "state": "RUNNING"
+    },
+    {
+      "guid": "task-2-guid",
+      "sequence_id": 2,
+      "state": "RUNNING"
+    }
+  ]
+}`, server.URL())
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/tasks", "per_page=2&states=RUNNING"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("requests pages of that size and stops once it has enough tasks", func() {
+				query := url.Values{"states": []string{"RUNNING"}}
+				tasks, warnings, err := client.GetApplicationTasks("some-app-guid", query, 2)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(tasks).To(Equal([]Task{
+					{GUID: "task-1-guid", SequenceID: 1, State: "RUNNING"},
+					{GUID: "task-2-guid", SequenceID: 2, State: "RUNNING"},
+				}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+				Expect(query).To(Equal(url.Values{"states": []string{"RUNNING"}}))
+			})
+		})
+
 		Context("when the application does not exist", func() {
 			BeforeEach(func() {
 				response := `{
@@ -255,7 +318,7 @@ var _ = Describe("Task", func() {
 			})
 
 			It("returns a ResourceNotFoundError", func() {
-				_, _, err := client.GetApplicationTasks("some-app-guid", nil)
+				_, _, err := client.GetApplicationTasks("some-app-guid", nil, 0)
 				Expect(err).To(MatchError(cloudcontroller.ResourceNotFoundError{Message: "App not found"}))
 			})
 		})
@@ -285,7 +348,7 @@ var _ = Describe("Task", func() {
 			})
 
 			It("returns the errors and all warnings", func() {
-				_, warnings, err := client.GetApplicationTasks("some-app-guid", nil)
+				_, warnings, err := client.GetApplicationTasks("some-app-guid", nil, 0)
 				Expect(err).To(MatchError(UnexpectedResponseError{
 					ResponseCode: http.StatusTeapot,
 					CCErrorResponse: CCErrorResponse{
@@ -308,6 +371,76 @@ var _ = Describe("Task", func() {
 		})
 	})
 
+	Describe("GetTask", func() {
+		Context("when the task exists", func() {
+			BeforeEach(func() {
+				response := `{
+          "guid": "task-3-guid",
+          "sequence_id": 3,
+          "name": "task-3",
+          "command": "some-command",
+          "state": "FAILED",
+          "created_at": "2016-11-07T07:59:01Z",
+          "memory_in_mb": 512,
+          "disk_in_mb": 1024,
+          "result": {
+            "failure_reason": "Exited with status 1"
+          }
+        }`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/tasks/task-3-guid"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning"}}),
+					),
+				)
+			})
+
+			It("returns the task and warnings", func() {
+				task, warnings, err := client.GetTask("task-3-guid")
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(task).To(Equal(Task{
+					GUID:       "task-3-guid",
+					SequenceID: 3,
+					Name:       "task-3",
+					Command:    "some-command",
+					State:      TaskFailed,
+					CreatedAt:  "2016-11-07T07:59:01Z",
+					MemoryInMB: 512,
+					DiskInMB:   1024,
+					Result:     TaskResult{FailureReason: "Exited with status 1"},
+				}))
+				Expect(warnings).To(ConsistOf("warning"))
+			})
+		})
+
+		Context("when the task does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+  "errors": [
+    {
+      "code": 10010,
+      "detail": "Task not found",
+      "title": "CF-ResourceNotFound"
+    }
+  ]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/tasks/task-3-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning"}}),
+					),
+				)
+			})
+
+			It("returns a ResourceNotFoundError and all warnings", func() {
+				_, warnings, err := client.GetTask("task-3-guid")
+				Expect(err).To(MatchError(cloudcontroller.ResourceNotFoundError{Message: "Task not found"}))
+				Expect(warnings).To(ConsistOf("warning"))
+			})
+		})
+	})
+
 	Describe("UpdateTask", func() {
 		Context("when the request succeeds", func() {
 			BeforeEach(func() {
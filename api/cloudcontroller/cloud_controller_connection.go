@@ -5,12 +5,16 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/json"
+	"io"
 	"io/ioutil"
 	"net"
 	"net/http"
 	"net/url"
 	"strings"
 	"time"
+
+	"code.cloudfoundry.org/cli/util/proxy"
+	"code.cloudfoundry.org/cli/util/tlsconfig"
 )
 
 // CloudControllerConnection represents a connection to the Cloud Controller
@@ -24,25 +28,51 @@ type CloudControllerConnection struct {
 type Config struct {
 	DialTimeout       time.Duration
 	SkipSSLValidation bool
+
+	// CACertFile is the path to a PEM encoded bundle of certificate
+	// authorities that are trusted in addition to the system's.
+	CACertFile string
+
+	// ClientCertFile and ClientKeyFile are the paths to the PEM encoded
+	// certificate and key presented to servers that require a client
+	// certificate.
+	ClientCertFile string
+	ClientKeyFile  string
+
+	// Proxy decides which proxy connections go through. If nil, the proxy
+	// settings in the environment are used.
+	Proxy *proxy.Resolver
 }
 
 // NewConnection returns a new CloudControllerConnection with provided
-// configuration.
-func NewConnection(config Config) *CloudControllerConnection {
+// configuration. An error is returned if the certificate files cannot be
+// loaded.
+func NewConnection(config Config) (*CloudControllerConnection, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: config.SkipSSLValidation,
+	}
+	err := tlsconfig.Apply(tlsConfig, config.CACertFile, config.ClientCertFile, config.ClientKeyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	resolver := config.Proxy
+	if resolver == nil {
+		resolver = proxy.FromEnvironment()
+	}
+
 	tr := &http.Transport{
-		TLSClientConfig: &tls.Config{
-			InsecureSkipVerify: config.SkipSSLValidation,
-		},
-		Proxy: http.ProxyFromEnvironment,
-		DialContext: (&net.Dialer{
+		TLSClientConfig: tlsConfig,
+		Proxy:           resolver.Proxy,
+		DialContext: resolver.DialContext(&net.Dialer{
 			KeepAlive: 30 * time.Second,
 			Timeout:   config.DialTimeout,
-		}).DialContext,
+		}),
 	}
 
 	return &CloudControllerConnection{
 		HTTPClient: &http.Client{Transport: tr},
-	}
+	}, nil
 }
 
 // Make performs the request and parses the response.
@@ -93,6 +123,12 @@ func (connection *CloudControllerConnection) populateResponse(response *http.Res
 		}
 	}
 
+	if passedResponse.Writer != nil && response.StatusCode < 400 {
+		defer response.Body.Close()
+		_, err := io.Copy(passedResponse.Writer, response.Body)
+		return err
+	}
+
 	rawBytes, err := ioutil.ReadAll(response.Body)
 	defer response.Body.Close()
 	if err != nil {
@@ -1,12 +1,18 @@
 package cloudcontroller_test
 
 import (
+	"bytes"
+	"encoding/pem"
 	"fmt"
+	"io/ioutil"
 	"net/http"
+	"os"
+	"path/filepath"
 	"runtime"
 	"strings"
 
 	. "code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/util/tlsconfig"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/ghttp"
@@ -21,7 +27,18 @@ var _ = Describe("Cloud Controller Connection", func() {
 	var connection *CloudControllerConnection
 
 	BeforeEach(func() {
-		connection = NewConnection(Config{SkipSSLValidation: true})
+		var err error
+		connection, err = NewConnection(Config{SkipSSLValidation: true})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("NewConnection", func() {
+		Context("when the certificate files cannot be loaded", func() {
+			It("returns the error", func() {
+				_, err := NewConnection(Config{ClientCertFile: "some-cert-file"})
+				Expect(err).To(MatchError(tlsconfig.IncompleteClientCertError{}))
+			})
+		})
 	})
 
 	Describe("Make", func() {
@@ -70,6 +87,59 @@ var _ = Describe("Cloud Controller Connection", func() {
 			})
 		})
 
+		Describe("Response Writer", func() {
+			var request *http.Request
+
+			BeforeEach(func() {
+				var err error
+				request, err = http.NewRequest(http.MethodGet, fmt.Sprintf("%s/v2/foo", server.URL()), nil)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			Context("when the request succeeds", func() {
+				BeforeEach(func() {
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodGet, "/v2/foo"),
+							RespondWith(http.StatusOK, "some-bits"),
+						),
+					)
+				})
+
+				It("copies the body to the writer instead of RawResponse", func() {
+					body := &bytes.Buffer{}
+					response := Response{Writer: body}
+
+					err := connection.Make(request, &response)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body.String()).To(Equal("some-bits"))
+					Expect(response.RawResponse).To(BeEmpty())
+				})
+			})
+
+			Context("when the request fails", func() {
+				BeforeEach(func() {
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodGet, "/v2/foo"),
+							RespondWith(http.StatusTeapot, "some-error"),
+						),
+					)
+				})
+
+				It("keeps the body of the error out of the writer", func() {
+					body := &bytes.Buffer{}
+					response := Response{Writer: body}
+
+					err := connection.Make(request, &response)
+					Expect(err).To(BeAssignableToTypeOf(RawHTTPStatusError{}))
+					Expect(err.(RawHTTPStatusError).RawResponse).To(Equal([]byte("some-error")))
+					Expect(body.Len()).To(BeZero())
+				})
+			})
+		})
+
 		Describe("HTTP Response", func() {
 			var request *http.Request
 
@@ -131,7 +201,9 @@ var _ = Describe("Cloud Controller Connection", func() {
 		Describe("Errors", func() {
 			Context("when the server does not exist", func() {
 				BeforeEach(func() {
-					connection = NewConnection(Config{})
+					var err error
+					connection, err = NewConnection(Config{})
+					Expect(err).ToNot(HaveOccurred())
 				})
 
 				It("returns a RequestError", func() {
@@ -157,7 +229,9 @@ var _ = Describe("Cloud Controller Connection", func() {
 							),
 						)
 
-						connection = NewConnection(Config{})
+						var err error
+						connection, err = NewConnection(Config{})
+						Expect(err).ToNot(HaveOccurred())
 					})
 
 					It("returns a UnverifiedServerError", func() {
@@ -171,6 +245,46 @@ var _ = Describe("Cloud Controller Connection", func() {
 				})
 			})
 
+			Context("when the server's certificate authority is given", func() {
+				var tmpdir string
+
+				BeforeEach(func() {
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodGet, "/v2/foo"),
+							RespondWith(http.StatusOK, "{}"),
+						),
+					)
+
+					var err error
+					tmpdir, err = ioutil.TempDir("", "cloud_controller_connection")
+					Expect(err).ToNot(HaveOccurred())
+
+					caCertFile := filepath.Join(tmpdir, "ca.pem")
+					caCert := pem.EncodeToMemory(&pem.Block{
+						Type:  "CERTIFICATE",
+						Bytes: server.HTTPTestServer.TLS.Certificates[0].Certificate[0],
+					})
+					Expect(ioutil.WriteFile(caCertFile, caCert, 0600)).To(Succeed())
+
+					connection, err = NewConnection(Config{CACertFile: caCertFile})
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				AfterEach(func() {
+					os.RemoveAll(tmpdir)
+				})
+
+				It("verifies the server's certificate", func() {
+					request, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/v2/foo", server.URL()), nil)
+					Expect(err).ToNot(HaveOccurred())
+
+					var response Response
+					err = connection.Make(request, &response)
+					Expect(err).ToNot(HaveOccurred())
+				})
+			})
+
 			Context("when the server's certificate does not match the hostname", func() {
 				Context("skipSSLValidation is false", func() {
 					BeforeEach(func() {
@@ -183,7 +297,9 @@ var _ = Describe("Cloud Controller Connection", func() {
 							),
 						)
 
-						connection = NewConnection(Config{})
+						var err error
+						connection, err = NewConnection(Config{})
+						Expect(err).ToNot(HaveOccurred())
 					})
 
 					// loopback.cli.ci.cf-app.com is a custom DNS record setup to point to 127.0.0.1
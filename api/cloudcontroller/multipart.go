@@ -0,0 +1,30 @@
+package cloudcontroller
+
+import (
+	"io"
+	"mime/multipart"
+)
+
+// NewMultipartFile returns a request body holding file as the only part of a
+// multipart form, along with the content type of the form. The form is
+// written as the body is read, so that large files are not held in memory.
+// Closing the body stops the writing; if reading file fails, reading the body
+// fails with the same error.
+func NewMultipartFile(fieldName string, fileName string, file io.Reader) (io.ReadCloser, string) {
+	bodyReader, bodyWriter := io.Pipe()
+	form := multipart.NewWriter(bodyWriter)
+	contentType := form.FormDataContentType()
+
+	go func() {
+		part, err := form.CreateFormFile(fieldName, fileName)
+		if err == nil {
+			_, err = io.Copy(part, file)
+		}
+		if err == nil {
+			err = form.Close()
+		}
+		bodyWriter.CloseWithError(err)
+	}()
+
+	return bodyReader, contentType
+}
@@ -0,0 +1,54 @@
+package cloudcontroller_test
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"strings"
+
+	. "code.cloudfoundry.org/cli/api/cloudcontroller"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) {
+	return 0, errors.New("banana")
+}
+
+var _ = Describe("NewMultipartFile", func() {
+	It("streams the file as the only part of a multipart form", func() {
+		body, contentType := NewMultipartFile("droplet", "droplet.tgz", strings.NewReader("some-droplet-bits"))
+		defer body.Close()
+
+		mediaType, params, err := mime.ParseMediaType(contentType)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(mediaType).To(Equal("multipart/form-data"))
+
+		form := multipart.NewReader(body, params["boundary"])
+		part, err := form.NextPart()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(part.FormName()).To(Equal("droplet"))
+		Expect(part.FileName()).To(Equal("droplet.tgz"))
+
+		contents, err := ioutil.ReadAll(part)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(contents)).To(Equal("some-droplet-bits"))
+
+		_, err = form.NextPart()
+		Expect(err).To(Equal(io.EOF))
+	})
+
+	Context("when reading the file fails", func() {
+		It("fails reading the body with the same error", func() {
+			body, _ := NewMultipartFile("droplet", "droplet.tgz", failingReader{})
+			defer body.Close()
+
+			_, err := ioutil.ReadAll(body)
+			Expect(err).To(MatchError("banana"))
+		})
+	})
+})
@@ -0,0 +1,14 @@
+package cloudcontroller
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/util/retry"
+)
+
+// MarkRetrySafe returns a copy of the request that retry wrappers may repeat
+// even though its method is not idempotent. Only mark POST requests that the
+// Cloud Controller documents as safe to repeat.
+func MarkRetrySafe(request *http.Request) *http.Request {
+	return retry.MarkSafe(request)
+}
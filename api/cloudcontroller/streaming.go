@@ -0,0 +1,21 @@
+package cloudcontroller
+
+import (
+	"context"
+	"net/http"
+)
+
+type streamingKey struct{}
+
+// MarkStreaming returns a copy of the request whose body is read as it is
+// sent, such as the pipe of a large upload. Wrappers pass the body through
+// without buffering it, so the request cannot be repeated.
+func MarkStreaming(request *http.Request) *http.Request {
+	return request.WithContext(context.WithValue(request.Context(), streamingKey{}, true))
+}
+
+// IsStreaming returns true if the request was marked with MarkStreaming.
+func IsStreaming(request *http.Request) bool {
+	streaming, _ := request.Context().Value(streamingKey{}).(bool)
+	return streaming
+}
@@ -0,0 +1,66 @@
+package wrapper
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/util/har"
+)
+
+//go:generate counterfeiter . HARRecorderOutput
+
+// HARRecorderOutput is the interface for storing requests and responses in a
+// HAR archive.
+type HARRecorderOutput interface {
+	HandleInternalError(err error)
+	RecordEntry(request *http.Request, requestBody []byte, response *http.Response, responseBody []byte, timer *har.Timer) error
+}
+
+// HARRecorder is the wrapper that records requests to and responses from the
+// Cloud Controller server, along with their timings, in a HAR archive.
+type HARRecorder struct {
+	connection cloudcontroller.Connection
+	output     HARRecorderOutput
+}
+
+// NewHARRecorder returns a pointer to a HARRecorder wrapper.
+func NewHARRecorder(output HARRecorderOutput) *HARRecorder {
+	return &HARRecorder{
+		output: output,
+	}
+}
+
+// Wrap sets the connection on the HARRecorder and returns itself.
+func (recorder *HARRecorder) Wrap(innerconnection cloudcontroller.Connection) cloudcontroller.Connection {
+	recorder.connection = innerconnection
+	return recorder
+}
+
+// Make times the request passed to the inner connection and records it along
+// with the response, if one was received.
+func (recorder *HARRecorder) Make(request *http.Request, passedResponse *cloudcontroller.Response) error {
+	var rawRequestBody []byte
+	if request.Body != nil && !cloudcontroller.IsStreaming(request) {
+		var err error
+		rawRequestBody, err = ioutil.ReadAll(request.Body)
+		request.Body.Close()
+		if err != nil {
+			return err
+		}
+		request.Body = ioutil.NopCloser(bytes.NewBuffer(rawRequestBody))
+	}
+
+	timedRequest, timer := har.StartTimer(request)
+	err := recorder.connection.Make(timedRequest, passedResponse)
+
+	if passedResponse.HTTPResponse != nil {
+		recordErr := recorder.output.RecordEntry(request, rawRequestBody, passedResponse.HTTPResponse, passedResponse.RawResponse, timer)
+		if recordErr != nil {
+			recorder.output.HandleInternalError(recordErr)
+		}
+	}
+
+	return err
+}
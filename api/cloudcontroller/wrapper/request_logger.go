@@ -88,7 +88,7 @@ func (logger *RequestLogger) displayRequest(request *http.Request) error {
 		return err
 	}
 
-	if request.Body != nil {
+	if request.Body != nil && !cloudcontroller.IsStreaming(request) {
 		rawRequestBody, err := ioutil.ReadAll(request.Body)
 		defer request.Body.Close()
 		if err != nil {
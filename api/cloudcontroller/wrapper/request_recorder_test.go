@@ -0,0 +1,112 @@
+package wrapper_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/cloudcontrollerfakes"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/wrapper/wrapperfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Request Recorder", func() {
+	var (
+		fakeConnection *cloudcontrollerfakes.FakeConnection
+		fakeOutput     *wrapperfakes.FakeRequestRecorderOutput
+
+		wrapper cloudcontroller.Connection
+
+		request  *http.Request
+		response *cloudcontroller.Response
+		err      error
+	)
+
+	BeforeEach(func() {
+		fakeConnection = new(cloudcontrollerfakes.FakeConnection)
+		fakeOutput = new(wrapperfakes.FakeRequestRecorderOutput)
+
+		wrapper = NewRequestRecorder(fakeOutput).Wrap(fakeConnection)
+
+		var err error
+		request, err = http.NewRequest(http.MethodPost, "https://foo.bar.com/v2/apps", bytes.NewBufferString(`{"name":"some-app"}`))
+		Expect(err).NotTo(HaveOccurred())
+
+		response = &cloudcontroller.Response{}
+	})
+
+	JustBeforeEach(func() {
+		err = wrapper.Make(request, response)
+	})
+
+	Context("when the inner connection receives a response", func() {
+		var httpResponse *http.Response
+
+		BeforeEach(func() {
+			httpResponse = &http.Response{StatusCode: http.StatusCreated}
+			fakeConnection.MakeStub = func(req *http.Request, passedResponse *cloudcontroller.Response) error {
+				body, readErr := ioutil.ReadAll(req.Body)
+				Expect(readErr).ToNot(HaveOccurred())
+				Expect(body).To(Equal([]byte(`{"name":"some-app"}`)))
+
+				passedResponse.HTTPResponse = httpResponse
+				passedResponse.RawResponse = []byte(`{"metadata":{"guid":"some-app-guid"}}`)
+				return nil
+			}
+		})
+
+		It("passes the request to the inner connection and records the interaction", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+
+			Expect(fakeOutput.RecordInteractionCallCount()).To(Equal(1))
+			recordedRequest, requestBody, recordedResponse, responseBody := fakeOutput.RecordInteractionArgsForCall(0)
+			Expect(recordedRequest).To(Equal(request))
+			Expect(requestBody).To(Equal([]byte(`{"name":"some-app"}`)))
+			Expect(recordedResponse).To(Equal(httpResponse))
+			Expect(responseBody).To(Equal([]byte(`{"metadata":{"guid":"some-app-guid"}}`)))
+		})
+
+		Context("when recording fails", func() {
+			BeforeEach(func() {
+				fakeOutput.RecordInteractionReturns(errors.New("disk full"))
+			})
+
+			It("handles the error and returns the response", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeOutput.HandleInternalErrorCallCount()).To(Equal(1))
+				Expect(fakeOutput.HandleInternalErrorArgsForCall(0)).To(MatchError("disk full"))
+			})
+		})
+	})
+
+	Context("when the inner connection returns an error with a response", func() {
+		BeforeEach(func() {
+			fakeConnection.MakeStub = func(_ *http.Request, passedResponse *cloudcontroller.Response) error {
+				passedResponse.HTTPResponse = &http.Response{StatusCode: http.StatusNotFound}
+				return cloudcontroller.RawHTTPStatusError{StatusCode: http.StatusNotFound}
+			}
+		})
+
+		It("records the interaction and returns the error", func() {
+			Expect(err).To(MatchError(cloudcontroller.RawHTTPStatusError{StatusCode: http.StatusNotFound}))
+			Expect(fakeOutput.RecordInteractionCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when the inner connection does not receive a response", func() {
+		BeforeEach(func() {
+			fakeConnection.MakeReturns(cloudcontroller.RequestError{Err: errors.New("connection refused")})
+		})
+
+		It("does not record anything and returns the error", func() {
+			Expect(err).To(MatchError(cloudcontroller.RequestError{Err: errors.New("connection refused")}))
+			Expect(fakeOutput.RecordInteractionCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,36 @@
+package wrapper
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+)
+
+// RequestReplayer is the wrapper that serves responses from previously
+// recorded interactions instead of sending requests to the Cloud Controller
+// server.
+type RequestReplayer struct {
+	connection *cloudcontroller.CloudControllerConnection
+}
+
+// NewRequestReplayer returns a pointer to a RequestReplayer wrapper that
+// serves every request through the provided transport.
+func NewRequestReplayer(transport http.RoundTripper) *RequestReplayer {
+	return &RequestReplayer{
+		connection: &cloudcontroller.CloudControllerConnection{
+			HTTPClient: &http.Client{Transport: transport},
+		},
+	}
+}
+
+// Wrap discards the inner connection, ensuring no request reaches the
+// network, and returns itself.
+func (replayer *RequestReplayer) Wrap(_ cloudcontroller.Connection) cloudcontroller.Connection {
+	return replayer
+}
+
+// Make serves the request from the replay transport and parses the response
+// like a regular connection would.
+func (replayer *RequestReplayer) Make(request *http.Request, passedResponse *cloudcontroller.Response) error {
+	return replayer.connection.Make(request, passedResponse)
+}
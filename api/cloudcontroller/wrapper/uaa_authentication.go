@@ -27,19 +27,28 @@ type TokenCache interface {
 }
 
 // UAAAuthentication wraps connections and adds authentication headers to all
-// requests
+// requests. The access token is refreshed before a request if the schedule
+// says it is about to expire, and after a request the server rejected
+// because of an invalid token.
 type UAAAuthentication struct {
 	connection cloudcontroller.Connection
 	client     UAAClient
 	cache      TokenCache
+	schedule   *uaa.RefreshSchedule
 }
 
 // NewUAAAuthentication returns a pointer to a UAAAuthentication wrapper with
-// the client and a token cache.
-func NewUAAAuthentication(client UAAClient, cache TokenCache) *UAAAuthentication {
+// the client, a token cache and the refresh schedule shared with the other
+// authentication wrappers. If schedule is nil, tokens are refreshed once they
+// have expired.
+func NewUAAAuthentication(client UAAClient, cache TokenCache, schedule *uaa.RefreshSchedule) *UAAAuthentication {
+	if schedule == nil {
+		schedule = uaa.NewRefreshSchedule(0)
+	}
 	return &UAAAuthentication{
-		client: client,
-		cache:  cache,
+		client:   client,
+		cache:    cache,
+		schedule: schedule,
 	}
 }
 
@@ -50,14 +59,17 @@ func (t *UAAAuthentication) Wrap(innerconnection cloudcontroller.Connection) clo
 }
 
 // Make adds authentication headers to the passed in request and then calls the
-// wrapped connection's Make
+// wrapped connection's Make. Requests rejected because of an invalid token are
+// repeated with a refreshed token, unless they are marked with
+// cloudcontroller.MarkStreaming.
 func (t *UAAAuthentication) Make(request *http.Request, passedResponse *cloudcontroller.Response) error {
 	var (
 		err            error
 		rawRequestBody []byte
 	)
 
-	if request.Body != nil {
+	streaming := cloudcontroller.IsStreaming(request)
+	if request.Body != nil && !streaming {
 		rawRequestBody, err = ioutil.ReadAll(request.Body)
 		defer request.Body.Close()
 		if err != nil {
@@ -66,18 +78,31 @@ func (t *UAAAuthentication) Make(request *http.Request, passedResponse *cloudcon
 		request.Body = ioutil.NopCloser(bytes.NewBuffer(rawRequestBody))
 	}
 
-	request.Header.Set("Authorization", t.cache.AccessToken())
+	if accessToken := t.cache.AccessToken(); t.schedule.NeedsRefresh(accessToken) {
+		err = t.refreshToken(accessToken)
+		if err != nil {
+			return err
+		}
+	}
+
+	accessToken := t.cache.AccessToken()
+	request.Header.Set("Authorization", accessToken)
 
 	err = t.connection.Make(request, passedResponse)
+	if passedResponse != nil {
+		t.schedule.ObserveDate(passedResponse.HTTPResponse)
+	}
 	if _, ok := err.(cloudcontroller.InvalidAuthTokenError); ok {
-		var token uaa.RefreshToken
-		token, err = t.client.RefreshAccessToken(t.cache.RefreshToken())
-		if err != nil {
-			return err
+		refreshErr := t.refreshToken(accessToken)
+		if refreshErr != nil {
+			return refreshErr
 		}
 
-		t.cache.SetAccessToken(token.AuthorizationToken())
-		t.cache.SetRefreshToken(token.RefreshToken)
+		// The body of a streaming request has been consumed, so the request
+		// fails with the refreshed token stored for the next one.
+		if streaming {
+			return err
+		}
 
 		if rawRequestBody != nil {
 			request.Body = ioutil.NopCloser(bytes.NewBuffer(rawRequestBody))
@@ -88,3 +113,12 @@ func (t *UAAAuthentication) Make(request *http.Request, passedResponse *cloudcon
 
 	return err
 }
+
+// refreshToken replaces staleToken in the cache with a new access token,
+// unless a concurrent request already has.
+func (t *UAAAuthentication) refreshToken(staleToken string) error {
+	return t.schedule.Refresh(staleToken, t.cache.AccessToken, func() error {
+		_, err := uaa.RefreshStoredAccessToken(t.client, t.cache)
+		return err
+	})
+}
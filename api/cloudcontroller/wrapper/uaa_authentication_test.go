@@ -1,10 +1,13 @@
 package wrapper_test
 
 import (
+	"encoding/base64"
 	"errors"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/cloudcontrollerfakes"
@@ -33,7 +36,7 @@ var _ = Describe("UAA Authentication", func() {
 		inMemoryCache = util.NewInMemoryTokenCache()
 		inMemoryCache.SetAccessToken("a-ok")
 
-		inner := NewUAAAuthentication(fakeClient, inMemoryCache)
+		inner := NewUAAAuthentication(fakeClient, inMemoryCache, nil)
 		wrapper = inner.Wrap(fakeConnection)
 
 		request = &http.Request{
@@ -84,6 +87,54 @@ var _ = Describe("UAA Authentication", func() {
 			})
 		})
 
+		Context("when the token is about to expire", func() {
+			BeforeEach(func() {
+				inMemoryCache.SetAccessToken(accessTokenExpiringAt(time.Now().Add(30 * time.Second)))
+				inMemoryCache.SetRefreshToken("some-refresh-token")
+
+				inner := NewUAAAuthentication(fakeClient, inMemoryCache, uaa.NewRefreshSchedule(time.Minute))
+				wrapper = inner.Wrap(fakeConnection)
+			})
+
+			Context("when the refresh succeeds", func() {
+				BeforeEach(func() {
+					fakeClient.RefreshAccessTokenReturns(
+						uaa.RefreshToken{
+							AccessToken:  "refreshed-token",
+							RefreshToken: "new-refresh-token",
+							Type:         "bearer",
+						},
+						nil,
+					)
+				})
+
+				It("refreshes the token before sending the request", func() {
+					err := wrapper.Make(request, nil)
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(1))
+					Expect(fakeClient.RefreshAccessTokenArgsForCall(0)).To(Equal("some-refresh-token"))
+					Expect(inMemoryCache.RefreshToken()).To(Equal("new-refresh-token"))
+
+					Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+					authenticatedRequest, _ := fakeConnection.MakeArgsForCall(0)
+					Expect(authenticatedRequest.Header.Get("Authorization")).To(Equal("bearer refreshed-token"))
+				})
+			})
+
+			Context("when the refresh fails", func() {
+				BeforeEach(func() {
+					fakeClient.RefreshAccessTokenReturns(uaa.RefreshToken{}, errors.New("refresh failed"))
+				})
+
+				It("returns the error without sending the request", func() {
+					err := wrapper.Make(request, nil)
+					Expect(err).To(MatchError("refresh failed"))
+					Expect(fakeConnection.MakeCallCount()).To(Equal(0))
+				})
+			})
+		})
+
 		Context("when the token is invalid", func() {
 			var expectedBody string
 
@@ -135,5 +186,52 @@ var _ = Describe("UAA Authentication", func() {
 				Expect(inMemoryCache.RefreshToken()).To(Equal("bananananananana"))
 			})
 		})
+
+		Context("when the token of a streaming request is invalid", func() {
+			var err error
+
+			BeforeEach(func() {
+				request.Body = ioutil.NopCloser(strings.NewReader("some-droplet-bits"))
+				request = cloudcontroller.MarkStreaming(request)
+
+				fakeConnection.MakeStub = func(request *http.Request, response *cloudcontroller.Response) error {
+					body, readErr := ioutil.ReadAll(request.Body)
+					Expect(readErr).NotTo(HaveOccurred())
+					Expect(string(body)).To(Equal("some-droplet-bits"))
+					return cloudcontroller.InvalidAuthTokenError{}
+				}
+
+				inMemoryCache.SetAccessToken("what")
+
+				fakeClient.RefreshAccessTokenReturns(
+					uaa.RefreshToken{
+						AccessToken:  "foobar-2",
+						RefreshToken: "bananananananana",
+						Type:         "bearer",
+					},
+					nil,
+				)
+
+				err = wrapper.Make(request, nil)
+			})
+
+			It("refreshes the token without resending the request", func() {
+				Expect(err).To(MatchError(cloudcontroller.InvalidAuthTokenError{}))
+				Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(1))
+				Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+				Expect(inMemoryCache.AccessToken()).To(Equal("bearer foobar-2"))
+			})
+		})
 	})
 })
+
+// accessTokenExpiringAt returns an authorization header value for a JWT that
+// expires at expiry.
+func accessTokenExpiringAt(expiry time.Time) string {
+	encode := base64.RawURLEncoding.EncodeToString
+	return fmt.Sprintf("bearer %s.%s.%s",
+		encode([]byte(`{"alg":"HS256","typ":"JWT"}`)),
+		encode([]byte(fmt.Sprintf(`{"user_name":"some-user","exp":%d}`, expiry.Unix()))),
+		encode([]byte("signature")),
+	)
+}
@@ -0,0 +1,124 @@
+// This file was generated by counterfeiter
+package wrapperfakes
+
+import (
+	"net/http"
+	"sync"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+)
+
+type FakeRequestRecorderOutput struct {
+	HandleInternalErrorStub        func(err error)
+	handleInternalErrorMutex       sync.RWMutex
+	handleInternalErrorArgsForCall []struct {
+		err error
+	}
+	RecordInteractionStub        func(request *http.Request, requestBody []byte, response *http.Response, responseBody []byte) error
+	recordInteractionMutex       sync.RWMutex
+	recordInteractionArgsForCall []struct {
+		request      *http.Request
+		requestBody  []byte
+		response     *http.Response
+		responseBody []byte
+	}
+	recordInteractionReturns struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeRequestRecorderOutput) HandleInternalError(err error) {
+	fake.handleInternalErrorMutex.Lock()
+	fake.handleInternalErrorArgsForCall = append(fake.handleInternalErrorArgsForCall, struct {
+		err error
+	}{err})
+	fake.recordInvocation("HandleInternalError", []interface{}{err})
+	fake.handleInternalErrorMutex.Unlock()
+	if fake.HandleInternalErrorStub != nil {
+		fake.HandleInternalErrorStub(err)
+	}
+}
+
+func (fake *FakeRequestRecorderOutput) HandleInternalErrorCallCount() int {
+	fake.handleInternalErrorMutex.RLock()
+	defer fake.handleInternalErrorMutex.RUnlock()
+	return len(fake.handleInternalErrorArgsForCall)
+}
+
+func (fake *FakeRequestRecorderOutput) HandleInternalErrorArgsForCall(i int) error {
+	fake.handleInternalErrorMutex.RLock()
+	defer fake.handleInternalErrorMutex.RUnlock()
+	return fake.handleInternalErrorArgsForCall[i].err
+}
+
+func (fake *FakeRequestRecorderOutput) RecordInteraction(request *http.Request, requestBody []byte, response *http.Response, responseBody []byte) error {
+	var requestBodyCopy []byte
+	if requestBody != nil {
+		requestBodyCopy = make([]byte, len(requestBody))
+		copy(requestBodyCopy, requestBody)
+	}
+	var responseBodyCopy []byte
+	if responseBody != nil {
+		responseBodyCopy = make([]byte, len(responseBody))
+		copy(responseBodyCopy, responseBody)
+	}
+	fake.recordInteractionMutex.Lock()
+	fake.recordInteractionArgsForCall = append(fake.recordInteractionArgsForCall, struct {
+		request      *http.Request
+		requestBody  []byte
+		response     *http.Response
+		responseBody []byte
+	}{request, requestBodyCopy, response, responseBodyCopy})
+	fake.recordInvocation("RecordInteraction", []interface{}{request, requestBodyCopy, response, responseBodyCopy})
+	fake.recordInteractionMutex.Unlock()
+	if fake.RecordInteractionStub != nil {
+		return fake.RecordInteractionStub(request, requestBody, response, responseBody)
+	} else {
+		return fake.recordInteractionReturns.result1
+	}
+}
+
+func (fake *FakeRequestRecorderOutput) RecordInteractionCallCount() int {
+	fake.recordInteractionMutex.RLock()
+	defer fake.recordInteractionMutex.RUnlock()
+	return len(fake.recordInteractionArgsForCall)
+}
+
+func (fake *FakeRequestRecorderOutput) RecordInteractionArgsForCall(i int) (*http.Request, []byte, *http.Response, []byte) {
+	fake.recordInteractionMutex.RLock()
+	defer fake.recordInteractionMutex.RUnlock()
+	return fake.recordInteractionArgsForCall[i].request, fake.recordInteractionArgsForCall[i].requestBody, fake.recordInteractionArgsForCall[i].response, fake.recordInteractionArgsForCall[i].responseBody
+}
+
+func (fake *FakeRequestRecorderOutput) RecordInteractionReturns(result1 error) {
+	fake.RecordInteractionStub = nil
+	fake.recordInteractionReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRequestRecorderOutput) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.handleInternalErrorMutex.RLock()
+	defer fake.handleInternalErrorMutex.RUnlock()
+	fake.recordInteractionMutex.RLock()
+	defer fake.recordInteractionMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeRequestRecorderOutput) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ wrapper.RequestRecorderOutput = new(FakeRequestRecorderOutput)
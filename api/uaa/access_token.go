@@ -0,0 +1,96 @@
+package uaa
+
+import (
+	"strings"
+	"time"
+
+	"github.com/SermoDigital/jose/jws"
+)
+
+// AccessToken represents the claims of a UAA access token.
+type AccessToken struct {
+	// UserName is the name of the user the token was issued to. It is empty
+	// for client credentials tokens.
+	UserName string
+
+	// UserID is the UAA ID of the user the token was issued to.
+	UserID string
+
+	// ClientID is the ID of the OAuth client the token was issued through.
+	ClientID string
+
+	// Origin is the identity provider the user logged in with.
+	Origin string
+
+	// Scopes are the scopes granted to the token.
+	Scopes []string
+
+	// IssuedAt is when the token was issued.
+	IssuedAt time.Time
+
+	// ExpiresAt is when the token expires. It is zero if the token does not
+	// expire.
+	ExpiresAt time.Time
+}
+
+// HasScope returns true if the token was granted scope.
+func (token AccessToken) HasScope(scope string) bool {
+	for _, granted := range token.Scopes {
+		if granted == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// DecodeAccessToken decodes the claims of the access token in the
+// authorization header value, such as "bearer <JWT>". The token's signature
+// is not verified.
+func DecodeAccessToken(accessToken string) (AccessToken, error) {
+	parts := strings.Fields(accessToken)
+	if len(parts) == 0 {
+		return AccessToken{}, InvalidAccessTokenError{}
+	}
+
+	token, err := jws.ParseJWT([]byte(parts[len(parts)-1]))
+	if err != nil {
+		return AccessToken{}, InvalidAccessTokenError{}
+	}
+
+	claims := token.Claims()
+	decoded := AccessToken{
+		UserName: stringClaim(claims.Get("user_name")),
+		UserID:   stringClaim(claims.Get("user_id")),
+		ClientID: stringClaim(claims.Get("client_id")),
+		Origin:   stringClaim(claims.Get("origin")),
+	}
+	if scopes, ok := claims.Get("scope").([]interface{}); ok {
+		for _, scope := range scopes {
+			decoded.Scopes = append(decoded.Scopes, stringClaim(scope))
+		}
+	}
+	if issuedAt, ok := claims.IssuedAt(); ok {
+		decoded.IssuedAt = issuedAt
+	}
+	if expiresAt, ok := claims.Expiration(); ok {
+		decoded.ExpiresAt = expiresAt
+	}
+
+	return decoded, nil
+}
+
+// AccessTokenExpiry returns when the access token in the authorization
+// header value expires, from its exp claim. It returns false if the token is
+// not a JWT or has no expiry.
+func AccessTokenExpiry(accessToken string) (time.Time, bool) {
+	token, err := DecodeAccessToken(accessToken)
+	if err != nil || token.ExpiresAt.IsZero() {
+		return time.Time{}, false
+	}
+	return token.ExpiresAt, true
+}
+
+func stringClaim(claim interface{}) string {
+	value, _ := claim.(string)
+	return value
+}
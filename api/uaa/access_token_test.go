@@ -0,0 +1,52 @@
+package uaa_test
+
+import (
+	"encoding/base64"
+	"time"
+
+	. "code.cloudfoundry.org/cli/api/uaa"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AccessToken", func() {
+	Describe("DecodeAccessToken", func() {
+		It("decodes the token's claims", func() {
+			encode := base64.RawURLEncoding.EncodeToString
+			accessToken := "bearer " + encode([]byte(`{"alg":"HS256","typ":"JWT"}`)) + "." +
+				encode([]byte(`{
+					"user_name": "some-user",
+					"user_id": "some-user-id",
+					"client_id": "cf",
+					"origin": "uaa",
+					"scope": ["cloud_controller.read", "cloud_controller.admin"],
+					"iat": 1488369600,
+					"exp": 1488370200
+				}`)) + "." + encode([]byte("signature"))
+
+			token, err := DecodeAccessToken(accessToken)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(token).To(Equal(AccessToken{
+				UserName:  "some-user",
+				UserID:    "some-user-id",
+				ClientID:  "cf",
+				Origin:    "uaa",
+				Scopes:    []string{"cloud_controller.read", "cloud_controller.admin"},
+				IssuedAt:  time.Unix(1488369600, 0),
+				ExpiresAt: time.Unix(1488370200, 0),
+			}))
+
+			Expect(token.HasScope("cloud_controller.admin")).To(BeTrue())
+			Expect(token.HasScope("uaa.admin")).To(BeFalse())
+		})
+
+		It("returns an InvalidAccessTokenError when the token is not a JWT", func() {
+			_, err := DecodeAccessToken("bearer some-opaque-token")
+			Expect(err).To(MatchError(InvalidAccessTokenError{}))
+
+			_, err = DecodeAccessToken("")
+			Expect(err).To(MatchError(InvalidAccessTokenError{}))
+		})
+	})
+})
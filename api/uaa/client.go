@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/cli/api/uaa/internal"
+	"code.cloudfoundry.org/cli/util/proxy"
 	"github.com/tedsuo/rata"
 )
 
@@ -52,12 +53,31 @@ type Config struct {
 	// be used only for testing.
 	SkipSSLValidation bool
 
+	// CACertFile is the path to a PEM encoded bundle of certificate
+	// authorities that are trusted in addition to the system's.
+	CACertFile string
+
+	// ClientCertFile and ClientKeyFile are the paths to the PEM encoded
+	// certificate and key presented to a UAA that requires a client
+	// certificate.
+	ClientCertFile string
+	ClientKeyFile  string
+
+	// Proxy decides which proxy connections go through. If nil, the proxy
+	// settings in the environment are used.
+	Proxy *proxy.Resolver
+
 	// URL is the api URL for the UAA target.
 	URL string
+
+	// Wrappers are applied to the connection before any other wrapper, in the
+	// order given.
+	Wrappers []ConnectionWrapper
 }
 
-// NewClient returns a new UAA Client with the provided configuration
-func NewClient(config Config) *Client {
+// NewClient returns a new UAA Client with the provided configuration. An
+// error is returned if the certificate files cannot be loaded.
+func NewClient(config Config) (*Client, error) {
 	userAgent := fmt.Sprintf("%s/%s (%s; %s %s)",
 		config.AppName,
 		config.AppVersion,
@@ -66,16 +86,24 @@ func NewClient(config Config) *Client {
 		runtime.GOOS,
 	)
 
+	connection, err := NewConnection(config)
+	if err != nil {
+		return nil, err
+	}
+
 	client := Client{
 		URL:    config.URL,
 		id:     config.ClientID,
 		secret: config.ClientSecret,
 
 		router:     rata.NewRequestGenerator(config.URL, internal.Routes),
-		connection: NewConnection(config.SkipSSLValidation, config.DialTimeout),
+		connection: connection,
 		userAgent:  userAgent,
 	}
+	for _, wrapper := range config.Wrappers {
+		client.WrapConnection(wrapper)
+	}
 	client.WrapConnection(NewErrorWrapper())
 
-	return &client
+	return &client, nil
 }
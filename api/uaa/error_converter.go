@@ -3,6 +3,7 @@ package uaa
 import (
 	"encoding/json"
 	"net/http"
+	"strings"
 )
 
 // errorWrapper is the wrapper that converts responses with 4xx and 5xx status
@@ -56,9 +57,14 @@ func convert(rawHTTPStatusErr RawHTTPStatusError) error {
 		return rawHTTPStatusErr
 	case http.StatusForbidden: // 403
 		if uaaErrorResponse.Type == "insufficient_scope" {
-			return InsufficientScopeError{Message: uaaErrorResponse.Description}
+			return InsufficientScopeError{
+				Message: uaaErrorResponse.Description,
+				Scopes:  strings.Fields(uaaErrorResponse.Scope),
+			}
 		}
 		return rawHTTPStatusErr
+	case http.StatusNotFound: // 404
+		return ResourceNotFoundError{Message: uaaErrorResponse.Description}
 	case http.StatusConflict: // 409
 		return ConflictError{Message: uaaErrorResponse.Description}
 	default:
@@ -153,11 +153,31 @@ var _ = Describe("Error Wrapper", func() {
 					It("returns an InsufficientScopeError", func() {
 						Expect(fakeConnection.MakeCallCount()).To(Equal(1))
 
-						Expect(makeErr).To(MatchError(InsufficientScopeError{Message: "Insufficient scope for this resource"}))
+						Expect(makeErr).To(MatchError(InsufficientScopeError{
+							Message: "Insufficient scope for this resource",
+							Scopes:  []string{"admin", "scim.write", "scim.create", "zones.admin"},
+						}))
 					})
 				})
 			})
 
+			Context("(404) Not Found", func() {
+				BeforeEach(func() {
+					fakeConnectionErr.StatusCode = http.StatusNotFound
+					fakeConnectionErr.RawResponse = []byte(`{
+	"error": "scim_resource_not_found",
+  "error_description": "Member some-user-guid does not exist in group some-group-guid"
+}`)
+					fakeConnection.MakeReturns(fakeConnectionErr)
+				})
+
+				It("returns a ResourceNotFoundError", func() {
+					Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+
+					Expect(makeErr).To(MatchError(ResourceNotFoundError{Message: "Member some-user-guid does not exist in group some-group-guid"}))
+				})
+			})
+
 			Context("(409) Conflict", func() {
 				BeforeEach(func() {
 					fakeConnectionErr.StatusCode = http.StatusConflict
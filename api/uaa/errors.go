@@ -16,6 +16,7 @@ func (r RawHTTPStatusError) Error() string {
 type UAAErrorResponse struct {
 	Type        string `json:"error"`
 	Description string `json:"error_description"`
+	Scope       string `json:"scope,omitempty"`
 }
 
 func (e UAAErrorResponse) Error() string {
@@ -32,6 +33,17 @@ func (e ConflictError) Error() string {
 	return e.Message
 }
 
+// ResourceNotFoundError is returned when the response status code is 404. It
+// represents when the requested resource, such as a group membership, does
+// not exist.
+type ResourceNotFoundError struct {
+	Message string
+}
+
+func (e ResourceNotFoundError) Error() string {
+	return e.Message
+}
+
 // UnverifiedServerError replaces x509.UnknownAuthorityError when the server
 // has SSL but the client is unable to verify it's certificate
 type UnverifiedServerError struct {
@@ -65,6 +77,9 @@ func (e InvalidAuthTokenError) Error() string {
 // InsufficientScopeError is returned when the client has insufficient scope
 type InsufficientScopeError struct {
 	Message string
+
+	// Scopes are the scopes, any one of which grants access to the resource.
+	Scopes []string
 }
 
 func (e InsufficientScopeError) Error() string {
@@ -79,3 +94,10 @@ type InvalidSCIMResourceError struct {
 func (e InvalidSCIMResourceError) Error() string {
 	return e.Message
 }
+
+// InvalidAccessTokenError is returned when an access token cannot be decoded.
+type InvalidAccessTokenError struct{}
+
+func (e InvalidAccessTokenError) Error() string {
+	return "access token is not a JSON Web Token"
+}
@@ -0,0 +1,39 @@
+package uaa
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FilterEqual returns a SCIM filter matching resources whose attribute equals
+// value.
+func FilterEqual(attribute string, value string) string {
+	return fmt.Sprintf("%s eq %s", attribute, quoteFilterValue(value))
+}
+
+// FilterContains returns a SCIM filter matching resources whose attribute
+// contains value.
+func FilterContains(attribute string, value string) string {
+	return fmt.Sprintf("%s co %s", attribute, quoteFilterValue(value))
+}
+
+// FilterAnd returns a SCIM filter matching resources that match all of the
+// non-empty filters.
+func FilterAnd(filters ...string) string {
+	var nonEmpty []string
+	for _, filter := range filters {
+		if filter != "" {
+			nonEmpty = append(nonEmpty, filter)
+		}
+	}
+	if len(nonEmpty) <= 1 {
+		return strings.Join(nonEmpty, "")
+	}
+	return "(" + strings.Join(nonEmpty, ") and (") + ")"
+}
+
+func quoteFilterValue(value string) string {
+	value = strings.Replace(value, `\`, `\\`, -1)
+	value = strings.Replace(value, `"`, `\"`, -1)
+	return `"` + value + `"`
+}
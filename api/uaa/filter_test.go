@@ -0,0 +1,20 @@
+package uaa_test
+
+import (
+	. "code.cloudfoundry.org/cli/api/uaa"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SCIM filters", func() {
+	It("quotes values", func() {
+		Expect(FilterEqual("userName", `a"b\c`)).To(Equal(`userName eq "a\"b\\c"`))
+		Expect(FilterContains("userName", "smith")).To(Equal(`userName co "smith"`))
+	})
+
+	It("combines the non-empty filters", func() {
+		Expect(FilterAnd("", `origin eq "uaa"`)).To(Equal(`origin eq "uaa"`))
+		Expect(FilterAnd(`userName co "a"`, "", `origin eq "uaa"`)).To(Equal(`(userName co "a") and (origin eq "uaa")`))
+		Expect(FilterAnd()).To(BeEmpty())
+	})
+})
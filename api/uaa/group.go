@@ -0,0 +1,99 @@
+package uaa
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"code.cloudfoundry.org/cli/api/uaa/internal"
+	"github.com/tedsuo/rata"
+)
+
+// Group represents an UAA group, which grants its members the scope of the
+// same name.
+type Group struct {
+	ID          string `json:"id"`
+	Name        string `json:"displayName"`
+	Description string `json:"description"`
+}
+
+// groupsResponse represents the HTTP JSON response for listing groups.
+type groupsResponse struct {
+	Resources []Group `json:"resources"`
+}
+
+// groupMemberRequestBody represents the body of the request to add a member
+// to a group.
+type groupMemberRequestBody struct {
+	Origin string `json:"origin"`
+	Type   string `json:"type"`
+	Value  string `json:"value"`
+}
+
+// GetGroupsByName returns the UAA groups with the given name.
+func (client *Client) GetGroupsByName(name string) ([]Group, error) {
+	request, err := client.newRequest(requestOptions{
+		RequestName: internal.GetGroupsRequest,
+		Query: url.Values{
+			"filter": {FilterEqual("displayName", name)},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var groups groupsResponse
+	response := Response{
+		Result: &groups,
+	}
+
+	err = client.connection.Make(request, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return groups.Resources, nil
+}
+
+// AddGroupMember adds the user with the given ID and origin to the group.
+func (client *Client) AddGroupMember(groupID string, userID string, origin string) error {
+	bodyBytes, err := json.Marshal(groupMemberRequestBody{
+		Origin: origin,
+		Type:   "USER",
+		Value:  userID,
+	})
+	if err != nil {
+		return err
+	}
+
+	request, err := client.newRequest(requestOptions{
+		RequestName: internal.AddGroupMemberRequest,
+		Params:      rata.Params{"group_guid": groupID},
+		Header: http.Header{
+			"Content-Type": {"application/json"},
+		},
+		Body: bytes.NewBuffer(bodyBytes),
+	})
+	if err != nil {
+		return err
+	}
+
+	return client.connection.Make(request, &Response{})
+}
+
+// RemoveGroupMember removes the user with the given ID from the group.
+func (client *Client) RemoveGroupMember(groupID string, userID string) error {
+	request, err := client.newRequest(requestOptions{
+		RequestName: internal.RemoveGroupMemberRequest,
+		Params: rata.Params{
+			"group_guid": groupID,
+			"user_guid":  userID,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return client.connection.Make(request, &Response{})
+}
@@ -0,0 +1,98 @@
+package uaa_test
+
+import (
+	"net/http"
+
+	. "code.cloudfoundry.org/cli/api/uaa"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Group", func() {
+	var (
+		client *Client
+	)
+
+	BeforeEach(func() {
+		client = NewTestUAAClientAndStore()
+	})
+
+	Describe("GetGroupsByName", func() {
+		BeforeEach(func() {
+			response := `{
+				"resources": [
+					{"id": "group-guid", "displayName": "scim.read", "description": "Read users"}
+				]
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/Groups", `filter=displayName+eq+%22scim.read%22`),
+					RespondWith(http.StatusOK, response),
+				))
+		})
+
+		It("returns the groups with the name", func() {
+			groups, err := client.GetGroupsByName("scim.read")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(groups).To(Equal([]Group{
+				{ID: "group-guid", Name: "scim.read", Description: "Read users"},
+			}))
+		})
+	})
+
+	Describe("AddGroupMember", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/Groups/group-guid/members"),
+					VerifyHeaderKV("Content-Type", "application/json"),
+					VerifyJSON(`{"origin": "ldap", "type": "USER", "value": "user-guid"}`),
+					RespondWith(http.StatusCreated, `{"origin": "ldap", "type": "USER", "value": "user-guid"}`),
+				))
+		})
+
+		It("adds the user to the group", func() {
+			err := client.AddGroupMember("group-guid", "user-guid", "ldap")
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("RemoveGroupMember", func() {
+		Context("when the user is a member of the group", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/Groups/group-guid/members/user-guid"),
+						RespondWith(http.StatusOK, `{"origin": "uaa", "type": "USER", "value": "user-guid"}`),
+					))
+			})
+
+			It("removes the user from the group", func() {
+				err := client.RemoveGroupMember("group-guid", "user-guid")
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when the user is not a member of the group", func() {
+			var response string
+
+			BeforeEach(func() {
+				response = `{
+					"error": "scim_resource_not_found",
+					"error_description": "Member user-guid does not exist in group group-guid"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/Groups/group-guid/members/user-guid"),
+						RespondWith(http.StatusNotFound, response),
+					))
+			})
+
+			It("returns a ResourceNotFoundError", func() {
+				err := client.RemoveGroupMember("group-guid", "user-guid")
+				Expect(err).To(MatchError(ResourceNotFoundError{Message: "Member user-guid does not exist in group group-guid"}))
+			})
+		})
+	})
+})
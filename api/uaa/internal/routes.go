@@ -7,8 +7,15 @@ import (
 )
 
 const (
-	RefreshTokenRequest = "RefreshToken"
-	NewUserRequest      = "NewUser"
+	RefreshTokenRequest      = "RefreshToken"
+	NewUserRequest           = "NewUser"
+	GetUsersRequest          = "GetUsers"
+	GetUserRequest           = "GetUser"
+	PatchUserRequest         = "PatchUser"
+	SetUserPasswordRequest   = "SetUserPassword"
+	GetGroupsRequest         = "GetGroups"
+	AddGroupMemberRequest    = "AddGroupMember"
+	RemoveGroupMemberRequest = "RemoveGroupMember"
 )
 
 // Routes is a list of routes used by the rata library to construct request
@@ -16,4 +23,11 @@ const (
 var Routes = rata.Routes{
 	{Path: "/oauth/token", Method: http.MethodPost, Name: RefreshTokenRequest},
 	{Path: "/Users", Method: http.MethodPost, Name: NewUserRequest},
+	{Path: "/Users", Method: http.MethodGet, Name: GetUsersRequest},
+	{Path: "/Users/:user_guid", Method: http.MethodGet, Name: GetUserRequest},
+	{Path: "/Users/:user_guid", Method: http.MethodPatch, Name: PatchUserRequest},
+	{Path: "/Users/:user_guid/password", Method: http.MethodPut, Name: SetUserPasswordRequest},
+	{Path: "/Groups", Method: http.MethodGet, Name: GetGroupsRequest},
+	{Path: "/Groups/:group_guid/members", Method: http.MethodPost, Name: AddGroupMemberRequest},
+	{Path: "/Groups/:group_guid/members/:user_guid", Method: http.MethodDelete, Name: RemoveGroupMemberRequest},
 }
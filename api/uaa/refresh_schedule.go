@@ -0,0 +1,94 @@
+package uaa
+
+import (
+	"net/http"
+	"sync"
+	"time"
+)
+
+// RefreshSchedule decides when access tokens are refreshed before they
+// expire, and serializes refreshes so that concurrent requests with the same
+// expired token refresh it once. Expiry is judged by the servers' clock,
+// which is estimated from the Date header of their responses, so that a local
+// clock that is ahead does not cause a refresh on every request.
+//
+// A RefreshSchedule is shared by all the authentication wrappers in the
+// process.
+type RefreshSchedule struct {
+	// Window is how long before it expires an access token is refreshed.
+	Window time.Duration
+
+	// Now returns the local time.
+	Now func() time.Time
+
+	refreshMutex sync.Mutex
+
+	mutex       sync.Mutex
+	clockOffset time.Duration
+	freshToken  string
+}
+
+// NewRefreshSchedule returns a RefreshSchedule that refreshes access tokens
+// window before they expire.
+func NewRefreshSchedule(window time.Duration) *RefreshSchedule {
+	return &RefreshSchedule{
+		Window: window,
+		Now:    time.Now,
+	}
+}
+
+// ObserveDate records how far the server's clock, given by the Date header
+// of response, is from the local clock.
+func (schedule *RefreshSchedule) ObserveDate(response *http.Response) {
+	if response == nil {
+		return
+	}
+	serverTime, err := http.ParseTime(response.Header.Get("Date"))
+	if err != nil {
+		return
+	}
+
+	schedule.mutex.Lock()
+	defer schedule.mutex.Unlock()
+	schedule.clockOffset = serverTime.Sub(schedule.Now())
+}
+
+// NeedsRefresh returns true if accessToken expires within the window. Tokens
+// without an expiry, and tokens that were just refreshed, never need a
+// refresh; they are refreshed when the server rejects them.
+func (schedule *RefreshSchedule) NeedsRefresh(accessToken string) bool {
+	expiry, ok := AccessTokenExpiry(accessToken)
+	if !ok {
+		return false
+	}
+
+	schedule.mutex.Lock()
+	defer schedule.mutex.Unlock()
+	if accessToken == schedule.freshToken {
+		return false
+	}
+	serverNow := schedule.Now().Add(schedule.clockOffset)
+	return !serverNow.Add(schedule.Window).Before(expiry)
+}
+
+// Refresh calls refresh to replace staleToken, unless another request has
+// already replaced it while this one waited its turn. currentToken returns
+// the access token in use.
+func (schedule *RefreshSchedule) Refresh(staleToken string, currentToken func() string, refresh func() error) error {
+	schedule.refreshMutex.Lock()
+	defer schedule.refreshMutex.Unlock()
+
+	if currentToken() != staleToken {
+		return nil
+	}
+
+	err := refresh()
+	if err != nil {
+		return err
+	}
+
+	schedule.mutex.Lock()
+	schedule.freshToken = currentToken()
+	schedule.mutex.Unlock()
+	return nil
+}
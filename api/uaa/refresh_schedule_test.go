@@ -0,0 +1,129 @@
+package uaa_test
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	. "code.cloudfoundry.org/cli/api/uaa"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// accessTokenExpiringAt returns an authorization header value for a JWT that
+// expires at expiry.
+func accessTokenExpiringAt(expiry time.Time) string {
+	encode := base64.RawURLEncoding.EncodeToString
+	return fmt.Sprintf("bearer %s.%s.%s",
+		encode([]byte(`{"alg":"HS256","typ":"JWT"}`)),
+		encode([]byte(fmt.Sprintf(`{"user_name":"some-user","exp":%d}`, expiry.Unix()))),
+		encode([]byte("signature")),
+	)
+}
+
+var _ = Describe("RefreshSchedule", func() {
+	var (
+		schedule *RefreshSchedule
+		now      time.Time
+	)
+
+	BeforeEach(func() {
+		now = time.Date(2017, time.March, 1, 12, 0, 0, 0, time.UTC)
+		schedule = NewRefreshSchedule(time.Minute)
+		schedule.Now = func() time.Time { return now }
+	})
+
+	Describe("NeedsRefresh", func() {
+		It("is true when the token expires within the window", func() {
+			Expect(schedule.NeedsRefresh(accessTokenExpiringAt(now.Add(30 * time.Second)))).To(BeTrue())
+			Expect(schedule.NeedsRefresh(accessTokenExpiringAt(now.Add(-time.Hour)))).To(BeTrue())
+		})
+
+		It("is false when the token expires after the window", func() {
+			Expect(schedule.NeedsRefresh(accessTokenExpiringAt(now.Add(10 * time.Minute)))).To(BeFalse())
+		})
+
+		It("is false when the token is not a JWT", func() {
+			Expect(schedule.NeedsRefresh("bearer some-opaque-token")).To(BeFalse())
+			Expect(schedule.NeedsRefresh("")).To(BeFalse())
+		})
+
+		Context("when the local clock is ahead of the server's", func() {
+			BeforeEach(func() {
+				schedule.ObserveDate(&http.Response{
+					Header: http.Header{"Date": {now.Add(-time.Hour).Format(http.TimeFormat)}},
+				})
+			})
+
+			It("judges expiry by the server's clock", func() {
+				Expect(schedule.NeedsRefresh(accessTokenExpiringAt(now.Add(-30 * time.Minute)))).To(BeFalse())
+				Expect(schedule.NeedsRefresh(accessTokenExpiringAt(now.Add(-time.Hour)))).To(BeTrue())
+			})
+		})
+
+		Context("when the response has no Date header", func() {
+			It("uses the local clock", func() {
+				schedule.ObserveDate(&http.Response{Header: http.Header{}})
+				schedule.ObserveDate(nil)
+				Expect(schedule.NeedsRefresh(accessTokenExpiringAt(now.Add(30 * time.Second)))).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("Refresh", func() {
+		var (
+			currentToken string
+			mutex        sync.Mutex
+			refreshCount int
+		)
+
+		BeforeEach(func() {
+			currentToken = accessTokenExpiringAt(now.Add(30 * time.Second))
+			refreshCount = 0
+		})
+
+		getToken := func() string {
+			mutex.Lock()
+			defer mutex.Unlock()
+			return currentToken
+		}
+
+		It("refreshes a stale token once when requests refresh it concurrently", func() {
+			staleToken := getToken()
+			refreshedToken := accessTokenExpiringAt(now.Add(20 * time.Second))
+
+			var wg sync.WaitGroup
+			for i := 0; i < 10; i++ {
+				wg.Add(1)
+				go func() {
+					defer GinkgoRecover()
+					defer wg.Done()
+					err := schedule.Refresh(staleToken, getToken, func() error {
+						mutex.Lock()
+						defer mutex.Unlock()
+						refreshCount++
+						currentToken = refreshedToken
+						return nil
+					})
+					Expect(err).ToNot(HaveOccurred())
+				}()
+			}
+			wg.Wait()
+
+			Expect(refreshCount).To(Equal(1))
+
+			By("not refreshing the new token again, even though it expires within the window")
+			Expect(schedule.NeedsRefresh(refreshedToken)).To(BeFalse())
+		})
+
+		It("returns the refresh error", func() {
+			err := schedule.Refresh(getToken(), getToken, func() error {
+				return fmt.Errorf("refresh failed")
+			})
+			Expect(err).To(MatchError("refresh failed"))
+		})
+	})
+})
@@ -40,6 +40,9 @@ func (client *Client) RefreshAccessToken(refreshToken string) (RefreshToken, err
 	if err != nil {
 		return RefreshToken{}, err
 	}
+	// Redeeming the same refresh token twice yields a valid access token both
+	// times, so a failed refresh can be repeated.
+	request = MarkRetrySafe(request)
 
 	var refreshResponse RefreshToken
 	response := Response{
@@ -53,3 +56,28 @@ func (client *Client) RefreshAccessToken(refreshToken string) (RefreshToken, err
 
 	return refreshResponse, nil
 }
+
+// AccessTokenRefresher gets new tokens from the UAA with a refresh token.
+type AccessTokenRefresher interface {
+	RefreshAccessToken(refreshToken string) (RefreshToken, error)
+}
+
+// TokenStore is where the tokens of the current session are kept.
+type TokenStore interface {
+	RefreshToken() string
+	SetAccessToken(token string)
+	SetRefreshToken(token string)
+}
+
+// RefreshStoredAccessToken gets a new access token with the refresh token in
+// store, saves the new tokens in store and returns the new access token.
+func RefreshStoredAccessToken(refresher AccessTokenRefresher, store TokenStore) (string, error) {
+	token, err := refresher.RefreshAccessToken(store.RefreshToken())
+	if err != nil {
+		return "", err
+	}
+
+	store.SetAccessToken(token.AuthorizationToken())
+	store.SetRefreshToken(token.RefreshToken)
+	return token.AuthorizationToken(), nil
+}
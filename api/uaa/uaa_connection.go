@@ -10,6 +10,9 @@ import (
 	"net/http"
 	"net/url"
 	"time"
+
+	"code.cloudfoundry.org/cli/util/proxy"
+	"code.cloudfoundry.org/cli/util/tlsconfig"
 )
 
 // UAAConnection represents the connection to UAA
@@ -17,22 +20,35 @@ type UAAConnection struct {
 	HTTPClient *http.Client
 }
 
-// NewConnection returns a pointer to a new UAA Connection
-func NewConnection(skipSSLValidation bool, dialTimeout time.Duration) *UAAConnection {
+// NewConnection returns a pointer to a new UAA Connection using the dial
+// timeout, SSL validation and certificate files in config. An error is
+// returned if the certificate files cannot be loaded.
+func NewConnection(config Config) (*UAAConnection, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: config.SkipSSLValidation,
+	}
+	err := tlsconfig.Apply(tlsConfig, config.CACertFile, config.ClientCertFile, config.ClientKeyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	resolver := config.Proxy
+	if resolver == nil {
+		resolver = proxy.FromEnvironment()
+	}
+
 	tr := &http.Transport{
-		TLSClientConfig: &tls.Config{
-			InsecureSkipVerify: skipSSLValidation,
-		},
-		Proxy: http.ProxyFromEnvironment,
-		DialContext: (&net.Dialer{
+		TLSClientConfig: tlsConfig,
+		Proxy:           resolver.Proxy,
+		DialContext: resolver.DialContext(&net.Dialer{
 			KeepAlive: 30 * time.Second,
-			Timeout:   dialTimeout,
-		}).DialContext,
+			Timeout:   config.DialTimeout,
+		}),
 	}
 
 	return &UAAConnection{
 		HTTPClient: &http.Client{Transport: tr},
-	}
+	}, nil
 }
 
 // Make takes a passedRequest, converts it into an HTTP request and then
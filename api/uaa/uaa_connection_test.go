@@ -1,10 +1,15 @@
 package uaa_test
 
 import (
+	"encoding/pem"
 	"fmt"
+	"io/ioutil"
 	"net/http"
+	"os"
+	"path/filepath"
 
 	. "code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/util/tlsconfig"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/ghttp"
@@ -22,7 +27,18 @@ var _ = Describe("UAA Connection", func() {
 	)
 
 	BeforeEach(func() {
-		connection = NewConnection(true, 0)
+		var err error
+		connection, err = NewConnection(Config{SkipSSLValidation: true})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("NewConnection", func() {
+		Context("when the certificate files cannot be loaded", func() {
+			It("returns the error", func() {
+				_, err := NewConnection(Config{ClientKeyFile: "some-key-file"})
+				Expect(err).To(MatchError(tlsconfig.IncompleteClientCertError{}))
+			})
+		})
 	})
 
 	Describe("Make", func() {
@@ -99,7 +115,9 @@ var _ = Describe("UAA Connection", func() {
 		Describe("Errors", func() {
 			Context("when the server does not exist", func() {
 				BeforeEach(func() {
-					connection = NewConnection(false, 0)
+					var err error
+					connection, err = NewConnection(Config{})
+					Expect(err).ToNot(HaveOccurred())
 				})
 
 				It("returns a RequestError", func() {
@@ -125,7 +143,9 @@ var _ = Describe("UAA Connection", func() {
 							),
 						)
 
-						connection = NewConnection(false, 0)
+						var err error
+						connection, err = NewConnection(Config{})
+						Expect(err).ToNot(HaveOccurred())
 					})
 
 					It("returns a UnverifiedServerError", func() {
@@ -139,6 +159,46 @@ var _ = Describe("UAA Connection", func() {
 				})
 			})
 
+			Context("when the server's certificate authority is given", func() {
+				var tmpdir string
+
+				BeforeEach(func() {
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodGet, "/v2/foo"),
+							RespondWith(http.StatusOK, "{}"),
+						),
+					)
+
+					var err error
+					tmpdir, err = ioutil.TempDir("", "uaa_connection")
+					Expect(err).ToNot(HaveOccurred())
+
+					caCertFile := filepath.Join(tmpdir, "ca.pem")
+					caCert := pem.EncodeToMemory(&pem.Block{
+						Type:  "CERTIFICATE",
+						Bytes: server.HTTPTestServer.TLS.Certificates[0].Certificate[0],
+					})
+					Expect(ioutil.WriteFile(caCertFile, caCert, 0600)).To(Succeed())
+
+					connection, err = NewConnection(Config{CACertFile: caCertFile})
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				AfterEach(func() {
+					os.RemoveAll(tmpdir)
+				})
+
+				It("verifies the server's certificate", func() {
+					request, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/v2/foo", server.URL()), nil)
+					Expect(err).ToNot(HaveOccurred())
+
+					var response Response
+					err = connection.Make(request, &response)
+					Expect(err).ToNot(HaveOccurred())
+				})
+			})
+
 			Describe("RawHTTPStatusError", func() {
 				var uaaResponse string
 
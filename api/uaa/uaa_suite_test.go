@@ -36,7 +36,7 @@ var _ = BeforeEach(func() {
 })
 
 func NewTestUAAClientAndStore() *Client {
-	client := NewClient(Config{
+	client, err := NewClient(Config{
 		AppName:           "CF CLI UAA API Test",
 		AppVersion:        "Unknown",
 		ClientID:          "client-id",
@@ -44,5 +44,6 @@ func NewTestUAAClientAndStore() *Client {
 		SkipSSLValidation: true,
 		URL:               server.URL(),
 	})
+	Expect(err).ToNot(HaveOccurred())
 	return client
 }
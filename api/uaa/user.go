@@ -4,13 +4,98 @@ import (
 	"bytes"
 	"encoding/json"
 	"net/http"
+	"net/url"
+	"strconv"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/uaa/internal"
+	"github.com/tedsuo/rata"
+)
+
+// usersPageSize is the number of users requested per page when listing users.
+const usersPageSize = 100
+
+const (
+	// DirectMembership is the type of the membership of a user that was added
+	// to a group.
+	DirectMembership = "DIRECT"
+
+	// IndirectMembership is the type of the membership of a user that is a
+	// member of a group through another group.
+	IndirectMembership = "INDIRECT"
 )
 
 // User represents an UAA user account.
 type User struct {
-	ID string
+	ID         string
+	Username   string
+	Origin     string
+	Active     bool
+	Verified   bool
+	GivenName  string
+	FamilyName string
+	Emails     []string
+
+	// Groups are the groups the user is a direct or indirect member of.
+	Groups []UserGroup
+
+	Created   time.Time
+	LastLogon time.Time
+}
+
+// UserGroup represents a group membership of a user.
+type UserGroup struct {
+	ID   string
+	Name string
+
+	// Type is DirectMembership or IndirectMembership.
+	Type string
+}
+
+// UnmarshalJSON helps unmarshal an UAA SCIM user response.
+func (user *User) UnmarshalJSON(data []byte) error {
+	var scimUser struct {
+		ID       string   `json:"id"`
+		Username string   `json:"userName"`
+		Origin   string   `json:"origin"`
+		Active   bool     `json:"active"`
+		Verified bool     `json:"verified"`
+		Name     userName `json:"name"`
+		Emails   []email  `json:"emails"`
+		Groups   []struct {
+			Value   string `json:"value"`
+			Display string `json:"display"`
+			Type    string `json:"type"`
+		} `json:"groups"`
+		Meta struct {
+			Created time.Time `json:"created"`
+		} `json:"meta"`
+		LastLogonTime int64 `json:"lastLogonTime"`
+	}
+	err := json.Unmarshal(data, &scimUser)
+	if err != nil {
+		return err
+	}
+
+	user.ID = scimUser.ID
+	user.Username = scimUser.Username
+	user.Origin = scimUser.Origin
+	user.Active = scimUser.Active
+	user.Verified = scimUser.Verified
+	user.GivenName = scimUser.Name.GivenName
+	user.FamilyName = scimUser.Name.FamilyName
+	user.Created = scimUser.Meta.Created
+	for _, email := range scimUser.Emails {
+		user.Emails = append(user.Emails, email.Value)
+	}
+	for _, group := range scimUser.Groups {
+		user.Groups = append(user.Groups, UserGroup{ID: group.Value, Name: group.Display, Type: group.Type})
+	}
+	if scimUser.LastLogonTime > 0 {
+		// lastLogonTime is in milliseconds since the epoch.
+		user.LastLogon = time.Unix(0, scimUser.LastLogonTime*int64(time.Millisecond)).UTC()
+	}
+	return nil
 }
 
 // newUserRequestBody represents the body of the request.
@@ -83,3 +168,135 @@ func (client *Client) NewUser(user string, password string, origin string) (User
 
 	return User{ID: userResponse.ID}, nil
 }
+
+// usersResponse represents a page of the HTTP JSON response for listing
+// users.
+type usersResponse struct {
+	Resources    []User `json:"resources"`
+	StartIndex   int    `json:"startIndex"`
+	TotalResults int    `json:"totalResults"`
+}
+
+// GetUsers returns the UAA user accounts that match the SCIM filter, sorted
+// by username. All user accounts are returned if filter is empty.
+func (client *Client) GetUsers(filter string) ([]User, error) {
+	var users []User
+	for {
+		query := url.Values{
+			"sortBy":     {"userName"},
+			"startIndex": {strconv.Itoa(len(users) + 1)},
+			"count":      {strconv.Itoa(usersPageSize)},
+		}
+		if filter != "" {
+			query.Set("filter", filter)
+		}
+
+		request, err := client.newRequest(requestOptions{
+			RequestName: internal.GetUsersRequest,
+			Query:       query,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		var page usersResponse
+		response := Response{
+			Result: &page,
+		}
+
+		err = client.connection.Make(request, &response)
+		if err != nil {
+			return nil, err
+		}
+
+		users = append(users, page.Resources...)
+		if len(page.Resources) == 0 || len(users) >= page.TotalResults {
+			return users, nil
+		}
+	}
+}
+
+// GetUser returns the UAA user account with the given ID.
+func (client *Client) GetUser(userID string) (User, error) {
+	request, err := client.newRequest(requestOptions{
+		RequestName: internal.GetUserRequest,
+		Params:      rata.Params{"user_guid": userID},
+	})
+	if err != nil {
+		return User{}, err
+	}
+
+	var user User
+	response := Response{
+		Result: &user,
+	}
+
+	err = client.connection.Make(request, &response)
+	if err != nil {
+		return User{}, err
+	}
+
+	return user, nil
+}
+
+// SetUserActive activates or deactivates the UAA user account with the given
+// ID. A deactivated user cannot log in.
+func (client *Client) SetUserActive(userID string, active bool) (User, error) {
+	bodyBytes, err := json.Marshal(map[string]interface{}{
+		"active": active,
+	})
+	if err != nil {
+		return User{}, err
+	}
+
+	request, err := client.newRequest(requestOptions{
+		RequestName: internal.PatchUserRequest,
+		Params:      rata.Params{"user_guid": userID},
+		Header: http.Header{
+			"Content-Type": {"application/json"},
+			// Any version of the user is patched.
+			"If-Match": {"*"},
+		},
+		Body: bytes.NewBuffer(bodyBytes),
+	})
+	if err != nil {
+		return User{}, err
+	}
+
+	var user User
+	response := Response{
+		Result: &user,
+	}
+
+	err = client.connection.Make(request, &response)
+	if err != nil {
+		return User{}, err
+	}
+
+	return user, nil
+}
+
+// SetUserPassword sets the password of the UAA user account with the given ID
+// without requiring its current password. This requires the uaa.admin scope.
+func (client *Client) SetUserPassword(userID string, password string) error {
+	bodyBytes, err := json.Marshal(map[string]string{
+		"password": password,
+	})
+	if err != nil {
+		return err
+	}
+
+	request, err := client.newRequest(requestOptions{
+		RequestName: internal.SetUserPasswordRequest,
+		Params:      rata.Params{"user_guid": userID},
+		Header: http.Header{
+			"Content-Type": {"application/json"},
+		},
+		Body: bytes.NewBuffer(bodyBytes),
+	})
+	if err != nil {
+		return err
+	}
+
+	return client.connection.Make(request, &Response{})
+}
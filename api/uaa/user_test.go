@@ -2,6 +2,7 @@ package uaa_test
 
 import (
 	"net/http"
+	"time"
 
 	. "code.cloudfoundry.org/cli/api/uaa"
 	. "github.com/onsi/ginkgo"
@@ -92,4 +93,159 @@ var _ = Describe("User", func() {
 			})
 		})
 	})
+
+	Describe("GetUsers", func() {
+		Context("when the users span several pages", func() {
+			BeforeEach(func() {
+				page1 := `{
+					"resources": [
+						{
+							"id": "user-guid-1",
+							"userName": "alice@example.com",
+							"origin": "uaa",
+							"active": true,
+							"verified": true,
+							"name": {"givenName": "Alice", "familyName": "Smith"},
+							"emails": [{"value": "alice@example.com", "primary": true}],
+							"groups": [{"value": "group-guid-1", "display": "scim.read", "type": "DIRECT"}],
+							"meta": {"created": "2017-01-02T03:04:05.000Z"},
+							"lastLogonTime": 1483326245000
+						}
+					],
+					"startIndex": 1,
+					"itemsPerPage": 1,
+					"totalResults": 2
+				}`
+				page2 := `{
+					"resources": [
+						{
+							"id": "user-guid-2",
+							"userName": "bob@example.com",
+							"origin": "ldap",
+							"active": false
+						}
+					],
+					"startIndex": 2,
+					"itemsPerPage": 1,
+					"totalResults": 2
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/Users", `count=100&filter=origin+eq+%22uaa%22&sortBy=userName&startIndex=1`),
+						RespondWith(http.StatusOK, page1),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/Users", `count=100&filter=origin+eq+%22uaa%22&sortBy=userName&startIndex=2`),
+						RespondWith(http.StatusOK, page2),
+					),
+				)
+			})
+
+			It("returns the users from all the pages", func() {
+				users, err := client.GetUsers(`origin eq "uaa"`)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(users).To(Equal([]User{
+					{
+						ID:         "user-guid-1",
+						Username:   "alice@example.com",
+						Origin:     "uaa",
+						Active:     true,
+						Verified:   true,
+						GivenName:  "Alice",
+						FamilyName: "Smith",
+						Emails:     []string{"alice@example.com"},
+						Groups:     []UserGroup{{ID: "group-guid-1", Name: "scim.read", Type: DirectMembership}},
+						Created:    time.Date(2017, 1, 2, 3, 4, 5, 0, time.UTC),
+						LastLogon:  time.Date(2017, 1, 2, 3, 4, 5, 0, time.UTC),
+					},
+					{
+						ID:       "user-guid-2",
+						Username: "bob@example.com",
+						Origin:   "ldap",
+					},
+				}))
+				Expect(server.ReceivedRequests()).To(HaveLen(2))
+			})
+		})
+
+		Context("when the client does not have the scim.read scope", func() {
+			BeforeEach(func() {
+				response := `{
+					"error": "insufficient_scope",
+					"error_description": "Insufficient scope for this resource",
+					"scope": "uaa.admin scim.read"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/Users"),
+						RespondWith(http.StatusForbidden, response),
+					))
+			})
+
+			It("returns an InsufficientScopeError", func() {
+				_, err := client.GetUsers("")
+				Expect(err).To(MatchError(InsufficientScopeError{
+					Message: "Insufficient scope for this resource",
+					Scopes:  []string{"uaa.admin", "scim.read"},
+				}))
+			})
+		})
+	})
+
+	Describe("GetUser", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/Users/user-guid"),
+					RespondWith(http.StatusOK, `{"id": "user-guid", "userName": "alice@example.com", "origin": "uaa"}`),
+				))
+		})
+
+		It("returns the user", func() {
+			user, err := client.GetUser("user-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(user).To(Equal(User{
+				ID:       "user-guid",
+				Username: "alice@example.com",
+				Origin:   "uaa",
+			}))
+		})
+	})
+
+	Describe("SetUserActive", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPatch, "/Users/user-guid"),
+					VerifyHeaderKV("If-Match", "*"),
+					VerifyHeaderKV("Content-Type", "application/json"),
+					VerifyJSON(`{"active": false}`),
+					RespondWith(http.StatusOK, `{"id": "user-guid", "userName": "alice@example.com", "active": false}`),
+				))
+		})
+
+		It("deactivates the user", func() {
+			user, err := client.SetUserActive("user-guid", false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(user.Active).To(BeFalse())
+		})
+	})
+
+	Describe("SetUserPassword", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPut, "/Users/user-guid/password"),
+					VerifyHeaderKV("Content-Type", "application/json"),
+					VerifyJSON(`{"password": "new-password"}`),
+					RespondWith(http.StatusOK, `{"status": "ok", "message": "password updated"}`),
+				))
+		})
+
+		It("sets the password", func() {
+			err := client.SetUserPassword("user-guid", "new-password")
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
 })
@@ -0,0 +1,102 @@
+package wrapper_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptrace"
+
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/uaafakes"
+	. "code.cloudfoundry.org/cli/api/uaa/wrapper"
+	"code.cloudfoundry.org/cli/api/uaa/wrapper/wrapperfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("HAR Recorder", func() {
+	var (
+		fakeConnection *uaafakes.FakeConnection
+		fakeOutput     *wrapperfakes.FakeHARRecorderOutput
+
+		wrapper uaa.Connection
+
+		request  *http.Request
+		response *uaa.Response
+		err      error
+	)
+
+	BeforeEach(func() {
+		fakeConnection = new(uaafakes.FakeConnection)
+		fakeOutput = new(wrapperfakes.FakeHARRecorderOutput)
+
+		wrapper = NewHARRecorder(fakeOutput).Wrap(fakeConnection)
+
+		var err error
+		request, err = http.NewRequest(http.MethodPost, "https://foo.bar.com/Users", bytes.NewBufferString(`{"name":"some-app"}`))
+		Expect(err).NotTo(HaveOccurred())
+
+		response = &uaa.Response{}
+	})
+
+	JustBeforeEach(func() {
+		err = wrapper.Make(request, response)
+	})
+
+	Context("when the inner connection receives a response", func() {
+		var httpResponse *http.Response
+
+		BeforeEach(func() {
+			httpResponse = &http.Response{StatusCode: http.StatusCreated}
+			fakeConnection.MakeStub = func(req *http.Request, passedResponse *uaa.Response) error {
+				Expect(httptrace.ContextClientTrace(req.Context())).ToNot(BeNil())
+
+				body, readErr := ioutil.ReadAll(req.Body)
+				Expect(readErr).ToNot(HaveOccurred())
+				Expect(body).To(Equal([]byte(`{"name":"some-app"}`)))
+
+				passedResponse.HTTPResponse = httpResponse
+				passedResponse.RawResponse = []byte(`{"metadata":{"guid":"some-app-guid"}}`)
+				return nil
+			}
+		})
+
+		It("passes a traced request to the inner connection and records the entry", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+
+			Expect(fakeOutput.RecordEntryCallCount()).To(Equal(1))
+			recordedRequest, requestBody, recordedResponse, responseBody, timer := fakeOutput.RecordEntryArgsForCall(0)
+			Expect(recordedRequest).To(Equal(request))
+			Expect(requestBody).To(Equal([]byte(`{"name":"some-app"}`)))
+			Expect(recordedResponse).To(Equal(httpResponse))
+			Expect(responseBody).To(Equal([]byte(`{"metadata":{"guid":"some-app-guid"}}`)))
+			Expect(timer).ToNot(BeNil())
+		})
+
+		Context("when recording fails", func() {
+			BeforeEach(func() {
+				fakeOutput.RecordEntryReturns(errors.New("disk full"))
+			})
+
+			It("handles the error and returns the response", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeOutput.HandleInternalErrorCallCount()).To(Equal(1))
+				Expect(fakeOutput.HandleInternalErrorArgsForCall(0)).To(MatchError("disk full"))
+			})
+		})
+	})
+
+	Context("when the inner connection does not receive a response", func() {
+		BeforeEach(func() {
+			fakeConnection.MakeReturns(uaa.RequestError{Err: errors.New("connection refused")})
+		})
+
+		It("does not record anything and returns the error", func() {
+			Expect(err).To(MatchError(uaa.RequestError{Err: errors.New("connection refused")}))
+			Expect(fakeOutput.RecordEntryCallCount()).To(Equal(0))
+		})
+	})
+})
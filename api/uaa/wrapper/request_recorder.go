@@ -0,0 +1,64 @@
+package wrapper
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/uaa"
+)
+
+//go:generate counterfeiter . RequestRecorderOutput
+
+// RequestRecorderOutput is the interface for storing recorded requests and
+// responses.
+type RequestRecorderOutput interface {
+	HandleInternalError(err error)
+	RecordInteraction(request *http.Request, requestBody []byte, response *http.Response, responseBody []byte) error
+}
+
+// RequestRecorder is the wrapper that records every request to and response
+// from the UAA server, so that they can be replayed later.
+type RequestRecorder struct {
+	connection uaa.Connection
+	output     RequestRecorderOutput
+}
+
+// NewRequestRecorder returns a pointer to a RequestRecorder wrapper.
+func NewRequestRecorder(output RequestRecorderOutput) *RequestRecorder {
+	return &RequestRecorder{
+		output: output,
+	}
+}
+
+// Wrap sets the connection on the RequestRecorder and returns itself.
+func (recorder *RequestRecorder) Wrap(innerconnection uaa.Connection) uaa.Connection {
+	recorder.connection = innerconnection
+	return recorder
+}
+
+// Make passes the request to the inner connection and records the request
+// along with the response, if one was received.
+func (recorder *RequestRecorder) Make(request *http.Request, passedResponse *uaa.Response) error {
+	var rawRequestBody []byte
+	if request.Body != nil {
+		var err error
+		rawRequestBody, err = ioutil.ReadAll(request.Body)
+		request.Body.Close()
+		if err != nil {
+			return err
+		}
+		request.Body = ioutil.NopCloser(bytes.NewBuffer(rawRequestBody))
+	}
+
+	err := recorder.connection.Make(request, passedResponse)
+
+	if passedResponse.HTTPResponse != nil {
+		recordErr := recorder.output.RecordInteraction(request, rawRequestBody, passedResponse.HTTPResponse, passedResponse.RawResponse)
+		if recordErr != nil {
+			recorder.output.HandleInternalError(recordErr)
+		}
+	}
+
+	return err
+}
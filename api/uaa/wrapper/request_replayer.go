@@ -0,0 +1,35 @@
+package wrapper
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/uaa"
+)
+
+// RequestReplayer is the wrapper that serves responses from previously
+// recorded interactions instead of sending requests to the UAA server.
+type RequestReplayer struct {
+	connection *uaa.UAAConnection
+}
+
+// NewRequestReplayer returns a pointer to a RequestReplayer wrapper that
+// serves every request through the provided transport.
+func NewRequestReplayer(transport http.RoundTripper) *RequestReplayer {
+	return &RequestReplayer{
+		connection: &uaa.UAAConnection{
+			HTTPClient: &http.Client{Transport: transport},
+		},
+	}
+}
+
+// Wrap discards the inner connection, ensuring no request reaches the
+// network, and returns itself.
+func (replayer *RequestReplayer) Wrap(_ uaa.Connection) uaa.Connection {
+	return replayer
+}
+
+// Make serves the request from the replay transport and parses the response
+// like a regular connection would.
+func (replayer *RequestReplayer) Make(request *http.Request, passedResponse *uaa.Response) error {
+	return replayer.connection.Make(request, passedResponse)
+}
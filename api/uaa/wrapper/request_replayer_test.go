@@ -0,0 +1,101 @@
+package wrapper_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/uaafakes"
+	. "code.cloudfoundry.org/cli/api/uaa/wrapper"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(request *http.Request) (*http.Response, error) {
+	return f(request)
+}
+
+var _ = Describe("Request Replayer", func() {
+	var (
+		fakeConnection *uaafakes.FakeConnection
+		transport      roundTripperFunc
+
+		request  *http.Request
+		response *uaa.Response
+		err      error
+	)
+
+	BeforeEach(func() {
+		fakeConnection = new(uaafakes.FakeConnection)
+
+		var err error
+		request, err = http.NewRequest(http.MethodGet, "https://foo.bar.com/Users", nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		response = &uaa.Response{
+			Result: &map[string]string{},
+		}
+	})
+
+	JustBeforeEach(func() {
+		err = NewRequestReplayer(transport).Wrap(fakeConnection).Make(request, response)
+	})
+
+	Context("when the transport serves a response", func() {
+		BeforeEach(func() {
+			transport = func(req *http.Request) (*http.Response, error) {
+				Expect(req).To(Equal(request))
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Header:     http.Header{},
+					Body:       ioutil.NopCloser(strings.NewReader(`{"name":"some-app"}`)),
+				}, nil
+			}
+		})
+
+		It("parses the response without using the inner connection", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeConnection.MakeCallCount()).To(Equal(0))
+
+			Expect(response.Result).To(Equal(&map[string]string{"name": "some-app"}))
+			Expect(response.HTTPResponse.StatusCode).To(Equal(http.StatusOK))
+		})
+	})
+
+	Context("when the transport serves an error status code", func() {
+		BeforeEach(func() {
+			transport = func(*http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: http.StatusNotFound,
+					Header:     http.Header{},
+					Body:       ioutil.NopCloser(strings.NewReader(`{"code":10000}`)),
+				}, nil
+			}
+		})
+
+		It("returns a RawHTTPStatusError", func() {
+			Expect(err).To(MatchError(uaa.RawHTTPStatusError{
+				StatusCode:  http.StatusNotFound,
+				RawResponse: []byte(`{"code":10000}`),
+			}))
+		})
+	})
+
+	Context("when the transport has no response for the request", func() {
+		BeforeEach(func() {
+			transport = func(*http.Request) (*http.Response, error) {
+				return nil, errors.New("no recorded response")
+			}
+		})
+
+		It("returns a RequestError", func() {
+			Expect(err).To(BeAssignableToTypeOf(uaa.RequestError{}))
+			Expect(err.Error()).To(ContainSubstring("no recorded response"))
+		})
+	})
+})
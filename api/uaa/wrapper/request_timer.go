@@ -0,0 +1,44 @@
+package wrapper
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/util/har"
+)
+
+//go:generate counterfeiter . RequestTimerOutput
+
+// RequestTimerOutput is the interface for collecting request timings.
+type RequestTimerOutput interface {
+	RecordTiming(request *http.Request, response *http.Response, responseSize int, timer *har.Timer)
+}
+
+// RequestTimer is the wrapper that measures how long each request to the
+// UAA server takes.
+type RequestTimer struct {
+	connection uaa.Connection
+	output     RequestTimerOutput
+}
+
+// NewRequestTimer returns a pointer to a RequestTimer wrapper.
+func NewRequestTimer(output RequestTimerOutput) *RequestTimer {
+	return &RequestTimer{
+		output: output,
+	}
+}
+
+// Wrap sets the connection on the RequestTimer and returns itself.
+func (timer *RequestTimer) Wrap(innerconnection uaa.Connection) uaa.Connection {
+	timer.connection = innerconnection
+	return timer
+}
+
+// Make times the request passed to the inner connection and records the
+// timing, whether or not a response was received.
+func (timer *RequestTimer) Make(request *http.Request, passedResponse *uaa.Response) error {
+	timedRequest, requestTimer := har.StartTimer(request)
+	err := timer.connection.Make(timedRequest, passedResponse)
+	timer.output.RecordTiming(request, passedResponse.HTTPResponse, len(passedResponse.RawResponse), requestTimer)
+	return err
+}
@@ -0,0 +1,89 @@
+package wrapper_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptrace"
+
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/uaafakes"
+	. "code.cloudfoundry.org/cli/api/uaa/wrapper"
+	"code.cloudfoundry.org/cli/api/uaa/wrapper/wrapperfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Request Timer", func() {
+	var (
+		fakeConnection *uaafakes.FakeConnection
+		fakeOutput     *wrapperfakes.FakeRequestTimerOutput
+
+		wrapper uaa.Connection
+
+		request  *http.Request
+		response *uaa.Response
+		err      error
+	)
+
+	BeforeEach(func() {
+		fakeConnection = new(uaafakes.FakeConnection)
+		fakeOutput = new(wrapperfakes.FakeRequestTimerOutput)
+
+		wrapper = NewRequestTimer(fakeOutput).Wrap(fakeConnection)
+
+		var err error
+		request, err = http.NewRequest(http.MethodGet, "https://foo.bar.com/Users?startIndex=1", nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		response = &uaa.Response{}
+	})
+
+	JustBeforeEach(func() {
+		err = wrapper.Make(request, response)
+	})
+
+	Context("when the inner connection receives a response", func() {
+		var httpResponse *http.Response
+
+		BeforeEach(func() {
+			httpResponse = &http.Response{StatusCode: http.StatusOK}
+			fakeConnection.MakeStub = func(req *http.Request, passedResponse *uaa.Response) error {
+				Expect(httptrace.ContextClientTrace(req.Context())).ToNot(BeNil())
+
+				passedResponse.HTTPResponse = httpResponse
+				passedResponse.RawResponse = []byte(`{"resources":[],"totalResults":0}`)
+				return nil
+			}
+		})
+
+		It("passes a traced request to the inner connection and records the timing", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+
+			Expect(fakeOutput.RecordTimingCallCount()).To(Equal(1))
+			recordedRequest, recordedResponse, responseSize, timer := fakeOutput.RecordTimingArgsForCall(0)
+			Expect(recordedRequest).To(Equal(request))
+			Expect(recordedResponse).To(Equal(httpResponse))
+			Expect(responseSize).To(Equal(len(`{"resources":[],"totalResults":0}`)))
+			Expect(timer).ToNot(BeNil())
+		})
+	})
+
+	Context("when the inner connection does not receive a response", func() {
+		BeforeEach(func() {
+			fakeConnection.MakeReturns(uaa.RequestError{Err: errors.New("connection refused")})
+		})
+
+		It("records the timing and returns the error", func() {
+			Expect(err).To(MatchError(uaa.RequestError{Err: errors.New("connection refused")}))
+
+			Expect(fakeOutput.RecordTimingCallCount()).To(Equal(1))
+			recordedRequest, recordedResponse, responseSize, timer := fakeOutput.RecordTimingArgsForCall(0)
+			Expect(recordedRequest).To(Equal(request))
+			Expect(recordedResponse).To(BeNil())
+			Expect(responseSize).To(Equal(0))
+			Expect(timer).ToNot(BeNil())
+		})
+	})
+})
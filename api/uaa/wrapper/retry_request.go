@@ -4,20 +4,26 @@ import (
 	"bytes"
 	"io/ioutil"
 	"net/http"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/util/retry"
 )
 
-// RetryRequest is a wrapper that retries failed requests if they contain a 5XX
-// status code.
+// RetryRequest is a wrapper that retries failed requests as its retry.Policy
+// allows, waiting between attempts for as long as the policy says.
 type RetryRequest struct {
+	retry.Policy
+
 	maxRetries int
 	connection uaa.Connection
 }
 
-// NewRetryRequest returns a pointer to a RetryRequest wrapper.
+// NewRetryRequest returns a pointer to a RetryRequest wrapper that retries a
+// failed request up to maxRetries times.
 func NewRetryRequest(maxRetries int) *RetryRequest {
 	return &RetryRequest{
+		Policy:     retry.NewPolicy(),
 		maxRetries: maxRetries,
 	}
 }
@@ -28,7 +34,9 @@ func (retry *RetryRequest) Wrap(innerconnection uaa.Connection) uaa.Connection {
 	return retry
 }
 
-// Make retries the request if it comes back with a 5XX status code.
+// Make retries the request if it comes back with a retryable status code or
+// fails to connect. POST requests are only retried on other errors than 429
+// when they are marked with uaa.MarkRetrySafe.
 func (retry *RetryRequest) Make(request *http.Request, passedResponse *uaa.Response) error {
 	var err error
 	var rawRequestBody []byte
@@ -41,23 +49,27 @@ func (retry *RetryRequest) Make(request *http.Request, passedResponse *uaa.Respo
 		}
 	}
 
-	for i := 0; i < retry.maxRetries+1; i += 1 {
+	for attempt := 0; ; attempt += 1 {
 		if rawRequestBody != nil {
 			request.Body = ioutil.NopCloser(bytes.NewBuffer(rawRequestBody))
 		}
+		// The connection only sets the response when one is received, so a
+		// response left over from a previous attempt must not be mistaken for
+		// the outcome of this one.
+		passedResponse.HTTPResponse = nil
 		err = retry.connection.Make(request, passedResponse)
 		if err == nil {
 			return nil
 		}
 
-		if request.Method == http.MethodPost ||
-			passedResponse.HTTPResponse != nil &&
-				passedResponse.HTTPResponse.StatusCode != http.StatusInternalServerError &&
-				passedResponse.HTTPResponse.StatusCode != http.StatusBadGateway &&
-				passedResponse.HTTPResponse.StatusCode != http.StatusServiceUnavailable &&
-				passedResponse.HTTPResponse.StatusCode != http.StatusGatewayTimeout {
-			break
+		var networkErr error
+		if requestErr, ok := err.(uaa.RequestError); ok {
+			networkErr = requestErr.Err
+		}
+		if attempt >= retry.maxRetries || !retry.Retryable(request, passedResponse.HTTPResponse, networkErr) {
+			return err
 		}
+
+		time.Sleep(retry.Delay(attempt, passedResponse.HTTPResponse))
 	}
-	return err
 }
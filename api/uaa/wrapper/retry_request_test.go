@@ -2,8 +2,13 @@ package wrapper_test
 
 import (
 	"io/ioutil"
+	"net"
 	"net/http"
+	"net/url"
+	"os"
 	"strings"
+	"syscall"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/api/uaa/uaafakes"
@@ -14,6 +19,18 @@ import (
 )
 
 var _ = Describe("Retry Request", func() {
+	var (
+		retry          *RetryRequest
+		fakeConnection *uaafakes.FakeConnection
+	)
+
+	BeforeEach(func() {
+		retry = NewRetryRequest(2)
+		retry.BaseDelay = time.Millisecond
+		retry.MaxDelay = 10 * time.Millisecond
+		fakeConnection = new(uaafakes.FakeConnection)
+	})
+
 	DescribeTable("number of retries",
 		func(requestMethod string, responseStatusCode int, expectedNumberOfRetries int) {
 			request, err := http.NewRequest(requestMethod, "https://foo.bar.com/banana", nil)
@@ -22,13 +39,6 @@ var _ = Describe("Retry Request", func() {
 			rawRequestBody := "banana pants"
 			request.Body = ioutil.NopCloser(strings.NewReader(rawRequestBody))
 
-			response := &uaa.Response{
-				HTTPResponse: &http.Response{
-					StatusCode: responseStatusCode,
-				},
-			}
-
-			fakeConnection := new(uaafakes.FakeConnection)
 			expectedErr := uaa.RawHTTPStatusError{
 				StatusCode: responseStatusCode,
 			}
@@ -37,20 +47,23 @@ var _ = Describe("Retry Request", func() {
 				body, err := ioutil.ReadAll(request.Body)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(string(body)).To(Equal(rawRequestBody))
+				passedResponse.HTTPResponse = &http.Response{StatusCode: responseStatusCode}
 				return expectedErr
 			}
 
-			wrapper := NewRetryRequest(2).Wrap(fakeConnection)
-			err = wrapper.Make(request, response)
+			wrapper := retry.Wrap(fakeConnection)
+			err = wrapper.Make(request, &uaa.Response{})
 			Expect(err).To(MatchError(expectedErr))
 			Expect(fakeConnection.MakeCallCount()).To(Equal(expectedNumberOfRetries))
 		},
 
+		Entry("maxRetries for Non-Post (429) Too Many Requests", http.MethodGet, http.StatusTooManyRequests, 3),
 		Entry("maxRetries for Non-Post (500) Internal Server Error", http.MethodGet, http.StatusInternalServerError, 3),
 		Entry("maxRetries for Non-Post (502) Bad Gateway", http.MethodGet, http.StatusBadGateway, 3),
 		Entry("maxRetries for Non-Post (503) Service Unavailable", http.MethodGet, http.StatusServiceUnavailable, 3),
 		Entry("maxRetries for Non-Post (504) Gateway Timeout", http.MethodGet, http.StatusGatewayTimeout, 3),
 
+		Entry("maxRetries for Post (429) Too Many Requests", http.MethodPost, http.StatusTooManyRequests, 3),
 		Entry("1 for Post (500) Internal Server Error", http.MethodPost, http.StatusInternalServerError, 1),
 		Entry("1 for Post (502) Bad Gateway", http.MethodPost, http.StatusBadGateway, 1),
 		Entry("1 for Post (503) Service Unavailable", http.MethodPost, http.StatusServiceUnavailable, 1),
@@ -68,11 +81,111 @@ var _ = Describe("Retry Request", func() {
 			},
 		}
 
-		fakeConnection := new(uaafakes.FakeConnection)
-		wrapper := NewRetryRequest(2).Wrap(fakeConnection)
+		wrapper := retry.Wrap(fakeConnection)
 
 		err = wrapper.Make(request, response)
 		Expect(err).ToNot(HaveOccurred())
 		Expect(fakeConnection.MakeCallCount()).To(Equal(1))
 	})
+
+	It("stops retrying once a request succeeds", func() {
+		request, err := http.NewRequest(http.MethodGet, "https://foo.bar.com/banana", nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		fakeConnection.MakeStub = func(_ *http.Request, passedResponse *uaa.Response) error {
+			if fakeConnection.MakeCallCount() == 1 {
+				passedResponse.HTTPResponse = &http.Response{StatusCode: http.StatusServiceUnavailable}
+				return uaa.RawHTTPStatusError{StatusCode: http.StatusServiceUnavailable}
+			}
+			passedResponse.HTTPResponse = &http.Response{StatusCode: http.StatusOK}
+			return nil
+		}
+
+		err = retry.Wrap(fakeConnection).Make(request, &uaa.Response{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+	})
+
+	Context("when the POST request is marked as safe to retry", func() {
+		It("retries the request", func() {
+			request, err := http.NewRequest(http.MethodPost, "https://foo.bar.com/banana", strings.NewReader("banana pants"))
+			Expect(err).NotTo(HaveOccurred())
+			request = uaa.MarkRetrySafe(request)
+
+			fakeConnection.MakeStub = func(req *http.Request, passedResponse *uaa.Response) error {
+				body, err := ioutil.ReadAll(req.Body)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(body)).To(Equal("banana pants"))
+
+				passedResponse.HTTPResponse = &http.Response{StatusCode: http.StatusBadGateway}
+				return uaa.RawHTTPStatusError{StatusCode: http.StatusBadGateway}
+			}
+
+			err = retry.Wrap(fakeConnection).Make(request, &uaa.Response{})
+			Expect(err).To(HaveOccurred())
+			Expect(fakeConnection.MakeCallCount()).To(Equal(3))
+		})
+	})
+
+	Context("when a POST request gets a 429 and then fails before a response is received", func() {
+		It("does not retry the request again", func() {
+			request, err := http.NewRequest(http.MethodPost, "https://foo.bar.com/banana", strings.NewReader("banana pants"))
+			Expect(err).NotTo(HaveOccurred())
+
+			fakeConnection.MakeStub = func(_ *http.Request, passedResponse *uaa.Response) error {
+				if fakeConnection.MakeCallCount() == 1 {
+					passedResponse.HTTPResponse = &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": {"0"}}}
+					return uaa.RawHTTPStatusError{StatusCode: http.StatusTooManyRequests}
+				}
+				return uaa.RequestError{Err: &url.Error{Op: "Post", URL: "https://foo.bar.com/banana", Err: &net.OpError{Op: "read", Net: "tcp", Err: os.NewSyscallError("read", syscall.ECONNRESET)}}}
+			}
+
+			response := &uaa.Response{}
+			err = retry.Wrap(fakeConnection).Make(request, response)
+			Expect(err).To(BeAssignableToTypeOf(uaa.RequestError{}))
+			Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+			Expect(response.HTTPResponse).To(BeNil())
+		})
+	})
+
+	Context("when the request fails before a response is received", func() {
+		var (
+			request *http.Request
+			makeErr error
+		)
+
+		BeforeEach(func() {
+			var err error
+			request, err = http.NewRequest(http.MethodGet, "https://foo.bar.com/banana", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			fakeConnection.MakeStub = func(_ *http.Request, _ *uaa.Response) error {
+				return uaa.RequestError{Err: &url.Error{Op: "Get", URL: "https://foo.bar.com/banana", Err: makeErr}}
+			}
+		})
+
+		Context("because the connection was reset", func() {
+			BeforeEach(func() {
+				makeErr = &net.OpError{Op: "read", Net: "tcp", Err: os.NewSyscallError("read", syscall.ECONNRESET)}
+			})
+
+			It("retries the request", func() {
+				err := retry.Wrap(fakeConnection).Make(request, &uaa.Response{})
+				Expect(err).To(HaveOccurred())
+				Expect(fakeConnection.MakeCallCount()).To(Equal(3))
+			})
+		})
+
+		Context("because the host does not exist", func() {
+			BeforeEach(func() {
+				makeErr = &net.OpError{Op: "dial", Net: "tcp", Err: &net.DNSError{Err: "no such host", Name: "foo.bar.com"}}
+			})
+
+			It("does not retry the request", func() {
+				err := retry.Wrap(fakeConnection).Make(request, &uaa.Response{})
+				Expect(err).To(HaveOccurred())
+				Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+			})
+		})
+	})
 })
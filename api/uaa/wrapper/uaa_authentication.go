@@ -27,19 +27,28 @@ type TokenCache interface {
 }
 
 // UAAAuthentication wraps connections and adds authentication headers to all
-// requests
+// requests. The access token is refreshed before a request if the schedule
+// says it is about to expire, and after a request the server rejected
+// because of an invalid token.
 type UAAAuthentication struct {
 	connection uaa.Connection
 	client     UAAClient
 	cache      TokenCache
+	schedule   *uaa.RefreshSchedule
 }
 
 // NewUAAAuthentication returns a pointer to a UAAAuthentication wrapper with
-// the client and token cache.
-func NewUAAAuthentication(client UAAClient, cache TokenCache) *UAAAuthentication {
+// the client, token cache and the refresh schedule shared with the other
+// authentication wrappers. If schedule is nil, tokens are refreshed once they
+// have expired.
+func NewUAAAuthentication(client UAAClient, cache TokenCache, schedule *uaa.RefreshSchedule) *UAAAuthentication {
+	if schedule == nil {
+		schedule = uaa.NewRefreshSchedule(0)
+	}
 	return &UAAAuthentication{
-		client: client,
-		cache:  cache,
+		client:   client,
+		cache:    cache,
+		schedule: schedule,
 	}
 }
 
@@ -72,19 +81,26 @@ func (t *UAAAuthentication) Make(request *http.Request, passedResponse *uaa.Resp
 		}
 	}
 
-	request.Header.Set("Authorization", t.cache.AccessToken())
+	if accessToken := t.cache.AccessToken(); t.schedule.NeedsRefresh(accessToken) {
+		err = t.refreshToken(accessToken)
+		if err != nil {
+			return err
+		}
+	}
+
+	accessToken := t.cache.AccessToken()
+	request.Header.Set("Authorization", accessToken)
 
 	err = t.connection.Make(request, passedResponse)
+	if passedResponse != nil {
+		t.schedule.ObserveDate(passedResponse.HTTPResponse)
+	}
 	if _, ok := err.(uaa.InvalidAuthTokenError); ok {
-		var token uaa.RefreshToken
-		token, err = t.client.RefreshAccessToken(t.cache.RefreshToken())
+		err = t.refreshToken(accessToken)
 		if err != nil {
 			return err
 		}
 
-		t.cache.SetAccessToken(token.AuthorizationToken())
-		t.cache.SetRefreshToken(token.RefreshToken)
-
 		if rawRequestBody != nil {
 			request.Body = ioutil.NopCloser(bytes.NewBuffer(rawRequestBody))
 		}
@@ -94,3 +110,12 @@ func (t *UAAAuthentication) Make(request *http.Request, passedResponse *uaa.Resp
 
 	return err
 }
+
+// refreshToken replaces staleToken in the cache with a new access token,
+// unless a concurrent request already has.
+func (t *UAAAuthentication) refreshToken(staleToken string) error {
+	return t.schedule.Refresh(staleToken, t.cache.AccessToken, func() error {
+		_, err := uaa.RefreshStoredAccessToken(t.client, t.cache)
+		return err
+	})
+}
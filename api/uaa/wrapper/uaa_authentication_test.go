@@ -1,12 +1,14 @@
 package wrapper_test
 
 import (
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/api/uaa/uaafakes"
@@ -32,7 +34,7 @@ var _ = Describe("UAA Authentication", func() {
 		fakeClient = new(wrapperfakes.FakeUAAClient)
 		inMemoryCache = util.NewInMemoryTokenCache()
 
-		inner := NewUAAAuthentication(fakeClient, inMemoryCache)
+		inner := NewUAAAuthentication(fakeClient, inMemoryCache, nil)
 		wrapper = inner.Wrap(fakeConnection)
 	})
 
@@ -86,6 +88,57 @@ var _ = Describe("UAA Authentication", func() {
 			})
 		})
 
+		Context("when the token is about to expire", func() {
+			BeforeEach(func() {
+				request = &http.Request{
+					Header: http.Header{},
+				}
+				inMemoryCache.SetAccessToken(accessTokenExpiringAt(time.Now().Add(30 * time.Second)))
+				inMemoryCache.SetRefreshToken("some-refresh-token")
+
+				inner := NewUAAAuthentication(fakeClient, inMemoryCache, uaa.NewRefreshSchedule(time.Minute))
+				wrapper = inner.Wrap(fakeConnection)
+			})
+
+			Context("when the refresh succeeds", func() {
+				BeforeEach(func() {
+					fakeClient.RefreshAccessTokenReturns(
+						uaa.RefreshToken{
+							AccessToken:  "refreshed-token",
+							RefreshToken: "new-refresh-token",
+							Type:         "bearer",
+						},
+						nil,
+					)
+				})
+
+				It("refreshes the token before sending the request", func() {
+					err := wrapper.Make(request, nil)
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(1))
+					Expect(fakeClient.RefreshAccessTokenArgsForCall(0)).To(Equal("some-refresh-token"))
+					Expect(inMemoryCache.RefreshToken()).To(Equal("new-refresh-token"))
+
+					Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+					authenticatedRequest, _ := fakeConnection.MakeArgsForCall(0)
+					Expect(authenticatedRequest.Header.Get("Authorization")).To(Equal("bearer refreshed-token"))
+				})
+			})
+
+			Context("when the refresh fails", func() {
+				BeforeEach(func() {
+					fakeClient.RefreshAccessTokenReturns(uaa.RefreshToken{}, errors.New("refresh failed"))
+				})
+
+				It("returns the error without sending the request", func() {
+					err := wrapper.Make(request, nil)
+					Expect(err).To(MatchError("refresh failed"))
+					Expect(fakeConnection.MakeCallCount()).To(Equal(0))
+				})
+			})
+		})
+
 		Context("when the token is invalid", func() {
 			var expectedBody string
 
@@ -164,3 +217,14 @@ var _ = Describe("UAA Authentication", func() {
 		})
 	})
 })
+
+// accessTokenExpiringAt returns an authorization header value for a JWT that
+// expires at expiry.
+func accessTokenExpiringAt(expiry time.Time) string {
+	encode := base64.RawURLEncoding.EncodeToString
+	return fmt.Sprintf("bearer %s.%s.%s",
+		encode([]byte(`{"alg":"HS256","typ":"JWT"}`)),
+		encode([]byte(fmt.Sprintf(`{"user_name":"some-user","exp":%d}`, expiry.Unix()))),
+		encode([]byte("signature")),
+	)
+}
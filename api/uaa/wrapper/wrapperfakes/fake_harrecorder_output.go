@@ -0,0 +1,127 @@
+// This file was generated by counterfeiter
+package wrapperfakes
+
+import (
+	"net/http"
+	"sync"
+
+	"code.cloudfoundry.org/cli/api/uaa/wrapper"
+	"code.cloudfoundry.org/cli/util/har"
+)
+
+type FakeHARRecorderOutput struct {
+	HandleInternalErrorStub        func(err error)
+	handleInternalErrorMutex       sync.RWMutex
+	handleInternalErrorArgsForCall []struct {
+		err error
+	}
+	RecordEntryStub        func(request *http.Request, requestBody []byte, response *http.Response, responseBody []byte, timer *har.Timer) error
+	recordEntryMutex       sync.RWMutex
+	recordEntryArgsForCall []struct {
+		request      *http.Request
+		requestBody  []byte
+		response     *http.Response
+		responseBody []byte
+		timer        *har.Timer
+	}
+	recordEntryReturns struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeHARRecorderOutput) HandleInternalError(err error) {
+	fake.handleInternalErrorMutex.Lock()
+	fake.handleInternalErrorArgsForCall = append(fake.handleInternalErrorArgsForCall, struct {
+		err error
+	}{err})
+	fake.recordInvocation("HandleInternalError", []interface{}{err})
+	fake.handleInternalErrorMutex.Unlock()
+	if fake.HandleInternalErrorStub != nil {
+		fake.HandleInternalErrorStub(err)
+	}
+}
+
+func (fake *FakeHARRecorderOutput) HandleInternalErrorCallCount() int {
+	fake.handleInternalErrorMutex.RLock()
+	defer fake.handleInternalErrorMutex.RUnlock()
+	return len(fake.handleInternalErrorArgsForCall)
+}
+
+func (fake *FakeHARRecorderOutput) HandleInternalErrorArgsForCall(i int) error {
+	fake.handleInternalErrorMutex.RLock()
+	defer fake.handleInternalErrorMutex.RUnlock()
+	return fake.handleInternalErrorArgsForCall[i].err
+}
+
+func (fake *FakeHARRecorderOutput) RecordEntry(request *http.Request, requestBody []byte, response *http.Response, responseBody []byte, timer *har.Timer) error {
+	var requestBodyCopy []byte
+	if requestBody != nil {
+		requestBodyCopy = make([]byte, len(requestBody))
+		copy(requestBodyCopy, requestBody)
+	}
+	var responseBodyCopy []byte
+	if responseBody != nil {
+		responseBodyCopy = make([]byte, len(responseBody))
+		copy(responseBodyCopy, responseBody)
+	}
+	fake.recordEntryMutex.Lock()
+	fake.recordEntryArgsForCall = append(fake.recordEntryArgsForCall, struct {
+		request      *http.Request
+		requestBody  []byte
+		response     *http.Response
+		responseBody []byte
+		timer        *har.Timer
+	}{request, requestBodyCopy, response, responseBodyCopy, timer})
+	fake.recordInvocation("RecordEntry", []interface{}{request, requestBodyCopy, response, responseBodyCopy, timer})
+	fake.recordEntryMutex.Unlock()
+	if fake.RecordEntryStub != nil {
+		return fake.RecordEntryStub(request, requestBody, response, responseBody, timer)
+	} else {
+		return fake.recordEntryReturns.result1
+	}
+}
+
+func (fake *FakeHARRecorderOutput) RecordEntryCallCount() int {
+	fake.recordEntryMutex.RLock()
+	defer fake.recordEntryMutex.RUnlock()
+	return len(fake.recordEntryArgsForCall)
+}
+
+func (fake *FakeHARRecorderOutput) RecordEntryArgsForCall(i int) (*http.Request, []byte, *http.Response, []byte, *har.Timer) {
+	fake.recordEntryMutex.RLock()
+	defer fake.recordEntryMutex.RUnlock()
+	return fake.recordEntryArgsForCall[i].request, fake.recordEntryArgsForCall[i].requestBody, fake.recordEntryArgsForCall[i].response, fake.recordEntryArgsForCall[i].responseBody, fake.recordEntryArgsForCall[i].timer
+}
+
+func (fake *FakeHARRecorderOutput) RecordEntryReturns(result1 error) {
+	fake.RecordEntryStub = nil
+	fake.recordEntryReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHARRecorderOutput) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.handleInternalErrorMutex.RLock()
+	defer fake.handleInternalErrorMutex.RUnlock()
+	fake.recordEntryMutex.RLock()
+	defer fake.recordEntryMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeHARRecorderOutput) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ wrapper.HARRecorderOutput = new(FakeHARRecorderOutput)
@@ -0,0 +1,72 @@
+// This file was generated by counterfeiter
+package wrapperfakes
+
+import (
+	"net/http"
+	"sync"
+
+	"code.cloudfoundry.org/cli/api/uaa/wrapper"
+	"code.cloudfoundry.org/cli/util/har"
+)
+
+type FakeRequestTimerOutput struct {
+	RecordTimingStub        func(request *http.Request, response *http.Response, responseSize int, timer *har.Timer)
+	recordTimingMutex       sync.RWMutex
+	recordTimingArgsForCall []struct {
+		request      *http.Request
+		response     *http.Response
+		responseSize int
+		timer        *har.Timer
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeRequestTimerOutput) RecordTiming(request *http.Request, response *http.Response, responseSize int, timer *har.Timer) {
+	fake.recordTimingMutex.Lock()
+	fake.recordTimingArgsForCall = append(fake.recordTimingArgsForCall, struct {
+		request      *http.Request
+		response     *http.Response
+		responseSize int
+		timer        *har.Timer
+	}{request, response, responseSize, timer})
+	fake.recordInvocation("RecordTiming", []interface{}{request, response, responseSize, timer})
+	fake.recordTimingMutex.Unlock()
+	if fake.RecordTimingStub != nil {
+		fake.RecordTimingStub(request, response, responseSize, timer)
+	}
+}
+
+func (fake *FakeRequestTimerOutput) RecordTimingCallCount() int {
+	fake.recordTimingMutex.RLock()
+	defer fake.recordTimingMutex.RUnlock()
+	return len(fake.recordTimingArgsForCall)
+}
+
+func (fake *FakeRequestTimerOutput) RecordTimingArgsForCall(i int) (*http.Request, *http.Response, int, *har.Timer) {
+	fake.recordTimingMutex.RLock()
+	defer fake.recordTimingMutex.RUnlock()
+	return fake.recordTimingArgsForCall[i].request, fake.recordTimingArgsForCall[i].response, fake.recordTimingArgsForCall[i].responseSize, fake.recordTimingArgsForCall[i].timer
+}
+
+func (fake *FakeRequestTimerOutput) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.recordTimingMutex.RLock()
+	defer fake.recordTimingMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeRequestTimerOutput) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ wrapper.RequestTimerOutput = new(FakeRequestTimerOutput)
@@ -0,0 +1,82 @@
+// This file was generated by counterfeiter
+package actorsfakes
+
+import (
+	"io"
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/cf/actors"
+)
+
+type FakeDropletUploader struct {
+	UploadApplicationDropletStub        func(appGUID string, droplet io.Reader) (v2action.Warnings, error)
+	uploadApplicationDropletMutex       sync.RWMutex
+	uploadApplicationDropletArgsForCall []struct {
+		appGUID string
+		droplet io.Reader
+	}
+	uploadApplicationDropletReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeDropletUploader) UploadApplicationDroplet(appGUID string, droplet io.Reader) (v2action.Warnings, error) {
+	fake.uploadApplicationDropletMutex.Lock()
+	fake.uploadApplicationDropletArgsForCall = append(fake.uploadApplicationDropletArgsForCall, struct {
+		appGUID string
+		droplet io.Reader
+	}{appGUID, droplet})
+	fake.recordInvocation("UploadApplicationDroplet", []interface{}{appGUID, droplet})
+	fake.uploadApplicationDropletMutex.Unlock()
+	if fake.UploadApplicationDropletStub != nil {
+		return fake.UploadApplicationDropletStub(appGUID, droplet)
+	} else {
+		return fake.uploadApplicationDropletReturns.result1, fake.uploadApplicationDropletReturns.result2
+	}
+}
+
+func (fake *FakeDropletUploader) UploadApplicationDropletCallCount() int {
+	fake.uploadApplicationDropletMutex.RLock()
+	defer fake.uploadApplicationDropletMutex.RUnlock()
+	return len(fake.uploadApplicationDropletArgsForCall)
+}
+
+func (fake *FakeDropletUploader) UploadApplicationDropletArgsForCall(i int) (string, io.Reader) {
+	fake.uploadApplicationDropletMutex.RLock()
+	defer fake.uploadApplicationDropletMutex.RUnlock()
+	return fake.uploadApplicationDropletArgsForCall[i].appGUID, fake.uploadApplicationDropletArgsForCall[i].droplet
+}
+
+func (fake *FakeDropletUploader) UploadApplicationDropletReturns(result1 v2action.Warnings, result2 error) {
+	fake.UploadApplicationDropletStub = nil
+	fake.uploadApplicationDropletReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDropletUploader) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.uploadApplicationDropletMutex.RLock()
+	defer fake.uploadApplicationDropletMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeDropletUploader) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ actors.DropletUploader = new(FakeDropletUploader)
@@ -21,6 +21,16 @@ type FakePushActor struct {
 	uploadAppReturns struct {
 		result1 error
 	}
+	UploadDropletStub        func(appGUID string, dropletFile *os.File) ([]string, error)
+	uploadDropletMutex       sync.RWMutex
+	uploadDropletArgsForCall []struct {
+		appGUID     string
+		dropletFile *os.File
+	}
+	uploadDropletReturns struct {
+		result1 []string
+		result2 error
+	}
 	ProcessPathStub        func(dirOrZipFile string, f func(string) error) error
 	processPathMutex       sync.RWMutex
 	processPathArgsForCall []struct {
@@ -105,6 +115,41 @@ func (fake *FakePushActor) UploadAppReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakePushActor) UploadDroplet(appGUID string, dropletFile *os.File) ([]string, error) {
+	fake.uploadDropletMutex.Lock()
+	fake.uploadDropletArgsForCall = append(fake.uploadDropletArgsForCall, struct {
+		appGUID     string
+		dropletFile *os.File
+	}{appGUID, dropletFile})
+	fake.recordInvocation("UploadDroplet", []interface{}{appGUID, dropletFile})
+	fake.uploadDropletMutex.Unlock()
+	if fake.UploadDropletStub != nil {
+		return fake.UploadDropletStub(appGUID, dropletFile)
+	} else {
+		return fake.uploadDropletReturns.result1, fake.uploadDropletReturns.result2
+	}
+}
+
+func (fake *FakePushActor) UploadDropletCallCount() int {
+	fake.uploadDropletMutex.RLock()
+	defer fake.uploadDropletMutex.RUnlock()
+	return len(fake.uploadDropletArgsForCall)
+}
+
+func (fake *FakePushActor) UploadDropletArgsForCall(i int) (string, *os.File) {
+	fake.uploadDropletMutex.RLock()
+	defer fake.uploadDropletMutex.RUnlock()
+	return fake.uploadDropletArgsForCall[i].appGUID, fake.uploadDropletArgsForCall[i].dropletFile
+}
+
+func (fake *FakePushActor) UploadDropletReturns(result1 []string, result2 error) {
+	fake.UploadDropletStub = nil
+	fake.uploadDropletReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakePushActor) ProcessPath(dirOrZipFile string, f func(string) error) error {
 	fake.processPathMutex.Lock()
 	fake.processPathArgsForCall = append(fake.processPathArgsForCall, struct {
@@ -260,6 +305,8 @@ func (fake *FakePushActor) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.uploadAppMutex.RLock()
 	defer fake.uploadAppMutex.RUnlock()
+	fake.uploadDropletMutex.RLock()
+	defer fake.uploadDropletMutex.RUnlock()
 	fake.processPathMutex.RLock()
 	defer fake.processPathMutex.RUnlock()
 	fake.gatherFilesMutex.RLock()
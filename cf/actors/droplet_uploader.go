@@ -0,0 +1,59 @@
+package actors
+
+import (
+	"io"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . DropletUploader
+
+// DropletUploader uploads an already staged droplet as the droplet of an app.
+type DropletUploader interface {
+	UploadApplicationDroplet(appGUID string, droplet io.Reader) (v2action.Warnings, error)
+}
+
+// CloudControllerDropletUploader uploads droplets with the V2 Cloud Controller
+// client. The client is created on every upload the same way as for the V2
+// commands, so that uploads are retried, traced and recorded like their
+// requests.
+type CloudControllerDropletUploader struct {
+	config coreconfig.ReadWriter
+}
+
+func NewCloudControllerDropletUploader(config coreconfig.ReadWriter) CloudControllerDropletUploader {
+	return CloudControllerDropletUploader{
+		config: config,
+	}
+}
+
+func (uploader CloudControllerDropletUploader) UploadApplicationDroplet(appGUID string, droplet io.Reader) (v2action.Warnings, error) {
+	config, err := configv3.LoadConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	commandUI, err := ui.NewUI(config)
+	if err != nil {
+		return nil, err
+	}
+
+	ccClient, uaaClient, err := shared.NewClients(command.ConfigWithRequestTimings(config, command.NewRequestTimings()), commandUI)
+	if err != nil {
+		return nil, err
+	}
+
+	// The clients refresh the tokens stored in config, which is not written
+	// back by legacy commands, so the legacy config has to keep them.
+	defer func() {
+		uploader.config.SetAccessToken(config.AccessToken())
+		uploader.config.SetRefreshToken(config.RefreshToken())
+	}()
+
+	return v2action.NewActor(ccClient, uaaClient).UploadApplicationDroplet(appGUID, droplet)
+}
@@ -21,6 +21,7 @@ const windowsPathPrefix = `\\?\`
 
 type PushActor interface {
 	UploadApp(appGUID string, zipFile *os.File, presentFiles []resources.AppFileResource) error
+	UploadDroplet(appGUID string, dropletFile *os.File) ([]string, error)
 	ProcessPath(dirOrZipFile string, f func(string) error) error
 	GatherFiles(localFiles []models.AppFileFields, appDir string, uploadDir string, useCache bool) ([]resources.AppFileResource, bool, error)
 	ValidateAppParams(apps []models.AppParams) []error
@@ -28,18 +29,20 @@ type PushActor interface {
 }
 
 type PushActorImpl struct {
-	appBitsRepo applicationbits.Repository
-	appfiles    appfiles.AppFiles
-	zipper      appfiles.Zipper
-	routeActor  RouteActor
+	appBitsRepo     applicationbits.Repository
+	dropletUploader DropletUploader
+	appfiles        appfiles.AppFiles
+	zipper          appfiles.Zipper
+	routeActor      RouteActor
 }
 
-func NewPushActor(appBitsRepo applicationbits.Repository, zipper appfiles.Zipper, appfiles appfiles.AppFiles, routeActor RouteActor) PushActor {
+func NewPushActor(appBitsRepo applicationbits.Repository, dropletUploader DropletUploader, zipper appfiles.Zipper, appfiles appfiles.AppFiles, routeActor RouteActor) PushActor {
 	return PushActorImpl{
-		appBitsRepo: appBitsRepo,
-		appfiles:    appfiles,
-		zipper:      zipper,
-		routeActor:  routeActor,
+		appBitsRepo:     appBitsRepo,
+		dropletUploader: dropletUploader,
+		appfiles:        appfiles,
+		zipper:          zipper,
+		routeActor:      routeActor,
 	}
 }
 
@@ -179,6 +182,11 @@ func (actor PushActorImpl) UploadApp(appGUID string, zipFile *os.File, presentFi
 	return actor.appBitsRepo.UploadBits(appGUID, zipFile, presentFiles)
 }
 
+func (actor PushActorImpl) UploadDroplet(appGUID string, dropletFile *os.File) ([]string, error) {
+	warnings, err := actor.dropletUploader.UploadApplicationDroplet(appGUID, dropletFile)
+	return []string(warnings), err
+}
+
 func (actor PushActorImpl) ValidateAppParams(apps []models.AppParams) []error {
 	errs := []error{}
 
@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"runtime"
 
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/cf/actors"
 	"code.cloudfoundry.org/cli/cf/actors/actorsfakes"
 	"code.cloudfoundry.org/cli/cf/api/applicationbits/applicationbitsfakes"
@@ -21,23 +22,25 @@ import (
 
 var _ = Describe("Push Actor", func() {
 	var (
-		appBitsRepo  *applicationbitsfakes.FakeApplicationBitsRepository
-		appFiles     *appfilesfakes.FakeAppFiles
-		fakezipper   *appfilesfakes.FakeZipper
-		routeActor   *actorsfakes.FakeRouteActor
-		actor        actors.PushActor
-		fixturesDir  string
-		appDir       string
-		allFiles     []models.AppFileFields
-		presentFiles []resources.AppFileResource
+		appBitsRepo     *applicationbitsfakes.FakeApplicationBitsRepository
+		dropletUploader *actorsfakes.FakeDropletUploader
+		appFiles        *appfilesfakes.FakeAppFiles
+		fakezipper      *appfilesfakes.FakeZipper
+		routeActor      *actorsfakes.FakeRouteActor
+		actor           actors.PushActor
+		fixturesDir     string
+		appDir          string
+		allFiles        []models.AppFileFields
+		presentFiles    []resources.AppFileResource
 	)
 
 	BeforeEach(func() {
 		appBitsRepo = new(applicationbitsfakes.FakeApplicationBitsRepository)
+		dropletUploader = new(actorsfakes.FakeDropletUploader)
 		appFiles = new(appfilesfakes.FakeAppFiles)
 		fakezipper = new(appfilesfakes.FakeZipper)
 		routeActor = new(actorsfakes.FakeRouteActor)
-		actor = actors.NewPushActor(appBitsRepo, fakezipper, appFiles, routeActor)
+		actor = actors.NewPushActor(appBitsRepo, dropletUploader, fakezipper, appFiles, routeActor)
 		fixturesDir = filepath.Join("..", "..", "fixtures", "applications")
 		allFiles = []models.AppFileFields{
 			{Path: "example-app/.cfignore"},
@@ -275,6 +278,34 @@ var _ = Describe("Push Actor", func() {
 		It("Simply delegates to the UploadApp function on the app bits repo, which is not worth testing", func() {})
 	})
 
+	Describe("UploadDroplet", func() {
+		var dropletFile *os.File
+
+		BeforeEach(func() {
+			var err error
+			dropletFile, err = ioutil.TempFile("", "droplet")
+			Expect(err).NotTo(HaveOccurred())
+
+			dropletUploader.UploadApplicationDropletReturns(v2action.Warnings{"upload-warning"}, errors.New("upload failed"))
+		})
+
+		AfterEach(func() {
+			dropletFile.Close()
+			os.Remove(dropletFile.Name())
+		})
+
+		It("uploads the droplet through the droplet uploader and returns its warnings", func() {
+			warnings, err := actor.UploadDroplet("some-app-guid", dropletFile)
+			Expect(err).To(MatchError("upload failed"))
+			Expect(warnings).To(ConsistOf("upload-warning"))
+
+			Expect(dropletUploader.UploadApplicationDropletCallCount()).To(Equal(1))
+			appGUID, droplet := dropletUploader.UploadApplicationDropletArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(droplet).To(Equal(dropletFile))
+		})
+	})
+
 	Describe("ProcessPath", func() {
 		var (
 			wasCalled     bool
@@ -283,7 +314,7 @@ var _ = Describe("Push Actor", func() {
 
 		BeforeEach(func() {
 			zipper := &appfiles.ApplicationZipper{}
-			actor = actors.NewPushActor(appBitsRepo, zipper, appFiles, routeActor)
+			actor = actors.NewPushActor(appBitsRepo, dropletUploader, zipper, appFiles, routeActor)
 		})
 
 		Context("when given a zip file", func() {
@@ -334,7 +365,7 @@ var _ = Describe("Push Actor", func() {
 				e := errors.New("some-error")
 				fakezipper.UnzipReturns(e)
 				fakezipper.IsZipFileReturns(true)
-				actor = actors.NewPushActor(appBitsRepo, fakezipper, appFiles, routeActor)
+				actor = actors.NewPushActor(appBitsRepo, dropletUploader, fakezipper, appFiles, routeActor)
 
 				f := func(_ string) error {
 					return nil
@@ -1,6 +1,9 @@
 package appevents
 
 import (
+	"strings"
+	"time"
+
 	"code.cloudfoundry.org/cli/cf/api/resources"
 	"code.cloudfoundry.org/cli/cf/api/strategy"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
@@ -8,10 +11,56 @@ import (
 	"code.cloudfoundry.org/cli/cf/net"
 )
 
+const maxEventsPerPage = 100
+
 //go:generate counterfeiter . Repository
 
 type Repository interface {
 	RecentEvents(appGUID string, limit int64) ([]models.EventFields, error)
+	ListEvents(appGUID string, query EventsQuery) ([]models.EventFields, error)
+}
+
+// EventsQuery narrows down the events listed for an app. Zero values leave a
+// criterion out; a zero Limit lists every matching event.
+type EventsQuery struct {
+	Since time.Time
+	Until time.Time
+	Types []string
+	Limit int64
+}
+
+// Matches returns true if the event falls within the time range and has one
+// of the types of the query.
+func (q EventsQuery) Matches(event models.EventFields) bool {
+	if !q.Since.IsZero() && event.Timestamp.Before(q.Since) {
+		return false
+	}
+	if !q.Until.IsZero() && event.Timestamp.After(q.Until) {
+		return false
+	}
+	if len(q.Types) == 0 {
+		return true
+	}
+	for _, eventType := range q.Types {
+		if event.Name == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+func (q EventsQuery) filters() []string {
+	var filters []string
+	if len(q.Types) > 0 {
+		filters = append(filters, "type IN "+strings.Join(q.Types, ","))
+	}
+	if !q.Since.IsZero() {
+		filters = append(filters, "timestamp>="+q.Since.UTC().Format(time.RFC3339))
+	}
+	if !q.Until.IsZero() {
+		filters = append(filters, "timestamp<="+q.Until.UTC().Format(time.RFC3339))
+	}
+	return filters
 }
 
 type CloudControllerAppEventsRepository struct {
@@ -31,7 +80,7 @@ func NewCloudControllerAppEventsRepository(config coreconfig.Reader, gateway net
 func (repo CloudControllerAppEventsRepository) RecentEvents(appGUID string, limit int64) ([]models.EventFields, error) {
 	count := int64(0)
 	events := make([]models.EventFields, 0, limit)
-	apiErr := repo.listEvents(appGUID, limit, func(eventField models.EventFields) bool {
+	apiErr := repo.listEvents(repo.strategy.EventsURL(appGUID, limit), func(eventField models.EventFields) bool {
 		count++
 		events = append(events, eventField)
 		return count < limit
@@ -40,10 +89,30 @@ func (repo CloudControllerAppEventsRepository) RecentEvents(appGUID string, limi
 	return events, apiErr
 }
 
-func (repo CloudControllerAppEventsRepository) listEvents(appGUID string, limit int64, cb func(models.EventFields) bool) error {
+// ListEvents pages through the events of the app until Limit events matching
+// the query have been found. The filters are sent to the Cloud Controller
+// when it supports them and are applied again to every event received.
+func (repo CloudControllerAppEventsRepository) ListEvents(appGUID string, query EventsQuery) ([]models.EventFields, error) {
+	pageSize := query.Limit
+	if pageSize <= 0 || pageSize > maxEventsPerPage {
+		pageSize = maxEventsPerPage
+	}
+
+	events := []models.EventFields{}
+	apiErr := repo.listEvents(repo.strategy.FilteredEventsURL(appGUID, pageSize, query.filters()), func(eventField models.EventFields) bool {
+		if query.Matches(eventField) {
+			events = append(events, eventField)
+		}
+		return query.Limit <= 0 || int64(len(events)) < query.Limit
+	})
+
+	return events, apiErr
+}
+
+func (repo CloudControllerAppEventsRepository) listEvents(path string, cb func(models.EventFields) bool) error {
 	return repo.gateway.ListPaginatedResources(
 		repo.config.APIEndpoint(),
-		repo.strategy.EventsURL(appGUID, limit),
+		path,
 		repo.strategy.EventsResource(),
 
 		func(resource interface{}) bool {
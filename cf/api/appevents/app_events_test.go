@@ -14,6 +14,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/terminal/terminalfakes"
 	"code.cloudfoundry.org/cli/cf/trace/tracefakes"
 	testconfig "code.cloudfoundry.org/cli/util/testhelpers/configuration"
+	. "code.cloudfoundry.org/cli/util/testhelpers/matchers"
 	testnet "code.cloudfoundry.org/cli/util/testhelpers/net"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -77,6 +78,51 @@ var _ = Describe("App Events Repo", func() {
 			}))
 		})
 	})
+
+	Describe("list events matching a query", func() {
+		It("pages through the events until the limit is reached", func() {
+			setupTestServer(filteredEventsPage1Request, filteredEventsPage2Request)
+
+			since := time.Date(2014, 1, 1, 0, 0, 0, 0, time.UTC)
+			list, err := repo.ListEvents("my-app-guid", EventsQuery{
+				Since: since,
+				Types: []string{"audit.app.update", "app.crash"},
+				Limit: 2,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(handler).To(HaveAllRequestsCalled())
+
+			Expect(list).To(HaveLen(2))
+			Expect(list[0].GUID).To(Equal("event-1-guid"))
+			Expect(list[1].GUID).To(Equal("event-3-guid"))
+		})
+	})
+
+	Describe("EventsQuery", func() {
+		var event models.EventFields
+
+		BeforeEach(func() {
+			event = models.EventFields{
+				Name:      "app.crash",
+				Timestamp: time.Date(2017, 5, 4, 12, 0, 0, 0, time.UTC),
+			}
+		})
+
+		It("matches every event when empty", func() {
+			Expect(EventsQuery{}.Matches(event)).To(BeTrue())
+		})
+
+		It("matches events within the time range", func() {
+			Expect(EventsQuery{Since: event.Timestamp, Until: event.Timestamp}.Matches(event)).To(BeTrue())
+			Expect(EventsQuery{Since: event.Timestamp.Add(time.Second)}.Matches(event)).To(BeFalse())
+			Expect(EventsQuery{Until: event.Timestamp.Add(-time.Second)}.Matches(event)).To(BeFalse())
+		})
+
+		It("matches events of the given types", func() {
+			Expect(EventsQuery{Types: []string{"audit.app.update", "app.crash"}}.Matches(event)).To(BeTrue())
+			Expect(EventsQuery{Types: []string{"audit.app.update"}}.Matches(event)).To(BeFalse())
+		})
+	})
 })
 
 const eventTimestampFormat = "2006-01-02T15:04:05-07:00"
@@ -134,3 +180,41 @@ var eventsRequest = testnet.TestRequest{
 			}
 		  ]
 		}`}}
+
+var filteredEventsPage1Request = testnet.TestRequest{
+	Method: "GET",
+	Path:   "/v2/events?q=actee%3Amy-app-guid&order-direction=desc&results-per-page=2",
+	Response: testnet.TestResponse{
+		Status: http.StatusOK,
+		Body: `{
+		  "next_url": "/v2/events?q=actee%3Amy-app-guid&page=2",
+		  "resources": [
+			{
+			  "metadata": { "guid": "event-1-guid" },
+			  "entity": { "type": "audit.app.update", "timestamp": "2014-01-21T00:20:11+00:00" }
+			},
+			{
+			  "metadata": { "guid": "event-2-guid" },
+			  "entity": { "type": "audit.app.start", "timestamp": "2014-01-20T00:20:11+00:00" }
+			}
+		  ]
+		}`}}
+
+var filteredEventsPage2Request = testnet.TestRequest{
+	Method: "GET",
+	Path:   "/v2/events?q=actee%3Amy-app-guid&page=2",
+	Response: testnet.TestResponse{
+		Status: http.StatusOK,
+		Body: `{
+		  "next_url": "/v2/events?q=actee%3Amy-app-guid&page=3",
+		  "resources": [
+			{
+			  "metadata": { "guid": "event-3-guid" },
+			  "entity": { "type": "app.crash", "timestamp": "2014-01-19T00:20:11+00:00" }
+			},
+			{
+			  "metadata": { "guid": "event-4-guid" },
+			  "entity": { "type": "app.crash", "timestamp": "2014-01-18T00:20:11+00:00" }
+			}
+		  ]
+		}`}}
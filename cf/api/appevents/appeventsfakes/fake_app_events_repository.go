@@ -19,6 +19,16 @@ type FakeAppEventsRepository struct {
 		result1 []models.EventFields
 		result2 error
 	}
+	ListEventsStub        func(appGUID string, query appevents.EventsQuery) ([]models.EventFields, error)
+	listEventsMutex       sync.RWMutex
+	listEventsArgsForCall []struct {
+		appGUID string
+		query   appevents.EventsQuery
+	}
+	listEventsReturns struct {
+		result1 []models.EventFields
+		result2 error
+	}
 }
 
 func (fake *FakeAppEventsRepository) RecentEvents(appGUID string, limit int64) ([]models.EventFields, error) {
@@ -55,4 +65,38 @@ func (fake *FakeAppEventsRepository) RecentEventsReturns(result1 []models.EventF
 	}{result1, result2}
 }
 
+func (fake *FakeAppEventsRepository) ListEvents(appGUID string, query appevents.EventsQuery) ([]models.EventFields, error) {
+	fake.listEventsMutex.Lock()
+	fake.listEventsArgsForCall = append(fake.listEventsArgsForCall, struct {
+		appGUID string
+		query   appevents.EventsQuery
+	}{appGUID, query})
+	fake.listEventsMutex.Unlock()
+	if fake.ListEventsStub != nil {
+		return fake.ListEventsStub(appGUID, query)
+	} else {
+		return fake.listEventsReturns.result1, fake.listEventsReturns.result2
+	}
+}
+
+func (fake *FakeAppEventsRepository) ListEventsCallCount() int {
+	fake.listEventsMutex.RLock()
+	defer fake.listEventsMutex.RUnlock()
+	return len(fake.listEventsArgsForCall)
+}
+
+func (fake *FakeAppEventsRepository) ListEventsArgsForCall(i int) (string, appevents.EventsQuery) {
+	fake.listEventsMutex.RLock()
+	defer fake.listEventsMutex.RUnlock()
+	return fake.listEventsArgsForCall[i].appGUID, fake.listEventsArgsForCall[i].query
+}
+
+func (fake *FakeAppEventsRepository) ListEventsReturns(result1 []models.EventFields, result2 error) {
+	fake.ListEventsStub = nil
+	fake.listEventsReturns = struct {
+		result1 []models.EventFields
+		result2 error
+	}{result1, result2}
+}
+
 var _ appevents.Repository = new(FakeAppEventsRepository)
@@ -19,6 +19,16 @@ type FakeRepository struct {
 		result1 []models.EventFields
 		result2 error
 	}
+	ListEventsStub        func(appGUID string, query appevents.EventsQuery) ([]models.EventFields, error)
+	listEventsMutex       sync.RWMutex
+	listEventsArgsForCall []struct {
+		appGUID string
+		query   appevents.EventsQuery
+	}
+	listEventsReturns struct {
+		result1 []models.EventFields
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -58,11 +68,48 @@ func (fake *FakeRepository) RecentEventsReturns(result1 []models.EventFields, re
 	}{result1, result2}
 }
 
+func (fake *FakeRepository) ListEvents(appGUID string, query appevents.EventsQuery) ([]models.EventFields, error) {
+	fake.listEventsMutex.Lock()
+	fake.listEventsArgsForCall = append(fake.listEventsArgsForCall, struct {
+		appGUID string
+		query   appevents.EventsQuery
+	}{appGUID, query})
+	fake.recordInvocation("ListEvents", []interface{}{appGUID, query})
+	fake.listEventsMutex.Unlock()
+	if fake.ListEventsStub != nil {
+		return fake.ListEventsStub(appGUID, query)
+	} else {
+		return fake.listEventsReturns.result1, fake.listEventsReturns.result2
+	}
+}
+
+func (fake *FakeRepository) ListEventsCallCount() int {
+	fake.listEventsMutex.RLock()
+	defer fake.listEventsMutex.RUnlock()
+	return len(fake.listEventsArgsForCall)
+}
+
+func (fake *FakeRepository) ListEventsArgsForCall(i int) (string, appevents.EventsQuery) {
+	fake.listEventsMutex.RLock()
+	defer fake.listEventsMutex.RUnlock()
+	return fake.listEventsArgsForCall[i].appGUID, fake.listEventsArgsForCall[i].query
+}
+
+func (fake *FakeRepository) ListEventsReturns(result1 []models.EventFields, result2 error) {
+	fake.ListEventsStub = nil
+	fake.listEventsReturns = struct {
+		result1 []models.EventFields
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeRepository) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.recentEventsMutex.RLock()
 	defer fake.recentEventsMutex.RUnlock()
+	fake.listEventsMutex.RLock()
+	defer fake.listEventsMutex.RUnlock()
 	return fake.invocations
 }
 
@@ -0,0 +1,22 @@
+package appevents
+
+import (
+	"errors"
+	"time"
+
+	. "code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/util/pasttime"
+)
+
+// ParseEventTime accepts either a duration before now, where a "d" suffix
+// stands for days, or an absolute time. Absolute times without a time zone
+// are local.
+func ParseEventTime(value string, now time.Time) (time.Time, error) {
+	t, err := pasttime.Parse(value, now)
+	if err != nil {
+		return time.Time{}, errors.New(T("Invalid time: {{.Time}}\nUse a duration such as 2h or 7d, or a date such as 2017-05-04 or 2017-05-04T15:04:05Z", map[string]interface{}{
+			"Time": value,
+		}))
+	}
+	return t, nil
+}
@@ -0,0 +1,112 @@
+package auditevents
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/cf/api/resources"
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/cf/net"
+)
+
+const maxEventsPerPage = 100
+
+//go:generate counterfeiter . Repository
+
+type Repository interface {
+	ListEvents(query Query) ([]models.AuditEvent, error)
+	GetEvent(guid string) (models.AuditEvent, error)
+}
+
+// Query selects the audit events to list. SpaceGUID and OrganizationGUID
+// scope the query; the Cloud Controller cannot filter by actor, so Actor is
+// compared against the GUID and name of the actor of every event received. A
+// zero Limit lists every matching event.
+type Query struct {
+	SpaceGUID        string
+	OrganizationGUID string
+	Types            []string
+	Actor            string
+	Since            time.Time
+	Until            time.Time
+	Limit            int64
+}
+
+// Matches returns true if the event was caused by the actor of the query.
+func (q Query) Matches(event models.AuditEvent) bool {
+	return q.Actor == "" || event.Actor == q.Actor || strings.EqualFold(event.ActorName, q.Actor)
+}
+
+func (q Query) path(pageSize int64) string {
+	var filters []string
+	if q.SpaceGUID != "" {
+		filters = append(filters, "space_guid:"+q.SpaceGUID)
+	}
+	if q.OrganizationGUID != "" {
+		filters = append(filters, "organization_guid:"+q.OrganizationGUID)
+	}
+	if len(q.Types) > 0 {
+		filters = append(filters, "type IN "+strings.Join(q.Types, ","))
+	}
+	if !q.Since.IsZero() {
+		filters = append(filters, "timestamp>="+q.Since.UTC().Format(time.RFC3339))
+	}
+	if !q.Until.IsZero() {
+		filters = append(filters, "timestamp<="+q.Until.UTC().Format(time.RFC3339))
+	}
+
+	values := url.Values{}
+	values.Set("order-direction", "desc")
+	values.Set("results-per-page", strconv.FormatInt(pageSize, 10))
+	for _, filter := range filters {
+		values.Add("q", filter)
+	}
+	return "/v2/events?" + values.Encode()
+}
+
+type CloudControllerRepository struct {
+	config  coreconfig.Reader
+	gateway net.Gateway
+}
+
+func NewCloudControllerRepository(config coreconfig.Reader, gateway net.Gateway) CloudControllerRepository {
+	return CloudControllerRepository{
+		config:  config,
+		gateway: gateway,
+	}
+}
+
+func (repo CloudControllerRepository) ListEvents(query Query) ([]models.AuditEvent, error) {
+	pageSize := query.Limit
+	if pageSize <= 0 || pageSize > maxEventsPerPage || query.Actor != "" {
+		pageSize = maxEventsPerPage
+	}
+
+	events := []models.AuditEvent{}
+	err := repo.gateway.ListPaginatedResources(
+		repo.config.APIEndpoint(),
+		query.path(pageSize),
+		resources.EventResourceNewV2{},
+		func(resource interface{}) bool {
+			event := resource.(resources.EventResourceNewV2).ToAuditEvent()
+			if query.Matches(event) {
+				events = append(events, event)
+			}
+			return query.Limit <= 0 || int64(len(events)) < query.Limit
+		})
+
+	return events, err
+}
+
+func (repo CloudControllerRepository) GetEvent(guid string) (models.AuditEvent, error) {
+	resource := new(resources.EventResourceNewV2)
+	err := repo.gateway.GetResource(fmt.Sprintf("%s/v2/events/%s", repo.config.APIEndpoint(), guid), resource)
+	if err != nil {
+		return models.AuditEvent{}, err
+	}
+	return resource.ToAuditEvent(), nil
+}
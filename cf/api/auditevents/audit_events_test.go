@@ -0,0 +1,181 @@
+package auditevents_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "code.cloudfoundry.org/cli/cf/api/auditevents"
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/net"
+	"code.cloudfoundry.org/cli/cf/terminal/terminalfakes"
+	"code.cloudfoundry.org/cli/cf/trace/tracefakes"
+	testconfig "code.cloudfoundry.org/cli/util/testhelpers/configuration"
+	. "code.cloudfoundry.org/cli/util/testhelpers/matchers"
+	testnet "code.cloudfoundry.org/cli/util/testhelpers/net"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Audit Events Repo", func() {
+	var (
+		server  *httptest.Server
+		handler *testnet.TestHandler
+		config  coreconfig.ReadWriter
+		repo    Repository
+	)
+
+	BeforeEach(func() {
+		config = testconfig.NewRepository()
+		config.SetAccessToken("BEARER my_access_token")
+	})
+
+	JustBeforeEach(func() {
+		gateway := net.NewCloudControllerGateway(config, time.Now, new(terminalfakes.FakeUI), new(tracefakes.FakePrinter), "")
+		repo = NewCloudControllerRepository(config, gateway)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	setupTestServer := func(requests ...testnet.TestRequest) {
+		server, handler = testnet.NewServer(requests)
+		config.SetAPIEndpoint(server.URL)
+	}
+
+	Describe("ListEvents", func() {
+		It("lists the events of a space", func() {
+			setupTestServer(testnet.TestRequest{
+				Method: "GET",
+				Path:   "/v2/events?q=space_guid%3Amy-space-guid&order-direction=desc&results-per-page=2",
+				Response: testnet.TestResponse{
+					Status: http.StatusOK,
+					Body:   auditEventsPage1,
+				},
+			})
+
+			events, err := repo.ListEvents(Query{SpaceGUID: "my-space-guid", Limit: 2})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(handler).To(HaveAllRequestsCalled())
+
+			Expect(events).To(HaveLen(2))
+			Expect(events[0].GUID).To(Equal("event-1-guid"))
+			Expect(events[0].Name).To(Equal("audit.service_instance.delete"))
+			Expect(events[0].ActorName).To(Equal("admin"))
+			Expect(events[0].ActeeName).To(Equal("my-db"))
+			Expect(events[0].SpaceGUID).To(Equal("my-space-guid"))
+			Expect(events[1].GUID).To(Equal("event-2-guid"))
+		})
+
+		It("pages through the events to find the ones caused by the actor", func() {
+			setupTestServer(
+				testnet.TestRequest{
+					Method: "GET",
+					Path:   "/v2/events?q=organization_guid%3Amy-org-guid&order-direction=desc&results-per-page=100",
+					Response: testnet.TestResponse{
+						Status: http.StatusOK,
+						Body:   auditEventsPage1,
+					},
+				},
+				testnet.TestRequest{
+					Method: "GET",
+					Path:   "/v2/events?page=2",
+					Response: testnet.TestResponse{
+						Status: http.StatusOK,
+						Body:   auditEventsPage2,
+					},
+				},
+			)
+
+			events, err := repo.ListEvents(Query{OrganizationGUID: "my-org-guid", Actor: "ADMIN", Limit: 2})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(handler).To(HaveAllRequestsCalled())
+
+			Expect(events).To(HaveLen(2))
+			Expect(events[0].GUID).To(Equal("event-1-guid"))
+			Expect(events[1].GUID).To(Equal("event-3-guid"))
+		})
+	})
+
+	Describe("GetEvent", func() {
+		It("returns the event with its metadata", func() {
+			setupTestServer(testnet.TestRequest{
+				Method: "GET",
+				Path:   "/v2/events/event-2-guid",
+				Response: testnet.TestResponse{
+					Status: http.StatusOK,
+					Body: `{
+					  "metadata": { "guid": "event-2-guid" },
+					  "entity": {
+						"type": "audit.user.space_developer_add",
+						"actor": "manager-guid",
+						"actor_name": "manager",
+						"timestamp": "2017-05-04T10:00:00Z",
+						"metadata": { "request": { "username": "dev" } }
+					  }
+					}`,
+				},
+			})
+
+			event, err := repo.GetEvent("event-2-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(event.GUID).To(Equal("event-2-guid"))
+			Expect(event.Metadata).To(Equal(map[string]interface{}{
+				"request": map[string]interface{}{"username": "dev"},
+			}))
+		})
+	})
+})
+
+const auditEventsPage1 = `{
+  "next_url": "/v2/events?page=2",
+  "resources": [
+	{
+	  "metadata": { "guid": "event-1-guid" },
+	  "entity": {
+		"type": "audit.service_instance.delete",
+		"actor": "admin-guid",
+		"actor_type": "user",
+		"actor_name": "admin",
+		"actee": "service-instance-guid",
+		"actee_type": "service_instance",
+		"actee_name": "my-db",
+		"space_guid": "my-space-guid",
+		"organization_guid": "my-org-guid",
+		"timestamp": "2017-05-04T12:00:00Z",
+		"metadata": { "request": { "recursive": true } }
+	  }
+	},
+	{
+	  "metadata": { "guid": "event-2-guid" },
+	  "entity": {
+		"type": "audit.user.space_developer_add",
+		"actor": "manager-guid",
+		"actor_type": "user",
+		"actor_name": "manager",
+		"space_guid": "my-space-guid",
+		"organization_guid": "my-org-guid",
+		"timestamp": "2017-05-04T10:00:00Z",
+		"metadata": { "request": { "username": "dev" } }
+	  }
+	}
+  ]
+}`
+
+const auditEventsPage2 = `{
+  "next_url": "/v2/events?page=3",
+  "resources": [
+	{
+	  "metadata": { "guid": "event-3-guid" },
+	  "entity": {
+		"type": "audit.app.delete-request",
+		"actor": "admin-guid",
+		"actor_type": "user",
+		"actor_name": "admin",
+		"timestamp": "2017-05-03T10:00:00Z",
+		"metadata": {}
+	  }
+	}
+  ]
+}`
@@ -0,0 +1,124 @@
+// This file was generated by counterfeiter
+package auditeventsfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/cf/api/auditevents"
+	"code.cloudfoundry.org/cli/cf/models"
+)
+
+type FakeRepository struct {
+	ListEventsStub        func(query auditevents.Query) ([]models.AuditEvent, error)
+	listEventsMutex       sync.RWMutex
+	listEventsArgsForCall []struct {
+		query auditevents.Query
+	}
+	listEventsReturns struct {
+		result1 []models.AuditEvent
+		result2 error
+	}
+	GetEventStub        func(guid string) (models.AuditEvent, error)
+	getEventMutex       sync.RWMutex
+	getEventArgsForCall []struct {
+		guid string
+	}
+	getEventReturns struct {
+		result1 models.AuditEvent
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeRepository) ListEvents(query auditevents.Query) ([]models.AuditEvent, error) {
+	fake.listEventsMutex.Lock()
+	fake.listEventsArgsForCall = append(fake.listEventsArgsForCall, struct {
+		query auditevents.Query
+	}{query})
+	fake.recordInvocation("ListEvents", []interface{}{query})
+	fake.listEventsMutex.Unlock()
+	if fake.ListEventsStub != nil {
+		return fake.ListEventsStub(query)
+	} else {
+		return fake.listEventsReturns.result1, fake.listEventsReturns.result2
+	}
+}
+
+func (fake *FakeRepository) ListEventsCallCount() int {
+	fake.listEventsMutex.RLock()
+	defer fake.listEventsMutex.RUnlock()
+	return len(fake.listEventsArgsForCall)
+}
+
+func (fake *FakeRepository) ListEventsArgsForCall(i int) auditevents.Query {
+	fake.listEventsMutex.RLock()
+	defer fake.listEventsMutex.RUnlock()
+	return fake.listEventsArgsForCall[i].query
+}
+
+func (fake *FakeRepository) ListEventsReturns(result1 []models.AuditEvent, result2 error) {
+	fake.ListEventsStub = nil
+	fake.listEventsReturns = struct {
+		result1 []models.AuditEvent
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetEvent(guid string) (models.AuditEvent, error) {
+	fake.getEventMutex.Lock()
+	fake.getEventArgsForCall = append(fake.getEventArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("GetEvent", []interface{}{guid})
+	fake.getEventMutex.Unlock()
+	if fake.GetEventStub != nil {
+		return fake.GetEventStub(guid)
+	} else {
+		return fake.getEventReturns.result1, fake.getEventReturns.result2
+	}
+}
+
+func (fake *FakeRepository) GetEventCallCount() int {
+	fake.getEventMutex.RLock()
+	defer fake.getEventMutex.RUnlock()
+	return len(fake.getEventArgsForCall)
+}
+
+func (fake *FakeRepository) GetEventArgsForCall(i int) string {
+	fake.getEventMutex.RLock()
+	defer fake.getEventMutex.RUnlock()
+	return fake.getEventArgsForCall[i].guid
+}
+
+func (fake *FakeRepository) GetEventReturns(result1 models.AuditEvent, result2 error) {
+	fake.GetEventStub = nil
+	fake.getEventReturns = struct {
+		result1 models.AuditEvent
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.listEventsMutex.RLock()
+	defer fake.listEventsMutex.RUnlock()
+	fake.getEventMutex.RLock()
+	defer fake.getEventMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeRepository) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ auditevents.Repository = new(FakeRepository)
@@ -4,6 +4,7 @@ import (
 	"crypto/tls"
 	"encoding/base64"
 	"fmt"
+	gonet "net"
 	"net/http"
 	"net/url"
 	"strings"
@@ -13,6 +14,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/errors"
 	. "code.cloudfoundry.org/cli/cf/i18n"
 	"code.cloudfoundry.org/cli/cf/net"
+	"code.cloudfoundry.org/cli/util/tlsconfig"
 )
 
 //go:generate counterfeiter . TokenRefresher
@@ -49,6 +51,15 @@ func NewUAARepository(gateway net.Gateway, config coreconfig.ReadWriter, dumper
 }
 
 func (uaa UAARepository) Authorize(token string) (string, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: uaa.config.IsSSLDisabled(),
+	}
+	err := tlsconfig.Apply(tlsConfig, uaa.config.CACertFile(), uaa.config.ClientCertFile(), uaa.config.ClientKeyFile())
+	if err != nil {
+		return "", err
+	}
+
+	resolver := uaa.gateway.Proxy()
 	httpClient := &http.Client{
 		CheckRedirect: func(req *http.Request, _ []*http.Request) error {
 			uaa.DumpRequest(req)
@@ -56,11 +67,10 @@ func (uaa UAARepository) Authorize(token string) (string, error) {
 		},
 		Timeout: 30 * time.Second,
 		Transport: &http.Transport{
-			DisableKeepAlives: true,
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: uaa.config.IsSSLDisabled(),
-			},
-			Proxy:               http.ProxyFromEnvironment,
+			DisableKeepAlives:   true,
+			TLSClientConfig:     tlsConfig,
+			Proxy:               resolver.Proxy,
+			DialContext:         resolver.DialContext(&gonet.Dialer{}),
 			TLSHandshakeTimeout: 10 * time.Second,
 		},
 	}
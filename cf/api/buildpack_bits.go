@@ -215,11 +215,12 @@ func (repo CloudControllerBuildpackBitsRepository) downloadBuildpack(url string,
 			}
 		}
 
+		resolver := repo.gateway.Proxy()
 		client := &http.Client{
 			Transport: &http.Transport{
-				Dial:            (&gonet.Dialer{Timeout: 5 * time.Second}).Dial,
+				DialContext:     resolver.DialContext(&gonet.Dialer{Timeout: 5 * time.Second}),
 				TLSClientConfig: &tls.Config{RootCAs: certPool},
-				Proxy:           http.ProxyFromEnvironment,
+				Proxy:           resolver.Proxy,
 			},
 		}
 
@@ -0,0 +1,66 @@
+package logs
+
+import (
+	"regexp"
+	"strings"
+)
+
+const (
+	MessageTypeOut = "OUT"
+	MessageTypeErr = "ERR"
+)
+
+// Filter narrows down a stream of log messages. Each non-empty criterion must
+// match for a message to be displayed; within a criterion any value may match.
+type Filter struct {
+	SourceTypes  []string
+	Instances    []string
+	MessageTypes []string
+	Pattern      *regexp.Regexp
+}
+
+// IsEmpty returns true when the filter has no criteria and lets every message
+// through.
+func (f Filter) IsEmpty() bool {
+	return len(f.SourceTypes) == 0 && len(f.Instances) == 0 && len(f.MessageTypes) == 0 && f.Pattern == nil
+}
+
+// Matches returns true if the message satisfies every criterion of the
+// filter. Source types are compared case insensitively against the first
+// segment of the message's source, so APP matches APP/PROC/WEB. Notices
+// about the log stream itself always match.
+func (f Filter) Matches(msg Loggable) bool {
+	if msg.GetSourceName() == StreamNoticeSource {
+		return true
+	}
+
+	if len(f.SourceTypes) > 0 {
+		source := strings.SplitN(msg.GetSourceName(), "/", 2)[0]
+		if !containsFold(f.SourceTypes, source) && !containsFold(f.SourceTypes, msg.GetSourceName()) {
+			return false
+		}
+	}
+
+	if len(f.Instances) > 0 && !containsFold(f.Instances, msg.GetSourceInstance()) {
+		return false
+	}
+
+	if len(f.MessageTypes) > 0 && !containsFold(f.MessageTypes, msg.GetMessageType()) {
+		return false
+	}
+
+	if f.Pattern != nil && !f.Pattern.MatchString(msg.ToSimpleLog()) {
+		return false
+	}
+
+	return true
+}
+
+func containsFold(list []string, value string) bool {
+	for _, item := range list {
+		if strings.EqualFold(item, value) {
+			return true
+		}
+	}
+	return false
+}
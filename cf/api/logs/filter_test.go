@@ -0,0 +1,98 @@
+package logs_test
+
+import (
+	"regexp"
+
+	"code.cloudfoundry.org/cli/cf/api/logs"
+	"github.com/cloudfoundry/sonde-go/events"
+	"github.com/gogo/protobuf/proto"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Filter", func() {
+	var (
+		filter  logs.Filter
+		message logs.Loggable
+	)
+
+	BeforeEach(func() {
+		filter = logs.Filter{}
+
+		messageType := events.LogMessage_ERR
+		message = logs.NewNoaaLogMessage(&events.LogMessage{
+			Message:        []byte("connection refused\n"),
+			AppId:          proto.String("app-guid"),
+			MessageType:    &messageType,
+			SourceType:     proto.String("APP/PROC/WEB"),
+			SourceInstance: proto.String("1"),
+			Timestamp:      proto.Int64(1000),
+		})
+	})
+
+	It("matches every message when empty", func() {
+		Expect(filter.IsEmpty()).To(BeTrue())
+		Expect(filter.Matches(message)).To(BeTrue())
+	})
+
+	Describe("source types", func() {
+		It("matches the first segment of the source case insensitively", func() {
+			filter.SourceTypes = []string{"RTR", "app"}
+			Expect(filter.Matches(message)).To(BeTrue())
+		})
+
+		It("matches the full source", func() {
+			filter.SourceTypes = []string{"APP/PROC/WEB"}
+			Expect(filter.Matches(message)).To(BeTrue())
+		})
+
+		It("rejects other sources", func() {
+			filter.SourceTypes = []string{"RTR", "STG"}
+			Expect(filter.Matches(message)).To(BeFalse())
+		})
+	})
+
+	Describe("instances", func() {
+		It("matches listed instances only", func() {
+			filter.Instances = []string{"0", "1"}
+			Expect(filter.Matches(message)).To(BeTrue())
+
+			filter.Instances = []string{"0"}
+			Expect(filter.Matches(message)).To(BeFalse())
+		})
+	})
+
+	Describe("message types", func() {
+		It("matches listed message types only", func() {
+			filter.MessageTypes = []string{logs.MessageTypeErr}
+			Expect(filter.Matches(message)).To(BeTrue())
+
+			filter.MessageTypes = []string{logs.MessageTypeOut}
+			Expect(filter.Matches(message)).To(BeFalse())
+		})
+	})
+
+	Describe("pattern", func() {
+		It("matches against the message text", func() {
+			filter.Pattern = regexp.MustCompile("refused$")
+			Expect(filter.Matches(message)).To(BeTrue())
+
+			filter.Pattern = regexp.MustCompile("timeout")
+			Expect(filter.Matches(message)).To(BeFalse())
+		})
+	})
+
+	It("requires every criterion to match", func() {
+		filter.SourceTypes = []string{"APP"}
+		filter.Instances = []string{"1"}
+		filter.MessageTypes = []string{logs.MessageTypeOut}
+		Expect(filter.Matches(message)).To(BeFalse())
+	})
+
+	It("always matches notices about the log stream", func() {
+		filter.SourceTypes = []string{"APP"}
+		filter.Pattern = regexp.MustCompile("refused")
+		Expect(filter.Matches(logs.NewStreamNotice("app-guid", "Log stream interrupted"))).To(BeTrue())
+	})
+})
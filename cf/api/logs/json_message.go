@@ -0,0 +1,40 @@
+package logs
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// JSONLogMessage is the structured form of a log message, written as one
+// JSON object per line by `cf logs --format json`.
+type JSONLogMessage struct {
+	AppGUID        string `json:"app_guid"`
+	SourceType     string `json:"source_type"`
+	SourceInstance string `json:"source_instance"`
+	MessageType    string `json:"message_type"`
+	Timestamp      string `json:"timestamp"`
+	Message        string `json:"message"`
+}
+
+// NewJSONLogMessage converts a Loggable into its structured form. Timestamps
+// are always rendered in UTC so that output from different machines can be
+// compared directly.
+func NewJSONLogMessage(msg Loggable) JSONLogMessage {
+	return JSONLogMessage{
+		AppGUID:        msg.GetAppGUID(),
+		SourceType:     msg.GetSourceName(),
+		SourceInstance: msg.GetSourceInstance(),
+		MessageType:    msg.GetMessageType(),
+		Timestamp:      msg.GetTimestamp().UTC().Format(time.RFC3339Nano),
+		Message:        msg.ToSimpleLog(),
+	}
+}
+
+// ToJSON returns the message as a single line of JSON.
+func ToJSON(msg Loggable) (string, error) {
+	raw, err := json.Marshal(NewJSONLogMessage(msg))
+	if err != nil {
+		return "", err
+	}
+	return string(raw), nil
+}
@@ -0,0 +1,56 @@
+package logs_test
+
+import (
+	"encoding/json"
+	"time"
+
+	"code.cloudfoundry.org/cli/cf/api/logs"
+	"github.com/cloudfoundry/sonde-go/events"
+	"github.com/gogo/protobuf/proto"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("JSON log messages", func() {
+	var message logs.Loggable
+
+	BeforeEach(func() {
+		messageType := events.LogMessage_ERR
+		timestamp := time.Date(2017, 5, 4, 12, 30, 15, 123456789, time.FixedZone("CEST", 2*60*60))
+		message = logs.NewNoaaLogMessage(&events.LogMessage{
+			Message:        []byte("something \"broke\"\n"),
+			AppId:          proto.String("app-guid"),
+			MessageType:    &messageType,
+			SourceType:     proto.String("APP"),
+			SourceInstance: proto.String("2"),
+			Timestamp:      proto.Int64(timestamp.UnixNano()),
+		})
+	})
+
+	It("converts all fields with a UTC RFC3339Nano timestamp", func() {
+		Expect(logs.NewJSONLogMessage(message)).To(Equal(logs.JSONLogMessage{
+			AppGUID:        "app-guid",
+			SourceType:     "APP",
+			SourceInstance: "2",
+			MessageType:    "ERR",
+			Timestamp:      "2017-05-04T10:30:15.123456789Z",
+			Message:        "something \"broke\"",
+		}))
+	})
+
+	It("renders a single line of valid JSON", func() {
+		line, err := logs.ToJSON(message)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(line).ToNot(ContainSubstring("\n"))
+
+		var decoded map[string]string
+		Expect(json.Unmarshal([]byte(line), &decoded)).To(Succeed())
+		Expect(decoded).To(HaveKeyWithValue("app_guid", "app-guid"))
+		Expect(decoded).To(HaveKeyWithValue("source_type", "APP"))
+		Expect(decoded).To(HaveKeyWithValue("source_instance", "2"))
+		Expect(decoded).To(HaveKeyWithValue("message_type", "ERR"))
+		Expect(decoded).To(HaveKeyWithValue("timestamp", "2017-05-04T10:30:15.123456789Z"))
+		Expect(decoded).To(HaveKeyWithValue("message", "something \"broke\""))
+	})
+})
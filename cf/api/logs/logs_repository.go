@@ -6,7 +6,11 @@ import "time"
 type Loggable interface {
 	ToLog(loc *time.Location) string
 	ToSimpleLog() string
+	GetAppGUID() string
 	GetSourceName() string
+	GetSourceInstance() string
+	GetMessageType() string
+	GetTimestamp() time.Time
 }
 
 //go:generate counterfeiter . Repository
@@ -17,6 +21,21 @@ type Repository interface {
 	Close()
 }
 
+//go:generate counterfeiter . RepositoryFactory
+
+// RepositoryFactory creates log repositories that each own a separate
+// connection, so that the logs of several apps can be tailed at once.
+type RepositoryFactory interface {
+	NewRepository() Repository
+}
+
+// RepositoryFactoryFunc adapts an ordinary function to a RepositoryFactory.
+type RepositoryFactoryFunc func() Repository
+
+func (f RepositoryFactoryFunc) NewRepository() Repository {
+	return f()
+}
+
 const defaultBufferTime time.Duration = 25 * time.Millisecond
 
 func max(a, b int) int {
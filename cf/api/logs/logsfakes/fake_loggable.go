@@ -23,12 +23,36 @@ type FakeLoggable struct {
 	toSimpleLogReturns     struct {
 		result1 string
 	}
+	GetAppGUIDStub        func() string
+	getAppGUIDMutex       sync.RWMutex
+	getAppGUIDArgsForCall []struct{}
+	getAppGUIDReturns     struct {
+		result1 string
+	}
 	GetSourceNameStub        func() string
 	getSourceNameMutex       sync.RWMutex
 	getSourceNameArgsForCall []struct{}
 	getSourceNameReturns     struct {
 		result1 string
 	}
+	GetSourceInstanceStub        func() string
+	getSourceInstanceMutex       sync.RWMutex
+	getSourceInstanceArgsForCall []struct{}
+	getSourceInstanceReturns     struct {
+		result1 string
+	}
+	GetMessageTypeStub        func() string
+	getMessageTypeMutex       sync.RWMutex
+	getMessageTypeArgsForCall []struct{}
+	getMessageTypeReturns     struct {
+		result1 string
+	}
+	GetTimestampStub        func() time.Time
+	getTimestampMutex       sync.RWMutex
+	getTimestampArgsForCall []struct{}
+	getTimestampReturns     struct {
+		result1 time.Time
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -91,6 +115,31 @@ func (fake *FakeLoggable) ToSimpleLogReturns(result1 string) {
 	}{result1}
 }
 
+func (fake *FakeLoggable) GetAppGUID() string {
+	fake.getAppGUIDMutex.Lock()
+	fake.getAppGUIDArgsForCall = append(fake.getAppGUIDArgsForCall, struct{}{})
+	fake.recordInvocation("GetAppGUID", []interface{}{})
+	fake.getAppGUIDMutex.Unlock()
+	if fake.GetAppGUIDStub != nil {
+		return fake.GetAppGUIDStub()
+	} else {
+		return fake.getAppGUIDReturns.result1
+	}
+}
+
+func (fake *FakeLoggable) GetAppGUIDCallCount() int {
+	fake.getAppGUIDMutex.RLock()
+	defer fake.getAppGUIDMutex.RUnlock()
+	return len(fake.getAppGUIDArgsForCall)
+}
+
+func (fake *FakeLoggable) GetAppGUIDReturns(result1 string) {
+	fake.GetAppGUIDStub = nil
+	fake.getAppGUIDReturns = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeLoggable) GetSourceName() string {
 	fake.getSourceNameMutex.Lock()
 	fake.getSourceNameArgsForCall = append(fake.getSourceNameArgsForCall, struct{}{})
@@ -116,6 +165,81 @@ func (fake *FakeLoggable) GetSourceNameReturns(result1 string) {
 	}{result1}
 }
 
+func (fake *FakeLoggable) GetSourceInstance() string {
+	fake.getSourceInstanceMutex.Lock()
+	fake.getSourceInstanceArgsForCall = append(fake.getSourceInstanceArgsForCall, struct{}{})
+	fake.recordInvocation("GetSourceInstance", []interface{}{})
+	fake.getSourceInstanceMutex.Unlock()
+	if fake.GetSourceInstanceStub != nil {
+		return fake.GetSourceInstanceStub()
+	} else {
+		return fake.getSourceInstanceReturns.result1
+	}
+}
+
+func (fake *FakeLoggable) GetSourceInstanceCallCount() int {
+	fake.getSourceInstanceMutex.RLock()
+	defer fake.getSourceInstanceMutex.RUnlock()
+	return len(fake.getSourceInstanceArgsForCall)
+}
+
+func (fake *FakeLoggable) GetSourceInstanceReturns(result1 string) {
+	fake.GetSourceInstanceStub = nil
+	fake.getSourceInstanceReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeLoggable) GetMessageType() string {
+	fake.getMessageTypeMutex.Lock()
+	fake.getMessageTypeArgsForCall = append(fake.getMessageTypeArgsForCall, struct{}{})
+	fake.recordInvocation("GetMessageType", []interface{}{})
+	fake.getMessageTypeMutex.Unlock()
+	if fake.GetMessageTypeStub != nil {
+		return fake.GetMessageTypeStub()
+	} else {
+		return fake.getMessageTypeReturns.result1
+	}
+}
+
+func (fake *FakeLoggable) GetMessageTypeCallCount() int {
+	fake.getMessageTypeMutex.RLock()
+	defer fake.getMessageTypeMutex.RUnlock()
+	return len(fake.getMessageTypeArgsForCall)
+}
+
+func (fake *FakeLoggable) GetMessageTypeReturns(result1 string) {
+	fake.GetMessageTypeStub = nil
+	fake.getMessageTypeReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeLoggable) GetTimestamp() time.Time {
+	fake.getTimestampMutex.Lock()
+	fake.getTimestampArgsForCall = append(fake.getTimestampArgsForCall, struct{}{})
+	fake.recordInvocation("GetTimestamp", []interface{}{})
+	fake.getTimestampMutex.Unlock()
+	if fake.GetTimestampStub != nil {
+		return fake.GetTimestampStub()
+	} else {
+		return fake.getTimestampReturns.result1
+	}
+}
+
+func (fake *FakeLoggable) GetTimestampCallCount() int {
+	fake.getTimestampMutex.RLock()
+	defer fake.getTimestampMutex.RUnlock()
+	return len(fake.getTimestampArgsForCall)
+}
+
+func (fake *FakeLoggable) GetTimestampReturns(result1 time.Time) {
+	fake.GetTimestampStub = nil
+	fake.getTimestampReturns = struct {
+		result1 time.Time
+	}{result1}
+}
+
 func (fake *FakeLoggable) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -123,8 +247,16 @@ func (fake *FakeLoggable) Invocations() map[string][][]interface{} {
 	defer fake.toLogMutex.RUnlock()
 	fake.toSimpleLogMutex.RLock()
 	defer fake.toSimpleLogMutex.RUnlock()
+	fake.getAppGUIDMutex.RLock()
+	defer fake.getAppGUIDMutex.RUnlock()
 	fake.getSourceNameMutex.RLock()
 	defer fake.getSourceNameMutex.RUnlock()
+	fake.getSourceInstanceMutex.RLock()
+	defer fake.getSourceInstanceMutex.RUnlock()
+	fake.getMessageTypeMutex.RLock()
+	defer fake.getMessageTypeMutex.RUnlock()
+	fake.getTimestampMutex.RLock()
+	defer fake.getTimestampMutex.RUnlock()
 	return fake.invocations
 }
 
@@ -3,6 +3,7 @@ package logsfakes
 
 import (
 	"sync"
+	"time"
 
 	"code.cloudfoundry.org/cli/cf/api/logs"
 	"github.com/cloudfoundry/noaa/consumer"
@@ -46,6 +47,16 @@ type FakeNoaaConsumer struct {
 	refreshTokenFromArgsForCall []struct {
 		tr consumer.TokenRefresher
 	}
+	SetMinRetryDelayStub        func(d time.Duration)
+	setMinRetryDelayMutex       sync.RWMutex
+	setMinRetryDelayArgsForCall []struct {
+		d time.Duration
+	}
+	SetMaxRetryDelayStub        func(d time.Duration)
+	setMaxRetryDelayMutex       sync.RWMutex
+	setMaxRetryDelayArgsForCall []struct {
+		d time.Duration
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -193,6 +204,54 @@ func (fake *FakeNoaaConsumer) RefreshTokenFromArgsForCall(i int) consumer.TokenR
 	return fake.refreshTokenFromArgsForCall[i].tr
 }
 
+func (fake *FakeNoaaConsumer) SetMinRetryDelay(d time.Duration) {
+	fake.setMinRetryDelayMutex.Lock()
+	fake.setMinRetryDelayArgsForCall = append(fake.setMinRetryDelayArgsForCall, struct {
+		d time.Duration
+	}{d})
+	fake.recordInvocation("SetMinRetryDelay", []interface{}{d})
+	fake.setMinRetryDelayMutex.Unlock()
+	if fake.SetMinRetryDelayStub != nil {
+		fake.SetMinRetryDelayStub(d)
+	}
+}
+
+func (fake *FakeNoaaConsumer) SetMinRetryDelayCallCount() int {
+	fake.setMinRetryDelayMutex.RLock()
+	defer fake.setMinRetryDelayMutex.RUnlock()
+	return len(fake.setMinRetryDelayArgsForCall)
+}
+
+func (fake *FakeNoaaConsumer) SetMinRetryDelayArgsForCall(i int) time.Duration {
+	fake.setMinRetryDelayMutex.RLock()
+	defer fake.setMinRetryDelayMutex.RUnlock()
+	return fake.setMinRetryDelayArgsForCall[i].d
+}
+
+func (fake *FakeNoaaConsumer) SetMaxRetryDelay(d time.Duration) {
+	fake.setMaxRetryDelayMutex.Lock()
+	fake.setMaxRetryDelayArgsForCall = append(fake.setMaxRetryDelayArgsForCall, struct {
+		d time.Duration
+	}{d})
+	fake.recordInvocation("SetMaxRetryDelay", []interface{}{d})
+	fake.setMaxRetryDelayMutex.Unlock()
+	if fake.SetMaxRetryDelayStub != nil {
+		fake.SetMaxRetryDelayStub(d)
+	}
+}
+
+func (fake *FakeNoaaConsumer) SetMaxRetryDelayCallCount() int {
+	fake.setMaxRetryDelayMutex.RLock()
+	defer fake.setMaxRetryDelayMutex.RUnlock()
+	return len(fake.setMaxRetryDelayArgsForCall)
+}
+
+func (fake *FakeNoaaConsumer) SetMaxRetryDelayArgsForCall(i int) time.Duration {
+	fake.setMaxRetryDelayMutex.RLock()
+	defer fake.setMaxRetryDelayMutex.RUnlock()
+	return fake.setMaxRetryDelayArgsForCall[i].d
+}
+
 func (fake *FakeNoaaConsumer) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -206,6 +265,10 @@ func (fake *FakeNoaaConsumer) Invocations() map[string][][]interface{} {
 	defer fake.setOnConnectCallbackMutex.RUnlock()
 	fake.refreshTokenFromMutex.RLock()
 	defer fake.refreshTokenFromMutex.RUnlock()
+	fake.setMinRetryDelayMutex.RLock()
+	defer fake.setMinRetryDelayMutex.RUnlock()
+	fake.setMaxRetryDelayMutex.RLock()
+	defer fake.setMaxRetryDelayMutex.RUnlock()
 	return fake.invocations
 }
 
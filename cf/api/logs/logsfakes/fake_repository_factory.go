@@ -0,0 +1,66 @@
+// This file was generated by counterfeiter
+package logsfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/cf/api/logs"
+)
+
+type FakeRepositoryFactory struct {
+	NewRepositoryStub        func() logs.Repository
+	newRepositoryMutex       sync.RWMutex
+	newRepositoryArgsForCall []struct{}
+	newRepositoryReturns     struct {
+		result1 logs.Repository
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeRepositoryFactory) NewRepository() logs.Repository {
+	fake.newRepositoryMutex.Lock()
+	fake.newRepositoryArgsForCall = append(fake.newRepositoryArgsForCall, struct{}{})
+	fake.recordInvocation("NewRepository", []interface{}{})
+	fake.newRepositoryMutex.Unlock()
+	if fake.NewRepositoryStub != nil {
+		return fake.NewRepositoryStub()
+	} else {
+		return fake.newRepositoryReturns.result1
+	}
+}
+
+func (fake *FakeRepositoryFactory) NewRepositoryCallCount() int {
+	fake.newRepositoryMutex.RLock()
+	defer fake.newRepositoryMutex.RUnlock()
+	return len(fake.newRepositoryArgsForCall)
+}
+
+func (fake *FakeRepositoryFactory) NewRepositoryReturns(result1 logs.Repository) {
+	fake.NewRepositoryStub = nil
+	fake.newRepositoryReturns = struct {
+		result1 logs.Repository
+	}{result1}
+}
+
+func (fake *FakeRepositoryFactory) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.newRepositoryMutex.RLock()
+	defer fake.newRepositoryMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeRepositoryFactory) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ logs.RepositoryFactory = new(FakeRepositoryFactory)
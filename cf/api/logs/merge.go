@@ -0,0 +1,19 @@
+package logs
+
+// MergeRecentLogs combines the recent logs of several apps into a single
+// slice ordered by timestamp, using the same ordering as NoaaMessageQueue.
+// Messages with equal timestamps keep the order in which they were passed in.
+func MergeRecentLogs(messageSets ...[]Loggable) []Loggable {
+	queue := NewNoaaMessageQueue()
+	for _, messages := range messageSets {
+		for _, message := range messages {
+			queue.PushLoggable(message)
+		}
+	}
+
+	var merged []Loggable
+	queue.EnumerateAndClear(func(message Loggable) {
+		merged = append(merged, message)
+	})
+	return merged
+}
@@ -0,0 +1,36 @@
+package logs_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/cf/api/logs"
+	"code.cloudfoundry.org/cli/cf/api/logs/logsfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("MergeRecentLogs", func() {
+	newMessage := func(seconds int64) *logsfakes.FakeLoggable {
+		message := new(logsfakes.FakeLoggable)
+		message.GetTimestampReturns(time.Unix(seconds, 0))
+		return message
+	}
+
+	It("orders the messages of all sets by timestamp", func() {
+		a1, a2 := newMessage(10), newMessage(30)
+		b1, b2 := newMessage(20), newMessage(40)
+
+		merged := logs.MergeRecentLogs([]logs.Loggable{a1, a2}, []logs.Loggable{b1, b2})
+		Expect(merged).To(Equal([]logs.Loggable{a1, b1, a2, b2}))
+	})
+
+	It("keeps messages with the same timestamp in their original order", func() {
+		a1 := newMessage(10)
+		b1 := newMessage(10)
+
+		merged := logs.MergeRecentLogs([]logs.Loggable{a1}, []logs.Loggable{b1})
+		Expect(merged[0]).To(BeIdenticalTo(a1))
+		Expect(merged[1]).To(BeIdenticalTo(b1))
+	})
+})
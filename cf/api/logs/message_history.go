@@ -0,0 +1,55 @@
+package logs
+
+import (
+	"fmt"
+
+	"github.com/cloudfoundry/sonde-go/events"
+)
+
+const maxHistoryMessages = 1000
+
+// messageHistory remembers the most recently received log messages so that
+// messages fetched again after a reconnect are not displayed twice.
+type messageHistory struct {
+	seen   map[string]struct{}
+	order  []string
+	limit  int
+	latest int64
+}
+
+func newMessageHistory(limit int) *messageHistory {
+	return &messageHistory{
+		seen:  map[string]struct{}{},
+		limit: limit,
+	}
+}
+
+// Add records the message and returns false if it had already been recorded.
+func (h *messageHistory) Add(msg *events.LogMessage) bool {
+	key := messageKey(msg)
+	if _, ok := h.seen[key]; ok {
+		return false
+	}
+
+	h.seen[key] = struct{}{}
+	h.order = append(h.order, key)
+	if len(h.order) > h.limit {
+		delete(h.seen, h.order[0])
+		h.order = h.order[1:]
+	}
+
+	if msg.GetTimestamp() > h.latest {
+		h.latest = msg.GetTimestamp()
+	}
+	return true
+}
+
+// Latest returns the timestamp of the newest message recorded, or 0 if none
+// has been recorded.
+func (h *messageHistory) Latest() int64 {
+	return h.latest
+}
+
+func messageKey(msg *events.LogMessage) string {
+	return fmt.Sprintf("%d|%s|%s|%d|%s", msg.GetTimestamp(), msg.GetSourceType(), msg.GetSourceInstance(), msg.GetMessageType(), msg.GetMessage())
+}
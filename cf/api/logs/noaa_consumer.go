@@ -1,6 +1,8 @@
 package logs
 
 import (
+	"time"
+
 	"github.com/cloudfoundry/noaa/consumer"
 	"github.com/cloudfoundry/sonde-go/events"
 )
@@ -14,4 +16,6 @@ type NoaaConsumer interface {
 	Close() error
 	SetOnConnectCallback(cb func())
 	RefreshTokenFrom(tr consumer.TokenRefresher)
+	SetMinRetryDelay(d time.Duration)
+	SetMaxRetryDelay(d time.Duration)
 }
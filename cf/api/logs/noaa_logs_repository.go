@@ -3,6 +3,7 @@ package logs
 import (
 	"errors"
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	. "code.cloudfoundry.org/cli/cf/i18n"
@@ -15,6 +16,11 @@ import (
 	"github.com/cloudfoundry/sonde-go/events"
 )
 
+const (
+	minReconnectDelay = 500 * time.Millisecond
+	maxReconnectDelay = 30 * time.Second
+)
+
 type NoaaLogsRepository struct {
 	config         coreconfig.Reader
 	consumer       NoaaConsumer
@@ -26,6 +32,8 @@ type NoaaLogsRepository struct {
 
 func NewNoaaLogsRepository(config coreconfig.Reader, consumer NoaaConsumer, tr authentication.TokenRefresher, retryTimeout time.Duration) *NoaaLogsRepository {
 	consumer.RefreshTokenFrom(tr)
+	consumer.SetMinRetryDelay(minReconnectDelay)
+	consumer.SetMaxRetryDelay(maxReconnectDelay)
 	return &NoaaLogsRepository{
 		config:         config,
 		consumer:       consumer,
@@ -51,7 +59,7 @@ func loggableMessagesFromNoaaMessages(messages []*events.LogMessage) []Loggable
 }
 
 func (repo *NoaaLogsRepository) RecentLogsFor(appGUID string) ([]Loggable, error) {
-	logs, err := repo.consumer.RecentLogs(appGUID, repo.config.AccessToken())
+	logs, err := repo.recentLogs(appGUID)
 
 	if err != nil {
 		return loggableMessagesFromNoaaMessages(logs), err
@@ -59,9 +67,30 @@ func (repo *NoaaLogsRepository) RecentLogsFor(appGUID string) ([]Loggable, error
 	return loggableMessagesFromNoaaMessages(noaa.SortRecent(logs)), err
 }
 
+// recentLogs fetches the recent logs of the app, refreshing the access token
+// once if it has been rejected.
+func (repo *NoaaLogsRepository) recentLogs(appGUID string) ([]*events.LogMessage, error) {
+	logs, err := repo.consumer.RecentLogs(appGUID, repo.config.AccessToken())
+	if _, ok := err.(*noaaerrors.UnauthorizedError); ok {
+		token, refreshErr := repo.tokenRefresher.RefreshAuthToken()
+		if refreshErr != nil {
+			return nil, refreshErr
+		}
+		logs, err = repo.consumer.RecentLogs(appGUID, token)
+	}
+	return logs, err
+}
+
+// TailLogsFor streams the logs of the app until the repository is closed.
+// Until the first connection is established, it gives up after the retry
+// timeout. Once connected, the consumer reconnects indefinitely with
+// exponential backoff, refreshing the access token when it is rejected; the
+// interruption is reported on logChan and the messages missed in the
+// meantime are backfilled from the recent logs.
 func (repo *NoaaLogsRepository) TailLogsFor(appGUID string, onConnect func(), logChan chan<- Loggable, errChan chan<- error) {
 	ticker := time.NewTicker(repo.BufferTime)
 	retryTimer := newUnstartedTimer()
+	startedAt := time.Now()
 
 	endpoint := repo.config.DopplerEndpoint()
 	if endpoint == "" {
@@ -69,9 +98,18 @@ func (repo *NoaaLogsRepository) TailLogsFor(appGUID string, onConnect func(), lo
 		return
 	}
 
+	var connectCount int64
+	connected := make(chan struct{}, 1)
 	repo.consumer.SetOnConnectCallback(func() {
 		retryTimer.Stop()
-		onConnect()
+		if atomic.AddInt64(&connectCount, 1) == 1 {
+			onConnect()
+		}
+
+		select {
+		case connected <- struct{}{}:
+		default:
+		}
 	})
 	c, e := repo.consumer.TailingLogs(appGUID, repo.config.AccessToken())
 
@@ -79,7 +117,12 @@ func (repo *NoaaLogsRepository) TailLogsFor(appGUID string, onConnect func(), lo
 		defer close(logChan)
 		defer close(errChan)
 
+		history := newMessageHistory(maxHistoryMessages)
 		timerRunning := false
+		interrupted := false
+		var interruptedAt time.Time
+		var connectsBeforeInterruption int64
+
 		for {
 			select {
 			case msg, ok := <-c:
@@ -89,13 +132,47 @@ func (repo *NoaaLogsRepository) TailLogsFor(appGUID string, onConnect func(), lo
 					return
 				}
 				timerRunning = false
-				repo.messageQueue.PushMessage(msg)
+				if history.Add(msg) {
+					repo.messageQueue.PushMessage(msg)
+				}
+			case <-connected:
+				if !interrupted || atomic.LoadInt64(&connectCount) <= connectsBeforeInterruption {
+					continue
+				}
+				interrupted = false
+
+				since := history.Latest()
+				if since == 0 {
+					since = startedAt.UnixNano()
+				}
+				count := repo.backfill(appGUID, since, history)
+				downtime := time.Since(interruptedAt)
+
+				repo.flushMessages(logChan)
+				logChan <- NewStreamNotice(appGUID, T("Log stream resumed after {{.Duration}}; recovered {{.Count}} missed messages.",
+					map[string]interface{}{
+						"Duration": downtime - downtime%time.Second,
+						"Count":    count,
+					}))
 			case err := <-e:
 				if err != nil {
 					if _, ok := err.(noaaerrors.RetryError); ok {
-						if !timerRunning {
-							timerRunning = true
-							retryTimer.Reset(repo.retryTimeout)
+						if atomic.LoadInt64(&connectCount) == 0 {
+							if !timerRunning {
+								timerRunning = true
+								retryTimer.Reset(repo.retryTimeout)
+							}
+							continue
+						}
+
+						if !interrupted {
+							interrupted = true
+							interruptedAt = time.Now()
+							connectsBeforeInterruption = atomic.LoadInt64(&connectCount)
+
+							repo.flushMessages(logChan)
+							logChan <- NewStreamNotice(appGUID, T("Log stream interrupted: {{.Err}}. Reconnecting...",
+								map[string]interface{}{"Err": err.Error()}))
 						}
 						continue
 					}
@@ -120,9 +197,30 @@ func (repo *NoaaLogsRepository) TailLogsFor(appGUID string, onConnect func(), lo
 	}()
 }
 
+// backfill queues the recent messages no older than since that have not
+// been received yet, and returns how many there were.
+func (repo *NoaaLogsRepository) backfill(appGUID string, since int64, history *messageHistory) int {
+	messages, err := repo.recentLogs(appGUID)
+	if err != nil {
+		return 0
+	}
+
+	count := 0
+	for _, msg := range noaa.SortRecent(messages) {
+		if msg.GetTimestamp() < since {
+			continue
+		}
+		if history.Add(msg) {
+			repo.messageQueue.PushMessage(msg)
+			count++
+		}
+	}
+	return count
+}
+
 func (repo *NoaaLogsRepository) flushMessages(c chan<- Loggable) {
-	repo.messageQueue.EnumerateAndClear(func(m *events.LogMessage) {
-		c <- NewNoaaLogMessage(m)
+	repo.messageQueue.EnumerateAndClear(func(m Loggable) {
+		c <- m
 	})
 }
 
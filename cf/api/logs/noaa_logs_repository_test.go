@@ -46,6 +46,15 @@ var _ = Describe("logs with noaa repository", func() {
 		})
 	})
 
+	Describe("Reconnecting", func() {
+		It("sets the backoff between reconnection attempts", func() {
+			Expect(fakeNoaaConsumer.SetMinRetryDelayCallCount()).To(Equal(1))
+			Expect(fakeNoaaConsumer.SetMinRetryDelayArgsForCall(0)).To(Equal(500 * time.Millisecond))
+			Expect(fakeNoaaConsumer.SetMaxRetryDelayCallCount()).To(Equal(1))
+			Expect(fakeNoaaConsumer.SetMaxRetryDelayArgsForCall(0)).To(Equal(30 * time.Second))
+		})
+	})
+
 	Describe("RecentLogsFor", func() {
 		Context("when an error does not occur", func() {
 			var msg1, msg2, msg3 *events.LogMessage
@@ -79,6 +88,29 @@ var _ = Describe("logs with noaa repository", func() {
 				}))
 			})
 		})
+
+		Context("when the access token is rejected", func() {
+			BeforeEach(func() {
+				fakeNoaaConsumer.RecentLogsStub = func(appGUID string, authToken string) ([]*events.LogMessage, error) {
+					if authToken == "the-access-token" {
+						return nil, noaaerrors.NewUnauthorizedError("expired")
+					}
+					return []*events.LogMessage{makeNoaaLogMessage("message 1", 1000)}, nil
+				}
+				fakeTokenRefresher.RefreshAuthTokenReturns("the-new-token", nil)
+			})
+
+			It("refreshes the token and tries again", func() {
+				messages, err := repo.RecentLogsFor("app-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(messages).To(HaveLen(1))
+
+				Expect(fakeTokenRefresher.RefreshAuthTokenCallCount()).To(Equal(1))
+				Expect(fakeNoaaConsumer.RecentLogsCallCount()).To(Equal(2))
+				_, token := fakeNoaaConsumer.RecentLogsArgsForCall(1)
+				Expect(token).To(Equal("the-new-token"))
+			})
+		})
 	})
 
 	Describe("TailLogsFor", func() {
@@ -183,33 +215,64 @@ var _ = Describe("logs with noaa repository", func() {
 				Eventually(errChan, time.Second).Should(Receive(Equal(expectedErr)))
 			})
 
-			It("Resets the retry timeout after a successful reconnection", func() {
-				defer repo.Close()
-				err := noaaerrors.NewRetryError(errors.New("oops"))
+			Context("after a successful connection", func() {
+				var (
+					err            error
+					onConnectCount int
+				)
+
+				BeforeEach(func() {
+					err = noaaerrors.NewRetryError(errors.New("oops"))
+					onConnectCount = 0
+
+					fakeNoaaConsumer.TailingLogsStub = func(appGuid string, authToken string) (<-chan *events.LogMessage, <-chan error) {
+						e <- err
+						return c, e
+					}
+					fakeNoaaConsumer.RecentLogsReturns([]*events.LogMessage{
+						makeNoaaLogMessage("before", 50),
+						makeNoaaLogMessage("foo", 100),
+						makeNoaaLogMessage("missed", 200),
+					}, nil)
+				})
 
-				fakeNoaaConsumer.TailingLogsStub = func(appGuid string, authToken string) (<-chan *events.LogMessage, <-chan error) {
-					e <- err
-					return c, e
-				}
+				It("keeps reconnecting, reports the interruption and backfills the missed messages", func() {
+					defer repo.Close()
 
-				var wg sync.WaitGroup
-				wg.Add(1)
-				defer wg.Wait()
-				go func() {
-					defer wg.Done()
-					repo.TailLogsFor("app-guid", func() {}, logChan, errChan)
-				}()
+					var wg sync.WaitGroup
+					wg.Add(1)
+					defer wg.Wait()
+					go func() {
+						defer wg.Done()
+						repo.TailLogsFor("app-guid", func() { onConnectCount++ }, logChan, errChan)
+					}()
 
-				Consistently(errChan, time.Second).ShouldNot(Receive())
-				fakeNoaaConsumer.SetOnConnectCallbackArgsForCall(0)()
+					Eventually(fakeNoaaConsumer.SetOnConnectCallbackCallCount).Should(Equal(1))
+					onConnect := fakeNoaaConsumer.SetOnConnectCallbackArgsForCall(0)
+					onConnect()
 
-				c <- makeNoaaLogMessage("foo", 100)
-				Eventually(logChan).Should(Receive())
-				Consistently(errChan, time.Second).ShouldNot(Receive())
+					var msg logs.Loggable
+					c <- makeNoaaLogMessage("foo", 100)
+					Eventually(logChan).Should(Receive(&msg))
+					Expect(msg.ToSimpleLog()).To(Equal("foo"))
 
-				e <- err
-				expectedErr := errors.New("Timed out waiting for connection to Loggregator (doppler.test.com).")
-				Eventually(errChan, 2*time.Second).Should(Receive(Equal(expectedErr)))
+					e <- err
+					Eventually(logChan).Should(Receive(&msg))
+					Expect(msg.GetSourceName()).To(Equal(logs.StreamNoticeSource))
+					Expect(msg.ToSimpleLog()).To(Equal("Log stream interrupted: oops. Reconnecting..."))
+					Consistently(errChan, 2*time.Second).ShouldNot(Receive())
+
+					onConnect()
+					Eventually(logChan).Should(Receive(&msg))
+					Expect(msg.ToSimpleLog()).To(Equal("missed"))
+					Eventually(logChan).Should(Receive(&msg))
+					Expect(msg.GetSourceName()).To(Equal(logs.StreamNoticeSource))
+					Expect(msg.ToSimpleLog()).To(MatchRegexp("Log stream resumed after .*; recovered 1 missed messages."))
+
+					Expect(onConnectCount).To(Equal(1))
+					appGuid, _ := fakeNoaaConsumer.RecentLogsArgsForCall(0)
+					Expect(appGuid).To(Equal("app-guid"))
+				})
 			})
 		})
 
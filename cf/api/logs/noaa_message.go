@@ -26,10 +26,29 @@ func (m *noaaLogMessage) ToSimpleLog() string {
 	return strings.TrimRight(msgText, "\r\n")
 }
 
+func (m *noaaLogMessage) GetAppGUID() string {
+	return m.msg.GetAppId()
+}
+
 func (m *noaaLogMessage) GetSourceName() string {
 	return m.msg.GetSourceType()
 }
 
+func (m *noaaLogMessage) GetSourceInstance() string {
+	return m.msg.GetSourceInstance()
+}
+
+func (m *noaaLogMessage) GetMessageType() string {
+	if m.msg.GetMessageType() == events.LogMessage_ERR {
+		return MessageTypeErr
+	}
+	return MessageTypeOut
+}
+
+func (m *noaaLogMessage) GetTimestamp() time.Time {
+	return time.Unix(0, m.msg.GetTimestamp())
+}
+
 func (m *noaaLogMessage) ToLog(loc *time.Location) string {
 	logMsg := m.msg
 
@@ -63,11 +82,10 @@ func (m *noaaLogMessage) ToLog(loc *time.Location) string {
 	msgLines := strings.Split(msgText, "\n")
 	contentPadding := strings.Repeat(" ", utf8.RuneCountInString(logHeader))
 	coloringFunc := terminal.LogStdoutColor
-	logType := "OUT"
+	logType := m.GetMessageType()
 
-	if logMsg.GetMessageType() == events.LogMessage_ERR {
+	if logType == MessageTypeErr {
 		coloringFunc = terminal.LogStderrColor
-		logType = "ERR"
 	}
 
 	logContent := fmt.Sprintf("%s %s", logType, msgLines[0])
@@ -8,7 +8,7 @@ import (
 )
 
 type NoaaMessageQueue struct {
-	messages []*events.LogMessage
+	messages []Loggable
 	mutex    sync.Mutex
 }
 
@@ -17,6 +17,10 @@ func NewNoaaMessageQueue() *NoaaMessageQueue {
 }
 
 func (pq *NoaaMessageQueue) PushMessage(message *events.LogMessage) {
+	pq.PushLoggable(NewNoaaLogMessage(message))
+}
+
+func (pq *NoaaMessageQueue) PushLoggable(message Loggable) {
 	pq.mutex.Lock()
 	defer pq.mutex.Unlock()
 
@@ -25,7 +29,7 @@ func (pq *NoaaMessageQueue) PushMessage(message *events.LogMessage) {
 
 // implement sort interface so we can sort messages as we receive them in PushMessage
 func (pq *NoaaMessageQueue) Less(i, j int) bool {
-	return *pq.messages[i].Timestamp < *pq.messages[j].Timestamp
+	return pq.messages[i].GetTimestamp().Before(pq.messages[j].GetTimestamp())
 }
 
 func (pq *NoaaMessageQueue) Swap(i, j int) {
@@ -36,7 +40,7 @@ func (pq *NoaaMessageQueue) Len() int {
 	return len(pq.messages)
 }
 
-func (pq *NoaaMessageQueue) EnumerateAndClear(onMessage func(*events.LogMessage)) {
+func (pq *NoaaMessageQueue) EnumerateAndClear(onMessage func(Loggable)) {
 	pq.mutex.Lock()
 	defer pq.mutex.Unlock()
 
@@ -46,5 +50,5 @@ func (pq *NoaaMessageQueue) EnumerateAndClear(onMessage func(*events.LogMessage)
 		onMessage(x)
 	}
 
-	pq.messages = []*events.LogMessage{}
+	pq.messages = []Loggable{}
 }
@@ -22,22 +22,22 @@ var _ = Describe("NoaaMessageQueue", func() {
 		pq.PushMessage(msg4)
 		pq.PushMessage(msg1)
 
-		var messages []*events.LogMessage
+		var messages []Loggable
 
-		pq.EnumerateAndClear(func(m *events.LogMessage) {
+		pq.EnumerateAndClear(func(m Loggable) {
 			messages = append(messages, m)
 		})
 
-		Expect(messages).To(Equal([]*events.LogMessage{
-			msg1,
-			msg2,
-			msg3,
-			msg4,
+		Expect(messages).To(Equal([]Loggable{
+			NewNoaaLogMessage(msg1),
+			NewNoaaLogMessage(msg2),
+			NewNoaaLogMessage(msg3),
+			NewNoaaLogMessage(msg4),
 		}))
 
-		var messagesAfter []*events.LogMessage
+		var messagesAfter []Loggable
 
-		pq.EnumerateAndClear(func(m *events.LogMessage) {
+		pq.EnumerateAndClear(func(m Loggable) {
 			messagesAfter = append(messagesAfter, m)
 		})
 
@@ -0,0 +1,220 @@
+package logs
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RouterSourceType is the source type of the access log messages emitted by
+// the router for every request to an app.
+const RouterSourceType = "RTR"
+
+var (
+	routerAccessLogPattern    = regexp.MustCompile(`^(\S+) - \[[^\]]*\] "(\S+) (\S+) [^"]*" (\d{3}) (\d+) (\d+) `)
+	routerResponseTimePattern = regexp.MustCompile(`response_time:(\d+(?:\.\d+)?)`)
+)
+
+// RouterAccessLog holds the fields of a router access log message.
+type RouterAccessLog struct {
+	Host          string
+	Method        string
+	Path          string
+	StatusCode    int
+	BytesReceived int64
+	BytesSent     int64
+	ResponseTime  time.Duration
+	Timestamp     time.Time
+}
+
+// ParseRouterAccessLog parses an RTR log message. It returns false if the
+// message is not a router access log or lacks a response time.
+func ParseRouterAccessLog(msg Loggable) (RouterAccessLog, bool) {
+	if !strings.EqualFold(msg.GetSourceName(), RouterSourceType) {
+		return RouterAccessLog{}, false
+	}
+
+	text := msg.ToSimpleLog()
+	fields := routerAccessLogPattern.FindStringSubmatch(text)
+	responseTime := routerResponseTimePattern.FindStringSubmatch(text)
+	if fields == nil || responseTime == nil {
+		return RouterAccessLog{}, false
+	}
+
+	statusCode, _ := strconv.Atoi(fields[4])
+	bytesReceived, _ := strconv.ParseInt(fields[5], 10, 64)
+	bytesSent, _ := strconv.ParseInt(fields[6], 10, 64)
+	seconds, err := strconv.ParseFloat(responseTime[1], 64)
+	if err != nil {
+		return RouterAccessLog{}, false
+	}
+
+	return RouterAccessLog{
+		Host:          fields[1],
+		Method:        fields[2],
+		Path:          strings.SplitN(fields[3], "?", 2)[0],
+		StatusCode:    statusCode,
+		BytesReceived: bytesReceived,
+		BytesSent:     bytesSent,
+		ResponseTime:  time.Duration(seconds * float64(time.Second)),
+		Timestamp:     msg.GetTimestamp(),
+	}, true
+}
+
+// RouterStats aggregates router access logs. When Window is set, only the
+// requests made within Window of the time of the summary are taken into
+// account.
+type RouterStats struct {
+	Window  time.Duration
+	entries []RouterAccessLog
+}
+
+// StatusCount is the number of requests answered with a status code.
+type StatusCount struct {
+	StatusCode int
+	Requests   int
+}
+
+// PathStats summarizes the response times of the requests for one path.
+type PathStats struct {
+	Path                string
+	Requests            int
+	MeanResponseTime    time.Duration
+	MaximumResponseTime time.Duration
+}
+
+// RouterStatsSummary is a snapshot of the aggregated router access logs.
+type RouterStatsSummary struct {
+	Requests       int
+	RequestsPerSec float64
+	StatusCounts   []StatusCount
+	P50            time.Duration
+	P95            time.Duration
+	P99            time.Duration
+	SlowestPaths   []PathStats
+}
+
+// Add records the message if it is a router access log and returns whether
+// it was one.
+func (s *RouterStats) Add(msg Loggable) bool {
+	entry, ok := ParseRouterAccessLog(msg)
+	if !ok {
+		return false
+	}
+
+	position := sort.Search(len(s.entries), func(i int) bool {
+		return s.entries[i].Timestamp.After(entry.Timestamp)
+	})
+	s.entries = append(s.entries, RouterAccessLog{})
+	copy(s.entries[position+1:], s.entries[position:])
+	s.entries[position] = entry
+
+	s.prune(s.entries[len(s.entries)-1].Timestamp)
+	return true
+}
+
+// Summary computes the statistics of the recorded requests, listing at most
+// maxPaths of the paths with the highest mean response time. When Window is
+// set, the requests made more than Window before now are dropped first.
+func (s *RouterStats) Summary(now time.Time, maxPaths int) RouterStatsSummary {
+	s.prune(now)
+
+	summary := RouterStatsSummary{Requests: len(s.entries)}
+	if len(s.entries) == 0 {
+		return summary
+	}
+
+	span := s.entries[len(s.entries)-1].Timestamp.Sub(s.entries[0].Timestamp)
+	if span < time.Second {
+		span = time.Second
+	}
+	summary.RequestsPerSec = float64(len(s.entries)) / span.Seconds()
+
+	statusCounts := map[int]int{}
+	responseTimes := make([]time.Duration, len(s.entries))
+	paths := map[string]*PathStats{}
+	for i, entry := range s.entries {
+		statusCounts[entry.StatusCode]++
+		responseTimes[i] = entry.ResponseTime
+
+		path, ok := paths[entry.Path]
+		if !ok {
+			path = &PathStats{Path: entry.Path}
+			paths[entry.Path] = path
+		}
+		path.Requests++
+		path.MeanResponseTime += entry.ResponseTime
+		if entry.ResponseTime > path.MaximumResponseTime {
+			path.MaximumResponseTime = entry.ResponseTime
+		}
+	}
+
+	for statusCode, requests := range statusCounts {
+		summary.StatusCounts = append(summary.StatusCounts, StatusCount{StatusCode: statusCode, Requests: requests})
+	}
+	sort.Sort(statusCountsByCode(summary.StatusCounts))
+
+	sort.Sort(durations(responseTimes))
+	summary.P50 = percentile(responseTimes, 50)
+	summary.P95 = percentile(responseTimes, 95)
+	summary.P99 = percentile(responseTimes, 99)
+
+	for _, path := range paths {
+		path.MeanResponseTime /= time.Duration(path.Requests)
+		summary.SlowestPaths = append(summary.SlowestPaths, *path)
+	}
+	sort.Sort(pathStatsBySlowest(summary.SlowestPaths))
+	if len(summary.SlowestPaths) > maxPaths {
+		summary.SlowestPaths = summary.SlowestPaths[:maxPaths]
+	}
+
+	return summary
+}
+
+// prune drops the requests made more than Window before latest. It does
+// nothing when Window is not set.
+func (s *RouterStats) prune(latest time.Time) {
+	if s.Window <= 0 {
+		return
+	}
+
+	cutoff := latest.Add(-s.Window)
+	first := sort.Search(len(s.entries), func(i int) bool {
+		return !s.entries[i].Timestamp.Before(cutoff)
+	})
+	s.entries = s.entries[first:]
+}
+
+// percentile returns the nearest-rank percentile of the sorted durations.
+func percentile(sorted []time.Duration, p int) time.Duration {
+	rank := (p*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+type statusCountsByCode []StatusCount
+
+func (c statusCountsByCode) Len() int           { return len(c) }
+func (c statusCountsByCode) Swap(i, j int)      { c[i], c[j] = c[j], c[i] }
+func (c statusCountsByCode) Less(i, j int) bool { return c[i].StatusCode < c[j].StatusCode }
+
+type durations []time.Duration
+
+func (d durations) Len() int           { return len(d) }
+func (d durations) Swap(i, j int)      { d[i], d[j] = d[j], d[i] }
+func (d durations) Less(i, j int) bool { return d[i] < d[j] }
+
+type pathStatsBySlowest []PathStats
+
+func (p pathStatsBySlowest) Len() int      { return len(p) }
+func (p pathStatsBySlowest) Swap(i, j int) { p[i], p[j] = p[j], p[i] }
+func (p pathStatsBySlowest) Less(i, j int) bool {
+	if p[i].MeanResponseTime != p[j].MeanResponseTime {
+		return p[i].MeanResponseTime > p[j].MeanResponseTime
+	}
+	return p[i].Path < p[j].Path
+}
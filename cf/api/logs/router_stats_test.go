@@ -0,0 +1,127 @@
+package logs_test
+
+import (
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/cli/cf/api/logs"
+	"github.com/cloudfoundry/sonde-go/events"
+	"github.com/gogo/protobuf/proto"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RouterStats", func() {
+	var base time.Time
+
+	rtrMessage := func(path string, status int, responseTime string, at time.Time) logs.Loggable {
+		messageType := events.LogMessage_OUT
+		return logs.NewNoaaLogMessage(&events.LogMessage{
+			Message: []byte(fmt.Sprintf(`my-app.example.com - [2017-03-02T18:40:56.123+0000] "GET %s HTTP/1.1" %d 12 345 "-" "curl/7.43.0" "10.0.0.1:54321" "10.0.0.2:61001" x_forwarded_for:"10.0.0.1" x_forwarded_proto:"https" vcap_request_id:"abc" response_time:%s app_id:"app-guid" app_index:"0"`,
+				path, status, responseTime)),
+			AppId:       proto.String("app-guid"),
+			MessageType: &messageType,
+			SourceType:  proto.String("RTR"),
+			Timestamp:   proto.Int64(at.UnixNano()),
+		})
+	}
+
+	BeforeEach(func() {
+		base = time.Unix(1000, 0)
+	})
+
+	Describe("ParseRouterAccessLog", func() {
+		It("parses the fields of an access log", func() {
+			entry, ok := logs.ParseRouterAccessLog(rtrMessage("/orders?page=2", 503, "0.250", base))
+			Expect(ok).To(BeTrue())
+			Expect(entry).To(Equal(logs.RouterAccessLog{
+				Host:          "my-app.example.com",
+				Method:        "GET",
+				Path:          "/orders",
+				StatusCode:    503,
+				BytesReceived: 12,
+				BytesSent:     345,
+				ResponseTime:  250 * time.Millisecond,
+				Timestamp:     base,
+			}))
+		})
+
+		It("ignores messages from other sources", func() {
+			_, ok := logs.ParseRouterAccessLog(logs.NewNoaaLogMessage(makeNoaaLogMessage("hello", 1000)))
+			Expect(ok).To(BeFalse())
+		})
+
+		It("ignores router messages that are not access logs", func() {
+			msg := logs.NewNoaaLogMessage(&events.LogMessage{
+				Message:    []byte("Registered route my-app.example.com"),
+				SourceType: proto.String("RTR"),
+				Timestamp:  proto.Int64(1000),
+			})
+			_, ok := logs.ParseRouterAccessLog(msg)
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Describe("Summary", func() {
+		var stats *logs.RouterStats
+
+		BeforeEach(func() {
+			stats = &logs.RouterStats{}
+		})
+
+		It("is empty when no requests were recorded", func() {
+			Expect(stats.Summary(base, 5)).To(Equal(logs.RouterStatsSummary{}))
+		})
+
+		It("summarizes the requests", func() {
+			for i := 0; i < 10; i++ {
+				Expect(stats.Add(rtrMessage("/fast", 200, fmt.Sprintf("0.0%d", i), base.Add(time.Duration(i)*time.Second)))).To(BeTrue())
+			}
+			Expect(stats.Add(rtrMessage("/slow", 500, "2.5", base.Add(4*time.Second)))).To(BeTrue())
+			Expect(stats.Add(logs.NewNoaaLogMessage(makeNoaaLogMessage("hello", 1000)))).To(BeFalse())
+
+			summary := stats.Summary(base.Add(9*time.Second), 1)
+			Expect(summary.Requests).To(Equal(11))
+			Expect(summary.RequestsPerSec).To(BeNumerically("~", 11.0/9.0))
+			Expect(summary.StatusCounts).To(Equal([]logs.StatusCount{
+				{StatusCode: 200, Requests: 10},
+				{StatusCode: 500, Requests: 1},
+			}))
+			Expect(summary.P50).To(Equal(50 * time.Millisecond))
+			Expect(summary.P95).To(Equal(2500 * time.Millisecond))
+			Expect(summary.P99).To(Equal(2500 * time.Millisecond))
+			Expect(summary.SlowestPaths).To(Equal([]logs.PathStats{
+				{Path: "/slow", Requests: 1, MeanResponseTime: 2500 * time.Millisecond, MaximumResponseTime: 2500 * time.Millisecond},
+			}))
+		})
+
+		It("only considers the requests within the window", func() {
+			stats.Window = time.Minute
+			stats.Add(rtrMessage("/old", 200, "0.1", base))
+			stats.Add(rtrMessage("/new", 404, "0.2", base.Add(2*time.Minute)))
+
+			summary := stats.Summary(base.Add(2*time.Minute), 5)
+			Expect(summary.Requests).To(Equal(1))
+			Expect(summary.StatusCounts).To(Equal([]logs.StatusCount{{StatusCode: 404, Requests: 1}}))
+		})
+
+		It("drops the requests that left the window when no new ones arrive", func() {
+			stats.Window = time.Minute
+			stats.Add(rtrMessage("/old", 200, "0.1", base))
+			stats.Add(rtrMessage("/new", 404, "0.2", base.Add(30*time.Second)))
+
+			summary := stats.Summary(base.Add(80*time.Second), 5)
+			Expect(summary.Requests).To(Equal(1))
+			Expect(summary.StatusCounts).To(Equal([]logs.StatusCount{{StatusCode: 404, Requests: 1}}))
+
+			Expect(stats.Summary(base.Add(2*time.Minute), 5)).To(Equal(logs.RouterStatsSummary{}))
+		})
+
+		It("keeps all the requests when no window is set", func() {
+			stats.Add(rtrMessage("/old", 200, "0.1", base))
+
+			Expect(stats.Summary(base.Add(time.Hour), 5).Requests).To(Equal(1))
+		})
+	})
+})
@@ -0,0 +1,57 @@
+package logs
+
+import (
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/cli/cf/terminal"
+)
+
+// StreamNoticeSource is the source name reported by notices about the state
+// of the log stream, as opposed to messages emitted by the app.
+const StreamNoticeSource = "CLI"
+
+// StreamNotice is a Loggable generated by the CLI itself, for example to tell
+// the user that the log stream was interrupted and later resumed.
+type StreamNotice struct {
+	AppGUID   string
+	Message   string
+	Timestamp time.Time
+}
+
+func NewStreamNotice(appGUID string, message string) *StreamNotice {
+	return &StreamNotice{
+		AppGUID:   appGUID,
+		Message:   message,
+		Timestamp: time.Now(),
+	}
+}
+
+func (n *StreamNotice) ToSimpleLog() string {
+	return n.Message
+}
+
+func (n *StreamNotice) ToLog(loc *time.Location) string {
+	timeString := n.Timestamp.In(loc).Format("2006-01-02T15:04:05.00-0700")
+	return terminal.WarningColor(fmt.Sprintf("%s [%s] %s", timeString, StreamNoticeSource, n.Message))
+}
+
+func (n *StreamNotice) GetAppGUID() string {
+	return n.AppGUID
+}
+
+func (n *StreamNotice) GetSourceName() string {
+	return StreamNoticeSource
+}
+
+func (n *StreamNotice) GetSourceInstance() string {
+	return ""
+}
+
+func (n *StreamNotice) GetMessageType() string {
+	return MessageTypeOut
+}
+
+func (n *StreamNotice) GetTimestamp() time.Time {
+	return n.Timestamp
+}
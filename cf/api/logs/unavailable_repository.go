@@ -0,0 +1,22 @@
+package logs
+
+// UnavailableRepository is a Repository that cannot connect to the logging
+// endpoint, for instance because the configured certificate files are
+// invalid. Every request for logs fails with the error.
+type UnavailableRepository struct {
+	err error
+}
+
+func NewUnavailableRepository(err error) UnavailableRepository {
+	return UnavailableRepository{err: err}
+}
+
+func (repo UnavailableRepository) RecentLogsFor(appGUID string) ([]Loggable, error) {
+	return nil, repo.err
+}
+
+func (repo UnavailableRepository) TailLogsFor(appGUID string, onConnect func(), logChan chan<- Loggable, errChan chan<- error) {
+	errChan <- repo.err
+}
+
+func (repo UnavailableRepository) Close() {}
@@ -0,0 +1,32 @@
+package logs_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/cf/api/logs"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("UnavailableRepository", func() {
+	var (
+		expectedErr error
+		repo        logs.UnavailableRepository
+	)
+
+	BeforeEach(func() {
+		expectedErr = errors.New("no PEM encoded certificates found in some-path")
+		repo = logs.NewUnavailableRepository(expectedErr)
+	})
+
+	It("fails to get the recent logs", func() {
+		_, err := repo.RecentLogsFor("some-app-guid")
+		Expect(err).To(MatchError(expectedErr))
+	})
+
+	It("fails to tail the logs", func() {
+		errChan := make(chan error, 1)
+		repo.TailLogsFor("some-app-guid", func() {}, make(chan logs.Loggable), errChan)
+		Expect(errChan).To(Receive(MatchError(expectedErr)))
+	})
+})
@@ -2,7 +2,7 @@ package api
 
 import (
 	"crypto/tls"
-	"net/http"
+	"net/url"
 	"strconv"
 	"time"
 
@@ -11,6 +11,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/api/appinstances"
 	"code.cloudfoundry.org/cli/cf/api/applicationbits"
 	"code.cloudfoundry.org/cli/cf/api/applications"
+	"code.cloudfoundry.org/cli/cf/api/auditevents"
 	"code.cloudfoundry.org/cli/cf/api/authentication"
 	"code.cloudfoundry.org/cli/cf/api/copyapplicationsource"
 	"code.cloudfoundry.org/cli/cf/api/environmentvariablegroups"
@@ -33,6 +34,8 @@ import (
 	"code.cloudfoundry.org/cli/cf/terminal"
 	"code.cloudfoundry.org/cli/cf/trace"
 	"code.cloudfoundry.org/cli/cf/v3/repository"
+	"code.cloudfoundry.org/cli/util/har"
+	"code.cloudfoundry.org/cli/util/tlsconfig"
 	v3client "github.com/cloudfoundry/go-ccapi/v3/client"
 	"github.com/cloudfoundry/noaa/consumer"
 )
@@ -49,6 +52,7 @@ type RepositoryLocator struct {
 	appSummaryRepo                  AppSummaryRepository
 	appInstancesRepo                appinstances.Repository
 	appEventsRepo                   appevents.Repository
+	auditEventsRepo                 auditevents.Repository
 	appFilesRepo                    api_appfiles.Repository
 	domainRepo                      DomainRepository
 	routeRepo                       RouteRepository
@@ -62,6 +66,7 @@ type RepositoryLocator struct {
 	userRepo                        UserRepository
 	passwordRepo                    password.Repository
 	logsRepo                        logs.Repository
+	logsRepoFactory                 logs.RepositoryFactory
 	authTokenRepo                   ServiceAuthTokenRepository
 	serviceBrokerRepo               ServiceBrokerRepository
 	servicePlanRepo                 CloudControllerServicePlanRepository
@@ -97,6 +102,7 @@ func NewRepositoryLocator(config coreconfig.ReadWriter, gatewaysByName map[strin
 
 	loc.appBitsRepo = applicationbits.NewCloudControllerApplicationBitsRepository(config, cloudControllerGateway)
 	loc.appEventsRepo = appevents.NewCloudControllerAppEventsRepository(config, cloudControllerGateway, strategy)
+	loc.auditEventsRepo = auditevents.NewCloudControllerRepository(config, cloudControllerGateway)
 	loc.appFilesRepo = api_appfiles.NewCloudControllerAppFilesRepository(config, cloudControllerGateway)
 	loc.appRepo = applications.NewCloudControllerRepository(config, cloudControllerGateway)
 	loc.appSummaryRepo = NewCloudControllerAppSummaryRepository(config, cloudControllerGateway)
@@ -107,6 +113,7 @@ func NewRepositoryLocator(config coreconfig.ReadWriter, gatewaysByName map[strin
 	loc.endpointRepo = NewEndpointRepository(cloudControllerGateway)
 
 	tlsConfig := net.NewTLSConfig([]tls.Certificate{}, config.IsSSLDisabled())
+	tlsErr := tlsconfig.Apply(tlsConfig, config.CACertFile(), config.ClientCertFile(), config.ClientKeyFile())
 
 	var noaaRetryTimeout time.Duration
 	convertedTime, err := strconv.Atoi(envDialTimeout)
@@ -116,9 +123,28 @@ func NewRepositoryLocator(config coreconfig.ReadWriter, gatewaysByName map[strin
 		noaaRetryTimeout = time.Duration(convertedTime) * 3 * time.Second
 	}
 
-	consumer := consumer.New(config.DopplerEndpoint(), tlsConfig, http.ProxyFromEnvironment)
-	consumer.SetDebugPrinter(terminal.DebugPrinter{Logger: logger})
-	loc.logsRepo = logs.NewNoaaLogsRepository(config, consumer, loc.authRepo, noaaRetryTimeout)
+	authRepo := loc.authRepo
+	newLogsRepo := func() logs.Repository {
+		// Like the gateways, the logs repository reports invalid certificate
+		// files when it is first used.
+		if tlsErr != nil {
+			return logs.NewUnavailableRepository(tlsErr)
+		}
+
+		scheme := "wss"
+		if dopplerURL, parseErr := url.Parse(config.DopplerEndpoint()); parseErr == nil && dopplerURL.Scheme != "" {
+			scheme = dopplerURL.Scheme
+		}
+		consumer := consumer.New(config.DopplerEndpoint(), tlsConfig, cloudControllerGateway.Proxy().ForScheme(scheme))
+		var debugPrinter har.DebugPrinter = terminal.DebugPrinter{Logger: logger}
+		if harFile := cloudControllerGateway.HARFile(); harFile != nil {
+			debugPrinter = har.NewHandshakePrinter(harFile, debugPrinter)
+		}
+		consumer.SetDebugPrinter(debugPrinter)
+		return logs.NewNoaaLogsRepository(config, consumer, authRepo, noaaRetryTimeout)
+	}
+	loc.logsRepo = newLogsRepo()
+	loc.logsRepoFactory = logs.RepositoryFactoryFunc(newLogsRepo)
 
 	loc.organizationRepo = organizations.NewCloudControllerOrganizationRepository(config, cloudControllerGateway)
 	loc.passwordRepo = password.NewCloudControllerRepository(config, uaaGateway)
@@ -253,6 +279,15 @@ func (locator RepositoryLocator) GetAppEventsRepository() appevents.Repository {
 	return locator.appEventsRepo
 }
 
+func (locator RepositoryLocator) SetAuditEventsRepository(repo auditevents.Repository) RepositoryLocator {
+	locator.auditEventsRepo = repo
+	return locator
+}
+
+func (locator RepositoryLocator) GetAuditEventsRepository() auditevents.Repository {
+	return locator.auditEventsRepo
+}
+
 func (locator RepositoryLocator) SetAppFileRepository(repo api_appfiles.Repository) RepositoryLocator {
 	locator.appFilesRepo = repo
 	return locator
@@ -364,6 +399,15 @@ func (locator RepositoryLocator) GetLogsRepository() logs.Repository {
 	return locator.logsRepo
 }
 
+func (locator RepositoryLocator) SetLogsRepositoryFactory(factory logs.RepositoryFactory) RepositoryLocator {
+	locator.logsRepoFactory = factory
+	return locator
+}
+
+func (locator RepositoryLocator) GetLogsRepositoryFactory() logs.RepositoryFactory {
+	return locator.logsRepoFactory
+}
+
 func (locator RepositoryLocator) SetServiceAuthTokenRepository(repo ServiceAuthTokenRepository) RepositoryLocator {
 	locator.authTokenRepo = repo
 	return locator
@@ -18,11 +18,17 @@ type EventResource interface {
 type EventResourceNewV2 struct {
 	Resource
 	Entity struct {
-		Timestamp time.Time
-		Type      string
-		Actor     string `json:"actor"`
-		ActorName string `json:"actor_name"`
-		Metadata  map[string]interface{}
+		Timestamp        time.Time
+		Type             string
+		Actor            string `json:"actor"`
+		ActorType        string `json:"actor_type"`
+		ActorName        string `json:"actor_name"`
+		Actee            string `json:"actee"`
+		ActeeType        string `json:"actee_type"`
+		ActeeName        string `json:"actee_name"`
+		SpaceGUID        string `json:"space_guid"`
+		OrganizationGUID string `json:"organization_guid"`
+		Metadata         map[string]interface{}
 	}
 }
 
@@ -52,6 +58,19 @@ func (resource EventResourceNewV2) ToFields() models.EventFields {
 	}
 }
 
+func (resource EventResourceNewV2) ToAuditEvent() models.AuditEvent {
+	return models.AuditEvent{
+		EventFields:      resource.ToFields(),
+		ActorType:        resource.Entity.ActorType,
+		ActeeGUID:        resource.Entity.Actee,
+		ActeeType:        resource.Entity.ActeeType,
+		ActeeName:        resource.Entity.ActeeName,
+		SpaceGUID:        resource.Entity.SpaceGUID,
+		OrganizationGUID: resource.Entity.OrganizationGUID,
+		Metadata:         resource.Entity.Metadata,
+	}
+}
+
 func (resource EventResourceOldV2) ToFields() models.EventFields {
 	return models.EventFields{
 		GUID:      resource.Metadata.GUID,
@@ -144,6 +144,46 @@ var _ = Describe("Event resources", func() {
 			Expect(eventFields.Timestamp).To(Equal(timestamp))
 			Expect(eventFields.Description).To(Equal("disk_quota: 1024, instances: 1, state: STOPPED, environment_json: PRIVATE DATA HIDDEN"))
 		})
+
+		It("converts to an audit event with the actor, actee and scope", func() {
+			resource := new(EventResourceNewV2)
+			err := json.Unmarshal([]byte(`
+			{
+			  "metadata": {
+				"guid": "event-1-guid"
+			  },
+			  "entity": {
+				"type": "audit.service_instance.delete",
+				"actor": "user-guid",
+				"actor_type": "user",
+				"actor_name": "admin",
+				"actee": "service-instance-guid",
+				"actee_type": "service_instance",
+				"actee_name": "my-db",
+				"space_guid": "space-guid",
+				"organization_guid": "org-guid",
+				"timestamp": "2014-01-22T19:34:16+00:00",
+				"metadata": {
+				  "request": {
+					"recursive": true
+				  }
+				}
+			  }
+			}`), &resource)
+			Expect(err).NotTo(HaveOccurred())
+
+			event := resource.ToAuditEvent()
+			Expect(event.EventFields).To(Equal(resource.ToFields()))
+			Expect(event.ActorType).To(Equal("user"))
+			Expect(event.ActeeGUID).To(Equal("service-instance-guid"))
+			Expect(event.ActeeType).To(Equal("service_instance"))
+			Expect(event.ActeeName).To(Equal("my-db"))
+			Expect(event.SpaceGUID).To(Equal("space-guid"))
+			Expect(event.OrganizationGUID).To(Equal("org-guid"))
+			Expect(event.Metadata).To(Equal(map[string]interface{}{
+				"request": map[string]interface{}{"recursive": true},
+			}))
+		})
 	})
 
 	Describe("Old V2 Resources", func() {
@@ -31,6 +31,10 @@ var _ = Describe("EndpointStrategy", func() {
 				Expect(strategy.EventsURL("the-guid", 20)).To(Equal("/v2/apps/the-guid/events?results-per-page=20"))
 			})
 
+			It("ignores filters, which the app events endpoint does not support", func() {
+				Expect(strategy.FilteredEventsURL("the-guid", 20, []string{"type IN app.crash"})).To(Equal("/v2/apps/the-guid/events?results-per-page=20"))
+			})
+
 			It("returns an old EventResource", func() {
 				Expect(strategy.EventsResource()).To(BeAssignableToTypeOf(resources.EventResourceOldV2{}))
 			})
@@ -45,6 +49,10 @@ var _ = Describe("EndpointStrategy", func() {
 				Expect(strategy.EventsURL("guids-r-us", 42)).To(Equal("/v2/events?order-direction=desc&q=actee%3Aguids-r-us&results-per-page=42"))
 			})
 
+			It("adds the filters to the query", func() {
+				Expect(strategy.FilteredEventsURL("guids-r-us", 42, []string{"type IN app.crash", "timestamp>=2017-01-01T00:00:00Z"})).To(Equal("/v2/events?order-direction=desc&q=actee%3Aguids-r-us&q=type+IN+app.crash&q=timestamp%3E%3D2017-01-01T00%3A00%3A00Z&results-per-page=42"))
+			})
+
 			It("returns a new EventResource", func() {
 				Expect(strategy.EventsResource()).To(BeAssignableToTypeOf(resources.EventResourceNewV2{}))
 			})
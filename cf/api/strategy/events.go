@@ -6,6 +6,7 @@ import "code.cloudfoundry.org/cli/cf/api/resources"
 
 type EventsEndpointStrategy interface {
 	EventsURL(appGUID string, limit int64) string
+	FilteredEventsURL(appGUID string, limit int64, filters []string) string
 	EventsResource() resources.EventResource
 }
 
@@ -17,6 +18,12 @@ func (s eventsEndpointStrategy) EventsURL(appGUID string, limit int64) string {
 	})
 }
 
+// FilteredEventsURL ignores the filters because the app events endpoint does
+// not support them.
+func (s eventsEndpointStrategy) FilteredEventsURL(appGUID string, limit int64, filters []string) string {
+	return s.EventsURL(appGUID, limit)
+}
+
 func (s eventsEndpointStrategy) EventsResource() resources.EventResource {
 	return resources.EventResourceOldV2{}
 }
@@ -31,6 +38,15 @@ func (s globalEventsEndpointStrategy) EventsURL(appGUID string, limit int64) str
 	})
 }
 
+func (s globalEventsEndpointStrategy) FilteredEventsURL(appGUID string, limit int64, filters []string) string {
+	return buildURL(v2("events"), params{
+		resultsPerPage: limit,
+		orderDirection: "desc",
+		q:              map[string]string{"actee": appGUID},
+		filters:        filters,
+	})
+}
+
 func (s globalEventsEndpointStrategy) EventsResource() resources.EventResource {
 	return resources.EventResourceNewV2{}
 }
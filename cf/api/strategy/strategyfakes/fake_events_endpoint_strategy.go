@@ -18,6 +18,16 @@ type FakeEventsEndpointStrategy struct {
 	eventsURLReturns struct {
 		result1 string
 	}
+	FilteredEventsURLStub        func(appGUID string, limit int64, filters []string) string
+	filteredEventsURLMutex       sync.RWMutex
+	filteredEventsURLArgsForCall []struct {
+		appGUID string
+		limit   int64
+		filters []string
+	}
+	filteredEventsURLReturns struct {
+		result1 string
+	}
 	EventsResourceStub        func() resources.EventResource
 	eventsResourceMutex       sync.RWMutex
 	eventsResourceArgsForCall []struct{}
@@ -62,6 +72,46 @@ func (fake *FakeEventsEndpointStrategy) EventsURLReturns(result1 string) {
 	}{result1}
 }
 
+func (fake *FakeEventsEndpointStrategy) FilteredEventsURL(appGUID string, limit int64, filters []string) string {
+	var filtersCopy []string
+	if filters != nil {
+		filtersCopy = make([]string, len(filters))
+		copy(filtersCopy, filters)
+	}
+	fake.filteredEventsURLMutex.Lock()
+	fake.filteredEventsURLArgsForCall = append(fake.filteredEventsURLArgsForCall, struct {
+		appGUID string
+		limit   int64
+		filters []string
+	}{appGUID, limit, filtersCopy})
+	fake.recordInvocation("FilteredEventsURL", []interface{}{appGUID, limit, filtersCopy})
+	fake.filteredEventsURLMutex.Unlock()
+	if fake.FilteredEventsURLStub != nil {
+		return fake.FilteredEventsURLStub(appGUID, limit, filters)
+	} else {
+		return fake.filteredEventsURLReturns.result1
+	}
+}
+
+func (fake *FakeEventsEndpointStrategy) FilteredEventsURLCallCount() int {
+	fake.filteredEventsURLMutex.RLock()
+	defer fake.filteredEventsURLMutex.RUnlock()
+	return len(fake.filteredEventsURLArgsForCall)
+}
+
+func (fake *FakeEventsEndpointStrategy) FilteredEventsURLArgsForCall(i int) (string, int64, []string) {
+	fake.filteredEventsURLMutex.RLock()
+	defer fake.filteredEventsURLMutex.RUnlock()
+	return fake.filteredEventsURLArgsForCall[i].appGUID, fake.filteredEventsURLArgsForCall[i].limit, fake.filteredEventsURLArgsForCall[i].filters
+}
+
+func (fake *FakeEventsEndpointStrategy) FilteredEventsURLReturns(result1 string) {
+	fake.FilteredEventsURLStub = nil
+	fake.filteredEventsURLReturns = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeEventsEndpointStrategy) EventsResource() resources.EventResource {
 	fake.eventsResourceMutex.Lock()
 	fake.eventsResourceArgsForCall = append(fake.eventsResourceArgsForCall, struct{}{})
@@ -92,6 +142,8 @@ func (fake *FakeEventsEndpointStrategy) Invocations() map[string][][]interface{}
 	defer fake.invocationsMutex.RUnlock()
 	fake.eventsURLMutex.RLock()
 	defer fake.eventsURLMutex.RUnlock()
+	fake.filteredEventsURLMutex.RLock()
+	defer fake.filteredEventsURLMutex.RUnlock()
 	fake.eventsResourceMutex.RLock()
 	defer fake.eventsResourceMutex.RUnlock()
 	return fake.invocations
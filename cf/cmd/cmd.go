@@ -45,7 +45,7 @@ func Main(traceEnv string, args []string) {
 	args = append([]string{args[0]}, handleHelp(args[1:])...)
 
 	newArgs, isVerbose := handleVerbose(args)
-	args = newArgs
+	args, timings := handleTimings(newArgs)
 
 	errFunc := func(err error) {
 		if err != nil {
@@ -76,6 +76,11 @@ func Main(traceEnv string, args []string) {
 	deps := commandregistry.NewDependency(Writer, traceLogger, os.Getenv("CF_DIAL_TIMEOUT"))
 	defer deps.Config.Close()
 
+	if timings {
+		deps.UI.Failed(T("Request timings are not supported for this command."))
+		os.Exit(1)
+	}
+
 	warningProducers := []net.WarningProducer{}
 	for _, warningProducer := range deps.Gateways {
 		warningProducers = append(warningProducers, warningProducer)
@@ -203,6 +208,22 @@ func handleHelp(args []string) []string {
 	}
 }
 
+// handleTimings removes the --timings global flag and reports whether it was
+// given. Request timings are only collected for commands that use the V2 and
+// V3 API clients, so the legacy commands reject the flag.
+func handleTimings(args []string) ([]string, bool) {
+	var timings bool
+	newArgs := []string{}
+	for _, arg := range args {
+		if arg == "--timings" {
+			timings = true
+			continue
+		}
+		newArgs = append(newArgs, arg)
+	}
+	return newArgs, timings
+}
+
 func handleVerbose(args []string) ([]string, bool) {
 	var verbose bool
 	idx := -1
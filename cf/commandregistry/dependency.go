@@ -23,8 +23,10 @@ import (
 	"code.cloudfoundry.org/cli/cf/net"
 	"code.cloudfoundry.org/cli/cf/terminal"
 	"code.cloudfoundry.org/cli/cf/trace"
+	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/plugin/models"
 	"code.cloudfoundry.org/cli/util"
+	"code.cloudfoundry.org/cli/util/har"
 	"code.cloudfoundry.org/cli/util/words/generator"
 )
 
@@ -98,10 +100,19 @@ func NewDependency(writer io.Writer, logger trace.Printer, envDialTimeout string
 	terminal.UserAskedForColors = deps.Config.ColorEnabled()
 	terminal.InitColorSupport()
 
+	cloudControllerGateway := net.NewCloudControllerGateway(deps.Config, time.Now, deps.UI, logger, envDialTimeout)
+	uaaGateway := net.NewUAAGateway(deps.Config, deps.UI, logger, envDialTimeout)
+	routingAPIGateway := net.NewRoutingAPIGateway(deps.Config, time.Now, deps.UI, logger, envDialTimeout)
+	if harPath := os.Getenv("CF_TRACE_HAR"); harPath != "" {
+		harFile := har.NewFile(harPath, command.IsSecretHeader)
+		cloudControllerGateway.SetHARFile(harFile)
+		uaaGateway.SetHARFile(harFile)
+		routingAPIGateway.SetHARFile(harFile)
+	}
 	deps.Gateways = map[string]net.Gateway{
-		"cloud-controller": net.NewCloudControllerGateway(deps.Config, time.Now, deps.UI, logger, envDialTimeout),
-		"uaa":              net.NewUAAGateway(deps.Config, deps.UI, logger, envDialTimeout),
-		"routing-api":      net.NewRoutingAPIGateway(deps.Config, time.Now, deps.UI, logger, envDialTimeout),
+		"cloud-controller": cloudControllerGateway,
+		"uaa":              uaaGateway,
+		"routing-api":      routingAPIGateway,
 	}
 	deps.RepoLocator = api.NewRepositoryLocator(deps.Config, deps.Gateways, logger, envDialTimeout)
 
@@ -145,7 +156,8 @@ func NewDependency(writer io.Writer, logger trace.Printer, envDialTimeout string
 	deps.AppFiles = appfiles.ApplicationFiles{}
 
 	deps.RouteActor = actors.NewRouteActor(deps.UI, deps.RepoLocator.GetRouteRepository(), deps.RepoLocator.GetDomainRepository())
-	deps.PushActor = actors.NewPushActor(deps.RepoLocator.GetApplicationBitsRepository(), deps.AppZipper, deps.AppFiles, deps.RouteActor)
+	dropletUploader := actors.NewCloudControllerDropletUploader(deps.Config)
+	deps.PushActor = actors.NewPushActor(deps.RepoLocator.GetApplicationBitsRepository(), dropletUploader, deps.AppZipper, deps.AppFiles, deps.RouteActor)
 
 	deps.ChecksumUtil = util.NewSha1Checksum("")
 
@@ -2,6 +2,7 @@ package commands
 
 import (
 	"fmt"
+	"path/filepath"
 	"strings"
 
 	"code.cloudfoundry.org/cli/cf"
@@ -28,12 +29,15 @@ func (cmd API) MetaData() commandregistry.CommandMetadata {
 	fs := make(map[string]flags.FlagSet)
 	fs["unset"] = &flags.BoolFlag{Name: "unset", Usage: T("Remove all api endpoint targeting")}
 	fs["skip-ssl-validation"] = &flags.BoolFlag{Name: "skip-ssl-validation", Usage: T("Skip verification of the API endpoint. Not recommended!")}
+	fs["ca-cert"] = &flags.StringFlag{Name: "ca-cert", Usage: T("Trust the certificate authorities in this PEM encoded file, in addition to the system's")}
+	fs["client-cert"] = &flags.StringFlag{Name: "client-cert", Usage: T("Present the PEM encoded certificate in this file to servers that request one. Requires --client-key")}
+	fs["client-key"] = &flags.StringFlag{Name: "client-key", Usage: T("Private key of the client certificate, PEM encoded. Requires --client-cert")}
 
 	return commandregistry.CommandMetadata{
 		Name:        "api",
 		Description: T("Set or view target api url"),
 		Usage: []string{
-			T("CF_NAME api [URL]"),
+			T("CF_NAME api [URL] [--ca-cert CA_CERT_FILE] [--client-cert CLIENT_CERT_FILE --client-key CLIENT_KEY_FILE]"),
 		},
 		Flags: fs,
 	}
@@ -55,6 +59,7 @@ func (cmd API) Execute(c flags.FlagContext) error {
 	if c.Bool("unset") {
 		cmd.ui.Say(T("Unsetting api endpoint..."))
 		cmd.config.SetAPIEndpoint("")
+		cmd.setCertificateFiles("", "", "")
 
 		cmd.ui.Ok()
 		cmd.ui.Say(T("\nNo api endpoint set."))
@@ -73,7 +78,12 @@ func (cmd API) Execute(c flags.FlagContext) error {
 
 		cmd.ui.Say(T("Setting api endpoint to {{.Endpoint}}...",
 			map[string]interface{}{"Endpoint": terminal.EntityNameColor(endpoint)}))
-		err := cmd.setAPIEndpoint(endpoint, c.Bool("skip-ssl-validation"), cmd.MetaData().Name)
+		err := cmd.setCertificateFiles(c.String("ca-cert"), c.String("client-cert"), c.String("client-key"))
+		if err != nil {
+			return err
+		}
+
+		err = cmd.setAPIEndpoint(endpoint, c.Bool("skip-ssl-validation"), cmd.MetaData().Name)
 		if err != nil {
 			return err
 		}
@@ -102,12 +112,14 @@ func (cmd API) setAPIEndpoint(endpoint string, skipSSL bool, cmdName string) err
 	if err != nil {
 		cmd.config.SetAPIEndpoint("")
 		cmd.config.SetSSLDisabled(false)
+		cmd.setCertificateFiles("", "", "")
 
 		switch typedErr := err.(type) {
 		case *errors.InvalidSSLCert:
 			cfAPICommand := terminal.CommandColor(fmt.Sprintf("%s %s --skip-ssl-validation", cf.Name, cmdName))
-			tipMessage := fmt.Sprintf(T("TIP: Use '{{.APICommand}}' to continue with an insecure API endpoint",
-				map[string]interface{}{"APICommand": cfAPICommand}))
+			cfCACertCommand := terminal.CommandColor(fmt.Sprintf("%s api --ca-cert CA_CERT_FILE", cf.Name))
+			tipMessage := fmt.Sprintf(T("TIP: Use '{{.CACertCommand}}' to trust a private certificate authority, or '{{.APICommand}}' to continue with an insecure API endpoint",
+				map[string]interface{}{"CACertCommand": cfCACertCommand, "APICommand": cfAPICommand}))
 			return errors.New(T("Invalid SSL Cert for {{.URL}}\n{{.TipMessage}}",
 				map[string]interface{}{"URL": typedErr.URL, "TipMessage": tipMessage}))
 		default:
@@ -120,3 +132,24 @@ func (cmd API) setAPIEndpoint(endpoint string, skipSSL bool, cmdName string) err
 	}
 	return nil
 }
+
+// setCertificateFiles saves the absolute paths of the certificate files, so
+// that they can be found from any working directory.
+func (cmd API) setCertificateFiles(caCertFile string, clientCertFile string, clientKeyFile string) error {
+	paths := []*string{&caCertFile, &clientCertFile, &clientKeyFile}
+	for _, path := range paths {
+		if *path == "" {
+			continue
+		}
+		absolutePath, err := filepath.Abs(*path)
+		if err != nil {
+			return err
+		}
+		*path = absolutePath
+	}
+
+	cmd.config.SetCACertFile(caCertFile)
+	cmd.config.SetClientCertFile(clientCertFile)
+	cmd.config.SetClientKeyFile(clientKeyFile)
+	return nil
+}
@@ -2,6 +2,8 @@ package commands_test
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 
 	"code.cloudfoundry.org/cli/cf"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
@@ -196,6 +198,33 @@ var _ = Describe("Api", func() {
 			})
 		})
 
+		Context("when the user passed in certificate files", func() {
+			It("saves the absolute paths of the files in the config", func() {
+				callApi([]string{"--ca-cert", "ca.pem", "--client-cert", "/some/client.pem", "--client-key", "client-key.pem", "https://example.com"})
+				Expect(runCLIErr).NotTo(HaveOccurred())
+
+				workingDir, err := os.Getwd()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(config.CACertFile()).To(Equal(filepath.Join(workingDir, "ca.pem")))
+				Expect(config.ClientCertFile()).To(Equal("/some/client.pem"))
+				Expect(config.ClientKeyFile()).To(Equal(filepath.Join(workingDir, "client-key.pem")))
+			})
+
+			Context("when setting the endpoint fails", func() {
+				BeforeEach(func() {
+					endpointRepo.GetCCInfoReturns(nil, "", errors.NewInvalidSSLCert("https://example.com", "it don't work"))
+				})
+
+				It("clears the certificate files", func() {
+					callApi([]string{"--ca-cert", "/some/ca.pem", "https://example.com"})
+					Expect(runCLIErr).To(HaveOccurred())
+					Expect(runCLIErr.Error()).To(ContainSubstring("--ca-cert"))
+
+					Expect(config.CACertFile()).To(BeEmpty())
+				})
+			})
+		})
+
 		Context("when the ssl certificate is invalid", func() {
 			BeforeEach(func() {
 				endpointRepo.GetCCInfoReturns(nil, "", errors.NewInvalidSSLCert("https://example.com", "it don't work"))
@@ -3,21 +3,30 @@ package application
 import (
 	"errors"
 	"fmt"
+	"sort"
+	"time"
 
 	"code.cloudfoundry.org/cli/cf/api/appevents"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/flags"
 	. "code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/cf/models"
 	"code.cloudfoundry.org/cli/cf/requirements"
 	"code.cloudfoundry.org/cli/cf/terminal"
 )
 
+const (
+	DefaultEventsLimit        = 50
+	DefaultEventsPollInterval = 5 * time.Second
+)
+
 type Events struct {
-	ui         terminal.UI
-	config     coreconfig.Reader
-	appReq     requirements.ApplicationRequirement
-	eventsRepo appevents.Repository
+	ui           terminal.UI
+	config       coreconfig.Reader
+	appReq       requirements.ApplicationRequirement
+	eventsRepo   appevents.Repository
+	PollInterval time.Duration
 }
 
 func init() {
@@ -25,13 +34,27 @@ func init() {
 }
 
 func (cmd *Events) MetaData() commandregistry.CommandMetadata {
+	fs := make(map[string]flags.FlagSet)
+	fs["since"] = &flags.StringFlag{Name: "since", Usage: T("Only show events at or after this time, given as a duration ago (e.g. 90m, 2h, 7d) or a date (e.g. 2017-05-04, 2017-05-04T15:04:05Z)")}
+	fs["until"] = &flags.StringFlag{Name: "until", Usage: T("Only show events at or before this time, in the same formats as --since")}
+	fs["type"] = &flags.StringFlag{Name: "type", Usage: T("Only show events of these comma-separated types, e.g. app.crash,audit.app.update")}
+	fs["limit"] = &flags.IntFlag{Name: "limit", Usage: T("Maximum number of events to show (Default: 50)")}
+	fs["all"] = &flags.BoolFlag{Name: "all", Usage: T("Show all matching events, fetching as many pages as needed")}
+	fs["follow"] = &flags.BoolFlag{Name: "follow", Usage: T("Keep polling for new events and print them as they occur")}
+
 	return commandregistry.CommandMetadata{
 		Name:        "events",
 		Description: T("Show recent app events"),
 		Usage: []string{
 			"CF_NAME events ",
-			T("APP_NAME"),
+			T("APP_NAME [--since TIME] [--until TIME] [--type TYPES] [--limit NUMBER | --all] [--follow]"),
+		},
+		Examples: []string{
+			"CF_NAME events my-app --since 2h --type app.crash",
+			"CF_NAME events my-app --since 2017-05-01 --until 2017-05-04 --all",
+			"CF_NAME events my-app --follow",
 		},
+		Flags: fs,
 	}
 }
 
@@ -56,10 +79,16 @@ func (cmd *Events) SetDependency(deps commandregistry.Dependency, pluginCall boo
 	cmd.ui = deps.UI
 	cmd.config = deps.Config
 	cmd.eventsRepo = deps.RepoLocator.GetAppEventsRepository()
+	cmd.PollInterval = DefaultEventsPollInterval
 	return cmd
 }
 
 func (cmd *Events) Execute(c flags.FlagContext) error {
+	query, err := cmd.buildQuery(c, time.Now())
+	if err != nil {
+		return err
+	}
+
 	app := cmd.appReq.GetApplication()
 
 	cmd.ui.Say(T("Getting events for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...\n",
@@ -71,24 +100,23 @@ func (cmd *Events) Execute(c flags.FlagContext) error {
 
 	table := cmd.ui.Table([]string{T("time"), T("event"), T("actor"), T("description")})
 
-	events, err := cmd.eventsRepo.RecentEvents(app.GUID, 50)
+	var events []models.EventFields
+	if isDefaultEventsQuery(c) {
+		events, err = cmd.eventsRepo.RecentEvents(app.GUID, DefaultEventsLimit)
+	} else {
+		events, err = cmd.eventsRepo.ListEvents(app.GUID, query)
+	}
 	if err != nil {
 		return errors.New(T("Failed fetching events.\n{{.APIErr}}",
 			map[string]interface{}{"APIErr": err.Error()}))
 	}
 
-	for _, event := range events {
-		actor := event.ActorName
-		if actor == "" {
-			actor = event.Actor
-		}
+	if c.Bool("follow") {
+		sort.Stable(eventsByTimestamp(events))
+	}
 
-		table.Add(
-			event.Timestamp.Local().Format("2006-01-02T15:04:05.00-0700"),
-			event.Name,
-			actor,
-			event.Description,
-		)
+	for _, event := range events {
+		table.Add(eventRow(event)...)
 	}
 
 	err = table.Print()
@@ -99,7 +127,130 @@ func (cmd *Events) Execute(c flags.FlagContext) error {
 	if len(events) == 0 {
 		cmd.ui.Say(T("No events for app {{.AppName}}",
 			map[string]interface{}{"AppName": terminal.EntityNameColor(app.Name)}))
-		return nil
+	}
+
+	if c.Bool("follow") {
+		return cmd.follow(app.GUID, query, events, table)
 	}
 	return nil
 }
+
+// follow polls for events newer than the ones already displayed and prints
+// them in chronological order until an error occurs.
+func (cmd *Events) follow(appGUID string, query appevents.EventsQuery, events []models.EventFields, table *terminal.UITable) error {
+	since := time.Now()
+	seen := map[string]time.Time{}
+	for i, event := range events {
+		seen[event.GUID] = event.Timestamp
+		if i == 0 || event.Timestamp.After(since) {
+			since = event.Timestamp
+		}
+	}
+
+	for {
+		time.Sleep(cmd.PollInterval)
+
+		events, err := cmd.eventsRepo.ListEvents(appGUID, appevents.EventsQuery{
+			Since: since,
+			Types: query.Types,
+		})
+		if err != nil {
+			return errors.New(T("Failed fetching events.\n{{.APIErr}}",
+				map[string]interface{}{"APIErr": err.Error()}))
+		}
+
+		sort.Stable(eventsByTimestamp(events))
+		for _, event := range events {
+			if _, ok := seen[event.GUID]; ok {
+				continue
+			}
+			seen[event.GUID] = event.Timestamp
+			if event.Timestamp.After(since) {
+				since = event.Timestamp
+			}
+			table.Add(eventRow(event)...)
+		}
+
+		err = table.Print()
+		if err != nil {
+			return err
+		}
+
+		for guid, timestamp := range seen {
+			if timestamp.Before(since) {
+				delete(seen, guid)
+			}
+		}
+	}
+}
+
+func (cmd *Events) buildQuery(c flags.FlagContext, now time.Time) (appevents.EventsQuery, error) {
+	query := appevents.EventsQuery{
+		Types: flags.SplitList(c.String("type")),
+		Limit: DefaultEventsLimit,
+	}
+
+	if c.IsSet("limit") && c.Bool("all") {
+		return appevents.EventsQuery{}, errors.New(T("Incorrect Usage. --limit and --all cannot be used together"))
+	}
+	if c.IsSet("until") && c.Bool("follow") {
+		return appevents.EventsQuery{}, errors.New(T("Incorrect Usage. --until and --follow cannot be used together"))
+	}
+
+	if c.Bool("all") {
+		query.Limit = 0
+	} else if c.IsSet("limit") {
+		if c.Int("limit") <= 0 {
+			return appevents.EventsQuery{}, errors.New(T("Incorrect Usage. --limit must be a positive number"))
+		}
+		query.Limit = int64(c.Int("limit"))
+	}
+
+	var err error
+	if c.IsSet("since") {
+		query.Since, err = appevents.ParseEventTime(c.String("since"), now)
+		if err != nil {
+			return appevents.EventsQuery{}, err
+		}
+	}
+	if c.IsSet("until") {
+		query.Until, err = appevents.ParseEventTime(c.String("until"), now)
+		if err != nil {
+			return appevents.EventsQuery{}, err
+		}
+	}
+	if !query.Since.IsZero() && !query.Until.IsZero() && query.Until.Before(query.Since) {
+		return appevents.EventsQuery{}, errors.New(T("Incorrect Usage. --until must not be before --since"))
+	}
+
+	return query, nil
+}
+
+func isDefaultEventsQuery(c flags.FlagContext) bool {
+	for _, name := range []string{"since", "until", "type", "limit", "all"} {
+		if c.IsSet(name) {
+			return false
+		}
+	}
+	return true
+}
+
+func eventRow(event models.EventFields) []string {
+	actor := event.ActorName
+	if actor == "" {
+		actor = event.Actor
+	}
+
+	return []string{
+		event.Timestamp.Local().Format("2006-01-02T15:04:05.00-0700"),
+		event.Name,
+		actor,
+		event.Description,
+	}
+}
+
+type eventsByTimestamp []models.EventFields
+
+func (e eventsByTimestamp) Len() int           { return len(e) }
+func (e eventsByTimestamp) Swap(i, j int)      { e[i], e[j] = e[j], e[i] }
+func (e eventsByTimestamp) Less(i, j int) bool { return e[i].Timestamp.Before(e[j].Timestamp) }
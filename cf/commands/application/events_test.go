@@ -11,6 +11,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/models"
 	"code.cloudfoundry.org/cli/cf/requirements"
 
+	"code.cloudfoundry.org/cli/cf/api/appevents"
 	"code.cloudfoundry.org/cli/cf/api/appevents/appeventsfakes"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig/coreconfigfakes"
 	"code.cloudfoundry.org/cli/cf/requirements/requirementsfakes"
@@ -201,6 +202,150 @@ var _ = Describe("events command", func() {
 			})
 		})
 
+		Context("when a time range, types and a limit are given", func() {
+			BeforeEach(func() {
+				flagContext = flags.NewFlagContext(cmd.MetaData().Flags)
+				err := flagContext.Parse("my-app", "--since", "2017-05-04T10:00:00Z", "--until", "2h", "--type", "app.crash, audit.app.update", "--limit", "10")
+				Expect(err).NotTo(HaveOccurred())
+
+				eventsRepo.ListEventsReturns([]models.EventFields{
+					{GUID: "event-guid-1", Name: "app.crash", Actor: "some-actor"},
+				}, nil)
+
+				cmd.SetDependency(deps, false)
+				cmd.Requirements(reqFactory, flagContext)
+			})
+
+			It("lists the matching events", func() {
+				Expect(executeCmdErr).NotTo(HaveOccurred())
+				Expect(eventsRepo.RecentEventsCallCount()).To(Equal(0))
+				Expect(eventsRepo.ListEventsCallCount()).To(Equal(1))
+
+				appGUID, query := eventsRepo.ListEventsArgsForCall(0)
+				Expect(appGUID).To(Equal("my-app-guid"))
+				Expect(query.Since.Equal(time.Date(2017, 5, 4, 10, 0, 0, 0, time.UTC))).To(BeTrue())
+				Expect(query.Until).To(BeTemporally("~", time.Now().Add(-2*time.Hour), time.Minute))
+				Expect(query.Types).To(Equal([]string{"app.crash", "audit.app.update"}))
+				Expect(query.Limit).To(Equal(int64(10)))
+
+				Expect(ui.Outputs()).To(ContainSubstrings([]string{"app.crash", "some-actor"}))
+			})
+		})
+
+		Context("when --all is given", func() {
+			BeforeEach(func() {
+				flagContext = flags.NewFlagContext(cmd.MetaData().Flags)
+				err := flagContext.Parse("my-app", "--all", "--since", "7d")
+				Expect(err).NotTo(HaveOccurred())
+
+				cmd.SetDependency(deps, false)
+				cmd.Requirements(reqFactory, flagContext)
+			})
+
+			It("lists every matching event", func() {
+				Expect(executeCmdErr).NotTo(HaveOccurred())
+				_, query := eventsRepo.ListEventsArgsForCall(0)
+				Expect(query.Limit).To(BeZero())
+				Expect(query.Since).To(BeTemporally("~", time.Now().AddDate(0, 0, -7), time.Minute))
+			})
+		})
+
+		Context("when the options are invalid", func() {
+			parse := func(args ...string) {
+				flagContext = flags.NewFlagContext(cmd.MetaData().Flags)
+				err := flagContext.Parse(append([]string{"my-app"}, args...)...)
+				Expect(err).NotTo(HaveOccurred())
+
+				cmd.SetDependency(deps, false)
+				cmd.Requirements(reqFactory, flagContext)
+			}
+
+			Context("when --limit and --all are combined", func() {
+				BeforeEach(func() {
+					parse("--limit", "5", "--all")
+				})
+
+				It("fails", func() {
+					Expect(executeCmdErr).To(MatchError("Incorrect Usage. --limit and --all cannot be used together"))
+					Expect(eventsRepo.ListEventsCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the time cannot be parsed", func() {
+				BeforeEach(func() {
+					parse("--since", "last tuesday")
+				})
+
+				It("fails", func() {
+					Expect(executeCmdErr).To(HaveOccurred())
+					Expect(executeCmdErr.Error()).To(ContainSubstring("Invalid time: last tuesday"))
+				})
+			})
+
+			Context("when --until and --follow are combined", func() {
+				BeforeEach(func() {
+					parse("--until", "1h", "--follow")
+				})
+
+				It("fails", func() {
+					Expect(executeCmdErr).To(MatchError("Incorrect Usage. --until and --follow cannot be used together"))
+				})
+			})
+		})
+
+		Context("when --follow is given", func() {
+			var firstTimestamp time.Time
+
+			BeforeEach(func() {
+				firstTimestamp = time.Date(2017, 5, 4, 10, 0, 0, 0, time.UTC)
+
+				flagContext = flags.NewFlagContext(cmd.MetaData().Flags)
+				err := flagContext.Parse("my-app", "--follow", "--type", "app.crash")
+				Expect(err).NotTo(HaveOccurred())
+
+				eventsRepo.ListEventsStub = func(appGUID string, query appevents.EventsQuery) ([]models.EventFields, error) {
+					switch eventsRepo.ListEventsCallCount() {
+					case 1:
+						return []models.EventFields{
+							{GUID: "event-guid-2", Name: "app.crash", Timestamp: firstTimestamp.Add(-time.Minute), Description: "second oldest"},
+							{GUID: "event-guid-1", Name: "app.crash", Timestamp: firstTimestamp.Add(-2 * time.Minute), Description: "oldest"},
+						}, nil
+					case 2:
+						return []models.EventFields{
+							{GUID: "event-guid-3", Name: "app.crash", Timestamp: firstTimestamp, Description: "newest"},
+							{GUID: "event-guid-2", Name: "app.crash", Timestamp: firstTimestamp.Add(-time.Minute), Description: "second oldest"},
+						}, nil
+					default:
+						return nil, errors.New("connection lost")
+					}
+				}
+
+				cmd.SetDependency(deps, false)
+				cmd.PollInterval = time.Millisecond
+				cmd.Requirements(reqFactory, flagContext)
+			})
+
+			It("prints new events in chronological order until polling fails", func() {
+				Expect(executeCmdErr).To(HaveOccurred())
+				Expect(executeCmdErr.Error()).To(ContainSubstring("connection lost"))
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"oldest"},
+					[]string{"second oldest"},
+					[]string{"newest"},
+				))
+				Expect(ui.Outputs()).To(HaveLen(6))
+
+				_, query := eventsRepo.ListEventsArgsForCall(1)
+				Expect(query.Since).To(Equal(firstTimestamp.Add(-time.Minute)))
+				Expect(query.Types).To(Equal([]string{"app.crash"}))
+				Expect(query.Limit).To(BeZero())
+
+				_, query = eventsRepo.ListEventsArgsForCall(2)
+				Expect(query.Since).To(Equal(firstTimestamp))
+			})
+		})
+
 		Context("when the request fails", func() {
 			BeforeEach(func() {
 				eventsRepo.RecentEventsReturns([]models.EventFields{}, errors.New("welp"))
@@ -2,8 +2,14 @@ package application
 
 import (
 	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
+	"unicode/utf8"
 
+	"code.cloudfoundry.org/cli/cf/api"
 	"code.cloudfoundry.org/cli/cf/api/logs"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
@@ -16,12 +22,24 @@ import (
 )
 
 type Logs struct {
-	ui       terminal.UI
-	logsRepo logs.Repository
-	config   coreconfig.Reader
-	appReq   requirements.ApplicationRequirement
+	ui              terminal.UI
+	logsRepo        logs.Repository
+	logsRepoFactory logs.RepositoryFactory
+	appSummaryRepo  api.AppSummaryRepository
+	config          coreconfig.Reader
+	appReqs         []requirements.ApplicationRequirement
+	filter          logs.Filter
+	jsonOutput      bool
+	location        *time.Location
+	rtrStats        *logs.RouterStats
 }
 
+const (
+	rtrStatsInterval = 10 * time.Second
+	rtrStatsWindow   = time.Minute
+	rtrStatsMaxPaths = 5
+)
+
 func init() {
 	commandregistry.Register(&Logs{})
 }
@@ -29,29 +47,63 @@ func init() {
 func (cmd *Logs) MetaData() commandregistry.CommandMetadata {
 	fs := make(map[string]flags.FlagSet)
 	fs["recent"] = &flags.BoolFlag{Name: "recent", Usage: T("Dump recent logs instead of tailing")}
+	fs["all-in-space"] = &flags.BoolFlag{Name: "all-in-space", Usage: T("Show logs for all apps in the targeted space")}
+	fs["source"] = &flags.StringFlag{Name: "source", Usage: T("Only show logs from these comma-separated source types, e.g. APP,RTR,STG,API,CELL")}
+	fs["instance"] = &flags.StringFlag{Name: "instance", Usage: T("Only show logs from these comma-separated instance indexes")}
+	fs["type"] = &flags.StringFlag{Name: "type", Usage: T("Only show logs of this message type (stdout or stderr)")}
+	fs["grep"] = &flags.StringFlag{Name: "grep", Usage: T("Only show log lines matching this regular expression")}
+	fs["format"] = &flags.StringFlag{Name: "format", Usage: T("Output format: text (default) or json, which prints one JSON object per log message")}
+	fs["timezone"] = &flags.StringFlag{Name: "timezone", Usage: T("Show text timestamps in this time zone, e.g. Europe/Berlin (default: local time)")}
+	fs["utc"] = &flags.BoolFlag{Name: "utc", Usage: T("Show text timestamps in UTC")}
+	fs["rtr-stats"] = &flags.BoolFlag{Name: "rtr-stats", Usage: T("Summarize router access logs instead of printing them: request rate, status codes, response time percentiles and slowest paths")}
 
 	return commandregistry.CommandMetadata{
 		Name:        "logs",
 		Description: T("Tail or show recent logs for an app"),
 		Usage: []string{
-			T("CF_NAME logs APP_NAME"),
+			T("CF_NAME logs APP_NAME [APP_NAME...]\n\n   CF_NAME logs --all-in-space"),
+		},
+		Examples: []string{
+			"CF_NAME logs my-app --recent --source APP,RTR",
+			"CF_NAME logs frontend backend worker",
+			"CF_NAME logs my-app --instance 0,1 --type stderr --grep 'timeout|refused'",
+			"CF_NAME logs my-app --recent --format json | jq .message",
+			"CF_NAME logs my-app --rtr-stats",
 		},
 		Flags: fs,
 	}
 }
 
 func (cmd *Logs) Requirements(requirementsFactory requirements.Factory, fc flags.FlagContext) ([]requirements.Requirement, error) {
-	if len(fc.Args()) != 1 {
+	if fc.Bool("all-in-space") {
+		if len(fc.Args()) != 0 {
+			cmd.ui.Failed(T("Incorrect Usage. App names cannot be provided with --all-in-space\n\n") + commandregistry.Commands.CommandUsage("logs"))
+			return nil, fmt.Errorf("Incorrect usage: %d arguments of %d required", len(fc.Args()), 0)
+		}
+	} else if len(fc.Args()) == 0 {
 		cmd.ui.Failed(T("Incorrect Usage. Requires an argument\n\n") + commandregistry.Commands.CommandUsage("logs"))
 		return nil, fmt.Errorf("Incorrect usage: %d arguments of %d required", len(fc.Args()), 1)
 	}
 
-	cmd.appReq = requirementsFactory.NewApplicationRequirement(fc.Args()[0])
+	for _, instance := range flags.SplitList(fc.String("instance")) {
+		if index, err := strconv.Atoi(instance); err != nil || index < 0 {
+			cmd.ui.Failed(T("Incorrect Usage. Invalid instance: {{.Instance}}\nInstance must be a non-negative integer\n\n", map[string]interface{}{
+				"Instance": instance,
+			}) + commandregistry.Commands.CommandUsage("logs"))
+			return nil, fmt.Errorf("Incorrect usage: invalid instance %s", instance)
+		}
+	}
 
 	reqs := []requirements.Requirement{
 		requirementsFactory.NewLoginRequirement(),
 		requirementsFactory.NewTargetedSpaceRequirement(),
-		cmd.appReq,
+	}
+
+	cmd.appReqs = nil
+	for _, appName := range fc.Args() {
+		appReq := requirementsFactory.NewApplicationRequirement(appName)
+		cmd.appReqs = append(cmd.appReqs, appReq)
+		reqs = append(reqs, appReq)
 	}
 
 	return reqs, nil
@@ -61,26 +113,66 @@ func (cmd *Logs) SetDependency(deps commandregistry.Dependency, pluginCall bool)
 	cmd.ui = deps.UI
 	cmd.config = deps.Config
 	cmd.logsRepo = deps.RepoLocator.GetLogsRepository()
+	cmd.logsRepoFactory = deps.RepoLocator.GetLogsRepositoryFactory()
+	cmd.appSummaryRepo = deps.RepoLocator.GetAppSummaryRepository()
 	return cmd
 }
 
 func (cmd *Logs) Execute(c flags.FlagContext) error {
-	app := cmd.appReq.GetApplication()
-
-	var err error
-	if c.Bool("recent") {
-		err = cmd.recentLogsFor(app)
-	} else {
-		err = cmd.tailLogsFor(app)
+	filter, err := cmd.buildFilter(c)
+	if err != nil {
+		return err
 	}
+	cmd.filter = filter
+
+	err = cmd.setOutputFormat(c)
 	if err != nil {
 		return err
 	}
-	return nil
+
+	if c.Bool("rtr-stats") {
+		if cmd.jsonOutput {
+			return errors.New(T("Incorrect Usage. --rtr-stats cannot be used with --format json"))
+		}
+		cmd.rtrStats = &logs.RouterStats{}
+		if !c.Bool("recent") {
+			cmd.rtrStats.Window = rtrStatsWindow
+		}
+	} else {
+		cmd.rtrStats = nil
+	}
+
+	var apps []models.Application
+	if c.Bool("all-in-space") {
+		apps, err = cmd.appSummaryRepo.GetSummariesInCurrentSpace()
+		if err != nil {
+			return err
+		}
+		if len(apps) == 0 {
+			cmd.sayBanner(T("No apps found"))
+			return nil
+		}
+	} else {
+		for _, appReq := range cmd.appReqs {
+			apps = append(apps, appReq.GetApplication())
+		}
+	}
+
+	if len(apps) == 1 {
+		if c.Bool("recent") {
+			return cmd.recentLogsFor(apps[0])
+		}
+		return cmd.tailLogsFor(apps[0])
+	}
+
+	if c.Bool("recent") {
+		return cmd.recentLogsForApps(apps)
+	}
+	return cmd.tailLogsForApps(apps)
 }
 
 func (cmd *Logs) recentLogsFor(app models.Application) error {
-	cmd.ui.Say(T("Connected, dumping recent logs for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...\n",
+	cmd.sayBanner(T("Connected, dumping recent logs for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...\n",
 		map[string]interface{}{
 			"AppName":   terminal.EntityNameColor(app.Name),
 			"OrgName":   terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
@@ -92,15 +184,38 @@ func (cmd *Logs) recentLogsFor(app models.Application) error {
 		return cmd.handleError(err)
 	}
 
-	for _, msg := range messages {
-		cmd.ui.Say("%s", msg.ToLog(time.Local))
+	cmd.printLogs(messages)
+	return nil
+}
+
+func (cmd *Logs) recentLogsForApps(apps []models.Application) error {
+	cmd.sayBanner(T("Connected, dumping recent logs for apps {{.AppNames}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...\n",
+		map[string]interface{}{
+			"AppNames":  terminal.EntityNameColor(appNames(apps)),
+			"OrgName":   terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
+			"SpaceName": terminal.EntityNameColor(cmd.config.SpaceFields().Name),
+			"Username":  terminal.EntityNameColor(cmd.config.Username())}))
+
+	prefixes := appLogPrefixes(apps)
+	messageSets := make([][]logs.Loggable, len(apps))
+	for i, app := range apps {
+		messages, err := cmd.logsRepo.RecentLogsFor(app.GUID)
+		if err != nil {
+			return cmd.handleError(err)
+		}
+
+		for _, msg := range messages {
+			messageSets[i] = append(messageSets[i], appLogMessage{Loggable: msg, prefix: prefixes[i]})
+		}
 	}
+
+	cmd.printLogs(logs.MergeRecentLogs(messageSets...))
 	return nil
 }
 
 func (cmd *Logs) tailLogsFor(app models.Application) error {
 	onConnect := func() {
-		cmd.ui.Say(T("Connected, tailing logs for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...\n",
+		cmd.sayBanner(T("Connected, tailing logs for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...\n",
 			map[string]interface{}{
 				"AppName":   terminal.EntityNameColor(app.Name),
 				"OrgName":   terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
@@ -113,24 +228,286 @@ func (cmd *Logs) tailLogsFor(app models.Application) error {
 
 	go cmd.logsRepo.TailLogsFor(app.GUID, onConnect, c, e)
 
+	return cmd.printStream(c, e)
+}
+
+func (cmd *Logs) tailLogsForApps(apps []models.Application) error {
+	var connectOnce sync.Once
+	onConnect := func() {
+		connectOnce.Do(func() {
+			cmd.sayBanner(T("Connected, tailing logs for apps {{.AppNames}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...\n",
+				map[string]interface{}{
+					"AppNames":  terminal.EntityNameColor(appNames(apps)),
+					"OrgName":   terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
+					"SpaceName": terminal.EntityNameColor(cmd.config.SpaceFields().Name),
+					"Username":  terminal.EntityNameColor(cmd.config.Username())}))
+		})
+	}
+
+	merged := make(chan logs.Loggable)
+	mergedErrs := make(chan error, len(apps))
+	prefixes := appLogPrefixes(apps)
+
+	var wg sync.WaitGroup
+	for i, app := range apps {
+		repo := cmd.logsRepoFactory.NewRepository()
+		defer repo.Close()
+
+		c := make(chan logs.Loggable)
+		e := make(chan error)
+		go repo.TailLogsFor(app.GUID, onConnect, c, e)
+
+		wg.Add(1)
+		go func(prefix string, c <-chan logs.Loggable, e <-chan error) {
+			defer wg.Done()
+			for {
+				select {
+				case msg, ok := <-c:
+					if !ok {
+						return
+					}
+					merged <- appLogMessage{Loggable: msg, prefix: prefix}
+				case err, ok := <-e:
+					if !ok {
+						e = nil
+						continue
+					}
+					if err != nil {
+						mergedErrs <- err
+						return
+					}
+				}
+			}
+		}(prefixes[i], c, e)
+	}
+
+	go func() {
+		wg.Wait()
+		close(merged)
+	}()
+
+	return cmd.printStream(merged, mergedErrs)
+}
+
+func (cmd *Logs) printLogs(messages []logs.Loggable) {
+	for _, msg := range messages {
+		if !cmd.filter.Matches(msg) {
+			continue
+		}
+		cmd.handleLog(msg)
+	}
+	cmd.printRouterStats()
+}
+
+func (cmd *Logs) printStream(c <-chan logs.Loggable, e <-chan error) error {
+	var refresh <-chan time.Time
+	if cmd.rtrStats != nil {
+		ticker := time.NewTicker(rtrStatsInterval)
+		defer ticker.Stop()
+		refresh = ticker.C
+	}
+
 	for {
 		select {
 		case msg, ok := <-c:
 			if !ok {
+				cmd.printRouterStats()
 				return nil
 			}
-			cmd.ui.Say("%s", msg.ToLog(time.Local))
+			if !cmd.filter.Matches(msg) {
+				continue
+			}
+			cmd.handleLog(msg)
+		case <-refresh:
+			cmd.printRouterStats()
 		case err := <-e:
 			return cmd.handleError(err)
 		}
 	}
 }
 
+// handleLog prints the message or, when summarizing router access logs,
+// records it. Notices about the log stream are printed in either case.
+func (cmd *Logs) handleLog(msg logs.Loggable) {
+	if cmd.rtrStats == nil {
+		cmd.printLog(msg)
+		return
+	}
+
+	if !cmd.rtrStats.Add(msg) && msg.GetSourceName() == logs.StreamNoticeSource {
+		cmd.printLog(msg)
+	}
+}
+
+func (cmd *Logs) printRouterStats() {
+	if cmd.rtrStats == nil {
+		return
+	}
+
+	now := time.Now()
+	summary := cmd.rtrStats.Summary(now, rtrStatsMaxPaths)
+	if cmd.rtrStats.Window > 0 {
+		cmd.ui.Say(T("\nRouter statistics for the last {{.Window}} as of {{.Time}}:", map[string]interface{}{
+			"Window": cmd.rtrStats.Window,
+			"Time":   now.In(cmd.location).Format("15:04:05"),
+		}))
+	} else {
+		cmd.ui.Say(T("\nRouter statistics:"))
+	}
+
+	if summary.Requests == 0 {
+		cmd.ui.Say(T("No router access logs found"))
+		return
+	}
+
+	statusCounts := make([]string, len(summary.StatusCounts))
+	for i, statusCount := range summary.StatusCounts {
+		statusCounts[i] = fmt.Sprintf("%d: %d (%.1f%%)", statusCount.StatusCode, statusCount.Requests, 100*float64(statusCount.Requests)/float64(summary.Requests))
+	}
+
+	cmd.ui.Say("%s %d (%.2f/s)", terminal.HeaderColor(T("requests:")), summary.Requests, summary.RequestsPerSec)
+	cmd.ui.Say("%s %s", terminal.HeaderColor(T("status codes:")), strings.Join(statusCounts, ", "))
+	cmd.ui.Say("%s p50 %s, p95 %s, p99 %s\n", terminal.HeaderColor(T("response time:")), summary.P50, summary.P95, summary.P99)
+
+	table := cmd.ui.Table([]string{T("slowest paths"), T("requests"), T("mean"), T("max")})
+	for _, path := range summary.SlowestPaths {
+		table.Add(path.Path, strconv.Itoa(path.Requests), path.MeanResponseTime.String(), path.MaximumResponseTime.String())
+	}
+	_ = table.Print()
+}
+
+func (cmd *Logs) printLog(msg logs.Loggable) {
+	if !cmd.jsonOutput {
+		cmd.ui.Say("%s", msg.ToLog(cmd.location))
+		return
+	}
+
+	line, err := logs.ToJSON(msg)
+	if err != nil {
+		cmd.ui.Warn(T("Unable to convert log message to JSON: {{.Err}}", map[string]interface{}{"Err": err.Error()}))
+		return
+	}
+	cmd.ui.Say("%s", line)
+}
+
+// sayBanner displays informational messages that are not log lines. They are
+// left out of JSON output so that every line of it can be parsed.
+func (cmd *Logs) sayBanner(message string) {
+	if cmd.jsonOutput {
+		return
+	}
+	cmd.ui.Say(message)
+}
+
+func (cmd *Logs) setOutputFormat(c flags.FlagContext) error {
+	switch strings.ToLower(c.String("format")) {
+	case "", "text":
+		cmd.jsonOutput = false
+	case "json":
+		cmd.jsonOutput = true
+	default:
+		return errors.New(T("Invalid format: {{.Format}}\nFormat must be text or json", map[string]interface{}{
+			"Format": c.String("format"),
+		}))
+	}
+
+	timezone := c.String("timezone")
+	if timezone != "" && c.Bool("utc") {
+		return errors.New(T("Incorrect Usage. --timezone and --utc cannot be used together"))
+	}
+	if cmd.jsonOutput && (timezone != "" || c.Bool("utc")) {
+		return errors.New(T("Incorrect Usage. --timezone and --utc only apply to the text format; JSON timestamps are always in UTC"))
+	}
+
+	cmd.location = time.Local
+	if c.Bool("utc") {
+		cmd.location = time.UTC
+	} else if timezone != "" {
+		location, err := time.LoadLocation(timezone)
+		if err != nil {
+			return errors.New(T("Invalid time zone: {{.TimeZone}}", map[string]interface{}{
+				"TimeZone": timezone,
+			}))
+		}
+		cmd.location = location
+	}
+
+	return nil
+}
+
+func (cmd *Logs) buildFilter(c flags.FlagContext) (logs.Filter, error) {
+	filter := logs.Filter{
+		SourceTypes: flags.SplitList(c.String("source")),
+		Instances:   flags.SplitList(c.String("instance")),
+	}
+
+	for _, messageType := range flags.SplitList(c.String("type")) {
+		switch strings.ToLower(messageType) {
+		case "stdout", "out":
+			filter.MessageTypes = append(filter.MessageTypes, logs.MessageTypeOut)
+		case "stderr", "err":
+			filter.MessageTypes = append(filter.MessageTypes, logs.MessageTypeErr)
+		default:
+			return logs.Filter{}, errors.New(T("Invalid message type: {{.MessageType}}\nMessage type must be stdout or stderr", map[string]interface{}{
+				"MessageType": messageType,
+			}))
+		}
+	}
+
+	if pattern := c.String("grep"); pattern != "" {
+		regex, err := regexp.Compile(pattern)
+		if err != nil {
+			return logs.Filter{}, errors.New(T("Invalid regular expression {{.Pattern}}: {{.Err}}", map[string]interface{}{
+				"Pattern": pattern,
+				"Err":     err.Error(),
+			}))
+		}
+		filter.Pattern = regex
+	}
+
+	return filter, nil
+}
+
+// appLogMessage prefixes a log message with the name of the app it belongs to
+// when the logs of several apps are merged into one stream.
+type appLogMessage struct {
+	logs.Loggable
+	prefix string
+}
+
+func (m appLogMessage) ToLog(loc *time.Location) string {
+	return m.prefix + m.Loggable.ToLog(loc)
+}
+
+func appLogPrefixes(apps []models.Application) []string {
+	width := 0
+	for _, app := range apps {
+		if nameLength := utf8.RuneCountInString(app.Name); nameLength > width {
+			width = nameLength
+		}
+	}
+
+	prefixes := make([]string, len(apps))
+	for i, app := range apps {
+		padding := strings.Repeat(" ", width-utf8.RuneCountInString(app.Name))
+		prefixes[i] = terminal.LogPrefixColor(fmt.Sprintf("[%s]", app.Name), i) + padding + " "
+	}
+	return prefixes
+}
+
+func appNames(apps []models.Application) string {
+	names := make([]string, len(apps))
+	for i, app := range apps {
+		names[i] = app.Name
+	}
+	return strings.Join(names, ", ")
+}
+
 func (cmd *Logs) handleError(err error) error {
 	switch err.(type) {
 	case nil:
 	case *errors.InvalidSSLCert:
-		return errors.New(err.Error() + T("\nTIP: use 'cf login -a API --skip-ssl-validation' or 'cf api API --skip-ssl-validation' to suppress this error"))
+		return errors.New(err.Error() + T("\nTIP: use 'cf api API --ca-cert CA_CERT_FILE' to trust a private certificate authority, or 'cf login -a API --skip-ssl-validation' or 'cf api API --skip-ssl-validation' to suppress this error"))
 	default:
 		return err
 	}
@@ -1,6 +1,10 @@
 package application_test
 
 import (
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/cli/cf/api/apifakes"
 	"code.cloudfoundry.org/cli/cf/api/logs"
 	"code.cloudfoundry.org/cli/cf/api/logs/logsfakes"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
@@ -22,6 +26,8 @@ var _ = Describe("logs command", func() {
 	var (
 		ui                  *testterm.FakeUI
 		logsRepo            *logsfakes.FakeRepository
+		logsRepoFactory     *logsfakes.FakeRepositoryFactory
+		appSummaryRepo      *apifakes.FakeAppSummaryRepository
 		requirementsFactory *requirementsfakes.FakeFactory
 		configRepo          coreconfig.Repository
 		deps                commandregistry.Dependency
@@ -30,6 +36,8 @@ var _ = Describe("logs command", func() {
 	updateCommandDependency := func(pluginCall bool) {
 		deps.UI = ui
 		deps.RepoLocator = deps.RepoLocator.SetLogsRepository(logsRepo)
+		deps.RepoLocator = deps.RepoLocator.SetLogsRepositoryFactory(logsRepoFactory)
+		deps.RepoLocator = deps.RepoLocator.SetAppSummaryRepository(appSummaryRepo)
 		deps.Config = configRepo
 		commandregistry.Commands.SetCommand(commandregistry.Commands.FindCommand("logs").SetDependency(deps, pluginCall))
 	}
@@ -38,6 +46,8 @@ var _ = Describe("logs command", func() {
 		ui = &testterm.FakeUI{}
 		configRepo = testconfig.NewRepositoryWithDefaults()
 		logsRepo = new(logsfakes.FakeRepository)
+		logsRepoFactory = new(logsfakes.FakeRepositoryFactory)
+		appSummaryRepo = new(apifakes.FakeAppSummaryRepository)
 		requirementsFactory = new(requirementsfakes.FakeFactory)
 	})
 
@@ -55,6 +65,15 @@ var _ = Describe("logs command", func() {
 			))
 		})
 
+		It("fails with usage when app names are combined with --all-in-space", func() {
+			requirementsFactory.NewLoginRequirementReturns(requirements.Passing{})
+
+			runCommand("--all-in-space", "my-app")
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Incorrect Usage", "--all-in-space"},
+			))
+		})
+
 		It("fails requirements when not logged in", func() {
 			requirementsFactory.NewLoginRequirementReturns(requirements.Failing{})
 
@@ -152,6 +171,353 @@ var _ = Describe("logs command", func() {
 			))
 		})
 
+		Context("when filters are provided", func() {
+			BeforeEach(func() {
+				appMessage := logsfakes.FakeLoggable{}
+				appMessage.ToLogReturns("App Line")
+				appMessage.ToSimpleLogReturns("app says hello")
+				appMessage.GetSourceNameReturns("APP")
+				appMessage.GetSourceInstanceReturns("0")
+				appMessage.GetMessageTypeReturns(logs.MessageTypeOut)
+
+				routerMessage := logsfakes.FakeLoggable{}
+				routerMessage.ToLogReturns("Router Line")
+				routerMessage.ToSimpleLogReturns("GET /health 200")
+				routerMessage.GetSourceNameReturns("RTR")
+				routerMessage.GetSourceInstanceReturns("1")
+				routerMessage.GetMessageTypeReturns(logs.MessageTypeOut)
+
+				errorMessage := logsfakes.FakeLoggable{}
+				errorMessage.ToLogReturns("Error Line")
+				errorMessage.ToSimpleLogReturns("app says goodbye")
+				errorMessage.GetSourceNameReturns("APP")
+				errorMessage.GetSourceInstanceReturns("1")
+				errorMessage.GetMessageTypeReturns(logs.MessageTypeErr)
+
+				filteredLogs := []logs.Loggable{&appMessage, &routerMessage, &errorMessage}
+				logsRepo.RecentLogsForReturns(filteredLogs, nil)
+				logsRepo.TailLogsForStub = func(appGUID string, onConnect func(), logChan chan<- logs.Loggable, errChan chan<- error) {
+					onConnect()
+					go func() {
+						for _, log := range filteredLogs {
+							logChan <- log
+						}
+						close(logChan)
+						close(errChan)
+					}()
+				}
+			})
+
+			It("filters recent logs by source type", func() {
+				runCommand("--recent", "--source", "rtr", "my-app")
+				Expect(ui.Outputs()).To(ContainSubstrings([]string{"Router Line"}))
+				Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"App Line"}))
+				Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"Error Line"}))
+			})
+
+			It("filters tailed logs by instance and message type", func() {
+				runCommand("--instance", "1", "--type", "stderr", "my-app")
+				Expect(ui.Outputs()).To(ContainSubstrings([]string{"Error Line"}))
+				Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"App Line"}))
+				Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"Router Line"}))
+			})
+
+			It("filters by a regular expression on the message", func() {
+				runCommand("--recent", "--grep", "^app says", "my-app")
+				Expect(ui.Outputs()).To(ContainSubstrings([]string{"App Line"}, []string{"Error Line"}))
+				Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"Router Line"}))
+			})
+
+			It("fails when the message type is invalid", func() {
+				Expect(runCommand("--type", "stdin", "my-app")).To(BeFalse())
+				Expect(ui.Outputs()).To(ContainSubstrings([]string{"Invalid message type: stdin"}))
+				Expect(logsRepo.TailLogsForCallCount()).To(Equal(0))
+			})
+
+			It("fails when the instance is not a number", func() {
+				Expect(runCommand("--instance", "first", "my-app")).To(BeFalse())
+				Expect(ui.Outputs()).To(ContainSubstrings([]string{"Invalid instance: first"}))
+			})
+
+			It("fails with a usage error when the instance is negative", func() {
+				Expect(runCommand("--instance", "0,-1", "my-app")).To(BeFalse())
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"Incorrect Usage", "Invalid instance: -1"},
+					[]string{"USAGE"},
+				))
+				Expect(logsRepo.TailLogsForCallCount()).To(Equal(0))
+			})
+
+			It("fails when the regular expression is invalid", func() {
+				Expect(runCommand("--recent", "--grep", "(", "my-app")).To(BeFalse())
+				Expect(ui.Outputs()).To(ContainSubstrings([]string{"Invalid regular expression"}))
+				Expect(logsRepo.RecentLogsForCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when an output format is provided", func() {
+			var message *logsfakes.FakeLoggable
+
+			BeforeEach(func() {
+				message = new(logsfakes.FakeLoggable)
+				message.ToLogReturns("Text Line")
+				message.ToSimpleLogReturns("hello")
+				message.GetAppGUIDReturns("my-app-guid")
+				message.GetSourceNameReturns("APP")
+				message.GetSourceInstanceReturns("0")
+				message.GetMessageTypeReturns(logs.MessageTypeOut)
+				message.GetTimestampReturns(time.Date(2017, 5, 4, 10, 30, 15, 5, time.UTC))
+
+				logsRepo.RecentLogsForReturns([]logs.Loggable{message}, nil)
+			})
+
+			It("prints one JSON object per message without banners", func() {
+				runCommand("--recent", "--format", "json", "my-app")
+
+				Expect(ui.Outputs()).To(Equal([]string{
+					`{"app_guid":"my-app-guid","source_type":"APP","source_instance":"0","message_type":"OUT","timestamp":"2017-05-04T10:30:15.000000005Z","message":"hello"}`,
+				}))
+			})
+
+			It("renders text timestamps in local time by default", func() {
+				runCommand("--recent", "my-app")
+				Expect(message.ToLogArgsForCall(0)).To(Equal(time.Local))
+			})
+
+			It("renders text timestamps in UTC with --utc", func() {
+				runCommand("--recent", "--utc", "my-app")
+				Expect(message.ToLogArgsForCall(0)).To(Equal(time.UTC))
+			})
+
+			It("renders text timestamps in the given time zone", func() {
+				runCommand("--recent", "--timezone", "UTC", "my-app")
+				Expect(message.ToLogArgsForCall(0).String()).To(Equal("UTC"))
+			})
+
+			It("fails when the time zone is unknown", func() {
+				Expect(runCommand("--recent", "--timezone", "Mars/Olympus_Mons", "my-app")).To(BeFalse())
+				Expect(ui.Outputs()).To(ContainSubstrings([]string{"Invalid time zone: Mars/Olympus_Mons"}))
+			})
+
+			It("fails when --timezone and --utc are combined", func() {
+				Expect(runCommand("--recent", "--timezone", "UTC", "--utc", "my-app")).To(BeFalse())
+				Expect(ui.Outputs()).To(ContainSubstrings([]string{"--timezone and --utc cannot be used together"}))
+			})
+
+			It("fails when a time zone is given for JSON output", func() {
+				Expect(runCommand("--recent", "--format", "json", "--utc", "my-app")).To(BeFalse())
+				Expect(logsRepo.RecentLogsForCallCount()).To(Equal(0))
+			})
+
+			It("fails when the format is unknown", func() {
+				Expect(runCommand("--recent", "--format", "xml", "my-app")).To(BeFalse())
+				Expect(ui.Outputs()).To(ContainSubstrings([]string{"Invalid format: xml"}))
+			})
+		})
+
+		Context("when --rtr-stats is provided", func() {
+			BeforeEach(func() {
+				rtrMessage := func(path string, status int, responseTime string, timestamp time.Time) logs.Loggable {
+					message := new(logsfakes.FakeLoggable)
+					message.ToLogReturns("Router Line")
+					message.ToSimpleLogReturns(`my-app.example.com - [2017-03-02T18:40:56.123+0000] "GET ` + path + ` HTTP/1.1" ` + strconv.Itoa(status) +
+						` 0 120 "-" "curl/7.43.0" "10.0.0.1:54321" "10.0.0.2:61001" x_forwarded_for:"10.0.0.1" response_time:` + responseTime + ` app_id:"my-app-guid"`)
+					message.GetSourceNameReturns("RTR")
+					message.GetTimestampReturns(timestamp)
+					return message
+				}
+
+				appMessage := new(logsfakes.FakeLoggable)
+				appMessage.ToLogReturns("App Line")
+				appMessage.GetSourceNameReturns("APP")
+
+				// The tailed stream only summarizes the requests of the last
+				// minute, so the requests are recent.
+				now := time.Now()
+				logsRepo.RecentLogsForReturns([]logs.Loggable{
+					rtrMessage("/", 200, "0.010", now.Add(-3*time.Second)),
+					appMessage,
+					rtrMessage("/reports?year=2017", 500, "1.5", now.Add(-2*time.Second)),
+					rtrMessage("/", 200, "0.020", now.Add(-time.Second)),
+				}, nil)
+			})
+
+			It("summarizes the router access logs instead of printing them", func() {
+				runCommand("--recent", "--rtr-stats", "my-app")
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"Router statistics"},
+					[]string{"requests:", "3", "1.50/s"},
+					[]string{"status codes:", "200: 2 (66.7%)", "500: 1 (33.3%)"},
+					[]string{"response time:", "p50 20ms", "p95 1.5s", "p99 1.5s"},
+					[]string{"slowest paths", "requests", "mean", "max"},
+					[]string{"/reports", "1", "1.5s", "1.5s"},
+					[]string{"/", "2", "15ms", "20ms"},
+				))
+				Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"Router Line"}))
+				Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"App Line"}))
+			})
+
+			It("prints a summary when the tailed stream ends", func() {
+				logsRepo.TailLogsForStub = func(appGUID string, onConnect func(), logChan chan<- logs.Loggable, errChan chan<- error) {
+					onConnect()
+					go func() {
+						messages, _ := logsRepo.RecentLogsFor(appGUID)
+						for _, message := range messages {
+							logChan <- message
+						}
+						close(logChan)
+						close(errChan)
+					}()
+				}
+
+				runCommand("--rtr-stats", "my-app")
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"Connected, tailing logs for app"},
+					[]string{"Router statistics for the last 1m0s"},
+					[]string{"requests:", "3"},
+				))
+			})
+
+			It("fails when combined with JSON output", func() {
+				Expect(runCommand("--recent", "--rtr-stats", "--format", "json", "my-app")).To(BeFalse())
+				Expect(logsRepo.RecentLogsForCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when several apps are given", func() {
+			var (
+				apps      []models.Application
+				appRepos  []*logsfakes.FakeRepository
+				appReqs   []*requirementsfakes.FakeApplicationRequirement
+				logsByApp map[string][]logs.Loggable
+			)
+
+			newMessage := func(text string, seconds int64) logs.Loggable {
+				message := new(logsfakes.FakeLoggable)
+				message.ToLogReturns(text)
+				message.GetTimestampReturns(time.Unix(seconds, 0))
+				return message
+			}
+
+			BeforeEach(func() {
+				apps = []models.Application{{}, {}}
+				apps[0].Name = "frontend"
+				apps[0].GUID = "frontend-guid"
+				apps[1].Name = "api"
+				apps[1].GUID = "api-guid"
+
+				appReqs = nil
+				for _, app := range apps {
+					appReq := new(requirementsfakes.FakeApplicationRequirement)
+					appReq.GetApplicationReturns(app)
+					appReqs = append(appReqs, appReq)
+				}
+				requirementsFactory.NewApplicationRequirementStub = func(name string) requirements.ApplicationRequirement {
+					if name == "api" {
+						return appReqs[1]
+					}
+					return appReqs[0]
+				}
+
+				logsByApp = map[string][]logs.Loggable{
+					"frontend-guid": {newMessage("frontend line 1", 1), newMessage("frontend line 2", 3)},
+					"api-guid":      {newMessage("api line 1", 2)},
+				}
+
+				logsRepo.RecentLogsForStub = func(appGUID string) ([]logs.Loggable, error) {
+					return logsByApp[appGUID], nil
+				}
+
+				appRepos = nil
+				logsRepoFactory.NewRepositoryStub = func() logs.Repository {
+					repo := new(logsfakes.FakeRepository)
+					repo.TailLogsForStub = func(appGUID string, onConnect func(), logChan chan<- logs.Loggable, errChan chan<- error) {
+						onConnect()
+						go func() {
+							for _, log := range logsByApp[appGUID] {
+								logChan <- log
+							}
+							close(logChan)
+							close(errChan)
+						}()
+					}
+					appRepos = append(appRepos, repo)
+					return repo
+				}
+			})
+
+			It("merges recent logs in timestamp order with app prefixes", func() {
+				runCommand("--recent", "frontend", "api")
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"Connected, dumping recent logs for apps", "frontend, api", "my-org", "my-space", "my-user"},
+					[]string{"[frontend]", "frontend line 1"},
+					[]string{"[api]", "api line 1"},
+					[]string{"[frontend]", "frontend line 2"},
+				))
+			})
+
+			It("tails every app with its own repository", func() {
+				runCommand("frontend", "api")
+
+				Expect(logsRepoFactory.NewRepositoryCallCount()).To(Equal(2))
+				tailedGUIDs := []string{}
+				for _, repo := range appRepos {
+					Expect(repo.TailLogsForCallCount()).To(Equal(1))
+					appGUID, _, _, _ := repo.TailLogsForArgsForCall(0)
+					tailedGUIDs = append(tailedGUIDs, appGUID)
+					Expect(repo.CloseCallCount()).To(Equal(1))
+				}
+				Expect(tailedGUIDs).To(ConsistOf("frontend-guid", "api-guid"))
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"Connected, tailing logs for apps", "frontend, api"},
+				))
+				Expect(ui.Outputs()).To(ContainSubstrings([]string{"[frontend]", "frontend line 1"}))
+				Expect(ui.Outputs()).To(ContainSubstrings([]string{"[frontend]", "frontend line 2"}))
+				Expect(ui.Outputs()).To(ContainSubstrings([]string{"[api]", "api line 1"}))
+			})
+
+			It("stops tailing when one of the streams fails", func() {
+				logsRepoFactory.NewRepositoryStub = func() logs.Repository {
+					repo := new(logsfakes.FakeRepository)
+					repo.TailLogsForStub = func(appGUID string, onConnect func(), logChan chan<- logs.Loggable, errChan chan<- error) {
+						errChan <- errors.New("stream went away")
+					}
+					return repo
+				}
+
+				Expect(runCommand("frontend", "api")).To(BeFalse())
+				Expect(ui.Outputs()).To(ContainSubstrings([]string{"stream went away"}))
+			})
+
+			Context("when --all-in-space is provided", func() {
+				BeforeEach(func() {
+					appSummaryRepo.GetSummariesInCurrentSpaceReturns(apps, nil)
+				})
+
+				It("shows the logs of every app in the space", func() {
+					runCommand("--recent", "--all-in-space")
+
+					Expect(appSummaryRepo.GetSummariesInCurrentSpaceCallCount()).To(Equal(1))
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"[frontend]", "frontend line 1"},
+						[]string{"[api]", "api line 1"},
+					))
+				})
+
+				It("tells the user when there are no apps", func() {
+					appSummaryRepo.GetSummariesInCurrentSpaceReturns(nil, nil)
+					runCommand("--all-in-space")
+
+					Expect(ui.Outputs()).To(ContainSubstrings([]string{"No apps found"}))
+					Expect(logsRepoFactory.NewRepositoryCallCount()).To(Equal(0))
+				})
+			})
+		})
+
 		Context("when the loggregator server has an invalid cert", func() {
 			Context("when the skip-ssl-validation flag is not set", func() {
 				It("fails and informs the user about the skip-ssl-validation flag", func() {
@@ -68,6 +68,7 @@ func (cmd *Push) MetaData() commandregistry.CommandMetadata {
 	fs["s"] = &flags.StringFlag{ShortName: "s", Usage: T("Stack to use (a stack is a pre-built file system, including an operating system, that can run apps)")}
 	fs["t"] = &flags.StringFlag{ShortName: "t", Usage: T("Maximum time (in seconds) for CLI to wait for application start, other server side timeouts may apply")}
 	fs["docker-image"] = &flags.StringFlag{Name: "docker-image", ShortName: "o", Usage: T("Docker-image to be used (e.g. user/docker-image-name)")}
+	fs["droplet"] = &flags.StringFlag{Name: "droplet", Usage: T("Path to a gzipped tarball of a staged droplet (e.g. created with 'cf download-droplet') to run instead of staging the app")}
 	fs["health-check-type"] = &flags.StringFlag{Name: "health-check-type", ShortName: "u", Usage: T("Application health check type (e.g. 'port' or 'none')")}
 	fs["no-hostname"] = &flags.BoolFlag{Name: "no-hostname", Usage: T("Map the root domain to this app")}
 	fs["no-manifest"] = &flags.BoolFlag{Name: "no-manifest", Usage: T("Ignore manifest file")}
@@ -102,6 +103,7 @@ func (cmd *Push) MetaData() commandregistry.CommandMetadata {
 			fmt.Sprintf("[-u %s] ", T("HEALTH_CHECK_TYPE")),
 			fmt.Sprintf("[--route-path %s] ", T("ROUTE_PATH")),
 			"\n   ",
+			fmt.Sprintf("[--droplet %s] ", T("DROPLET_PATH")),
 			// Commented to hide app-ports for release #117189491
 			// fmt.Sprintf("[--app-ports %s] ", T("APP_PORTS")),
 			"[--no-hostname] [--no-manifest] [--no-route] [--no-start] [--random-route]\n",
@@ -178,6 +180,10 @@ func (cmd *Push) SetDependency(deps commandregistry.Dependency, pluginCall bool)
 }
 
 func (cmd *Push) Execute(c flags.FlagContext) error {
+	if c.String("droplet") != "" && (c.String("p") != "" || c.String("docker-image") != "") {
+		return errors.New(T("Incorrect Usage: '--droplet' cannot be used with '-p' or '--docker-image'."))
+	}
+
 	appsFromManifest, err := cmd.getAppParamsFromManifest(c)
 	if err != nil {
 		return err
@@ -209,6 +215,10 @@ func (cmd *Push) Execute(c flags.FlagContext) error {
 		return err
 	}
 
+	if c.String("droplet") != "" && len(appSet) > 1 {
+		return errors.New(T("Incorrect Usage: '--droplet' can only be used when pushing a single app."))
+	}
+
 	_, err = cmd.authRepo.RefreshAuthToken()
 	if err != nil {
 		return err
@@ -279,7 +289,12 @@ func (cmd *Push) Execute(c flags.FlagContext) error {
 			return err
 		}
 
-		if c.String("docker-image") == "" {
+		if dropletPath := c.String("droplet"); dropletPath != "" {
+			err = cmd.uploadDroplet(app, dropletPath)
+			if err != nil {
+				return err
+			}
+		} else if c.String("docker-image") == "" {
 			err = cmd.actor.ProcessPath(*appParams.Path, cmd.processPathCallback(*appParams.Path, app))
 			if err != nil {
 				return errors.New(
@@ -344,6 +359,38 @@ func (cmd *Push) processPathCallback(path string, app models.Application) func(s
 	}
 }
 
+// uploadDroplet uploads an already staged droplet as the droplet of the app,
+// so that starting the app runs it without staging the app's files.
+func (cmd *Push) uploadDroplet(app models.Application, dropletPath string) error {
+	cmd.ui.Say(T("Uploading droplet {{.Path}} for {{.AppName}}...",
+		map[string]interface{}{
+			"Path":    terminal.EntityNameColor(dropletPath),
+			"AppName": terminal.EntityNameColor(app.Name),
+		}))
+
+	dropletFile, err := os.Open(dropletPath)
+	if err != nil {
+		return errors.New(T("Error opening droplet '{{.Path}}': {{.Error}}",
+			map[string]interface{}{
+				"Path":  dropletPath,
+				"Error": err.Error(),
+			}))
+	}
+	defer dropletFile.Close()
+
+	warnings, err := cmd.actor.UploadDroplet(app.GUID, dropletFile)
+	for _, warning := range warnings {
+		cmd.ui.Warn("%s", warning)
+	}
+	if err != nil {
+		return errors.New(T("Error uploading droplet.\n{{.APIErr}}",
+			map[string]interface{}{"APIErr": err.Error()}))
+	}
+
+	cmd.ui.Ok()
+	return nil
+}
+
 func (cmd *Push) updateRoutes(app models.Application, appParams models.AppParams, appParamsFromContext models.AppParams) error {
 	defaultRouteAcceptable := len(app.Routes) == 0
 	routeDefined := appParams.Domains != nil || !appParams.IsHostEmpty() || appParams.IsNoHostnameTrue()
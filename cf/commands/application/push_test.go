@@ -1,6 +1,7 @@
 package application_test
 
 import (
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"syscall"
@@ -850,6 +851,111 @@ var _ = Describe("Push Command", func() {
 					})
 				})
 
+				Context("when pushing a droplet with --droplet", func() {
+					var dropletPath string
+
+					BeforeEach(func() {
+						deps.UI = uiWithContents
+
+						dropletFile, err := ioutil.TempFile("", "droplet")
+						Expect(err).NotTo(HaveOccurred())
+						Expect(dropletFile.Close()).To(Succeed())
+						dropletPath = dropletFile.Name()
+
+						args = []string{"testApp", "--droplet", dropletPath}
+					})
+
+					AfterEach(func() {
+						os.Remove(dropletPath)
+					})
+
+					It("uploads the droplet instead of the app files", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+
+						Expect(actor.UploadDropletCallCount()).To(Equal(1))
+						appGUID, dropletFile := actor.UploadDropletArgsForCall(0)
+						Expect(appGUID).To(Equal("testApp-guid"))
+						Expect(dropletFile.Name()).To(Equal(dropletPath))
+
+						Expect(actor.ProcessPathCallCount()).To(Equal(0))
+						Expect(actor.UploadAppCallCount()).To(Equal(0))
+						Expect(terminal.Decolorize(string(output.Contents()))).To(ContainSubstring("Uploading droplet " + dropletPath + " for testApp..."))
+					})
+
+					It("starts the app", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+						Expect(starter.ApplicationStartCallCount()).To(Equal(1))
+					})
+
+					Context("when the droplet cannot be opened", func() {
+						BeforeEach(func() {
+							args = []string{"testApp", "--droplet", filepath.Join(dropletPath, "does-not-exist")}
+						})
+
+						It("returns an error", func() {
+							Expect(executeErr).To(HaveOccurred())
+							Expect(executeErr.Error()).To(ContainSubstring("Error opening droplet"))
+							Expect(actor.UploadDropletCallCount()).To(Equal(0))
+						})
+					})
+
+					Context("when uploading the droplet fails", func() {
+						BeforeEach(func() {
+							actor.UploadDropletReturns([]string{"upload-warning"}, errors.New("upload failed"))
+						})
+
+						It("returns an error and does not start the app", func() {
+							Expect(executeErr).To(HaveOccurred())
+							Expect(executeErr.Error()).To(ContainSubstring("Error uploading droplet."))
+							Expect(executeErr.Error()).To(ContainSubstring("upload failed"))
+							Expect(terminal.Decolorize(string(output.Contents()))).To(ContainSubstring("upload-warning"))
+							Expect(starter.ApplicationStartCallCount()).To(Equal(0))
+						})
+					})
+
+					Context("when -p is also given", func() {
+						BeforeEach(func() {
+							args = []string{"testApp", "--droplet", dropletPath, "-p", "some-path"}
+						})
+
+						It("returns an error without pushing", func() {
+							Expect(executeErr).To(MatchError("Incorrect Usage: '--droplet' cannot be used with '-p' or '--docker-image'."))
+							Expect(appRepo.CreateCallCount()).To(Equal(0))
+						})
+					})
+
+					Context("when --docker-image is also given", func() {
+						BeforeEach(func() {
+							args = []string{"testApp", "--droplet", dropletPath, "--docker-image", "sample/dockerImage"}
+						})
+
+						It("returns an error without pushing", func() {
+							Expect(executeErr).To(MatchError("Incorrect Usage: '--droplet' cannot be used with '-p' or '--docker-image'."))
+							Expect(appRepo.CreateCallCount()).To(Equal(0))
+						})
+					})
+
+					Context("when the manifest contains multiple apps", func() {
+						BeforeEach(func() {
+							manifestRepo.ReadManifestReturns(&manifest.Manifest{
+								Path: "manifest.yml",
+								Data: generic.NewMap(map[interface{}]interface{}{
+									"applications": []interface{}{
+										generic.NewMap(map[interface{}]interface{}{"name": "app-1"}),
+										generic.NewMap(map[interface{}]interface{}{"name": "app-2"}),
+									},
+								}),
+							}, nil)
+							args = []string{"--droplet", dropletPath}
+						})
+
+						It("returns an error without pushing", func() {
+							Expect(executeErr).To(MatchError("Incorrect Usage: '--droplet' can only be used when pushing a single app."))
+							Expect(appRepo.CreateCallCount()).To(Equal(0))
+						})
+					})
+				})
+
 				Context("when health-check-type '-u' or '--health-check-type' is set", func() {
 					Context("when the value is not 'port' or 'none'", func() {
 						BeforeEach(func() {
@@ -1,8 +1,15 @@
 package application
 
 import (
+	"errors"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
+	"code.cloudfoundry.org/cli/cf"
+	"code.cloudfoundry.org/cli/cf/api/appinstances"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/flags"
@@ -12,6 +19,8 @@ import (
 	"code.cloudfoundry.org/cli/cf/terminal"
 )
 
+const RollingStrategy = "rolling"
+
 //go:generate counterfeiter . Restarter
 
 type Restarter interface {
@@ -20,11 +29,15 @@ type Restarter interface {
 }
 
 type Restart struct {
-	ui      terminal.UI
-	config  coreconfig.Reader
-	starter Starter
-	stopper Stopper
-	appReq  requirements.ApplicationRequirement
+	ui               terminal.UI
+	config           coreconfig.Reader
+	starter          Starter
+	stopper          Stopper
+	appReq           requirements.ApplicationRequirement
+	appInstancesRepo appinstances.Repository
+
+	StartupTimeout time.Duration
+	PingerThrottle time.Duration
 }
 
 func init() {
@@ -32,13 +45,21 @@ func init() {
 }
 
 func (cmd *Restart) MetaData() commandregistry.CommandMetadata {
+	fs := make(map[string]flags.FlagSet)
+	fs["strategy"] = &flags.StringFlag{Name: "strategy", Usage: T("Restart the instances in batches instead of stopping the whole app first; the only strategy is 'rolling'")}
+	fs["max-in-flight"] = &flags.IntFlag{Name: "max-in-flight", Usage: T("Number of instances to restart at a time with the rolling strategy (Default: 1)")}
+
 	return commandregistry.CommandMetadata{
 		Name:        "restart",
 		ShortName:   "rs",
 		Description: T("Restart an app"),
 		Usage: []string{
-			T("CF_NAME restart APP_NAME"),
+			T("CF_NAME restart APP_NAME [--strategy rolling [--max-in-flight NUMBER]]"),
 		},
+		Examples: []string{
+			"CF_NAME restart my-app --strategy rolling --max-in-flight 2",
+		},
+		Flags: fs,
 	}
 }
 
@@ -48,6 +69,22 @@ func (cmd *Restart) Requirements(requirementsFactory requirements.Factory, fc fl
 		return nil, fmt.Errorf("Incorrect usage: %d arguments of %d required", len(fc.Args()), 1)
 	}
 
+	if fc.IsSet("strategy") && fc.String("strategy") != RollingStrategy {
+		cmd.ui.Failed(T("Incorrect Usage. --strategy must be 'rolling'\n\n") + commandregistry.Commands.CommandUsage("restart"))
+		return nil, fmt.Errorf("Incorrect usage: invalid strategy %s", fc.String("strategy"))
+	}
+
+	if fc.IsSet("max-in-flight") {
+		if !fc.IsSet("strategy") {
+			cmd.ui.Failed(T("Incorrect Usage. --max-in-flight can only be used with --strategy rolling\n\n") + commandregistry.Commands.CommandUsage("restart"))
+			return nil, errors.New("Incorrect usage: --max-in-flight requires --strategy rolling")
+		}
+		if fc.Int("max-in-flight") <= 0 {
+			cmd.ui.Failed(T("Incorrect Usage. --max-in-flight must be a positive number\n\n") + commandregistry.Commands.CommandUsage("restart"))
+			return nil, fmt.Errorf("Incorrect usage: invalid max in flight %d", fc.Int("max-in-flight"))
+		}
+	}
+
 	cmd.appReq = requirementsFactory.NewApplicationRequirement(fc.Args()[0])
 
 	reqs := []requirements.Requirement{
@@ -62,12 +99,21 @@ func (cmd *Restart) Requirements(requirementsFactory requirements.Factory, fc fl
 func (cmd *Restart) SetDependency(deps commandregistry.Dependency, pluginCall bool) commandregistry.Command {
 	cmd.ui = deps.UI
 	cmd.config = deps.Config
+	cmd.appInstancesRepo = deps.RepoLocator.GetAppInstancesRepository()
+	cmd.StartupTimeout = DefaultStartupTimeout
+	cmd.PingerThrottle = DefaultPingerThrottle
 
 	//get start for dependency
 	starter := commandregistry.Commands.FindCommand("start")
 	starter = starter.SetDependency(deps, false)
 	cmd.starter = starter.(Starter)
 
+	// rolling restarts wait for instances with the same timeouts as start
+	if start, ok := starter.(*Start); ok {
+		cmd.StartupTimeout = start.StartupTimeout
+		cmd.PingerThrottle = start.PingerThrottle
+	}
+
 	//get stop for dependency
 	stopper := commandregistry.Commands.FindCommand("stop")
 	stopper = stopper.SetDependency(deps, false)
@@ -78,6 +124,20 @@ func (cmd *Restart) SetDependency(deps commandregistry.Dependency, pluginCall bo
 
 func (cmd *Restart) Execute(c flags.FlagContext) error {
 	app := cmd.appReq.GetApplication()
+
+	if c.String("strategy") == RollingStrategy {
+		if app.State == models.ApplicationStateStarted {
+			maxInFlight := 1
+			if c.IsSet("max-in-flight") {
+				maxInFlight = c.Int("max-in-flight")
+			}
+			return cmd.RollingRestart(app, maxInFlight)
+		}
+
+		cmd.ui.Warn(T("App {{.AppName}} is not started, so it is restarted without the rolling strategy.",
+			map[string]interface{}{"AppName": app.Name}))
+	}
+
 	return cmd.ApplicationRestart(app, cmd.config.OrganizationFields().Name, cmd.config.SpaceFields().Name)
 }
 
@@ -95,3 +155,126 @@ func (cmd *Restart) ApplicationRestart(app models.Application, orgName, spaceNam
 	}
 	return nil
 }
+
+// RollingRestart restarts the instances of a started app maxInFlight at a
+// time, waiting for the replacements of each batch to be running before
+// restarting the next one.
+func (cmd *Restart) RollingRestart(app models.Application, maxInFlight int) error {
+	cmd.ui.Say(T("Restarting app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}, {{.MaxInFlight}} instance(s) at a time...",
+		map[string]interface{}{
+			"AppName":     terminal.EntityNameColor(app.Name),
+			"OrgName":     terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
+			"SpaceName":   terminal.EntityNameColor(cmd.config.SpaceFields().Name),
+			"CurrentUser": terminal.EntityNameColor(cmd.config.Username()),
+			"MaxInFlight": maxInFlight,
+		}))
+	cmd.ui.Say("")
+
+	instances, err := cmd.appInstancesRepo.GetInstances(app.GUID)
+	if err != nil {
+		return err
+	}
+
+	total := len(instances)
+	for start := 0; start < total; start += maxInFlight {
+		end := start + maxInFlight
+		if end > total {
+			end = total
+		}
+
+		batch := map[int]time.Time{}
+		for index := start; index < end; index++ {
+			batch[index] = instances[index].Since
+
+			cmd.ui.Say(T("Restarting instance {{.Instance}}...", map[string]interface{}{"Instance": index}))
+			err = cmd.appInstancesRepo.DeleteInstance(app.GUID, index)
+			if err != nil {
+				return cmd.rollingRestartError(app, start, total, fmt.Sprintf("%d", index), err.Error())
+			}
+		}
+
+		instances, err = cmd.waitForReplacements(app, batch, start, total)
+		if err != nil {
+			return err
+		}
+
+		cmd.ui.Say(T("{{.Restarted}} of {{.Total}} instances restarted",
+			map[string]interface{}{"Restarted": end, "Total": total}))
+	}
+
+	cmd.ui.Say("")
+	cmd.ui.Ok()
+	return nil
+}
+
+// waitForReplacements polls the instances of the app until every instance of
+// the batch has been replaced by a running one. An instance is replaced once
+// it reports running with a different start time than before the restart.
+// Replacements that crash are given until the startup timeout to recover, as
+// they are restarted by the platform.
+func (cmd *Restart) waitForReplacements(app models.Application, batch map[int]time.Time, restarted int, total int) ([]models.AppInstanceFields, error) {
+	var instances []models.AppInstanceFields
+	err := pollInstances(cmd.ui, cmd.appInstancesRepo, app.GUID, cmd.StartupTimeout, cmd.PingerThrottle, func(polled []models.AppInstanceFields) (bool, error) {
+		instances = polled
+		for index, since := range batch {
+			if index >= len(instances) || instances[index].State != models.InstanceRunning || instances[index].Since.Equal(since) {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+
+	if err == errInstancesTimeout {
+		instance, reason := cmd.replacementFailure(instances, batch)
+		return nil, cmd.rollingRestartError(app, restarted, total, instance, reason)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return instances, nil
+}
+
+// replacementFailure returns the instances of the batch that did not come up
+// and why, based on the last state of their instances.
+func (cmd *Restart) replacementFailure(instances []models.AppInstanceFields, batch map[int]time.Time) (string, string) {
+	for index := range batch {
+		if index >= len(instances) {
+			continue
+		}
+		switch instances[index].State {
+		case models.InstanceCrashed:
+			return strconv.Itoa(index), T("crashed")
+		case models.InstanceFlapping:
+			return strconv.Itoa(index), T("is failing")
+		}
+	}
+	return cmd.batchIndexes(batch), T("did not report running within {{.Timeout}}",
+		map[string]interface{}{"Timeout": cmd.StartupTimeout})
+}
+
+func (cmd *Restart) batchIndexes(batch map[int]time.Time) string {
+	var indexes []int
+	for index := range batch {
+		indexes = append(indexes, index)
+	}
+	sort.Ints(indexes)
+
+	var names []string
+	for _, index := range indexes {
+		names = append(names, strconv.Itoa(index))
+	}
+	return strings.Join(names, ", ")
+}
+
+func (cmd *Restart) rollingRestartError(app models.Application, restarted int, total int, instance string, reason string) error {
+	message := T("Rolling restart aborted: instance {{.Instance}} {{.Reason}}.\n{{.Restarted}} of {{.Total}} instances were restarted before the failure; the remaining instances were left running.",
+		map[string]interface{}{
+			"Instance":  instance,
+			"Reason":    reason,
+			"Restarted": restarted,
+			"Total":     total,
+		})
+	message += "\n\n" + T("TIP: use '{{.Command}}' for more information",
+		map[string]interface{}{"Command": terminal.CommandColor(fmt.Sprintf("%s logs %s --recent", cf.Name, app.Name))})
+	return errors.New(message)
+}
@@ -1,8 +1,12 @@
 package application_test
 
 import (
+	"errors"
 	"os"
+	"time"
 
+	"code.cloudfoundry.org/cli/cf/api/appinstances/appinstancesfakes"
+	"code.cloudfoundry.org/cli/cf/commands/application"
 	"code.cloudfoundry.org/cli/cf/commands/application/applicationfakes"
 	"code.cloudfoundry.org/cli/cf/models"
 	"code.cloudfoundry.org/cli/cf/requirements"
@@ -31,17 +35,22 @@ var _ = Describe("restart command", func() {
 		originalStart       commandregistry.Command
 		deps                commandregistry.Dependency
 		applicationReq      *requirementsfakes.FakeApplicationRequirement
+		appInstancesRepo    *appinstancesfakes.FakeAppInstancesRepository
 	)
 
 	updateCommandDependency := func(pluginCall bool) {
 		deps.UI = ui
 		deps.Config = config
+		deps.RepoLocator = deps.RepoLocator.SetAppInstancesRepository(appInstancesRepo)
 
 		//inject fake 'stopper and starter' into registry
 		commandregistry.Register(starter)
 		commandregistry.Register(stopper)
 
-		commandregistry.Commands.SetCommand(commandregistry.Commands.FindCommand("restart").SetDependency(deps, pluginCall))
+		restart := commandregistry.Commands.FindCommand("restart").SetDependency(deps, pluginCall).(*application.Restart)
+		restart.PingerThrottle = time.Millisecond
+		restart.StartupTimeout = 100 * time.Millisecond
+		commandregistry.Commands.SetCommand(restart)
 	}
 
 	runCommand := func(args ...string) bool {
@@ -54,6 +63,7 @@ var _ = Describe("restart command", func() {
 		requirementsFactory = new(requirementsfakes.FakeFactory)
 		starter = new(applicationfakes.FakeStarter)
 		stopper = new(applicationfakes.FakeStopper)
+		appInstancesRepo = new(appinstancesfakes.FakeAppInstancesRepository)
 		config = testconfig.NewRepositoryWithDefaults()
 
 		app = models.Application{}
@@ -93,6 +103,30 @@ var _ = Describe("restart command", func() {
 			))
 		})
 
+		It("fails with usage when the strategy is unknown", func() {
+			requirementsFactory.NewLoginRequirementReturns(requirements.Passing{})
+			Expect(runCommand("my-app", "--strategy", "blue-green")).To(BeFalse())
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Incorrect Usage", "--strategy must be 'rolling'"},
+			))
+		})
+
+		It("fails with usage when --max-in-flight is given without a strategy", func() {
+			requirementsFactory.NewLoginRequirementReturns(requirements.Passing{})
+			Expect(runCommand("my-app", "--max-in-flight", "2")).To(BeFalse())
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Incorrect Usage", "--max-in-flight can only be used with --strategy rolling"},
+			))
+		})
+
+		It("fails with usage when --max-in-flight is not positive", func() {
+			requirementsFactory.NewLoginRequirementReturns(requirements.Passing{})
+			Expect(runCommand("my-app", "--strategy", "rolling", "--max-in-flight", "0")).To(BeFalse())
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Incorrect Usage", "--max-in-flight must be a positive number"},
+			))
+		})
+
 		It("fails when not logged in", func() {
 			requirementsFactory.NewApplicationRequirementReturns(applicationReq)
 			requirementsFactory.NewLoginRequirementReturns(requirements.Passing{})
@@ -129,5 +163,198 @@ var _ = Describe("restart command", func() {
 			Expect(orgName).To(Equal(config.OrganizationFields().Name))
 			Expect(spaceName).To(Equal(config.SpaceFields().Name))
 		})
+
+		Context("when the rolling strategy is given", func() {
+			var (
+				before time.Time
+				after  time.Time
+			)
+
+			BeforeEach(func() {
+				app.State = models.ApplicationStateStarted
+				applicationReq.GetApplicationReturns(app)
+
+				before = time.Unix(1000, 0)
+				after = time.Unix(2000, 0)
+			})
+
+			instancesAt := func(states ...models.InstanceState) []models.AppInstanceFields {
+				var instances []models.AppInstanceFields
+				for _, state := range states {
+					since := before
+					if state != models.InstanceRunning {
+						since = time.Time{}
+					}
+					instances = append(instances, models.AppInstanceFields{State: state, Since: since})
+				}
+				return instances
+			}
+
+			Context("when every replacement comes back", func() {
+				BeforeEach(func() {
+					deleted := map[int]bool{}
+					appInstancesRepo.DeleteInstanceStub = func(_ string, index int) error {
+						deleted[index] = true
+						return nil
+					}
+					appInstancesRepo.GetInstancesStub = func(_ string) ([]models.AppInstanceFields, error) {
+						instances := instancesAt(models.InstanceRunning, models.InstanceRunning, models.InstanceRunning)
+						for index := range instances {
+							if deleted[index] {
+								instances[index].Since = after
+							}
+						}
+						return instances, nil
+					}
+				})
+
+				It("restarts the instances in batches without stopping the app", func() {
+					Expect(runCommand("my-app", "--strategy", "rolling", "--max-in-flight", "2")).To(BeTrue())
+
+					Expect(stopper.ApplicationStopCallCount()).To(BeZero())
+					Expect(starter.ApplicationStartCallCount()).To(BeZero())
+
+					Expect(appInstancesRepo.DeleteInstanceCallCount()).To(Equal(3))
+					for index := 0; index < 3; index++ {
+						appGUID, instance := appInstancesRepo.DeleteInstanceArgsForCall(index)
+						Expect(appGUID).To(Equal("my-app-guid"))
+						Expect(instance).To(Equal(index))
+					}
+
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"Restarting app", "my-app", "2 instance(s) at a time"},
+						[]string{"Restarting instance 0"},
+						[]string{"Restarting instance 1"},
+						[]string{"2 of 3 instances restarted"},
+						[]string{"Restarting instance 2"},
+						[]string{"3 of 3 instances restarted"},
+						[]string{"OK"},
+					))
+				})
+			})
+
+			Context("when a replacement crashes", func() {
+				BeforeEach(func() {
+					polls := [][]models.AppInstanceFields{
+						instancesAt(models.InstanceRunning, models.InstanceRunning),
+						instancesAt(models.InstanceStarting, models.InstanceRunning),
+						instancesAt(models.InstanceCrashed, models.InstanceRunning),
+					}
+					appInstancesRepo.GetInstancesStub = func(_ string) ([]models.AppInstanceFields, error) {
+						instances := polls[0]
+						if len(polls) > 1 {
+							polls = polls[1:]
+						}
+						return instances, nil
+					}
+				})
+
+				It("aborts once the startup timeout passes and reports how far the restart got", func() {
+					Expect(runCommand("my-app", "--strategy", "rolling")).To(BeFalse())
+
+					Expect(appInstancesRepo.DeleteInstanceCallCount()).To(Equal(1))
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"Rolling restart aborted: instance 0 crashed."},
+						[]string{"0 of 2 instances were restarted"},
+						[]string{"logs my-app --recent"},
+					))
+				})
+			})
+
+			Context("when a replacement crashes before it comes back", func() {
+				BeforeEach(func() {
+					polls := [][]models.AppInstanceFields{
+						instancesAt(models.InstanceRunning, models.InstanceRunning),
+						instancesAt(models.InstanceCrashed, models.InstanceRunning),
+						instancesAt(models.InstanceFlapping, models.InstanceRunning),
+					}
+					appInstancesRepo.GetInstancesStub = func(_ string) ([]models.AppInstanceFields, error) {
+						if len(polls) > 0 {
+							instances := polls[0]
+							polls = polls[1:]
+							return instances, nil
+						}
+						instances := instancesAt(models.InstanceRunning, models.InstanceRunning)
+						for index := 0; index < appInstancesRepo.DeleteInstanceCallCount(); index++ {
+							instances[index].Since = after
+						}
+						return instances, nil
+					}
+				})
+
+				It("keeps waiting for it", func() {
+					Expect(runCommand("my-app", "--strategy", "rolling")).To(BeTrue())
+					Expect(appInstancesRepo.DeleteInstanceCallCount()).To(Equal(2))
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"2 of 2 instances restarted"},
+						[]string{"OK"},
+					))
+				})
+			})
+
+			Context("when fetching the instances fails", func() {
+				BeforeEach(func() {
+					appInstancesRepo.GetInstancesStub = func(_ string) ([]models.AppInstanceFields, error) {
+						if appInstancesRepo.GetInstancesCallCount() == 1 {
+							return instancesAt(models.InstanceRunning), nil
+						}
+						return nil, errors.New("instances failed")
+					}
+				})
+
+				It("warns and keeps trying until the startup timeout", func() {
+					Expect(runCommand("my-app", "--strategy", "rolling")).To(BeFalse())
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"Could not fetch instance count: instances failed"},
+					))
+				})
+			})
+
+			Context("when a replacement does not come back in time", func() {
+				BeforeEach(func() {
+					appInstancesRepo.GetInstancesReturns(instancesAt(models.InstanceRunning, models.InstanceRunning), nil)
+				})
+
+				It("aborts with a timeout", func() {
+					Expect(runCommand("my-app", "--strategy", "rolling")).To(BeFalse())
+
+					Expect(appInstancesRepo.DeleteInstanceCallCount()).To(Equal(1))
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"Rolling restart aborted: instance 0 did not report running within"},
+					))
+				})
+			})
+
+			Context("when restarting an instance fails", func() {
+				BeforeEach(func() {
+					appInstancesRepo.GetInstancesReturns(instancesAt(models.InstanceRunning), nil)
+					appInstancesRepo.DeleteInstanceReturns(errors.New("delete failed"))
+				})
+
+				It("aborts with the error", func() {
+					Expect(runCommand("my-app", "--strategy", "rolling")).To(BeFalse())
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"Rolling restart aborted: instance 0 delete failed."},
+					))
+				})
+			})
+
+			Context("when the app is not started", func() {
+				BeforeEach(func() {
+					app.State = models.ApplicationStateStopped
+					applicationReq.GetApplicationReturns(app)
+				})
+
+				It("warns and restarts the app the usual way", func() {
+					Expect(runCommand("my-app", "--strategy", "rolling")).To(BeTrue())
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"App my-app is not started, so it is restarted without the rolling strategy."},
+					))
+					Expect(stopper.ApplicationStopCallCount()).To(Equal(1))
+					Expect(starter.ApplicationStartCallCount()).To(Equal(1))
+					Expect(appInstancesRepo.DeleteInstanceCallCount()).To(BeZero())
+				})
+			})
+		})
 	})
 })
@@ -357,36 +357,61 @@ Use '{{.Command}}' for more in depth log information.`,
 }
 
 func (cmd *Start) waitForOneRunningInstance(app models.Application) error {
-	timer := time.NewTimer(cmd.StartupTimeout)
+	err := pollInstances(cmd.ui, cmd.appInstancesRepo, app.GUID, cmd.StartupTimeout, cmd.PingerThrottle, func(instances []models.AppInstanceFields) (bool, error) {
+		count := countInstances(instances)
+		cmd.ui.Say(instancesDetails(count))
+
+		if count.running > 0 {
+			return true, nil
+		}
+
+		if count.flapping > 0 || count.crashed > 0 {
+			return false, fmt.Errorf(T("Start unsuccessful\n\nTIP: use '{{.Command}}' for more information",
+				map[string]interface{}{"Command": terminal.CommandColor(fmt.Sprintf("%s logs %s --recent", cf.Name, app.Name))}))
+		}
+
+		return false, nil
+	})
+
+	if err == errInstancesTimeout {
+		tipMsg := T("Start app timeout\n\nTIP: Application must be listening on the right port. Instead of hard coding the port, use the $PORT environment variable.") + "\n\n"
+		tipMsg += T("Use '{{.Command}}' for more information", map[string]interface{}{"Command": terminal.CommandColor(fmt.Sprintf("%s logs %s --recent", cf.Name, app.Name))})
+
+		return errors.New(tipMsg)
+	}
+	return err
+}
+
+// errInstancesTimeout is returned by pollInstances when the instances are not
+// ready within the timeout.
+var errInstancesTimeout = errors.New("timed out waiting for instances")
+
+// pollInstances fetches the instances of the app every throttle until ready
+// reports true or returns an error, or until the timeout passes. Failures to
+// fetch the instances are reported as warnings and retried.
+func pollInstances(ui terminal.UI, repo appinstances.Repository, appGUID string, timeout time.Duration, throttle time.Duration, ready func([]models.AppInstanceFields) (bool, error)) error {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
 
 	for {
 		select {
 		case <-timer.C:
-			tipMsg := T("Start app timeout\n\nTIP: Application must be listening on the right port. Instead of hard coding the port, use the $PORT environment variable.") + "\n\n"
-			tipMsg += T("Use '{{.Command}}' for more information", map[string]interface{}{"Command": terminal.CommandColor(fmt.Sprintf("%s logs %s --recent", cf.Name, app.Name))})
-
-			return errors.New(tipMsg)
+			return errInstancesTimeout
 
 		default:
-			count, err := cmd.fetchInstanceCount(app.GUID)
+			instances, err := repo.GetInstances(appGUID)
 			if err != nil {
-				cmd.ui.Warn("Could not fetch instance count: %s", err.Error())
-				time.Sleep(cmd.PingerThrottle)
+				ui.Warn(T("Could not fetch instance count: {{.Err}}", map[string]interface{}{"Err": err.Error()}))
+				time.Sleep(throttle)
 				continue
 			}
 
-			cmd.ui.Say(instancesDetails(count))
-
-			if count.running > 0 {
-				return nil
-			}
-
-			if count.flapping > 0 || count.crashed > 0 {
-				return fmt.Errorf(T("Start unsuccessful\n\nTIP: use '{{.Command}}' for more information",
-					map[string]interface{}{"Command": terminal.CommandColor(fmt.Sprintf("%s logs %s --recent", cf.Name, app.Name))}))
+			done, err := ready(instances)
+			if done || err != nil {
+				return err
 			}
 
-			time.Sleep(cmd.PingerThrottle)
+			time.Sleep(throttle)
 		}
 	}
 }
@@ -401,16 +426,11 @@ type instanceCount struct {
 	total           int
 }
 
-func (cmd Start) fetchInstanceCount(appGUID string) (instanceCount, error) {
+func countInstances(instances []models.AppInstanceFields) instanceCount {
 	count := instanceCount{
 		startingDetails: make(map[string]struct{}),
 	}
 
-	instances, apiErr := cmd.appInstancesRepo.GetInstances(appGUID)
-	if apiErr != nil {
-		return instanceCount{}, apiErr
-	}
-
 	count.total = len(instances)
 
 	for _, inst := range instances {
@@ -431,7 +451,7 @@ func (cmd Start) fetchInstanceCount(appGUID string) (instanceCount, error) {
 		}
 	}
 
-	return count, nil
+	return count
 }
 
 func instancesDetails(count instanceCount) string {
@@ -0,0 +1,397 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/cf/api/appevents"
+	"code.cloudfoundry.org/cli/cf/api/auditevents"
+	"code.cloudfoundry.org/cli/cf/commandregistry"
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/flags"
+	. "code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/cf/requirements"
+	"code.cloudfoundry.org/cli/cf/terminal"
+)
+
+const DefaultAuditEventsLimit = 50
+
+const (
+	auditEventsFormatTable = "table"
+	auditEventsFormatJSON  = "json"
+	auditEventsFormatCSV   = "csv"
+)
+
+type AuditEvents struct {
+	ui         terminal.UI
+	config     coreconfig.Reader
+	eventsRepo auditevents.Repository
+	orgReq     requirements.OrganizationRequirement
+	spaceReq   requirements.SpaceRequirement
+	format     string
+}
+
+func init() {
+	commandregistry.Register(&AuditEvents{})
+}
+
+func (cmd *AuditEvents) MetaData() commandregistry.CommandMetadata {
+	fs := make(map[string]flags.FlagSet)
+	fs["space"] = &flags.StringFlag{Name: "space", Usage: T("Show the events of this space in the targeted org (Default: targeted space)")}
+	fs["org"] = &flags.StringFlag{Name: "org", Usage: T("Show the events of every space in this org")}
+	fs["actor"] = &flags.StringFlag{Name: "actor", Usage: T("Only show events caused by this user name or GUID; without --space or --org, events are shown from everywhere")}
+	fs["type"] = &flags.StringFlag{Name: "type", Usage: T("Only show events of these comma-separated types, e.g. audit.app.update,audit.service_binding.create")}
+	fs["since"] = &flags.StringFlag{Name: "since", Usage: T("Only show events at or after this time, given as a duration ago (e.g. 90m, 2h, 7d) or a date (e.g. 2017-05-04, 2017-05-04T15:04:05Z)")}
+	fs["until"] = &flags.StringFlag{Name: "until", Usage: T("Only show events at or before this time, in the same formats as --since")}
+	fs["limit"] = &flags.IntFlag{Name: "limit", Usage: T("Maximum number of events to show (Default: 50)")}
+	fs["all"] = &flags.BoolFlag{Name: "all", Usage: T("Show all matching events, fetching as many pages as needed")}
+	fs["event"] = &flags.StringFlag{Name: "event", Usage: T("Show the details of the event with this GUID, including its request payload")}
+	fs["format"] = &flags.StringFlag{Name: "format", Usage: T("Output format: table (default), json or csv")}
+
+	return commandregistry.CommandMetadata{
+		Name:        "audit-events",
+		Description: T("Show the audit trail of a space, org or user"),
+		Usage: []string{
+			"CF_NAME audit-events ",
+			T("[--space SPACE | --org ORG] [--actor USER] [--type TYPES] [--since TIME] [--until TIME] [--limit NUMBER | --all] [--format FORMAT]\n\n"),
+			"   CF_NAME audit-events ",
+			T("--event EVENT_GUID [--format FORMAT]"),
+		},
+		Examples: []string{
+			"CF_NAME audit-events --since 7d --type audit.app.delete-request",
+			"CF_NAME audit-events --org my-org --actor admin --all --format csv > events.csv",
+			"CF_NAME audit-events --event 7c1fe5bb-8f36-4ac4-93b3-6d0fdbcb0e3f",
+		},
+		Flags: fs,
+	}
+}
+
+func (cmd *AuditEvents) Requirements(requirementsFactory requirements.Factory, fc flags.FlagContext) ([]requirements.Requirement, error) {
+	if len(fc.Args()) != 0 {
+		cmd.ui.Failed(T("Incorrect Usage. No argument required\n\n") + commandregistry.Commands.CommandUsage("audit-events"))
+		return nil, fmt.Errorf("Incorrect usage: %d arguments of %d required", len(fc.Args()), 0)
+	}
+
+	if fc.IsSet("space") && fc.IsSet("org") {
+		cmd.ui.Failed(T("Incorrect Usage. --space and --org cannot be used together\n\n") + commandregistry.Commands.CommandUsage("audit-events"))
+		return nil, errors.New("Incorrect usage: --space and --org cannot be used together")
+	}
+
+	cmd.orgReq = nil
+	cmd.spaceReq = nil
+
+	reqs := []requirements.Requirement{
+		requirementsFactory.NewLoginRequirement(),
+	}
+
+	switch {
+	case fc.IsSet("event"):
+	case fc.IsSet("org"):
+		cmd.orgReq = requirementsFactory.NewOrganizationRequirement(fc.String("org"))
+		reqs = append(reqs, cmd.orgReq)
+	case fc.IsSet("space"):
+		cmd.spaceReq = requirementsFactory.NewSpaceRequirement(fc.String("space"))
+		reqs = append(reqs, requirementsFactory.NewTargetedOrgRequirement(), cmd.spaceReq)
+	case !fc.IsSet("actor"):
+		reqs = append(reqs, requirementsFactory.NewTargetedSpaceRequirement())
+	}
+
+	return reqs, nil
+}
+
+func (cmd *AuditEvents) SetDependency(deps commandregistry.Dependency, pluginCall bool) commandregistry.Command {
+	cmd.ui = deps.UI
+	cmd.config = deps.Config
+	cmd.eventsRepo = deps.RepoLocator.GetAuditEventsRepository()
+	return cmd
+}
+
+func (cmd *AuditEvents) Execute(c flags.FlagContext) error {
+	cmd.format = strings.ToLower(c.String("format"))
+	switch cmd.format {
+	case "":
+		cmd.format = auditEventsFormatTable
+	case auditEventsFormatTable, auditEventsFormatJSON, auditEventsFormatCSV:
+	default:
+		return errors.New(T("Invalid format: {{.Format}}\nFormat must be table, json or csv", map[string]interface{}{
+			"Format": c.String("format"),
+		}))
+	}
+
+	if c.IsSet("event") {
+		return cmd.showEvent(c.String("event"))
+	}
+
+	query, err := cmd.buildQuery(c, time.Now())
+	if err != nil {
+		return err
+	}
+
+	cmd.sayBanner(cmd.scopeMessage(query))
+
+	events, err := cmd.eventsRepo.ListEvents(query)
+	if err != nil {
+		return errors.New(T("Failed fetching events.\n{{.APIErr}}",
+			map[string]interface{}{"APIErr": err.Error()}))
+	}
+
+	switch cmd.format {
+	case auditEventsFormatJSON:
+		return cmd.printJSON(auditEventsToJSON(events))
+	case auditEventsFormatCSV:
+		return cmd.printCSV(events)
+	}
+
+	if len(events) == 0 {
+		cmd.ui.Say(T("No events found"))
+		return nil
+	}
+
+	table := cmd.ui.Table([]string{T("time"), T("event"), T("actor"), T("target"), T("description"), T("guid")})
+	for _, event := range events {
+		table.Add(
+			event.Timestamp.Local().Format("2006-01-02T15:04:05.00-0700"),
+			event.Name,
+			auditEventActor(event),
+			auditEventTarget(event),
+			event.Description,
+			event.GUID,
+		)
+	}
+	return table.Print()
+}
+
+func (cmd *AuditEvents) buildQuery(c flags.FlagContext, now time.Time) (auditevents.Query, error) {
+	query := auditevents.Query{
+		Types: flags.SplitList(c.String("type")),
+		Actor: c.String("actor"),
+		Limit: DefaultAuditEventsLimit,
+	}
+
+	switch {
+	case cmd.orgReq != nil:
+		query.OrganizationGUID = cmd.orgReq.GetOrganization().GUID
+	case cmd.spaceReq != nil:
+		query.SpaceGUID = cmd.spaceReq.GetSpace().GUID
+	case query.Actor == "":
+		query.SpaceGUID = cmd.config.SpaceFields().GUID
+	}
+
+	if c.IsSet("limit") && c.Bool("all") {
+		return auditevents.Query{}, errors.New(T("Incorrect Usage. --limit and --all cannot be used together"))
+	}
+	if c.Bool("all") {
+		query.Limit = 0
+	} else if c.IsSet("limit") {
+		if c.Int("limit") <= 0 {
+			return auditevents.Query{}, errors.New(T("Incorrect Usage. --limit must be a positive number"))
+		}
+		query.Limit = int64(c.Int("limit"))
+	}
+
+	var err error
+	if c.IsSet("since") {
+		query.Since, err = appevents.ParseEventTime(c.String("since"), now)
+		if err != nil {
+			return auditevents.Query{}, err
+		}
+	}
+	if c.IsSet("until") {
+		query.Until, err = appevents.ParseEventTime(c.String("until"), now)
+		if err != nil {
+			return auditevents.Query{}, err
+		}
+	}
+	if !query.Since.IsZero() && !query.Until.IsZero() && query.Until.Before(query.Since) {
+		return auditevents.Query{}, errors.New(T("Incorrect Usage. --until must not be before --since"))
+	}
+
+	return query, nil
+}
+
+func (cmd *AuditEvents) scopeMessage(query auditevents.Query) string {
+	params := map[string]interface{}{
+		"Actor":    terminal.EntityNameColor(query.Actor),
+		"Username": terminal.EntityNameColor(cmd.config.Username()),
+	}
+
+	switch {
+	case cmd.orgReq != nil:
+		params["OrgName"] = terminal.EntityNameColor(cmd.orgReq.GetOrganization().Name)
+		if query.Actor != "" {
+			return T("Getting events caused by {{.Actor}} in org {{.OrgName}} as {{.Username}}...\n", params)
+		}
+		return T("Getting events in org {{.OrgName}} as {{.Username}}...\n", params)
+	case cmd.spaceReq != nil || query.Actor == "":
+		params["OrgName"] = terminal.EntityNameColor(cmd.config.OrganizationFields().Name)
+		params["SpaceName"] = terminal.EntityNameColor(cmd.config.SpaceFields().Name)
+		if cmd.spaceReq != nil {
+			params["SpaceName"] = terminal.EntityNameColor(cmd.spaceReq.GetSpace().Name)
+		}
+		if query.Actor != "" {
+			return T("Getting events caused by {{.Actor}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...\n", params)
+		}
+		return T("Getting events in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...\n", params)
+	default:
+		return T("Getting events caused by {{.Actor}} as {{.Username}}...\n", params)
+	}
+}
+
+func (cmd *AuditEvents) showEvent(guid string) error {
+	cmd.sayBanner(T("Getting event {{.EventGUID}} as {{.Username}}...\n",
+		map[string]interface{}{
+			"EventGUID": terminal.EntityNameColor(guid),
+			"Username":  terminal.EntityNameColor(cmd.config.Username()),
+		}))
+
+	event, err := cmd.eventsRepo.GetEvent(guid)
+	if err != nil {
+		return errors.New(T("Failed fetching event.\n{{.APIErr}}",
+			map[string]interface{}{"APIErr": err.Error()}))
+	}
+
+	switch cmd.format {
+	case auditEventsFormatJSON:
+		return cmd.printJSON(auditEventToJSON(event))
+	case auditEventsFormatCSV:
+		return cmd.printCSV([]models.AuditEvent{event})
+	}
+
+	table := cmd.ui.Table([]string{"", ""})
+	table.Add(terminal.HeaderColor(T("guid:")), event.GUID)
+	table.Add(terminal.HeaderColor(T("time:")), event.Timestamp.Local().Format("2006-01-02T15:04:05.00-0700"))
+	table.Add(terminal.HeaderColor(T("event:")), event.Name)
+	table.Add(terminal.HeaderColor(T("actor:")), fmt.Sprintf("%s (%s, %s)", auditEventActor(event), event.ActorType, event.Actor))
+	table.Add(terminal.HeaderColor(T("target:")), fmt.Sprintf("%s (%s)", auditEventTarget(event), event.ActeeGUID))
+	table.Add(terminal.HeaderColor(T("space:")), event.SpaceGUID)
+	table.Add(terminal.HeaderColor(T("org:")), event.OrganizationGUID)
+	table.Add(terminal.HeaderColor(T("description:")), event.Description)
+	err = table.Print()
+	if err != nil {
+		return err
+	}
+
+	var payload interface{} = event.Metadata
+	if request, ok := event.Metadata["request"]; ok {
+		payload = request
+	}
+	if payload == nil {
+		payload = map[string]interface{}{}
+	}
+
+	cmd.ui.Say("\n%s", terminal.HeaderColor(T("request:")))
+	return cmd.printJSON(payload)
+}
+
+// sayBanner displays informational messages that would otherwise end up in
+// exported JSON or CSV.
+func (cmd *AuditEvents) sayBanner(message string) {
+	if cmd.format != auditEventsFormatTable {
+		return
+	}
+	cmd.ui.Say(message)
+}
+
+func (cmd *AuditEvents) printJSON(value interface{}) error {
+	output, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return err
+	}
+	cmd.ui.Say("%s", output)
+	return nil
+}
+
+func (cmd *AuditEvents) printCSV(events []models.AuditEvent) error {
+	buffer := new(bytes.Buffer)
+	writer := csv.NewWriter(buffer)
+	_ = writer.Write([]string{"guid", "type", "timestamp", "actor", "actor_type", "actor_name", "actee", "actee_type", "actee_name", "space_guid", "organization_guid", "description"})
+	for _, event := range events {
+		_ = writer.Write([]string{
+			event.GUID,
+			event.Name,
+			event.Timestamp.UTC().Format(time.RFC3339),
+			event.Actor,
+			event.ActorType,
+			event.ActorName,
+			event.ActeeGUID,
+			event.ActeeType,
+			event.ActeeName,
+			event.SpaceGUID,
+			event.OrganizationGUID,
+			event.Description,
+		})
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return err
+	}
+
+	cmd.ui.Say("%s", strings.TrimSuffix(buffer.String(), "\n"))
+	return nil
+}
+
+type auditEventJSON struct {
+	GUID             string                 `json:"guid"`
+	Type             string                 `json:"type"`
+	Timestamp        string                 `json:"timestamp"`
+	Actor            string                 `json:"actor"`
+	ActorType        string                 `json:"actor_type"`
+	ActorName        string                 `json:"actor_name"`
+	Actee            string                 `json:"actee"`
+	ActeeType        string                 `json:"actee_type"`
+	ActeeName        string                 `json:"actee_name"`
+	SpaceGUID        string                 `json:"space_guid"`
+	OrganizationGUID string                 `json:"organization_guid"`
+	Description      string                 `json:"description"`
+	Metadata         map[string]interface{} `json:"metadata"`
+}
+
+func auditEventToJSON(event models.AuditEvent) auditEventJSON {
+	return auditEventJSON{
+		GUID:             event.GUID,
+		Type:             event.Name,
+		Timestamp:        event.Timestamp.UTC().Format(time.RFC3339),
+		Actor:            event.Actor,
+		ActorType:        event.ActorType,
+		ActorName:        event.ActorName,
+		Actee:            event.ActeeGUID,
+		ActeeType:        event.ActeeType,
+		ActeeName:        event.ActeeName,
+		SpaceGUID:        event.SpaceGUID,
+		OrganizationGUID: event.OrganizationGUID,
+		Description:      event.Description,
+		Metadata:         event.Metadata,
+	}
+}
+
+func auditEventsToJSON(events []models.AuditEvent) []auditEventJSON {
+	values := make([]auditEventJSON, len(events))
+	for i, event := range events {
+		values[i] = auditEventToJSON(event)
+	}
+	return values
+}
+
+func auditEventActor(event models.AuditEvent) string {
+	if event.ActorName != "" {
+		return event.ActorName
+	}
+	return event.Actor
+}
+
+func auditEventTarget(event models.AuditEvent) string {
+	name := event.ActeeName
+	if name == "" {
+		name = event.ActeeGUID
+	}
+	if event.ActeeType == "" {
+		return name
+	}
+	return event.ActeeType + " " + name
+}
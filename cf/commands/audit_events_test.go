@@ -0,0 +1,277 @@
+package commands_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/cf/api"
+	"code.cloudfoundry.org/cli/cf/api/auditevents"
+	"code.cloudfoundry.org/cli/cf/api/auditevents/auditeventsfakes"
+	"code.cloudfoundry.org/cli/cf/commandregistry"
+	"code.cloudfoundry.org/cli/cf/commands"
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig/coreconfigfakes"
+	"code.cloudfoundry.org/cli/cf/flags"
+	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/cf/requirements"
+	"code.cloudfoundry.org/cli/cf/requirements/requirementsfakes"
+	testterm "code.cloudfoundry.org/cli/util/testhelpers/terminal"
+
+	. "code.cloudfoundry.org/cli/util/testhelpers/matchers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AuditEvents", func() {
+	var (
+		ui          *testterm.FakeUI
+		config      *coreconfigfakes.FakeRepository
+		eventsRepo  *auditeventsfakes.FakeRepository
+		reqFactory  *requirementsfakes.FakeFactory
+		flagContext flags.FlagContext
+
+		loginRequirement         requirements.Requirement
+		targetedSpaceRequirement requirements.Requirement
+		targetedOrgRequirement   *requirementsfakes.FakeTargetedOrgRequirement
+		orgRequirement           *requirementsfakes.FakeOrganizationRequirement
+		spaceRequirement         *requirementsfakes.FakeSpaceRequirement
+
+		cmd *commands.AuditEvents
+
+		timestamp time.Time
+		event     models.AuditEvent
+	)
+
+	BeforeEach(func() {
+		cmd = &commands.AuditEvents{}
+
+		ui = new(testterm.FakeUI)
+		eventsRepo = new(auditeventsfakes.FakeRepository)
+		config = new(coreconfigfakes.FakeRepository)
+		config.OrganizationFieldsReturns(models.OrganizationFields{Name: "my-org", GUID: "my-org-guid"})
+		config.SpaceFieldsReturns(models.SpaceFields{Name: "my-space", GUID: "my-space-guid"})
+		config.UsernameReturns("my-user")
+
+		cmd.SetDependency(commandregistry.Dependency{
+			UI:          ui,
+			Config:      config,
+			RepoLocator: api.RepositoryLocator{}.SetAuditEventsRepository(eventsRepo),
+		}, false)
+
+		reqFactory = new(requirementsfakes.FakeFactory)
+		loginRequirement = &passingRequirement{Name: "login-requirement"}
+		reqFactory.NewLoginRequirementReturns(loginRequirement)
+		targetedSpaceRequirement = &passingRequirement{Name: "targeted-space-requirement"}
+		reqFactory.NewTargetedSpaceRequirementReturns(targetedSpaceRequirement)
+		targetedOrgRequirement = new(requirementsfakes.FakeTargetedOrgRequirement)
+		reqFactory.NewTargetedOrgRequirementReturns(targetedOrgRequirement)
+		orgRequirement = new(requirementsfakes.FakeOrganizationRequirement)
+		orgRequirement.GetOrganizationReturns(models.Organization{OrganizationFields: models.OrganizationFields{Name: "other-org", GUID: "other-org-guid"}})
+		reqFactory.NewOrganizationRequirementReturns(orgRequirement)
+		spaceRequirement = new(requirementsfakes.FakeSpaceRequirement)
+		spaceRequirement.GetSpaceReturns(models.Space{SpaceFields: models.SpaceFields{Name: "other-space", GUID: "other-space-guid"}})
+		reqFactory.NewSpaceRequirementReturns(spaceRequirement)
+
+		timestamp = time.Date(2017, 5, 4, 15, 4, 5, 0, time.UTC)
+		event = models.AuditEvent{
+			EventFields: models.EventFields{
+				GUID:        "event-guid",
+				Name:        "audit.app.update",
+				Timestamp:   timestamp,
+				Description: "instances: 3",
+				Actor:       "user-guid",
+				ActorName:   "admin",
+			},
+			ActorType:        "user",
+			ActeeGUID:        "app-guid",
+			ActeeType:        "app",
+			ActeeName:        "my-app",
+			SpaceGUID:        "my-space-guid",
+			OrganizationGUID: "my-org-guid",
+			Metadata: map[string]interface{}{
+				"request": map[string]interface{}{"instances": float64(3)},
+			},
+		}
+	})
+
+	runRequirements := func(args ...string) ([]requirements.Requirement, error) {
+		flagContext = flags.NewFlagContext(cmd.MetaData().Flags)
+		err := flagContext.Parse(args...)
+		Expect(err).NotTo(HaveOccurred())
+		return cmd.Requirements(reqFactory, flagContext)
+	}
+
+	Describe("Requirements", func() {
+		It("fails when given arguments", func() {
+			_, err := runRequirements("extra")
+			Expect(err).To(HaveOccurred())
+			Expect(ui.Outputs()).To(ContainSubstrings([]string{"Incorrect Usage", "No argument required"}))
+		})
+
+		It("fails when --space and --org are both given", func() {
+			_, err := runRequirements("--space", "s", "--org", "o")
+			Expect(err).To(HaveOccurred())
+			Expect(ui.Outputs()).To(ContainSubstrings([]string{"--space and --org cannot be used together"}))
+		})
+
+		It("requires a targeted space by default", func() {
+			reqs, err := runRequirements()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reqs).To(ConsistOf(loginRequirement, targetedSpaceRequirement))
+		})
+
+		It("requires the org when --org is given", func() {
+			reqs, err := runRequirements("--org", "other-org")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reqFactory.NewOrganizationRequirementArgsForCall(0)).To(Equal("other-org"))
+			Expect(reqs).To(ConsistOf(loginRequirement, orgRequirement))
+		})
+
+		It("requires a targeted org and the space when --space is given", func() {
+			reqs, err := runRequirements("--space", "other-space")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reqFactory.NewSpaceRequirementArgsForCall(0)).To(Equal("other-space"))
+			Expect(reqs).To(ConsistOf(loginRequirement, targetedOrgRequirement, spaceRequirement))
+		})
+
+		It("only requires a login when --actor or --event is given", func() {
+			reqs, err := runRequirements("--actor", "admin")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reqs).To(ConsistOf(loginRequirement))
+
+			reqs, err = runRequirements("--event", "event-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reqs).To(ConsistOf(loginRequirement))
+		})
+	})
+
+	Describe("Execute", func() {
+		runCommand := func(args ...string) error {
+			_, err := runRequirements(args...)
+			Expect(err).NotTo(HaveOccurred())
+			return cmd.Execute(flagContext)
+		}
+
+		BeforeEach(func() {
+			eventsRepo.ListEventsReturns([]models.AuditEvent{event}, nil)
+		})
+
+		It("lists the events of the targeted space", func() {
+			Expect(runCommand()).To(Succeed())
+
+			Expect(eventsRepo.ListEventsArgsForCall(0)).To(Equal(auditevents.Query{
+				SpaceGUID: "my-space-guid",
+				Limit:     50,
+			}))
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Getting events in org", "my-org", "my-space", "my-user"},
+				[]string{"time", "event", "actor", "target", "description", "guid"},
+				[]string{timestamp.Local().Format("2006-01-02T15:04:05.00-0700"), "audit.app.update", "admin", "app my-app", "instances: 3", "event-guid"},
+			))
+		})
+
+		It("scopes the events to the org, actor, types and time window", func() {
+			Expect(runCommand("--org", "other-org", "--actor", "admin", "--type", "audit.app.update, audit.app.delete-request", "--since", "2017-05-01", "--until", "2017-05-04T15:04:05Z", "--all")).To(Succeed())
+
+			query := eventsRepo.ListEventsArgsForCall(0)
+			Expect(query.OrganizationGUID).To(Equal("other-org-guid"))
+			Expect(query.SpaceGUID).To(BeEmpty())
+			Expect(query.Actor).To(Equal("admin"))
+			Expect(query.Types).To(Equal([]string{"audit.app.update", "audit.app.delete-request"}))
+			Expect(query.Since).To(Equal(time.Date(2017, 5, 1, 0, 0, 0, 0, time.Local)))
+			Expect(query.Until.Equal(timestamp)).To(BeTrue())
+			Expect(query.Limit).To(BeZero())
+			Expect(ui.Outputs()).To(ContainSubstrings([]string{"Getting events caused by", "admin", "other-org"}))
+		})
+
+		It("does not restrict the scope when only an actor is given", func() {
+			Expect(runCommand("--actor", "admin", "--limit", "10")).To(Succeed())
+
+			Expect(eventsRepo.ListEventsArgsForCall(0)).To(Equal(auditevents.Query{
+				Actor: "admin",
+				Limit: 10,
+			}))
+		})
+
+		It("uses the space given with --space", func() {
+			Expect(runCommand("--space", "other-space")).To(Succeed())
+
+			Expect(eventsRepo.ListEventsArgsForCall(0).SpaceGUID).To(Equal("other-space-guid"))
+			Expect(ui.Outputs()).To(ContainSubstrings([]string{"my-org", "other-space"}))
+		})
+
+		It("tells the user when there are no events", func() {
+			eventsRepo.ListEventsReturns([]models.AuditEvent{}, nil)
+			Expect(runCommand()).To(Succeed())
+			Expect(ui.Outputs()).To(ContainSubstrings([]string{"No events found"}))
+		})
+
+		It("rejects invalid options", func() {
+			Expect(runCommand("--limit", "5", "--all")).To(MatchError(ContainSubstring("--limit and --all cannot be used together")))
+			Expect(runCommand("--since", "yesterday")).To(MatchError(ContainSubstring("Invalid time: yesterday")))
+			Expect(runCommand("--format", "xml")).To(MatchError(ContainSubstring("Format must be table, json or csv")))
+			Expect(eventsRepo.ListEventsCallCount()).To(BeZero())
+		})
+
+		It("returns an error when the events cannot be fetched", func() {
+			eventsRepo.ListEventsReturns(nil, errors.New("boom"))
+			Expect(runCommand()).To(MatchError(ContainSubstring("boom")))
+		})
+
+		It("exports the events as JSON", func() {
+			Expect(runCommand("--format", "json")).To(Succeed())
+
+			var exported []map[string]interface{}
+			Expect(json.Unmarshal([]byte(strings.Join(ui.Outputs(), "\n")), &exported)).To(Succeed())
+			Expect(exported).To(HaveLen(1))
+			Expect(exported[0]).To(HaveKeyWithValue("guid", "event-guid"))
+			Expect(exported[0]).To(HaveKeyWithValue("timestamp", "2017-05-04T15:04:05Z"))
+			Expect(exported[0]).To(HaveKeyWithValue("actee_name", "my-app"))
+			Expect(exported[0]).To(HaveKeyWithValue("metadata", event.Metadata))
+		})
+
+		It("exports the events as CSV", func() {
+			Expect(runCommand("--format", "csv")).To(Succeed())
+
+			Expect(ui.Outputs()).To(Equal([]string{
+				"guid,type,timestamp,actor,actor_type,actor_name,actee,actee_type,actee_name,space_guid,organization_guid,description",
+				"event-guid,audit.app.update,2017-05-04T15:04:05Z,user-guid,user,admin,app-guid,app,my-app,my-space-guid,my-org-guid,instances: 3",
+			}))
+		})
+
+		Context("when --event is given", func() {
+			BeforeEach(func() {
+				eventsRepo.GetEventReturns(event, nil)
+			})
+
+			It("shows the details of the event and its request payload", func() {
+				Expect(runCommand("--event", "event-guid")).To(Succeed())
+
+				Expect(eventsRepo.GetEventArgsForCall(0)).To(Equal("event-guid"))
+				Expect(eventsRepo.ListEventsCallCount()).To(BeZero())
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"Getting event", "event-guid", "my-user"},
+					[]string{"event:", "audit.app.update"},
+					[]string{"actor:", "admin (user, user-guid)"},
+					[]string{"target:", "app my-app (app-guid)"},
+					[]string{"request:"},
+					[]string{`"instances": 3`},
+				))
+			})
+
+			It("exports the event as JSON", func() {
+				Expect(runCommand("--event", "event-guid", "--format", "json")).To(Succeed())
+
+				var exported map[string]interface{}
+				Expect(json.Unmarshal([]byte(strings.Join(ui.Outputs(), "\n")), &exported)).To(Succeed())
+				Expect(exported).To(HaveKeyWithValue("type", "audit.app.update"))
+			})
+
+			It("returns an error when the event cannot be fetched", func() {
+				eventsRepo.GetEventReturns(models.AuditEvent{}, errors.New("not found"))
+				Expect(runCommand("--event", "event-guid")).To(MatchError(ContainSubstring("not found")))
+			})
+		})
+	})
+})
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 
 	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/util/credentialhelper"
 )
 
 type AuthPromptType string
@@ -34,6 +35,9 @@ type Data struct {
 	OrganizationFields       models.OrganizationFields
 	SpaceFields              models.SpaceFields
 	SSLDisabled              bool
+	SSLCACertFile            string `json:",omitempty"`
+	SSLClientCertFile        string `json:",omitempty"`
+	SSLClientKeyFile         string `json:",omitempty"`
 	AsyncTimeout             uint
 	Trace                    string
 	ColorEnabled             string
@@ -41,6 +45,12 @@ type Data struct {
 	PluginRepos              []models.PluginRepo
 	MinCLIVersion            string
 	MinRecommendedCLIVersion string
+	RequestRetries           *int   `json:",omitempty"`
+	CredentialHelper         string `json:",omitempty"`
+
+	// credentialStore keeps the tokens in the credential helper, if one is
+	// configured.
+	credentialStore *credentialhelper.Store
 }
 
 func NewData() *Data {
@@ -54,7 +64,19 @@ func NewData() *Data {
 
 func (d *Data) JSONMarshalV3() ([]byte, error) {
 	d.ConfigVersion = 3
-	return json.MarshalIndent(d, "", "  ")
+	if d.CredentialHelper == "" {
+		return json.MarshalIndent(d, "", "  ")
+	}
+
+	err := d.storeCredentials()
+	if err != nil {
+		return nil, err
+	}
+
+	data := *d
+	data.AccessToken = ""
+	data.RefreshToken = ""
+	return json.MarshalIndent(data, "", "  ")
 }
 
 func (d *Data) JSONUnmarshalV3(input []byte) error {
@@ -68,5 +90,38 @@ func (d *Data) JSONUnmarshalV3(input []byte) error {
 		return nil
 	}
 
+	if d.CredentialHelper != "" {
+		return d.loadCredentials()
+	}
+
+	return nil
+}
+
+// loadCredentials replaces the tokens read from the config file with the
+// tokens the credential helper holds for the target.
+func (d *Data) loadCredentials() error {
+	d.credentialStore = credentialhelper.NewStore(d.CredentialHelper)
+	credentials, err := d.credentialStore.Load(d.currentCredentials())
+	if err != nil {
+		return err
+	}
+	d.AccessToken = credentials.AccessToken
+	d.RefreshToken = credentials.RefreshToken
 	return nil
 }
+
+// storeCredentials saves the tokens for the target in the credential helper.
+func (d *Data) storeCredentials() error {
+	if d.credentialStore == nil {
+		d.credentialStore = credentialhelper.NewStore(d.CredentialHelper)
+	}
+	return d.credentialStore.Save(d.currentCredentials())
+}
+
+func (d *Data) currentCredentials() credentialhelper.Credentials {
+	return credentialhelper.Credentials{
+		ServerURL:    d.Target,
+		AccessToken:  d.AccessToken,
+		RefreshToken: d.RefreshToken,
+	}
+}
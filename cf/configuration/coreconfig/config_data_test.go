@@ -1,8 +1,15 @@
 package coreconfig_test
 
 import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/util/credentialhelper"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -215,4 +222,72 @@ var _ = Describe("V3 Config files", func() {
 			Expect(*actualData).To(Equal(coreconfig.Data{}))
 		})
 	})
+
+	Context("when a credential helper is configured", func() {
+		var (
+			tempDir string
+			helper  credentialhelper.Helper
+		)
+
+		BeforeEach(func() {
+			var err error
+			tempDir, err = ioutil.TempDir("", "cf-config-data")
+			Expect(err).NotTo(HaveOccurred())
+			os.Setenv("CF_CREDENTIAL_FILE", filepath.Join(tempDir, "credentials.json"))
+
+			helper = credentialhelper.New(fileHelperPath)
+		})
+
+		AfterEach(func() {
+			os.Unsetenv("CF_CREDENTIAL_FILE")
+			os.RemoveAll(tempDir)
+		})
+
+		It("keeps the tokens in the helper instead of the config file", func() {
+			data := coreconfig.NewData()
+			data.Target = "api.example.com"
+			data.CredentialHelper = fileHelperPath
+			data.AccessToken = "the-access-token"
+			data.RefreshToken = "the-refresh-token"
+
+			jsonData, err := data.JSONMarshalV3()
+			Expect(err).NotTo(HaveOccurred())
+
+			var writtenData coreconfig.Data
+			Expect(json.Unmarshal(jsonData, &writtenData)).To(Succeed())
+			Expect(writtenData.AccessToken).To(BeEmpty())
+			Expect(writtenData.RefreshToken).To(BeEmpty())
+			Expect(writtenData.CredentialHelper).To(Equal(fileHelperPath))
+
+			credentials, err := helper.Get("api.example.com")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(credentials.AccessToken).To(Equal("the-access-token"))
+			Expect(credentials.RefreshToken).To(Equal("the-refresh-token"))
+
+			readData := coreconfig.NewData()
+			Expect(readData.JSONUnmarshalV3(jsonData)).To(Succeed())
+			Expect(readData.AccessToken).To(Equal("the-access-token"))
+			Expect(readData.RefreshToken).To(Equal("the-refresh-token"))
+
+			readData.AccessToken = ""
+			readData.RefreshToken = ""
+			_, err = readData.JSONMarshalV3()
+			Expect(err).NotTo(HaveOccurred())
+
+			credentials, err = helper.Get("api.example.com")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(credentials.Empty()).To(BeTrue())
+		})
+
+		Context("when the helper cannot be run", func() {
+			It("returns the helper's error", func() {
+				brokenHelper := filepath.Join(tempDir, "does-not-exist")
+				jsonData := []byte(fmt.Sprintf(`{"ConfigVersion": 3, "Target": "api.example.com", "CredentialHelper": %q}`, brokenHelper))
+
+				err := coreconfig.NewData().JSONUnmarshalV3(jsonData)
+				Expect(err).To(BeAssignableToTypeOf(credentialhelper.HelperError{}))
+				Expect(err.Error()).To(ContainSubstring(brokenHelper))
+			})
+		})
+	})
 })
@@ -80,6 +80,9 @@ type Reader interface {
 	UserEmail() string
 	IsLoggedIn() bool
 	IsSSLDisabled() bool
+	CACertFile() string
+	ClientCertFile() string
+	ClientKeyFile() string
 	IsMinAPIVersion(semver.Version) bool
 	IsMinCLIVersion(string) bool
 	MinCLIVersion() string
@@ -117,6 +120,9 @@ type ReadWriter interface {
 	SetOrganizationFields(models.OrganizationFields)
 	SetSpaceFields(models.SpaceFields)
 	SetSSLDisabled(bool)
+	SetCACertFile(string)
+	SetClientCertFile(string)
+	SetClientKeyFile(string)
 	SetAsyncTimeout(uint)
 	SetTrace(string)
 	SetColorEnabled(string)
@@ -323,6 +329,27 @@ func (c *ConfigRepository) IsSSLDisabled() (isSSLDisabled bool) {
 	return
 }
 
+func (c *ConfigRepository) CACertFile() (caCertFile string) {
+	c.read(func() {
+		caCertFile = c.data.SSLCACertFile
+	})
+	return
+}
+
+func (c *ConfigRepository) ClientCertFile() (clientCertFile string) {
+	c.read(func() {
+		clientCertFile = c.data.SSLClientCertFile
+	})
+	return
+}
+
+func (c *ConfigRepository) ClientKeyFile() (clientKeyFile string) {
+	c.read(func() {
+		clientKeyFile = c.data.SSLClientKeyFile
+	})
+	return
+}
+
 // SetCLIVersion should only be used in testing
 func (c *ConfigRepository) SetCLIVersion(v string) {
 	c.CFCLIVersion = v
@@ -528,6 +555,24 @@ func (c *ConfigRepository) SetSSLDisabled(disabled bool) {
 	})
 }
 
+func (c *ConfigRepository) SetCACertFile(caCertFile string) {
+	c.write(func() {
+		c.data.SSLCACertFile = caCertFile
+	})
+}
+
+func (c *ConfigRepository) SetClientCertFile(clientCertFile string) {
+	c.write(func() {
+		c.data.SSLClientCertFile = clientCertFile
+	})
+}
+
+func (c *ConfigRepository) SetClientKeyFile(clientKeyFile string) {
+	c.write(func() {
+		c.data.SSLClientKeyFile = clientKeyFile
+	})
+}
+
 func (c *ConfigRepository) SetAsyncTimeout(timeout uint) {
 	c.write(func() {
 		c.data.AsyncTimeout = timeout
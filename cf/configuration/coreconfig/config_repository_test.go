@@ -103,6 +103,15 @@ var _ = Describe("Configuration Repository", func() {
 		config.SetSSLDisabled(false)
 		Expect(config.IsSSLDisabled()).To(BeFalse())
 
+		config.SetCACertFile("/some/ca.pem")
+		Expect(config.CACertFile()).To(Equal("/some/ca.pem"))
+
+		config.SetClientCertFile("/some/client.pem")
+		Expect(config.ClientCertFile()).To(Equal("/some/client.pem"))
+
+		config.SetClientKeyFile("/some/client-key.pem")
+		Expect(config.ClientKeyFile()).To(Equal("/some/client-key.pem"))
+
 		config.SetLocale("en_US")
 		Expect(config.Locale()).To(Equal("en_US"))
 
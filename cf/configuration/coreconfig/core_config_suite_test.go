@@ -3,6 +3,7 @@ package coreconfig_test
 import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gexec"
 
 	"testing"
 )
@@ -11,3 +12,15 @@ func TestCoreConfig(t *testing.T) {
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "CoreConfig Suite")
 }
+
+var fileHelperPath string
+
+var _ = BeforeSuite(func() {
+	var err error
+	fileHelperPath, err = gexec.Build("code.cloudfoundry.org/cli/util/credentialhelper/cf-credential-file")
+	Expect(err).ToNot(HaveOccurred())
+})
+
+var _ = AfterSuite(func() {
+	gexec.CleanupBuildArtifacts()
+})
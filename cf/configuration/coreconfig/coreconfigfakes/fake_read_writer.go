@@ -136,6 +136,24 @@ type FakeReadWriter struct {
 	isSSLDisabledReturns     struct {
 		result1 bool
 	}
+	CACertFileStub        func() string
+	cACertFileMutex       sync.RWMutex
+	cACertFileArgsForCall []struct{}
+	cACertFileReturns     struct {
+		result1 string
+	}
+	ClientCertFileStub        func() string
+	clientCertFileMutex       sync.RWMutex
+	clientCertFileArgsForCall []struct{}
+	clientCertFileReturns     struct {
+		result1 string
+	}
+	ClientKeyFileStub        func() string
+	clientKeyFileMutex       sync.RWMutex
+	clientKeyFileArgsForCall []struct{}
+	clientKeyFileReturns     struct {
+		result1 string
+	}
 	IsMinAPIVersionStub        func(semver.Version) bool
 	isMinAPIVersionMutex       sync.RWMutex
 	isMinAPIVersionArgsForCall []struct {
@@ -283,6 +301,21 @@ type FakeReadWriter struct {
 	setSSLDisabledArgsForCall []struct {
 		arg1 bool
 	}
+	SetCACertFileStub        func(string)
+	setCACertFileMutex       sync.RWMutex
+	setCACertFileArgsForCall []struct {
+		arg1 string
+	}
+	SetClientCertFileStub        func(string)
+	setClientCertFileMutex       sync.RWMutex
+	setClientCertFileArgsForCall []struct {
+		arg1 string
+	}
+	SetClientKeyFileStub        func(string)
+	setClientKeyFileMutex       sync.RWMutex
+	setClientKeyFileArgsForCall []struct {
+		arg1 string
+	}
 	SetAsyncTimeoutStub        func(uint)
 	setAsyncTimeoutMutex       sync.RWMutex
 	setAsyncTimeoutArgsForCall []struct {
@@ -837,6 +870,12 @@ func (fake *FakeReadWriter) IsSSLDisabled() bool {
 func (fake *FakeReadWriter) IsSSLDisabledCallCount() int {
 	fake.isSSLDisabledMutex.RLock()
 	defer fake.isSSLDisabledMutex.RUnlock()
+	fake.cACertFileMutex.RLock()
+	defer fake.cACertFileMutex.RUnlock()
+	fake.clientCertFileMutex.RLock()
+	defer fake.clientCertFileMutex.RUnlock()
+	fake.clientKeyFileMutex.RLock()
+	defer fake.clientKeyFileMutex.RUnlock()
 	return len(fake.isSSLDisabledArgsForCall)
 }
 
@@ -847,6 +886,81 @@ func (fake *FakeReadWriter) IsSSLDisabledReturns(result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeReadWriter) CACertFile() string {
+	fake.cACertFileMutex.Lock()
+	fake.cACertFileArgsForCall = append(fake.cACertFileArgsForCall, struct{}{})
+	fake.recordInvocation("CACertFile", []interface{}{})
+	fake.cACertFileMutex.Unlock()
+	if fake.CACertFileStub != nil {
+		return fake.CACertFileStub()
+	} else {
+		return fake.cACertFileReturns.result1
+	}
+}
+
+func (fake *FakeReadWriter) CACertFileCallCount() int {
+	fake.cACertFileMutex.RLock()
+	defer fake.cACertFileMutex.RUnlock()
+	return len(fake.cACertFileArgsForCall)
+}
+
+func (fake *FakeReadWriter) CACertFileReturns(result1 string) {
+	fake.CACertFileStub = nil
+	fake.cACertFileReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeReadWriter) ClientCertFile() string {
+	fake.clientCertFileMutex.Lock()
+	fake.clientCertFileArgsForCall = append(fake.clientCertFileArgsForCall, struct{}{})
+	fake.recordInvocation("ClientCertFile", []interface{}{})
+	fake.clientCertFileMutex.Unlock()
+	if fake.ClientCertFileStub != nil {
+		return fake.ClientCertFileStub()
+	} else {
+		return fake.clientCertFileReturns.result1
+	}
+}
+
+func (fake *FakeReadWriter) ClientCertFileCallCount() int {
+	fake.clientCertFileMutex.RLock()
+	defer fake.clientCertFileMutex.RUnlock()
+	return len(fake.clientCertFileArgsForCall)
+}
+
+func (fake *FakeReadWriter) ClientCertFileReturns(result1 string) {
+	fake.ClientCertFileStub = nil
+	fake.clientCertFileReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeReadWriter) ClientKeyFile() string {
+	fake.clientKeyFileMutex.Lock()
+	fake.clientKeyFileArgsForCall = append(fake.clientKeyFileArgsForCall, struct{}{})
+	fake.recordInvocation("ClientKeyFile", []interface{}{})
+	fake.clientKeyFileMutex.Unlock()
+	if fake.ClientKeyFileStub != nil {
+		return fake.ClientKeyFileStub()
+	} else {
+		return fake.clientKeyFileReturns.result1
+	}
+}
+
+func (fake *FakeReadWriter) ClientKeyFileCallCount() int {
+	fake.clientKeyFileMutex.RLock()
+	defer fake.clientKeyFileMutex.RUnlock()
+	return len(fake.clientKeyFileArgsForCall)
+}
+
+func (fake *FakeReadWriter) ClientKeyFileReturns(result1 string) {
+	fake.ClientKeyFileStub = nil
+	fake.clientKeyFileReturns = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeReadWriter) IsMinAPIVersion(arg1 semver.Version) bool {
 	fake.isMinAPIVersionMutex.Lock()
 	fake.isMinAPIVersionArgsForCall = append(fake.isMinAPIVersionArgsForCall, struct {
@@ -1504,6 +1618,12 @@ func (fake *FakeReadWriter) SetSSLDisabled(arg1 bool) {
 func (fake *FakeReadWriter) SetSSLDisabledCallCount() int {
 	fake.setSSLDisabledMutex.RLock()
 	defer fake.setSSLDisabledMutex.RUnlock()
+	fake.setCACertFileMutex.RLock()
+	defer fake.setCACertFileMutex.RUnlock()
+	fake.setClientCertFileMutex.RLock()
+	defer fake.setClientCertFileMutex.RUnlock()
+	fake.setClientKeyFileMutex.RLock()
+	defer fake.setClientKeyFileMutex.RUnlock()
 	return len(fake.setSSLDisabledArgsForCall)
 }
 
@@ -1513,6 +1633,78 @@ func (fake *FakeReadWriter) SetSSLDisabledArgsForCall(i int) bool {
 	return fake.setSSLDisabledArgsForCall[i].arg1
 }
 
+func (fake *FakeReadWriter) SetCACertFile(arg1 string) {
+	fake.setCACertFileMutex.Lock()
+	fake.setCACertFileArgsForCall = append(fake.setCACertFileArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("SetCACertFile", []interface{}{arg1})
+	fake.setCACertFileMutex.Unlock()
+	if fake.SetCACertFileStub != nil {
+		fake.SetCACertFileStub(arg1)
+	}
+}
+
+func (fake *FakeReadWriter) SetCACertFileCallCount() int {
+	fake.setCACertFileMutex.RLock()
+	defer fake.setCACertFileMutex.RUnlock()
+	return len(fake.setCACertFileArgsForCall)
+}
+
+func (fake *FakeReadWriter) SetCACertFileArgsForCall(i int) string {
+	fake.setCACertFileMutex.RLock()
+	defer fake.setCACertFileMutex.RUnlock()
+	return fake.setCACertFileArgsForCall[i].arg1
+}
+
+func (fake *FakeReadWriter) SetClientCertFile(arg1 string) {
+	fake.setClientCertFileMutex.Lock()
+	fake.setClientCertFileArgsForCall = append(fake.setClientCertFileArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("SetClientCertFile", []interface{}{arg1})
+	fake.setClientCertFileMutex.Unlock()
+	if fake.SetClientCertFileStub != nil {
+		fake.SetClientCertFileStub(arg1)
+	}
+}
+
+func (fake *FakeReadWriter) SetClientCertFileCallCount() int {
+	fake.setClientCertFileMutex.RLock()
+	defer fake.setClientCertFileMutex.RUnlock()
+	return len(fake.setClientCertFileArgsForCall)
+}
+
+func (fake *FakeReadWriter) SetClientCertFileArgsForCall(i int) string {
+	fake.setClientCertFileMutex.RLock()
+	defer fake.setClientCertFileMutex.RUnlock()
+	return fake.setClientCertFileArgsForCall[i].arg1
+}
+
+func (fake *FakeReadWriter) SetClientKeyFile(arg1 string) {
+	fake.setClientKeyFileMutex.Lock()
+	fake.setClientKeyFileArgsForCall = append(fake.setClientKeyFileArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("SetClientKeyFile", []interface{}{arg1})
+	fake.setClientKeyFileMutex.Unlock()
+	if fake.SetClientKeyFileStub != nil {
+		fake.SetClientKeyFileStub(arg1)
+	}
+}
+
+func (fake *FakeReadWriter) SetClientKeyFileCallCount() int {
+	fake.setClientKeyFileMutex.RLock()
+	defer fake.setClientKeyFileMutex.RUnlock()
+	return len(fake.setClientKeyFileArgsForCall)
+}
+
+func (fake *FakeReadWriter) SetClientKeyFileArgsForCall(i int) string {
+	fake.setClientKeyFileMutex.RLock()
+	defer fake.setClientKeyFileMutex.RUnlock()
+	return fake.setClientKeyFileArgsForCall[i].arg1
+}
+
 func (fake *FakeReadWriter) SetAsyncTimeout(arg1 uint) {
 	fake.setAsyncTimeoutMutex.Lock()
 	fake.setAsyncTimeoutArgsForCall = append(fake.setAsyncTimeoutArgsForCall, struct {
@@ -0,0 +1,15 @@
+package flags
+
+import "strings"
+
+// SplitList splits a comma separated flag value into its items, trimming
+// whitespace around each item and dropping empty ones.
+func SplitList(value string) []string {
+	var list []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
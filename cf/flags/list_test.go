@@ -0,0 +1,22 @@
+package flags_test
+
+import (
+	"code.cloudfoundry.org/cli/cf/flags"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SplitList", func() {
+	It("splits a comma separated value and trims each item", func() {
+		Expect(flags.SplitList("a, b ,c")).To(Equal([]string{"a", "b", "c"}))
+	})
+
+	It("drops empty items", func() {
+		Expect(flags.SplitList(",a,,b, ")).To(Equal([]string{"a", "b"}))
+	})
+
+	It("returns nil for an empty value", func() {
+		Expect(flags.SplitList("")).To(BeNil())
+	})
+})
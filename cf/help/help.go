@@ -270,6 +270,8 @@ func newAppPresenter() appPresenter {
 					presentCommand("space-users"),
 					presentCommand("set-space-role"),
 					presentCommand("unset-space-role"),
+				}, {
+					presentCommand("audit-events"),
 				},
 			},
 		}, {
@@ -20,14 +20,23 @@ func GetHelpTemplate() string {
    CF_HOME=path/to/dir/               ` + T("Override path to default config directory") + `
    CF_DIAL_TIMEOUT=5                  ` + T("Max wait time to establish a connection, including name resolution, in seconds") + `
    CF_PLUGIN_HOME=path/to/dir/        ` + T("Override path to default plugin config directory") + `
+   CF_RECORD=path/to/cassette.json    ` + T("Record API requests and responses to a cassette file, with secrets redacted") + `
+   CF_REPLAY=path/to/cassette.json    ` + T("Serve API responses from a cassette file instead of the network") + `
+   CF_REQUEST_RETRIES=2               ` + T("Max number of times a failed API request is retried") + `
    CF_STAGING_TIMEOUT=15              ` + T("Max wait time for buildpack staging, in minutes") + `
    CF_STARTUP_TIMEOUT=5               ` + T("Max wait time for app instance startup, in minutes") + `
+   CF_TIMINGS=true                    ` + T("Display a summary of API request timings") + `
+   CF_TOKEN_REFRESH_WINDOW=60         ` + T("Refresh access tokens this many seconds before they expire") + `
    CF_TRACE=true                      ` + T("Print API request diagnostics to stdout") + `
    CF_TRACE=path/to/trace.log         ` + T("Append API request diagnostics to a log file") + `
+   CF_TRACE_HAR=path/to/trace.har     ` + T("Record API traffic to a HAR file") + `
+   http_proxy=proxy.example.com:8080  ` + T("Enable HTTP or SOCKS5 proxying for plain HTTP connections") + `
    https_proxy=proxy.example.com:8080 ` + T("Enable HTTP proxying for API requests") + `
+   no_proxy=.internal.example.com     ` + T("Connect directly to these comma separated hosts and domains") + `
 
 {{.Title "` + T("GLOBAL OPTIONS:") + `"}}
    --help, -h                         ` + T("Show help") + `
+   --timings                          ` + T("Display a summary of API request timings") + `
    -v                                 ` + T("Print API request diagnostics to stdout") + `
 `
 }
@@ -206,6 +206,7 @@ func mapToAppParams(basePath string, yamlMap generic.Map) (models.AppParams, err
 	appParams.HealthCheckType = stringVal(yamlMap, "health-check-type", &errs)
 	appParams.AppPorts = intSliceVal(yamlMap, "app-ports", &errs)
 	appParams.Routes = parseRoutes(yamlMap, &errs)
+	appParams.Tasks = parseTasks(yamlMap, &errs)
 
 	if appParams.Path != nil {
 		path := *appParams.Path
@@ -525,3 +526,47 @@ func parseRoutes(input generic.Map, errs *[]error) []models.ManifestRoute {
 
 	return manifestRoutes
 }
+
+func parseTasks(input generic.Map, errs *[]error) []models.ManifestTask {
+	if !input.Has("tasks") {
+		return nil
+	}
+
+	genericTasks, ok := input.Get("tasks").([]interface{})
+	if !ok {
+		*errs = append(*errs, errors.New(T("'tasks' should be a list")))
+		return nil
+	}
+
+	manifestTasks := []models.ManifestTask{}
+	names := map[string]bool{}
+	for _, genericTask := range genericTasks {
+		if !generic.IsMappable(genericTask) {
+			*errs = append(*errs, errors.New(T("each task in 'tasks' must have a 'name' and a 'command' property")))
+			continue
+		}
+
+		taskMap := generic.NewMap(genericTask)
+		name := stringVal(taskMap, "name", errs)
+		command := stringVal(taskMap, "command", errs)
+		if name == nil || command == nil {
+			*errs = append(*errs, errors.New(T("each task in 'tasks' must have a 'name' and a 'command' property")))
+			continue
+		}
+
+		if names[*name] {
+			*errs = append(*errs, errors.New(T("task '{{.TaskName}}' is defined more than once", map[string]interface{}{"TaskName": *name})))
+			continue
+		}
+		names[*name] = true
+
+		manifestTasks = append(manifestTasks, models.ManifestTask{
+			Name:      *name,
+			Command:   *command,
+			Memory:    bytesVal(taskMap, "memory", errs),
+			DiskQuota: bytesVal(taskMap, "disk_quota", errs),
+		})
+	}
+
+	return manifestTasks
+}
@@ -725,4 +725,77 @@ var _ = Describe("Manifests", func() {
 			})
 		})
 	})
+
+	Context("when tasks are provided", func() {
+		It("parses tasks into app params", func() {
+			m := NewManifest("/some/path/manifest.yml", generic.NewMap(map[interface{}]interface{}{
+				"applications": []interface{}{
+					generic.NewMap(map[interface{}]interface{}{
+						"name": "some-app",
+						"tasks": []interface{}{
+							map[interface{}]interface{}{
+								"name":       "migrate",
+								"command":    "bundle exec rake db:migrate",
+								"memory":     "1G",
+								"disk_quota": "512M",
+							},
+							map[interface{}]interface{}{
+								"name":    "warm-cache",
+								"command": "bin/warm",
+							},
+						},
+					}),
+				},
+			}))
+
+			apps, err := m.Applications()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(apps).To(HaveLen(1))
+
+			tasks := apps[0].Tasks
+			Expect(tasks).To(HaveLen(2))
+			Expect(tasks[0].Name).To(Equal("migrate"))
+			Expect(tasks[0].Command).To(Equal("bundle exec rake db:migrate"))
+			Expect(*tasks[0].Memory).To(Equal(int64(1024)))
+			Expect(*tasks[0].DiskQuota).To(Equal(int64(512)))
+			Expect(tasks[1].Name).To(Equal("warm-cache"))
+			Expect(tasks[1].Memory).To(BeNil())
+			Expect(tasks[1].DiskQuota).To(BeNil())
+		})
+
+		It("errors when 'tasks' is not a list", func() {
+			m := NewManifest("/some/path/manifest.yml", generic.NewMap(map[interface{}]interface{}{
+				"tasks": "migrate",
+			}))
+
+			_, err := m.Applications()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("'tasks' should be a list"))
+		})
+
+		It("errors when a task has no command", func() {
+			m := NewManifest("/some/path/manifest.yml", generic.NewMap(map[interface{}]interface{}{
+				"tasks": []interface{}{
+					map[interface{}]interface{}{"name": "migrate"},
+				},
+			}))
+
+			_, err := m.Applications()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("each task in 'tasks' must have a 'name' and a 'command' property"))
+		})
+
+		It("errors when a task name is defined more than once", func() {
+			m := NewManifest("/some/path/manifest.yml", generic.NewMap(map[interface{}]interface{}{
+				"tasks": []interface{}{
+					map[interface{}]interface{}{"name": "migrate", "command": "a"},
+					map[interface{}]interface{}{"name": "migrate", "command": "b"},
+				},
+			}))
+
+			_, err := m.Applications()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("task 'migrate' is defined more than once"))
+		})
+	})
 })
@@ -113,6 +113,7 @@ type AppParams struct {
 	PackageUpdatedAt   *time.Time
 	AppPorts           *[]int
 	Routes             []ManifestRoute
+	Tasks              []ManifestTask
 }
 
 func (app *AppParams) Merge(other *AppParams) {
@@ -0,0 +1,12 @@
+package models
+
+type AuditEvent struct {
+	EventFields
+	ActorType        string
+	ActeeGUID        string
+	ActeeType        string
+	ActeeName        string
+	SpaceGUID        string
+	OrganizationGUID string
+	Metadata         map[string]interface{}
+}
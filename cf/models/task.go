@@ -0,0 +1,10 @@
+package models
+
+// ManifestTask is a named task template defined under an application in a
+// manifest.
+type ManifestTask struct {
+	Name      string
+	Command   string
+	Memory    *int64
+	DiskQuota *int64
+}
@@ -38,6 +38,7 @@ func NewCloudControllerGateway(config coreconfig.Reader, clock func() time.Time,
 		Clock:           clock,
 		ui:              ui,
 		logger:          logger,
+		proxy:           newProxyResolver(logger),
 		PollingEnabled:  true,
 		DialTimeout:     dialTimeout(envDialTimeout),
 	}
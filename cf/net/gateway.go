@@ -21,6 +21,9 @@ import (
 	. "code.cloudfoundry.org/cli/cf/i18n"
 	"code.cloudfoundry.org/cli/cf/terminal"
 	"code.cloudfoundry.org/cli/cf/trace"
+	"code.cloudfoundry.org/cli/util/har"
+	"code.cloudfoundry.org/cli/util/proxy"
+	"code.cloudfoundry.org/cli/util/tlsconfig"
 	"code.cloudfoundry.org/cli/version"
 )
 
@@ -69,6 +72,8 @@ type Gateway struct {
 	transport       *http.Transport
 	ui              terminal.UI
 	logger          trace.Printer
+	harFile         *har.File
+	proxy           *proxy.Resolver
 	DialTimeout     time.Duration
 }
 
@@ -84,6 +89,26 @@ func (gateway *Gateway) SetTokenRefresher(auth tokenRefresher) {
 	gateway.authenticator = auth
 }
 
+// SetHARFile records every request made through the gateway to file.
+func (gateway *Gateway) SetHARFile(file *har.File) {
+	gateway.harFile = file
+}
+
+// HARFile returns the file requests are recorded to, or nil if they are not
+// recorded.
+func (gateway Gateway) HARFile() *har.File {
+	return gateway.harFile
+}
+
+// Proxy returns the resolver that decides which proxy the gateway's
+// connections go through.
+func (gateway Gateway) Proxy() *proxy.Resolver {
+	if gateway.proxy == nil {
+		return proxy.FromEnvironment()
+	}
+	return gateway.proxy
+}
+
 func (gateway Gateway) GetResource(url string, resource interface{}) (err error) {
 	request, err := gateway.NewRequest("GET", url, gateway.config.AccessToken(), nil)
 	if err != nil {
@@ -413,15 +438,26 @@ func (gateway Gateway) doRequest(request *http.Request) (*http.Response, error)
 	var err error
 
 	if gateway.transport == nil {
-		makeHTTPTransport(&gateway)
+		err = makeHTTPTransport(&gateway)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	httpClient := NewHTTPClient(gateway.transport, NewRequestDumper(gateway.logger))
 
 	httpClient.DumpRequest(request)
 
+	var harTimer *har.Timer
+	var harRequestBody string
+	sentRequest := request
+	if gateway.harFile != nil {
+		harRequestBody = readHARBody(request.Header, &request.Body)
+		sentRequest, harTimer = har.StartTimer(request)
+	}
+
 	for i := 0; i < 3; i++ {
-		response, err = httpClient.Do(request)
+		response, err = httpClient.Do(sentRequest)
 		if response == nil && err != nil {
 			continue
 		} else {
@@ -435,6 +471,10 @@ func (gateway Gateway) doRequest(request *http.Request) (*http.Response, error)
 
 	httpClient.DumpResponse(response)
 
+	if harTimer != nil {
+		gateway.recordHAREntry(request, harRequestBody, response, harTimer)
+	}
+
 	header := http.CanonicalHeaderKey("X-Cf-Warnings")
 	rawWarnings := response.Header[header]
 	for _, rawWarning := range rawWarnings {
@@ -445,15 +485,76 @@ func (gateway Gateway) doRequest(request *http.Request) (*http.Response, error)
 	return response, err
 }
 
-func makeHTTPTransport(gateway *Gateway) {
+func (gateway Gateway) recordHAREntry(request *http.Request, requestBody string, response *http.Response, timer *har.Timer) {
+	responseBody := readHARBody(response.Header, &response.Body)
+	started, elapsed, timings := timer.Stop()
+
+	err := gateway.harFile.AddEntry(har.Entry{
+		StartedDateTime: started,
+		Time:            elapsed,
+		Request:         har.NewRequest(request, requestBody),
+		Response:        har.NewResponse(response, responseBody),
+		Timings:         timings,
+	})
+	if err != nil {
+		gateway.ui.Warn(T("Error writing HAR file\n{{.Err}}", map[string]interface{}{"Err": err}))
+	}
+}
+
+// readHARBody reads the body for a HAR entry, with secrets sanitized like in
+// trace output, and replaces it so that it can still be read by the caller.
+// Multipart bodies, which carry application bits, and binary bodies, such as
+// droplets, are not read.
+func readHARBody(header http.Header, body *io.ReadCloser) string {
+	if *body == nil {
+		return ""
+	}
+	contentType := header.Get("Content-Type")
+	if strings.Contains(contentType, "multipart/form-data") {
+		return T("[MULTIPART/FORM-DATA CONTENT HIDDEN]")
+	}
+	if strings.Contains(contentType, "application/octet-stream") || strings.Contains(contentType, "application/zip") {
+		return T("[BINARY CONTENT HIDDEN]")
+	}
+
+	raw, err := ioutil.ReadAll(*body)
+	(*body).Close()
+	*body = ioutil.NopCloser(bytes.NewBuffer(raw))
+	if err != nil {
+		return ""
+	}
+	return trace.Sanitize(string(raw))
+}
+
+func makeHTTPTransport(gateway *Gateway) error {
+	gateway.transport = nil
+
+	tlsConfig := NewTLSConfig(gateway.trustedCerts, gateway.config.IsSSLDisabled())
+	err := tlsconfig.Apply(tlsConfig, gateway.config.CACertFile(), gateway.config.ClientCertFile(), gateway.config.ClientKeyFile())
+	if err != nil {
+		return err
+	}
+
+	resolver := gateway.Proxy()
 	gateway.transport = &http.Transport{
-		Dial: (&net.Dialer{
+		DialContext: resolver.DialContext(&net.Dialer{
 			KeepAlive: 30 * time.Second,
 			Timeout:   gateway.DialTimeout,
-		}).Dial,
-		TLSClientConfig: NewTLSConfig(gateway.trustedCerts, gateway.config.IsSSLDisabled()),
-		Proxy:           http.ProxyFromEnvironment,
+		}),
+		TLSClientConfig: tlsConfig,
+		Proxy:           resolver.Proxy,
+	}
+	return nil
+}
+
+// newProxyResolver returns a resolver for the proxy settings in the
+// environment that traces how connections to each host are made.
+func newProxyResolver(logger trace.Printer) *proxy.Resolver {
+	resolver := proxy.FromEnvironment()
+	if logger != nil {
+		resolver.AddLogger(NewProxyDecisionPrinter(logger))
 	}
+	return resolver
 }
 
 func dialTimeout(envDialTimeout string) time.Duration {
@@ -466,5 +567,7 @@ func dialTimeout(envDialTimeout string) time.Duration {
 
 func (gateway *Gateway) SetTrustedCerts(certificates []tls.Certificate) {
 	gateway.trustedCerts = certificates
+	// An invalid certificate file leaves the transport unset, so that the
+	// error is returned by the next request.
 	makeHTTPTransport(gateway)
 }
@@ -2,12 +2,15 @@ package net_test
 
 import (
 	"crypto/tls"
+	"encoding/json"
+	"encoding/pem"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"os"
+	"path/filepath"
 	"reflect"
 	"runtime"
 	"strings"
@@ -20,6 +23,8 @@ import (
 	"code.cloudfoundry.org/cli/cf/net/netfakes"
 	"code.cloudfoundry.org/cli/cf/terminal/terminalfakes"
 	"code.cloudfoundry.org/cli/cf/trace/tracefakes"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/har"
 	testconfig "code.cloudfoundry.org/cli/util/testhelpers/configuration"
 	testnet "code.cloudfoundry.org/cli/util/testhelpers/net"
 	"code.cloudfoundry.org/cli/version"
@@ -194,6 +199,93 @@ var _ = Describe("Gateway", func() {
 
 	})
 
+	Describe("recording to a HAR file", func() {
+		var (
+			tmpdir  string
+			harPath string
+		)
+
+		BeforeEach(func() {
+			ccServer = ghttp.NewServer()
+			config.SetAPIEndpoint(ccServer.URL())
+
+			var err error
+			tmpdir, err = ioutil.TempDir("", "gateway_har")
+			Expect(err).ToNot(HaveOccurred())
+			harPath = filepath.Join(tmpdir, "trace.har")
+			ccGateway.SetHARFile(har.NewFile(harPath, command.IsSecretHeader))
+
+			ccServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/v2/some-endpoint"),
+					ghttp.VerifyBody([]byte(`{"name":"some-name","password":"some-password"}`)),
+					ghttp.RespondWith(http.StatusCreated, `{"name":"some-name","access_token":"some-token"}`),
+				),
+			)
+		})
+
+		AfterEach(func() {
+			ccServer.Close()
+			os.RemoveAll(tmpdir)
+		})
+
+		It("records the request and response with secrets redacted", func() {
+			request, err := ccGateway.NewRequest("PUT", config.APIEndpoint()+"/v2/some-endpoint", "bearer some-access-token", strings.NewReader(`{"name":"some-name","password":"some-password"}`))
+			Expect(err).ToNot(HaveOccurred())
+
+			resource := map[string]string{}
+			_, err = ccGateway.PerformRequestForJSONResponse(request, &resource)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resource["name"]).To(Equal("some-name"))
+
+			Expect(ccGateway.HARFile()).ToNot(BeNil())
+
+			raw, err := ioutil.ReadFile(harPath)
+			Expect(err).ToNot(HaveOccurred())
+			var archive har.Archive
+			Expect(json.Unmarshal(raw, &archive)).To(Succeed())
+
+			Expect(archive.Log.Entries).To(HaveLen(1))
+			entry := archive.Log.Entries[0]
+			Expect(entry.Request.Method).To(Equal("PUT"))
+			Expect(entry.Request.URL).To(Equal(ccServer.URL() + "/v2/some-endpoint"))
+			Expect(entry.Request.Headers).To(ContainElement(har.NameValue{Name: "Authorization", Value: har.RedactedValue}))
+			Expect(entry.Request.PostData.Text).To(Equal(`{"name":"some-name","password":"[PRIVATE DATA HIDDEN]"}`))
+			Expect(entry.Response.Status).To(Equal(http.StatusCreated))
+			Expect(entry.Response.Content.Text).To(ContainSubstring(`"access_token":"[PRIVATE DATA HIDDEN]"`))
+			Expect(entry.Timings.Wait).To(BeNumerically(">=", 0))
+		})
+
+		Context("when the response is binary", func() {
+			BeforeEach(func() {
+				ccServer.SetHandler(0, ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/v2/some-download"),
+					ghttp.RespondWith(http.StatusOK, "some-droplet-bits", http.Header{"Content-Type": {"application/octet-stream"}}),
+				))
+			})
+
+			It("does not record the body and leaves it to the caller", func() {
+				request, err := ccGateway.NewRequest("GET", config.APIEndpoint()+"/v2/some-download", "bearer some-access-token", nil)
+				Expect(err).ToNot(HaveOccurred())
+
+				response, err := ccGateway.PerformRequest(request)
+				Expect(err).ToNot(HaveOccurred())
+				defer response.Body.Close()
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(body)).To(Equal("some-droplet-bits"))
+
+				raw, err := ioutil.ReadFile(harPath)
+				Expect(err).ToNot(HaveOccurred())
+				var archive har.Archive
+				Expect(json.Unmarshal(raw, &archive)).To(Succeed())
+
+				Expect(archive.Log.Entries).To(HaveLen(1))
+				Expect(archive.Log.Entries[0].Response.Content.Text).To(Equal("[BINARY CONTENT HIDDEN]"))
+			})
+		})
+	})
+
 	Describe("CRUD methods", func() {
 		Describe("Delete", func() {
 			var apiServer *httptest.Server
@@ -569,6 +661,42 @@ var _ = Describe("Gateway", func() {
 			})
 		})
 
+		Context("when a CA certificate file is configured", func() {
+			var caCertFile string
+
+			BeforeEach(func() {
+				tmpFile, err := ioutil.TempFile("", "gateway-ca")
+				Expect(err).NotTo(HaveOccurred())
+				caCertFile = tmpFile.Name()
+				tmpFile.Close()
+				config.SetCACertFile(caCertFile)
+			})
+
+			AfterEach(func() {
+				os.Remove(caCertFile)
+			})
+
+			Context("when the file contains the server's certificate authority", func() {
+				BeforeEach(func() {
+					caCert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: apiServer.TLS.Certificates[0].Certificate[0]})
+					Expect(ioutil.WriteFile(caCertFile, caCert, 0600)).To(Succeed())
+				})
+
+				It("trusts the server", func() {
+					_, apiErr := ccGateway.PerformRequest(request)
+					Expect(apiErr).NotTo(HaveOccurred())
+				})
+			})
+
+			Context("when the file does not contain certificates", func() {
+				It("returns an error", func() {
+					_, apiErr := ccGateway.PerformRequest(request)
+					Expect(apiErr).To(HaveOccurred())
+					Expect(apiErr.Error()).To(ContainSubstring("no PEM encoded certificates found in " + caCertFile))
+				})
+			})
+		})
+
 		Context("when SSL validation is disabled", func() {
 			BeforeEach(func() {
 				apiServer.TLS.Certificates = []tls.Certificate{testnet.MakeExpiredTLSCert()}
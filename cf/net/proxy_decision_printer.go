@@ -0,0 +1,20 @@
+package net
+
+import (
+	. "code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/cf/terminal"
+	"code.cloudfoundry.org/cli/cf/trace"
+	"code.cloudfoundry.org/cli/util/proxy"
+)
+
+type ProxyDecisionPrinter struct {
+	printer trace.Printer
+}
+
+func NewProxyDecisionPrinter(printer trace.Printer) ProxyDecisionPrinter {
+	return ProxyDecisionPrinter{printer: printer}
+}
+
+func (p ProxyDecisionPrinter) LogProxyDecision(decision proxy.Decision) {
+	p.printer.Printf("\n%s %s\n", terminal.HeaderColor(T("PROXY:")), decision)
+}
@@ -35,6 +35,7 @@ func NewRoutingAPIGateway(config coreconfig.Reader, clock func() time.Time, ui t
 		Clock:           clock,
 		ui:              ui,
 		logger:          logger,
+		proxy:           newProxyResolver(logger),
 		PollingEnabled:  true,
 		DialTimeout:     dialTimeout(envDialTimeout),
 	}
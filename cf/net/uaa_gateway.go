@@ -35,6 +35,7 @@ func NewUAAGateway(config coreconfig.Reader, ui terminal.UI, logger trace.Printe
 		Clock:           time.Now,
 		ui:              ui,
 		logger:          logger,
+		proxy:           newProxyResolver(logger),
 		PollingEnabled:  false,
 		DialTimeout:     dialTimeout(envDialTimeout),
 	}
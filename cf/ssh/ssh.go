@@ -21,6 +21,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/ssh/options"
 	"code.cloudfoundry.org/cli/cf/ssh/sigwinch"
 	"code.cloudfoundry.org/cli/cf/ssh/terminal"
+	"code.cloudfoundry.org/cli/util/proxy"
 	"github.com/docker/docker/pkg/term"
 )
 
@@ -446,19 +447,33 @@ func (c *secureShell) getWindowDimensions(terminalFd uintptr) (width int, height
 	return int(winSize.Width), int(winSize.Height)
 }
 
-type secureDialer struct{}
+type secureDialer struct {
+	proxy *proxy.Resolver
+}
 
 func (d *secureDialer) Dial(network string, address string, config *ssh.ClientConfig) (SecureClient, error) {
-	client, err := ssh.Dial(network, address, config)
+	conn, err := d.proxy.Dial(&net.Dialer{Timeout: config.Timeout}, network, address)
 	if err != nil {
 		return nil, err
 	}
 
-	return &secureClient{client: client}, nil
+	clientConn, chans, reqs, err := ssh.NewClientConn(conn, address, config)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return &secureClient{client: ssh.NewClient(clientConn, chans, reqs)}, nil
 }
 
 func DefaultSecureDialer() SecureDialer {
-	return &secureDialer{}
+	return NewSecureDialer(proxy.FromEnvironment())
+}
+
+// NewSecureDialer returns a SecureDialer that tunnels SSH connections through
+// the proxy chosen by resolver.
+func NewSecureDialer(resolver *proxy.Resolver) SecureDialer {
+	return &secureDialer{proxy: resolver}
 }
 
 type secureClient struct{ client *ssh.Client }
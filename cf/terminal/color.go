@@ -139,6 +139,14 @@ func LogSysHeaderColor(message string) string {
 	return ColorizeBold(message, cyan)
 }
 
+var logPrefixColors = []color.Attribute{cyan, magenta, yellow, green, color.FgBlue, red}
+
+// LogPrefixColor colors the prefix of a merged log line. Each index maps to a
+// color from a fixed palette so that every source keeps the same color.
+func LogPrefixColor(message string, index int) string {
+	return ColorizeBold(message, logPrefixColors[index%len(logPrefixColors)])
+}
+
 func isTerminal() bool {
 	return terminal.IsTerminal(int(os.Stdout.Fd()))
 }
@@ -0,0 +1,48 @@
+package command
+
+import "net/http"
+
+// Cassette is a recorded session of requests to and responses from the Cloud
+// Controller and UAA servers.
+type Cassette struct {
+	Interactions []CassetteInteraction `json:"interactions"`
+}
+
+// CassetteInteraction is a single request and the response it received.
+type CassetteInteraction struct {
+	Request  CassetteRequest  `json:"request"`
+	Response CassetteResponse `json:"response"`
+}
+
+// CassetteRequest is a recorded request.
+type CassetteRequest struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+// CassetteResponse is a recorded response.
+type CassetteResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// sanitizeCassetteHeader returns a copy of header with the headers that carry
+// secrets redacted.
+func sanitizeCassetteHeader(header http.Header) http.Header {
+	if len(header) == 0 {
+		return nil
+	}
+
+	sanitized := http.Header{}
+	for key, values := range header {
+		if IsSecretHeader(key) {
+			sanitized[key] = []string{RedactedValue}
+			continue
+		}
+		sanitized[key] = append([]string{}, values...)
+	}
+	return sanitized
+}
@@ -0,0 +1,111 @@
+package command
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// CassetteInteractionNotFoundError is returned when a request is made during
+// replay that was not recorded in the cassette.
+type CassetteInteractionNotFoundError struct {
+	Method string
+	URL    string
+}
+
+func (e CassetteInteractionNotFoundError) Error() string {
+	return fmt.Sprintf("no recorded response for %s %s", e.Method, e.URL)
+}
+
+// CassettePlayer is an http.RoundTripper that serves responses from a
+// cassette instead of the network. Requests are matched against the
+// recorded interactions by method, path and query, so a cassette can be
+// replayed against any target. Each recorded interaction is served once, in
+// the order it was recorded.
+type CassettePlayer struct {
+	interactions []CassetteInteraction
+	played       []bool
+	mutex        sync.Mutex
+}
+
+// NewCassettePlayer loads the cassette at filePath and returns a
+// CassettePlayer for it.
+func NewCassettePlayer(filePath string) (*CassettePlayer, error) {
+	cassette, err := loadCassette(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CassettePlayer{
+		interactions: cassette.Interactions,
+		played:       make([]bool, len(cassette.Interactions)),
+	}, nil
+}
+
+// RoundTrip returns the response of the first interaction matching the
+// request that has not been played yet.
+func (player *CassettePlayer) RoundTrip(request *http.Request) (*http.Response, error) {
+	if request.Body != nil {
+		ioutil.ReadAll(request.Body)
+		request.Body.Close()
+	}
+
+	player.mutex.Lock()
+	defer player.mutex.Unlock()
+
+	for i, interaction := range player.interactions {
+		if player.played[i] || interaction.Request.Method != request.Method {
+			continue
+		}
+
+		recordedURL, err := url.Parse(interaction.Request.URL)
+		if err != nil || recordedURL.RequestURI() != request.URL.RequestURI() {
+			continue
+		}
+
+		player.played[i] = true
+		return newCassetteResponse(request, interaction.Response), nil
+	}
+
+	return nil, CassetteInteractionNotFoundError{
+		Method: request.Method,
+		URL:    request.URL.String(),
+	}
+}
+
+func newCassetteResponse(request *http.Request, recorded CassetteResponse) *http.Response {
+	header := recorded.Header
+	if header == nil {
+		header = http.Header{}
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+		StatusCode:    recorded.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(strings.NewReader(recorded.Body)),
+		ContentLength: int64(len(recorded.Body)),
+		Request:       request,
+	}
+}
+
+func loadCassette(filePath string) (*Cassette, error) {
+	raw, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	var cassette Cassette
+	err = json.Unmarshal(raw, &cassette)
+	if err != nil {
+		return nil, err
+	}
+	return &cassette, nil
+}
@@ -0,0 +1,128 @@
+package command_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/command"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cassette Player", func() {
+	var (
+		tmpdir       string
+		cassetteFile string
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpdir, err = ioutil.TempDir("", "cassette_player")
+		Expect(err).ToNot(HaveOccurred())
+
+		cassetteFile = filepath.Join(tmpdir, "cassette.json")
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tmpdir)
+	})
+
+	Describe("NewCassettePlayer", func() {
+		Context("when the cassette does not exist", func() {
+			It("returns an error", func() {
+				_, err := NewCassettePlayer(cassetteFile)
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+		})
+
+		Context("when the cassette is not valid JSON", func() {
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(cassetteFile, []byte("not-json"), 0600)).To(Succeed())
+			})
+
+			It("returns an error", func() {
+				_, err := NewCassettePlayer(cassetteFile)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("RoundTrip", func() {
+		var player *CassettePlayer
+
+		BeforeEach(func() {
+			cassette := `{
+				"interactions": [
+					{
+						"request": {"method": "GET", "url": "https://api.recorded.com/v2/info"},
+						"response": {"status_code": 200, "header": {"X-Cf-Warnings": ["some-warning"]}, "body": "{\"api_version\":\"2.69.0\"}"}
+					},
+					{
+						"request": {"method": "GET", "url": "https://api.recorded.com/v2/apps?q=name:some-app"},
+						"response": {"status_code": 500, "body": "first"}
+					},
+					{
+						"request": {"method": "GET", "url": "https://api.recorded.com/v2/apps?q=name:some-app"},
+						"response": {"status_code": 200, "body": "second"}
+					}
+				]
+			}`
+			Expect(ioutil.WriteFile(cassetteFile, []byte(cassette), 0600)).To(Succeed())
+
+			var err error
+			player, err = NewCassettePlayer(cassetteFile)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("serves the recorded response regardless of the target host", func() {
+			request, err := http.NewRequest(http.MethodGet, "https://api.other.com/v2/info", nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			response, err := player.RoundTrip(request)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response.StatusCode).To(Equal(http.StatusOK))
+			Expect(response.Status).To(Equal("200 OK"))
+			Expect(response.Header.Get("X-Cf-Warnings")).To(Equal("some-warning"))
+			Expect(response.Request).To(Equal(request))
+
+			body, err := ioutil.ReadAll(response.Body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(body).To(MatchJSON(`{"api_version":"2.69.0"}`))
+		})
+
+		It("serves matching interactions in the order they were recorded", func() {
+			request, err := http.NewRequest(http.MethodGet, "https://api.recorded.com/v2/apps?q=name:some-app", nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			response, err := player.RoundTrip(request)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+
+			response, err = player.RoundTrip(request)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response.StatusCode).To(Equal(http.StatusOK))
+			body, err := ioutil.ReadAll(response.Body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(body)).To(Equal("second"))
+
+			_, err = player.RoundTrip(request)
+			Expect(err).To(MatchError(CassetteInteractionNotFoundError{
+				Method: http.MethodGet,
+				URL:    "https://api.recorded.com/v2/apps?q=name:some-app",
+			}))
+		})
+
+		Context("when no interaction matches the method", func() {
+			It("returns a CassetteInteractionNotFoundError", func() {
+				request, err := http.NewRequest(http.MethodDelete, "https://api.recorded.com/v2/info", nil)
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = player.RoundTrip(request)
+				Expect(err).To(MatchError("no recorded response for DELETE https://api.recorded.com/v2/info"))
+			})
+		})
+	})
+})
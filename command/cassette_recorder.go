@@ -0,0 +1,145 @@
+package command
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+)
+
+// cassetteTailSize is how much of the end of an existing cassette is read to
+// find where its interactions end.
+const cassetteTailSize = 64
+
+// ErrNotCassette is returned when an existing file does not end like a
+// cassette, so interactions cannot be appended to it.
+var ErrNotCassette = errors.New("not a cassette")
+
+// cassetteWriteMutex serializes writes from every CassetteRecorder, since the
+// Cloud Controller and UAA clients of a command record to the same path
+// concurrently.
+var cassetteWriteMutex sync.Mutex
+
+// CassetteRecorder stores requests and responses, with secrets redacted, in a
+// cassette file. Interactions are appended to the cassette if the file
+// already exists, so that a session spanning several commands can be
+// recorded. Only the end of the file is rewritten for each interaction, and
+// the file is a complete cassette after every interaction.
+type CassetteRecorder struct {
+	ui       UI
+	filePath string
+}
+
+// NewCassetteRecorder returns a CassetteRecorder that records to filePath.
+func NewCassetteRecorder(ui UI, filePath string) *CassetteRecorder {
+	return &CassetteRecorder{
+		ui:       ui,
+		filePath: filePath,
+	}
+}
+
+func (recorder *CassetteRecorder) HandleInternalError(err error) {
+	recorder.ui.DisplayWarning(err.Error())
+}
+
+// RecordInteraction appends the request and response to the cassette.
+func (recorder *CassetteRecorder) RecordInteraction(request *http.Request, requestBody []byte, response *http.Response, responseBody []byte) error {
+	sanitizedRequestBody, err := sanitizeBody(request.Header, requestBody)
+	if err != nil {
+		return err
+	}
+	sanitizedResponseBody, err := sanitizeBody(response.Header, responseBody)
+	if err != nil {
+		return err
+	}
+
+	rawInteraction, err := json.Marshal(CassetteInteraction{
+		Request: CassetteRequest{
+			Method: request.Method,
+			URL:    request.URL.String(),
+			Header: sanitizeCassetteHeader(request.Header),
+			Body:   sanitizedRequestBody,
+		},
+		Response: CassetteResponse{
+			StatusCode: response.StatusCode,
+			Header:     sanitizeCassetteHeader(response.Header),
+			Body:       sanitizedResponseBody,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	cassetteWriteMutex.Lock()
+	defer cassetteWriteMutex.Unlock()
+
+	cassetteFile, err := os.OpenFile(recorder.filePath, os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return err
+	}
+	defer cassetteFile.Close()
+
+	info, err := cassetteFile.Stat()
+	if err != nil {
+		return err
+	}
+	if info.Size() == 0 {
+		_, err = cassetteFile.Write([]byte(`{"interactions":[]}`))
+		if err != nil {
+			return err
+		}
+	}
+
+	offset, empty, err := interactionsEnd(cassetteFile)
+	if err != nil {
+		return err
+	}
+
+	separator := ",\n"
+	if empty {
+		separator = "\n"
+	}
+
+	end := []byte(separator + string(rawInteraction) + "\n]}\n")
+	_, err = cassetteFile.WriteAt(end, offset)
+	if err != nil {
+		return err
+	}
+	return cassetteFile.Truncate(offset + int64(len(end)))
+}
+
+// interactionsEnd returns the offset of the bracket that closes the
+// interactions of the cassette, which must be followed by nothing but the end
+// of the cassette, and whether the cassette has no interactions yet.
+func interactionsEnd(cassetteFile *os.File) (int64, bool, error) {
+	info, err := cassetteFile.Stat()
+	if err != nil {
+		return 0, false, err
+	}
+
+	start := info.Size() - cassetteTailSize
+	if start < 0 {
+		start = 0
+	}
+	tail := make([]byte, info.Size()-start)
+	_, err = cassetteFile.ReadAt(tail, start)
+	if err != nil && err != io.EOF {
+		return 0, false, err
+	}
+
+	end := len(tail)
+	for _, closing := range []byte("}]") {
+		end = len(bytes.TrimRight(tail[:end], " \t\r\n"))
+		if end == 0 || tail[end-1] != closing {
+			return 0, false, ErrNotCassette
+		}
+		end--
+	}
+
+	trimmed := bytes.TrimRight(tail[:end], " \t\r\n")
+	empty := len(trimmed) > 0 && trimmed[len(trimmed)-1] == '['
+	return start + int64(end), empty, nil
+}
@@ -0,0 +1,177 @@
+package command_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Cassette Recorder", func() {
+	var (
+		testUI       *ui.UI
+		recorder     *CassetteRecorder
+		tmpdir       string
+		cassetteFile string
+
+		request  *http.Request
+		response *http.Response
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(NewBuffer(), NewBuffer(), NewBuffer())
+		var err error
+		tmpdir, err = ioutil.TempDir("", "cassette_recorder")
+		Expect(err).ToNot(HaveOccurred())
+
+		cassetteFile = filepath.Join(tmpdir, "cassette.json")
+		recorder = NewCassetteRecorder(testUI, cassetteFile)
+
+		request, err = http.NewRequest(http.MethodPost, "https://api.example.com/v2/apps?async=true", nil)
+		Expect(err).ToNot(HaveOccurred())
+		request.Header.Set("Authorization", "bearer some-token")
+		request.Header.Set("Content-Type", "application/json")
+
+		response = &http.Response{
+			StatusCode: http.StatusCreated,
+			Header: http.Header{
+				"Content-Type":  {"application/json"},
+				"X-Cf-Warnings": {"some-warning"},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tmpdir)
+	})
+
+	readCassette := func() Cassette {
+		raw, err := ioutil.ReadFile(cassetteFile)
+		Expect(err).ToNot(HaveOccurred())
+
+		var cassette Cassette
+		Expect(json.Unmarshal(raw, &cassette)).To(Succeed())
+		return cassette
+	}
+
+	Describe("RecordInteraction", func() {
+		It("writes the interaction to the cassette with secrets redacted", func() {
+			err := recorder.RecordInteraction(request,
+				[]byte(`{"name":"some-app","password":"some-password"}`),
+				response,
+				[]byte(`{"entity":{"name":"some-app","access_token":"some-token"}}`))
+			Expect(err).ToNot(HaveOccurred())
+
+			cassette := readCassette()
+			Expect(cassette.Interactions).To(HaveLen(1))
+
+			interaction := cassette.Interactions[0]
+			Expect(interaction.Request.Method).To(Equal(http.MethodPost))
+			Expect(interaction.Request.URL).To(Equal("https://api.example.com/v2/apps?async=true"))
+			Expect(interaction.Request.Header.Get("Authorization")).To(Equal(RedactedValue))
+			Expect(interaction.Request.Header.Get("Content-Type")).To(Equal("application/json"))
+			Expect(interaction.Request.Body).To(MatchJSON(`{"name":"some-app","password":"[PRIVATE DATA HIDDEN]"}`))
+
+			Expect(interaction.Response.StatusCode).To(Equal(http.StatusCreated))
+			Expect(interaction.Response.Header.Get("X-Cf-Warnings")).To(Equal("some-warning"))
+			Expect(interaction.Response.Body).To(MatchJSON(`{"entity":{"name":"some-app","access_token":"[PRIVATE DATA HIDDEN]"}}`))
+
+			Expect(request.Header.Get("Authorization")).To(Equal("bearer some-token"))
+		})
+
+		It("redacts secrets from form encoded bodies", func() {
+			request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			err := recorder.RecordInteraction(request,
+				[]byte("grant_type=refresh_token&refresh_token=some-refresh-token"),
+				response,
+				nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			cassette := readCassette()
+			Expect(cassette.Interactions[0].Request.Body).To(Equal("grant_type=refresh_token&refresh_token=%5BPRIVATE+DATA+HIDDEN%5D"))
+			Expect(cassette.Interactions[0].Response.Body).To(BeEmpty())
+		})
+
+		It("hides multipart bodies", func() {
+			request.Header.Set("Content-Type", "multipart/form-data; boundary=some-boundary")
+			err := recorder.RecordInteraction(request, []byte("some-application-bits"), response, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(readCassette().Interactions[0].Request.Body).To(Equal(RedactedValue))
+		})
+
+		It("stores bodies that are not JSON as is", func() {
+			err := recorder.RecordInteraction(request, nil, response, []byte("some-plain-text"))
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(readCassette().Interactions[0].Response.Body).To(Equal("some-plain-text"))
+		})
+
+		It("appends every interaction to the cassette", func() {
+			Expect(recorder.RecordInteraction(request, nil, response, nil)).To(Succeed())
+			Expect(recorder.RecordInteraction(request, nil, response, nil)).To(Succeed())
+
+			Expect(readCassette().Interactions).To(HaveLen(2))
+		})
+
+		Context("when the cassette already exists", func() {
+			BeforeEach(func() {
+				err := ioutil.WriteFile(cassetteFile, []byte(`{"interactions":[{"request":{"method":"GET","url":"https://api.example.com/v2/info"},"response":{"status_code":200}}]}`), 0600)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("appends to the existing interactions", func() {
+				Expect(recorder.RecordInteraction(request, nil, response, nil)).To(Succeed())
+
+				cassette := readCassette()
+				Expect(cassette.Interactions).To(HaveLen(2))
+				Expect(cassette.Interactions[0].Request.URL).To(Equal("https://api.example.com/v2/info"))
+				Expect(cassette.Interactions[1].Request.Method).To(Equal(http.MethodPost))
+			})
+		})
+
+		Context("when the cassette is not valid JSON", func() {
+			BeforeEach(func() {
+				err := ioutil.WriteFile(cassetteFile, []byte("not-json"), 0600)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("returns an error", func() {
+				err := recorder.RecordInteraction(request, nil, response, nil)
+				Expect(err).To(MatchError(ErrNotCassette))
+			})
+		})
+
+		It("keeps the cassette valid when several recorders write to it concurrently", func() {
+			otherRecorder := NewCassetteRecorder(testUI, cassetteFile)
+
+			done := make(chan error)
+			for i := 0; i < 10; i++ {
+				go func(r *CassetteRecorder) {
+					done <- r.RecordInteraction(request, nil, response, nil)
+				}([]*CassetteRecorder{recorder, otherRecorder}[i%2])
+			}
+			for i := 0; i < 10; i++ {
+				Expect(<-done).To(Succeed())
+			}
+
+			Expect(readCassette().Interactions).To(HaveLen(10))
+		})
+	})
+
+	Describe("HandleInternalError", func() {
+		It("displays the error as a warning", func() {
+			recorder.HandleInternalError(errors.New("some-error"))
+			Expect(testUI.Err).To(Say("some-error"))
+		})
+	})
+})
@@ -34,6 +34,24 @@ type FakeConfig struct {
 	binaryVersionReturns     struct {
 		result1 string
 	}
+	CACertFileStub        func() string
+	cACertFileMutex       sync.RWMutex
+	cACertFileArgsForCall []struct{}
+	cACertFileReturns     struct {
+		result1 string
+	}
+	ClientCertFileStub        func() string
+	clientCertFileMutex       sync.RWMutex
+	clientCertFileArgsForCall []struct{}
+	clientCertFileReturns     struct {
+		result1 string
+	}
+	ClientKeyFileStub        func() string
+	clientKeyFileMutex       sync.RWMutex
+	clientKeyFileArgsForCall []struct{}
+	clientKeyFileReturns     struct {
+		result1 string
+	}
 	ColorEnabledStub        func() configv3.ColorSetting
 	colorEnabledMutex       sync.RWMutex
 	colorEnabledArgsForCall []struct{}
@@ -71,6 +89,18 @@ type FakeConfig struct {
 	hasTargetedSpaceReturns     struct {
 		result1 bool
 	}
+	HTTPProxyStub        func() string
+	hTTPProxyMutex       sync.RWMutex
+	hTTPProxyArgsForCall []struct{}
+	hTTPProxyReturns     struct {
+		result1 string
+	}
+	HTTPSProxyStub        func() string
+	hTTPSProxyMutex       sync.RWMutex
+	hTTPSProxyArgsForCall []struct{}
+	hTTPSProxyReturns     struct {
+		result1 string
+	}
 	LocaleStub        func() string
 	localeMutex       sync.RWMutex
 	localeArgsForCall []struct{}
@@ -83,6 +113,12 @@ type FakeConfig struct {
 	minCLIVersionReturns     struct {
 		result1 string
 	}
+	NoProxyStub        func() string
+	noProxyMutex       sync.RWMutex
+	noProxyArgsForCall []struct{}
+	noProxyReturns     struct {
+		result1 string
+	}
 	OverallPollingTimeoutStub        func() time.Duration
 	overallPollingTimeoutMutex       sync.RWMutex
 	overallPollingTimeoutArgsForCall []struct{}
@@ -101,17 +137,48 @@ type FakeConfig struct {
 	pollingIntervalReturns     struct {
 		result1 time.Duration
 	}
+	RecordFileStub        func() string
+	recordFileMutex       sync.RWMutex
+	recordFileArgsForCall []struct{}
+	recordFileReturns     struct {
+		result1 string
+	}
 	RefreshTokenStub        func() string
 	refreshTokenMutex       sync.RWMutex
 	refreshTokenArgsForCall []struct{}
 	refreshTokenReturns     struct {
 		result1 string
 	}
+	ReplayFileStub        func() string
+	replayFileMutex       sync.RWMutex
+	replayFileArgsForCall []struct{}
+	replayFileReturns     struct {
+		result1 string
+	}
+	RequestRetriesStub        func() int
+	requestRetriesMutex       sync.RWMutex
+	requestRetriesArgsForCall []struct{}
+	requestRetriesReturns     struct {
+		result1 int
+	}
+	RequestTimingsStub        func() *command.RequestTimings
+	requestTimingsMutex       sync.RWMutex
+	requestTimingsArgsForCall []struct{}
+	requestTimingsReturns     struct {
+		result1 *command.RequestTimings
+	}
 	SetAccessTokenStub        func(token string)
 	setAccessTokenMutex       sync.RWMutex
 	setAccessTokenArgsForCall []struct {
 		token string
 	}
+	SetCertificateInformationStub        func(caCertFile string, clientCertFile string, clientKeyFile string)
+	setCertificateInformationMutex       sync.RWMutex
+	setCertificateInformationArgsForCall []struct {
+		caCertFile     string
+		clientCertFile string
+		clientKeyFile  string
+	}
 	SetOrganizationInformationStub        func(guid string, name string)
 	setOrganizationInformationMutex       sync.RWMutex
 	setOrganizationInformationArgsForCall []struct {
@@ -155,6 +222,12 @@ type FakeConfig struct {
 	skipSSLValidationReturns     struct {
 		result1 bool
 	}
+	StartupTimeoutStub        func() time.Duration
+	startupTimeoutMutex       sync.RWMutex
+	startupTimeoutArgsForCall []struct{}
+	startupTimeoutReturns     struct {
+		result1 time.Duration
+	}
 	TargetStub        func() string
 	targetMutex       sync.RWMutex
 	targetArgsForCall []struct{}
@@ -173,6 +246,24 @@ type FakeConfig struct {
 	targetedSpaceReturns     struct {
 		result1 configv3.Space
 	}
+	TimingsStub        func() bool
+	timingsMutex       sync.RWMutex
+	timingsArgsForCall []struct{}
+	timingsReturns     struct {
+		result1 bool
+	}
+	TokenRefreshWindowStub        func() time.Duration
+	tokenRefreshWindowMutex       sync.RWMutex
+	tokenRefreshWindowArgsForCall []struct{}
+	tokenRefreshWindowReturns     struct {
+		result1 time.Duration
+	}
+	TraceHARFileStub        func() string
+	traceHARFileMutex       sync.RWMutex
+	traceHARFileArgsForCall []struct{}
+	traceHARFileReturns     struct {
+		result1 string
+	}
 	UAAOAuthClientStub        func() string
 	uAAOAuthClientMutex       sync.RWMutex
 	uAAOAuthClientArgsForCall []struct{}
@@ -302,6 +393,81 @@ func (fake *FakeConfig) BinaryVersionReturns(result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) CACertFile() string {
+	fake.cACertFileMutex.Lock()
+	fake.cACertFileArgsForCall = append(fake.cACertFileArgsForCall, struct{}{})
+	fake.recordInvocation("CACertFile", []interface{}{})
+	fake.cACertFileMutex.Unlock()
+	if fake.CACertFileStub != nil {
+		return fake.CACertFileStub()
+	} else {
+		return fake.cACertFileReturns.result1
+	}
+}
+
+func (fake *FakeConfig) CACertFileCallCount() int {
+	fake.cACertFileMutex.RLock()
+	defer fake.cACertFileMutex.RUnlock()
+	return len(fake.cACertFileArgsForCall)
+}
+
+func (fake *FakeConfig) CACertFileReturns(result1 string) {
+	fake.CACertFileStub = nil
+	fake.cACertFileReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ClientCertFile() string {
+	fake.clientCertFileMutex.Lock()
+	fake.clientCertFileArgsForCall = append(fake.clientCertFileArgsForCall, struct{}{})
+	fake.recordInvocation("ClientCertFile", []interface{}{})
+	fake.clientCertFileMutex.Unlock()
+	if fake.ClientCertFileStub != nil {
+		return fake.ClientCertFileStub()
+	} else {
+		return fake.clientCertFileReturns.result1
+	}
+}
+
+func (fake *FakeConfig) ClientCertFileCallCount() int {
+	fake.clientCertFileMutex.RLock()
+	defer fake.clientCertFileMutex.RUnlock()
+	return len(fake.clientCertFileArgsForCall)
+}
+
+func (fake *FakeConfig) ClientCertFileReturns(result1 string) {
+	fake.ClientCertFileStub = nil
+	fake.clientCertFileReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ClientKeyFile() string {
+	fake.clientKeyFileMutex.Lock()
+	fake.clientKeyFileArgsForCall = append(fake.clientKeyFileArgsForCall, struct{}{})
+	fake.recordInvocation("ClientKeyFile", []interface{}{})
+	fake.clientKeyFileMutex.Unlock()
+	if fake.ClientKeyFileStub != nil {
+		return fake.ClientKeyFileStub()
+	} else {
+		return fake.clientKeyFileReturns.result1
+	}
+}
+
+func (fake *FakeConfig) ClientKeyFileCallCount() int {
+	fake.clientKeyFileMutex.RLock()
+	defer fake.clientKeyFileMutex.RUnlock()
+	return len(fake.clientKeyFileArgsForCall)
+}
+
+func (fake *FakeConfig) ClientKeyFileReturns(result1 string) {
+	fake.ClientKeyFileStub = nil
+	fake.clientKeyFileReturns = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) ColorEnabled() configv3.ColorSetting {
 	fake.colorEnabledMutex.Lock()
 	fake.colorEnabledArgsForCall = append(fake.colorEnabledArgsForCall, struct{}{})
@@ -453,6 +619,56 @@ func (fake *FakeConfig) HasTargetedSpaceReturns(result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeConfig) HTTPProxy() string {
+	fake.hTTPProxyMutex.Lock()
+	fake.hTTPProxyArgsForCall = append(fake.hTTPProxyArgsForCall, struct{}{})
+	fake.recordInvocation("HTTPProxy", []interface{}{})
+	fake.hTTPProxyMutex.Unlock()
+	if fake.HTTPProxyStub != nil {
+		return fake.HTTPProxyStub()
+	} else {
+		return fake.hTTPProxyReturns.result1
+	}
+}
+
+func (fake *FakeConfig) HTTPProxyCallCount() int {
+	fake.hTTPProxyMutex.RLock()
+	defer fake.hTTPProxyMutex.RUnlock()
+	return len(fake.hTTPProxyArgsForCall)
+}
+
+func (fake *FakeConfig) HTTPProxyReturns(result1 string) {
+	fake.HTTPProxyStub = nil
+	fake.hTTPProxyReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) HTTPSProxy() string {
+	fake.hTTPSProxyMutex.Lock()
+	fake.hTTPSProxyArgsForCall = append(fake.hTTPSProxyArgsForCall, struct{}{})
+	fake.recordInvocation("HTTPSProxy", []interface{}{})
+	fake.hTTPSProxyMutex.Unlock()
+	if fake.HTTPSProxyStub != nil {
+		return fake.HTTPSProxyStub()
+	} else {
+		return fake.hTTPSProxyReturns.result1
+	}
+}
+
+func (fake *FakeConfig) HTTPSProxyCallCount() int {
+	fake.hTTPSProxyMutex.RLock()
+	defer fake.hTTPSProxyMutex.RUnlock()
+	return len(fake.hTTPSProxyArgsForCall)
+}
+
+func (fake *FakeConfig) HTTPSProxyReturns(result1 string) {
+	fake.HTTPSProxyStub = nil
+	fake.hTTPSProxyReturns = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) Locale() string {
 	fake.localeMutex.Lock()
 	fake.localeArgsForCall = append(fake.localeArgsForCall, struct{}{})
@@ -503,6 +719,31 @@ func (fake *FakeConfig) MinCLIVersionReturns(result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) NoProxy() string {
+	fake.noProxyMutex.Lock()
+	fake.noProxyArgsForCall = append(fake.noProxyArgsForCall, struct{}{})
+	fake.recordInvocation("NoProxy", []interface{}{})
+	fake.noProxyMutex.Unlock()
+	if fake.NoProxyStub != nil {
+		return fake.NoProxyStub()
+	} else {
+		return fake.noProxyReturns.result1
+	}
+}
+
+func (fake *FakeConfig) NoProxyCallCount() int {
+	fake.noProxyMutex.RLock()
+	defer fake.noProxyMutex.RUnlock()
+	return len(fake.noProxyArgsForCall)
+}
+
+func (fake *FakeConfig) NoProxyReturns(result1 string) {
+	fake.NoProxyStub = nil
+	fake.noProxyReturns = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) OverallPollingTimeout() time.Duration {
 	fake.overallPollingTimeoutMutex.Lock()
 	fake.overallPollingTimeoutArgsForCall = append(fake.overallPollingTimeoutArgsForCall, struct{}{})
@@ -578,6 +819,31 @@ func (fake *FakeConfig) PollingIntervalReturns(result1 time.Duration) {
 	}{result1}
 }
 
+func (fake *FakeConfig) RecordFile() string {
+	fake.recordFileMutex.Lock()
+	fake.recordFileArgsForCall = append(fake.recordFileArgsForCall, struct{}{})
+	fake.recordInvocation("RecordFile", []interface{}{})
+	fake.recordFileMutex.Unlock()
+	if fake.RecordFileStub != nil {
+		return fake.RecordFileStub()
+	} else {
+		return fake.recordFileReturns.result1
+	}
+}
+
+func (fake *FakeConfig) RecordFileCallCount() int {
+	fake.recordFileMutex.RLock()
+	defer fake.recordFileMutex.RUnlock()
+	return len(fake.recordFileArgsForCall)
+}
+
+func (fake *FakeConfig) RecordFileReturns(result1 string) {
+	fake.RecordFileStub = nil
+	fake.recordFileReturns = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) RefreshToken() string {
 	fake.refreshTokenMutex.Lock()
 	fake.refreshTokenArgsForCall = append(fake.refreshTokenArgsForCall, struct{}{})
@@ -603,6 +869,81 @@ func (fake *FakeConfig) RefreshTokenReturns(result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) ReplayFile() string {
+	fake.replayFileMutex.Lock()
+	fake.replayFileArgsForCall = append(fake.replayFileArgsForCall, struct{}{})
+	fake.recordInvocation("ReplayFile", []interface{}{})
+	fake.replayFileMutex.Unlock()
+	if fake.ReplayFileStub != nil {
+		return fake.ReplayFileStub()
+	} else {
+		return fake.replayFileReturns.result1
+	}
+}
+
+func (fake *FakeConfig) ReplayFileCallCount() int {
+	fake.replayFileMutex.RLock()
+	defer fake.replayFileMutex.RUnlock()
+	return len(fake.replayFileArgsForCall)
+}
+
+func (fake *FakeConfig) ReplayFileReturns(result1 string) {
+	fake.ReplayFileStub = nil
+	fake.replayFileReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) RequestRetries() int {
+	fake.requestRetriesMutex.Lock()
+	fake.requestRetriesArgsForCall = append(fake.requestRetriesArgsForCall, struct{}{})
+	fake.recordInvocation("RequestRetries", []interface{}{})
+	fake.requestRetriesMutex.Unlock()
+	if fake.RequestRetriesStub != nil {
+		return fake.RequestRetriesStub()
+	} else {
+		return fake.requestRetriesReturns.result1
+	}
+}
+
+func (fake *FakeConfig) RequestRetriesCallCount() int {
+	fake.requestRetriesMutex.RLock()
+	defer fake.requestRetriesMutex.RUnlock()
+	return len(fake.requestRetriesArgsForCall)
+}
+
+func (fake *FakeConfig) RequestRetriesReturns(result1 int) {
+	fake.RequestRetriesStub = nil
+	fake.requestRetriesReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeConfig) RequestTimings() *command.RequestTimings {
+	fake.requestTimingsMutex.Lock()
+	fake.requestTimingsArgsForCall = append(fake.requestTimingsArgsForCall, struct{}{})
+	fake.recordInvocation("RequestTimings", []interface{}{})
+	fake.requestTimingsMutex.Unlock()
+	if fake.RequestTimingsStub != nil {
+		return fake.RequestTimingsStub()
+	} else {
+		return fake.requestTimingsReturns.result1
+	}
+}
+
+func (fake *FakeConfig) RequestTimingsCallCount() int {
+	fake.requestTimingsMutex.RLock()
+	defer fake.requestTimingsMutex.RUnlock()
+	return len(fake.requestTimingsArgsForCall)
+}
+
+func (fake *FakeConfig) RequestTimingsReturns(result1 *command.RequestTimings) {
+	fake.RequestTimingsStub = nil
+	fake.requestTimingsReturns = struct {
+		result1 *command.RequestTimings
+	}{result1}
+}
+
 func (fake *FakeConfig) SetAccessToken(token string) {
 	fake.setAccessTokenMutex.Lock()
 	fake.setAccessTokenArgsForCall = append(fake.setAccessTokenArgsForCall, struct {
@@ -627,6 +968,32 @@ func (fake *FakeConfig) SetAccessTokenArgsForCall(i int) string {
 	return fake.setAccessTokenArgsForCall[i].token
 }
 
+func (fake *FakeConfig) SetCertificateInformation(caCertFile string, clientCertFile string, clientKeyFile string) {
+	fake.setCertificateInformationMutex.Lock()
+	fake.setCertificateInformationArgsForCall = append(fake.setCertificateInformationArgsForCall, struct {
+		caCertFile     string
+		clientCertFile string
+		clientKeyFile  string
+	}{caCertFile, clientCertFile, clientKeyFile})
+	fake.recordInvocation("SetCertificateInformation", []interface{}{caCertFile, clientCertFile, clientKeyFile})
+	fake.setCertificateInformationMutex.Unlock()
+	if fake.SetCertificateInformationStub != nil {
+		fake.SetCertificateInformationStub(caCertFile, clientCertFile, clientKeyFile)
+	}
+}
+
+func (fake *FakeConfig) SetCertificateInformationCallCount() int {
+	fake.setCertificateInformationMutex.RLock()
+	defer fake.setCertificateInformationMutex.RUnlock()
+	return len(fake.setCertificateInformationArgsForCall)
+}
+
+func (fake *FakeConfig) SetCertificateInformationArgsForCall(i int) (string, string, string) {
+	fake.setCertificateInformationMutex.RLock()
+	defer fake.setCertificateInformationMutex.RUnlock()
+	return fake.setCertificateInformationArgsForCall[i].caCertFile, fake.setCertificateInformationArgsForCall[i].clientCertFile, fake.setCertificateInformationArgsForCall[i].clientKeyFile
+}
+
 func (fake *FakeConfig) SetOrganizationInformation(guid string, name string) {
 	fake.setOrganizationInformationMutex.Lock()
 	fake.setOrganizationInformationArgsForCall = append(fake.setOrganizationInformationArgsForCall, struct {
@@ -784,6 +1151,31 @@ func (fake *FakeConfig) SkipSSLValidationReturns(result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeConfig) StartupTimeout() time.Duration {
+	fake.startupTimeoutMutex.Lock()
+	fake.startupTimeoutArgsForCall = append(fake.startupTimeoutArgsForCall, struct{}{})
+	fake.recordInvocation("StartupTimeout", []interface{}{})
+	fake.startupTimeoutMutex.Unlock()
+	if fake.StartupTimeoutStub != nil {
+		return fake.StartupTimeoutStub()
+	} else {
+		return fake.startupTimeoutReturns.result1
+	}
+}
+
+func (fake *FakeConfig) StartupTimeoutCallCount() int {
+	fake.startupTimeoutMutex.RLock()
+	defer fake.startupTimeoutMutex.RUnlock()
+	return len(fake.startupTimeoutArgsForCall)
+}
+
+func (fake *FakeConfig) StartupTimeoutReturns(result1 time.Duration) {
+	fake.StartupTimeoutStub = nil
+	fake.startupTimeoutReturns = struct {
+		result1 time.Duration
+	}{result1}
+}
+
 func (fake *FakeConfig) Target() string {
 	fake.targetMutex.Lock()
 	fake.targetArgsForCall = append(fake.targetArgsForCall, struct{}{})
@@ -859,6 +1251,81 @@ func (fake *FakeConfig) TargetedSpaceReturns(result1 configv3.Space) {
 	}{result1}
 }
 
+func (fake *FakeConfig) Timings() bool {
+	fake.timingsMutex.Lock()
+	fake.timingsArgsForCall = append(fake.timingsArgsForCall, struct{}{})
+	fake.recordInvocation("Timings", []interface{}{})
+	fake.timingsMutex.Unlock()
+	if fake.TimingsStub != nil {
+		return fake.TimingsStub()
+	} else {
+		return fake.timingsReturns.result1
+	}
+}
+
+func (fake *FakeConfig) TimingsCallCount() int {
+	fake.timingsMutex.RLock()
+	defer fake.timingsMutex.RUnlock()
+	return len(fake.timingsArgsForCall)
+}
+
+func (fake *FakeConfig) TimingsReturns(result1 bool) {
+	fake.TimingsStub = nil
+	fake.timingsReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) TokenRefreshWindow() time.Duration {
+	fake.tokenRefreshWindowMutex.Lock()
+	fake.tokenRefreshWindowArgsForCall = append(fake.tokenRefreshWindowArgsForCall, struct{}{})
+	fake.recordInvocation("TokenRefreshWindow", []interface{}{})
+	fake.tokenRefreshWindowMutex.Unlock()
+	if fake.TokenRefreshWindowStub != nil {
+		return fake.TokenRefreshWindowStub()
+	} else {
+		return fake.tokenRefreshWindowReturns.result1
+	}
+}
+
+func (fake *FakeConfig) TokenRefreshWindowCallCount() int {
+	fake.tokenRefreshWindowMutex.RLock()
+	defer fake.tokenRefreshWindowMutex.RUnlock()
+	return len(fake.tokenRefreshWindowArgsForCall)
+}
+
+func (fake *FakeConfig) TokenRefreshWindowReturns(result1 time.Duration) {
+	fake.TokenRefreshWindowStub = nil
+	fake.tokenRefreshWindowReturns = struct {
+		result1 time.Duration
+	}{result1}
+}
+
+func (fake *FakeConfig) TraceHARFile() string {
+	fake.traceHARFileMutex.Lock()
+	fake.traceHARFileArgsForCall = append(fake.traceHARFileArgsForCall, struct{}{})
+	fake.recordInvocation("TraceHARFile", []interface{}{})
+	fake.traceHARFileMutex.Unlock()
+	if fake.TraceHARFileStub != nil {
+		return fake.TraceHARFileStub()
+	} else {
+		return fake.traceHARFileReturns.result1
+	}
+}
+
+func (fake *FakeConfig) TraceHARFileCallCount() int {
+	fake.traceHARFileMutex.RLock()
+	defer fake.traceHARFileMutex.RUnlock()
+	return len(fake.traceHARFileArgsForCall)
+}
+
+func (fake *FakeConfig) TraceHARFileReturns(result1 string) {
+	fake.TraceHARFileStub = nil
+	fake.traceHARFileReturns = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) UAAOAuthClient() string {
 	fake.uAAOAuthClientMutex.Lock()
 	fake.uAAOAuthClientArgsForCall = append(fake.uAAOAuthClientArgsForCall, struct{}{})
@@ -978,6 +1445,12 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.binaryNameMutex.RUnlock()
 	fake.binaryVersionMutex.RLock()
 	defer fake.binaryVersionMutex.RUnlock()
+	fake.cACertFileMutex.RLock()
+	defer fake.cACertFileMutex.RUnlock()
+	fake.clientCertFileMutex.RLock()
+	defer fake.clientCertFileMutex.RUnlock()
+	fake.clientKeyFileMutex.RLock()
+	defer fake.clientKeyFileMutex.RUnlock()
 	fake.colorEnabledMutex.RLock()
 	defer fake.colorEnabledMutex.RUnlock()
 	fake.currentUserMutex.RLock()
@@ -990,20 +1463,36 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.hasTargetedOrganizationMutex.RUnlock()
 	fake.hasTargetedSpaceMutex.RLock()
 	defer fake.hasTargetedSpaceMutex.RUnlock()
+	fake.hTTPProxyMutex.RLock()
+	defer fake.hTTPProxyMutex.RUnlock()
+	fake.hTTPSProxyMutex.RLock()
+	defer fake.hTTPSProxyMutex.RUnlock()
 	fake.localeMutex.RLock()
 	defer fake.localeMutex.RUnlock()
 	fake.minCLIVersionMutex.RLock()
 	defer fake.minCLIVersionMutex.RUnlock()
+	fake.noProxyMutex.RLock()
+	defer fake.noProxyMutex.RUnlock()
 	fake.overallPollingTimeoutMutex.RLock()
 	defer fake.overallPollingTimeoutMutex.RUnlock()
 	fake.pluginsMutex.RLock()
 	defer fake.pluginsMutex.RUnlock()
 	fake.pollingIntervalMutex.RLock()
 	defer fake.pollingIntervalMutex.RUnlock()
+	fake.recordFileMutex.RLock()
+	defer fake.recordFileMutex.RUnlock()
 	fake.refreshTokenMutex.RLock()
 	defer fake.refreshTokenMutex.RUnlock()
+	fake.replayFileMutex.RLock()
+	defer fake.replayFileMutex.RUnlock()
+	fake.requestRetriesMutex.RLock()
+	defer fake.requestRetriesMutex.RUnlock()
+	fake.requestTimingsMutex.RLock()
+	defer fake.requestTimingsMutex.RUnlock()
 	fake.setAccessTokenMutex.RLock()
 	defer fake.setAccessTokenMutex.RUnlock()
+	fake.setCertificateInformationMutex.RLock()
+	defer fake.setCertificateInformationMutex.RUnlock()
 	fake.setOrganizationInformationMutex.RLock()
 	defer fake.setOrganizationInformationMutex.RUnlock()
 	fake.setRefreshTokenMutex.RLock()
@@ -1016,12 +1505,20 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.setTokenInformationMutex.RUnlock()
 	fake.skipSSLValidationMutex.RLock()
 	defer fake.skipSSLValidationMutex.RUnlock()
+	fake.startupTimeoutMutex.RLock()
+	defer fake.startupTimeoutMutex.RUnlock()
 	fake.targetMutex.RLock()
 	defer fake.targetMutex.RUnlock()
 	fake.targetedOrganizationMutex.RLock()
 	defer fake.targetedOrganizationMutex.RUnlock()
 	fake.targetedSpaceMutex.RLock()
 	defer fake.targetedSpaceMutex.RUnlock()
+	fake.timingsMutex.RLock()
+	defer fake.timingsMutex.RUnlock()
+	fake.tokenRefreshWindowMutex.RLock()
+	defer fake.tokenRefreshWindowMutex.RUnlock()
+	fake.traceHARFileMutex.RLock()
+	defer fake.traceHARFileMutex.RUnlock()
 	fake.uAAOAuthClientMutex.RLock()
 	defer fake.uAAOAuthClientMutex.RUnlock()
 	fake.uAAOAuthClientSecretMutex.RLock()
@@ -9,6 +9,7 @@ var Commands commandList
 
 type commandList struct {
 	VerboseOrVersion                   bool                                         `short:"v" long:"version" description:"verbose and version flag"`
+	Timings                            bool                                         `long:"timings" description:"display a summary of API request timings"`
 	App                                v2.AppCommand                                `command:"app" description:"Display health and status for app"`
 	Help                               HelpCommand                                  `command:"help" alias:"h" description:"Show help"`
 	Version                            VersionCommand                               `command:"version" description:"Print the version"`
@@ -29,6 +30,7 @@ type commandList struct {
 	Restage                            v2.RestageCommand                            `command:"restage" alias:"rg" description:"Recreate the app's executable artifact using the latest pushed app files and the latest environment (variables, service bindings, buildpack, stack, etc.)"`
 	RestartAppInstance                 v2.RestartAppInstanceCommand                 `command:"restart-app-instance" description:"Terminate the running application Instance at the given index and instantiate a new instance of the application with the same index"`
 	Events                             v2.EventsCommand                             `command:"events" description:"Show recent app events"`
+	Crashes                            v2.CrashesCommand                            `command:"crashes" description:"Show the recent crashes of an app with instance states and logs"`
 	Files                              v2.FilesCommand                              `command:"files" alias:"f" description:"Print out a list of files in a directory or the contents of a specific file of an app running on the DEA backend"`
 	Logs                               v2.LogsCommand                               `command:"logs" description:"Tail or show recent logs for an app"`
 	Env                                v2.EnvCommand                                `command:"env" alias:"e" description:"Show all env variables for an app"`
@@ -38,6 +40,7 @@ type commandList struct {
 	Stack                              v2.StackCommand                              `command:"stack" description:"Show information for a stack (a stack is a pre-built file system, including an operating system, that can run apps)"`
 	CopySource                         v2.CopySourceCommand                         `command:"copy-source" description:"Copies the source code of an application to another existing application (and restarts that application)"`
 	CreateAppManifest                  v2.CreateAppManifestCommand                  `command:"create-app-manifest" description:"Create an app manifest for an app that has been pushed successfully"`
+	DownloadDroplet                    v2.DownloadDropletCommand                    `command:"download-droplet" description:"Download the droplet of a staged app as a gzipped tarball"`
 	GetHealthCheck                     v2.GetHealthCheckCommand                     `command:"get-health-check" description:"Get the health_check_type value of an app"`
 	SetHealthCheck                     v2.SetHealthCheckCommand                     `command:"set-health-check" description:"Set health_check_type flag to either 'port' or 'none'"`
 	EnableSSH                          v2.EnableSSHCommand                          `command:"enable-ssh" description:"Enable ssh for the application"`
@@ -94,12 +97,17 @@ type commandList struct {
 	DeleteBuildpack                    v2.DeleteBuildpackCommand                    `command:"delete-buildpack" description:"Delete a buildpack"`
 	CreateUser                         v2.CreateUserCommand                         `command:"create-user" description:"Create a new user"`
 	DeleteUser                         v2.DeleteUserCommand                         `command:"delete-user" description:"Delete a user"`
+	Users                              v2.UsersCommand                              `command:"users" description:"List UAA users"`
+	User                               v2.UserCommand                               `command:"user" description:"Show, activate or deactivate a UAA user"`
+	SetUserPassword                    v2.SetUserPasswordCommand                    `command:"set-user-password" description:"Set or reset the password of a UAA user"`
+	UserGroups                         v2.UserGroupsCommand                         `command:"user-groups" description:"Show or change the UAA group memberships of a user"`
 	OrgUsers                           v2.OrgUsersCommand                           `command:"org-users" description:"Show org users by role"`
 	SetOrgRole                         v2.SetOrgRoleCommand                         `command:"set-org-role" description:"Assign an org role to a user"`
 	UnsetOrgRole                       v2.UnsetOrgRoleCommand                       `command:"unset-org-role" description:"Remove an org role from a user"`
 	SpaceUsers                         v2.SpaceUsersCommand                         `command:"space-users" description:"Show space users by role"`
 	SetSpaceRole                       v2.SetSpaceRoleCommand                       `command:"set-space-role" description:"Assign a space role to a user"`
 	UnsetSpaceRole                     v2.UnsetSpaceRoleCommand                     `command:"unset-space-role" description:"Remove a space role from a user"`
+	AuditEvents                        v2.AuditEventsCommand                        `command:"audit-events" description:"Show the audit trail of a space, org or user"`
 	Quotas                             v2.QuotasCommand                             `command:"quotas" description:"List available usage quotas"`
 	Quota                              v2.QuotaCommand                              `command:"quota" description:"Show quota info"`
 	SetQuota                           v2.SetQuotaCommand                           `command:"set-quota" description:"Assign a quota to an org"`
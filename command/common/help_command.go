@@ -291,15 +291,24 @@ func (cmd HelpCommand) environmentalVariablesTableData() [][]string {
 		{"CF_DIAL_TIMEOUT=5", cmd.UI.TranslateText("Max wait time to establish a connection, including name resolution, in seconds")},
 		{"CF_HOME=path/to/dir/", cmd.UI.TranslateText("Override path to default config directory")},
 		{"CF_PLUGIN_HOME=path/to/dir/", cmd.UI.TranslateText("Override path to default plugin config directory")},
+		{"CF_RECORD=path/to/cassette.json", cmd.UI.TranslateText("Record API requests and responses to a cassette file, with secrets redacted")},
+		{"CF_REPLAY=path/to/cassette.json", cmd.UI.TranslateText("Serve API responses from a cassette file instead of the network")},
+		{"CF_REQUEST_RETRIES=2", cmd.UI.TranslateText("Max number of times a failed API request is retried")},
+		{"CF_TIMINGS=true", cmd.UI.TranslateText("Display a summary of API request timings")},
+		{"CF_TOKEN_REFRESH_WINDOW=60", cmd.UI.TranslateText("Refresh access tokens this many seconds before they expire")},
 		{"CF_TRACE=true", cmd.UI.TranslateText("Print API request diagnostics to stdout")},
 		{"CF_TRACE=path/to/trace.log", cmd.UI.TranslateText("Append API request diagnostics to a log file")},
+		{"CF_TRACE_HAR=path/to/trace.har", cmd.UI.TranslateText("Record API traffic to a HAR file")},
+		{"http_proxy=proxy.example.com:8080", cmd.UI.TranslateText("Enable HTTP or SOCKS5 proxying for plain HTTP connections")},
 		{"https_proxy=proxy.example.com:8080", cmd.UI.TranslateText("Enable HTTP proxying for API requests")},
+		{"no_proxy=.internal.example.com", cmd.UI.TranslateText("Connect directly to these comma separated hosts and domains")},
 	}
 }
 
 func (cmd HelpCommand) globalOptionsTableData() [][]string {
 	return [][]string{
 		{"--help, -h", cmd.UI.TranslateText("Show help")},
+		{"--timings", cmd.UI.TranslateText("Display a summary of API request timings")},
 		{"-v", cmd.UI.TranslateText("Print API request diagnostics to stdout")},
 	}
 }
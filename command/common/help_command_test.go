@@ -353,6 +353,7 @@ var _ = Describe("help Command", func() {
 
 			Expect(testUI.Out).To(Say("Global options:"))
 			Expect(testUI.Out).To(Say("  --help, -h                         Show help"))
+			Expect(testUI.Out).To(Say("  --timings                          Display a summary of API request timings"))
 			Expect(testUI.Out).To(Say("  -v                                 Print API request diagnostics to stdout"))
 
 			Expect(testUI.Out).To(Say("These are commonly used commands. Use 'cf help -a' to see all, with descriptions."))
@@ -521,12 +522,21 @@ var _ = Describe("help Command", func() {
 				Expect(testUI.Out).To(Say("   CF_DIAL_TIMEOUT=5                  Max wait time to establish a connection, including name resolution, in seconds"))
 				Expect(testUI.Out).To(Say("   CF_HOME=path/to/dir/               Override path to default config directory"))
 				Expect(testUI.Out).To(Say("   CF_PLUGIN_HOME=path/to/dir/        Override path to default plugin config directory"))
+				Expect(testUI.Out).To(Say("   CF_RECORD=path/to/cassette.json    Record API requests and responses to a cassette file, with secrets redacted"))
+				Expect(testUI.Out).To(Say("   CF_REPLAY=path/to/cassette.json    Serve API responses from a cassette file instead of the network"))
+				Expect(testUI.Out).To(Say("   CF_REQUEST_RETRIES=2               Max number of times a failed API request is retried"))
+				Expect(testUI.Out).To(Say("   CF_TIMINGS=true                    Display a summary of API request timings"))
+				Expect(testUI.Out).To(Say("   CF_TOKEN_REFRESH_WINDOW=60         Refresh access tokens this many seconds before they expire"))
 				Expect(testUI.Out).To(Say("   CF_TRACE=true                      Print API request diagnostics to stdout"))
 				Expect(testUI.Out).To(Say("   CF_TRACE=path/to/trace.log         Append API request diagnostics to a log file"))
+				Expect(testUI.Out).To(Say("   CF_TRACE_HAR=path/to/trace.har     Record API traffic to a HAR file"))
+				Expect(testUI.Out).To(Say("   http_proxy=proxy.example.com:8080  Enable HTTP or SOCKS5 proxying for plain HTTP connections"))
 				Expect(testUI.Out).To(Say("   https_proxy=proxy.example.com:8080 Enable HTTP proxying for API requests"))
+				Expect(testUI.Out).To(Say("   no_proxy=.internal.example.com     Connect directly to these comma separated hosts and domains"))
 
 				Expect(testUI.Out).To(Say("GLOBAL OPTIONS:"))
 				Expect(testUI.Out).To(Say("   --help, -h                         Show help"))
+				Expect(testUI.Out).To(Say("   --timings                          Display a summary of API request timings"))
 				Expect(testUI.Out).To(Say("   -v                                 Print API request diagnostics to stdout"))
 			})
 
@@ -15,10 +15,10 @@ var HelpCategoryList = []HelpCategory{
 			{"push", "scale", "delete", "rename"},
 			{"start", "stop", "restart", "restage", "restart-app-instance"},
 			{"run-task", "tasks", "terminate-task"},
-			{"events", "files", "logs"},
+			{"events", "crashes", "files", "logs"},
 			{"env", "set-env", "unset-env"},
 			{"stacks", "stack"},
-			{"copy-source", "create-app-manifest"},
+			{"copy-source", "create-app-manifest", "download-droplet"},
 			{"get-health-check", "set-health-check", "enable-ssh", "disable-ssh", "ssh-enabled", "ssh"},
 		},
 	},
@@ -71,8 +71,10 @@ var HelpCategoryList = []HelpCategory{
 		CategoryName: "USER ADMIN:",
 		CommandList: [][]string{
 			{"create-user", "delete-user"},
+			{"users", "user", "set-user-password", "user-groups"},
 			{"org-users", "set-org-role", "unset-org-role"},
 			{"space-users", "set-space-role", "unset-space-role"},
+			{"audit-events"},
 		},
 	},
 	{
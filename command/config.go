@@ -14,28 +14,43 @@ type Config interface {
 	AccessToken() string
 	BinaryName() string
 	BinaryVersion() string
+	CACertFile() string
+	ClientCertFile() string
+	ClientKeyFile() string
 	ColorEnabled() configv3.ColorSetting
 	CurrentUser() (configv3.User, error)
 	DialTimeout() time.Duration
 	Experimental() bool
 	HasTargetedOrganization() bool
 	HasTargetedSpace() bool
+	HTTPProxy() string
+	HTTPSProxy() string
 	Locale() string
 	MinCLIVersion() string
+	NoProxy() string
 	OverallPollingTimeout() time.Duration
 	Plugins() map[string]configv3.Plugin
 	PollingInterval() time.Duration
+	RecordFile() string
 	RefreshToken() string
+	ReplayFile() string
+	RequestRetries() int
+	RequestTimings() *RequestTimings
 	SetAccessToken(token string)
+	SetCertificateInformation(caCertFile string, clientCertFile string, clientKeyFile string)
 	SetOrganizationInformation(guid string, name string)
 	SetRefreshToken(token string)
 	SetSpaceInformation(guid string, name string, allowSSH bool)
 	SetTargetInformation(api string, apiVersion string, auth string, minCLIVersion string, doppler string, uaa string, routing string, skipSSLValidation bool)
 	SetTokenInformation(accessToken string, refreshToken string, sshOAuthClient string)
 	SkipSSLValidation() bool
+	StartupTimeout() time.Duration
 	Target() string
 	TargetedOrganization() configv3.Organization
 	TargetedSpace() configv3.Space
+	Timings() bool
+	TokenRefreshWindow() time.Duration
+	TraceHARFile() string
 	UAAOAuthClient() string
 	UAAOAuthClientSecret() string
 	UnsetSpaceInformation()
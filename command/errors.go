@@ -1,6 +1,9 @@
 package command
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 type APIRequestError struct {
 	Err error
@@ -21,7 +24,7 @@ type InvalidSSLCertError struct {
 }
 
 func (e InvalidSSLCertError) Error() string {
-	return "Invalid SSL Cert for {{.API}}\nTIP: Use 'cf api --skip-ssl-validation' to continue with an insecure API endpoint"
+	return "Invalid SSL Cert for {{.API}}\nTIP: Use 'cf api --ca-cert CA_CERT_FILE' to trust a private certificate authority, or 'cf api --skip-ssl-validation' to continue with an insecure API endpoint"
 }
 
 func (e InvalidSSLCertError) Translate(translate func(string, ...interface{}) string) string {
@@ -44,6 +47,89 @@ func (e SSLCertErrorError) Translate(translate func(string, ...interface{}) stri
 	})
 }
 
+type InvalidCACertError struct {
+	Path string
+}
+
+func (e InvalidCACertError) Error() string {
+	return "No PEM encoded certificates found in CA certificate file {{.Path}}"
+}
+
+func (e InvalidCACertError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Path": e.Path,
+	})
+}
+
+type IncompleteClientCertError struct{}
+
+func (IncompleteClientCertError) Error() string {
+	return "Incorrect Usage: '--client-cert' and '--client-key' must be used together"
+}
+
+func (e IncompleteClientCertError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
+
+type InvalidAccessTokenError struct{}
+
+func (InvalidAccessTokenError) Error() string {
+	return "The access token could not be decoded. Log in again to get a new token."
+}
+
+func (e InvalidAccessTokenError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
+
+type InsufficientScopeError struct {
+	Scopes []string
+}
+
+func (e InsufficientScopeError) Error() string {
+	if len(e.Scopes) == 0 {
+		return "You are not authorized to perform the requested action. Your access token does not have the UAA scope it requires; ask your UAA administrator which scope that is."
+	}
+	return "You are not authorized to perform the requested action. It requires one of the UAA scopes {{.Scopes}}, which your access token does not have. Ask your UAA administrator to add you to the group of one of these scopes, then log in again."
+}
+
+func (e InsufficientScopeError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Scopes": strings.Join(e.Scopes, ", "),
+	})
+}
+
+type MissingScopeError struct {
+	Scope string
+}
+
+func (MissingScopeError) Error() string {
+	return "The access token does not have the scope {{.Scope}}."
+}
+
+func (e MissingScopeError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Scope": e.Scope,
+	})
+}
+
+type InvalidClientCertError struct {
+	CertFile string
+	KeyFile  string
+	Err      error
+}
+
+func (e InvalidClientCertError) Error() string {
+	return "Unable to load client certificate {{.CertFile}} and key {{.KeyFile}}: {{.Error}}"
+}
+
+func (e InvalidClientCertError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"CertFile": e.CertFile,
+		"KeyFile":  e.KeyFile,
+		"Error":    e.Err,
+	})
+}
+
 type NoAPISetError struct {
 	BinaryName string
 }
@@ -188,3 +274,17 @@ func (e MinimumAPIVersionNotMetError) Translate(translate func(string, ...interf
 		"MinimumVersion": e.MinimumVersion,
 	})
 }
+
+type ArgumentCombinationError struct {
+	Args []string
+}
+
+func (e ArgumentCombinationError) Error() string {
+	return "Incorrect Usage: The following arguments cannot be used together: {{.Args}}"
+}
+
+func (e ArgumentCombinationError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Args": strings.Join(e.Args, ", "),
+	})
+}
@@ -43,6 +43,11 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("InvalidSSLCertError", InvalidSSLCertError{}),
 		Entry("SSLCertErrorError", SSLCertErrorError{}),
 		Entry("APINotFoundError", APINotFoundError{}),
+		Entry("InvalidCACertError", InvalidCACertError{}),
+		Entry("InvalidClientCertError", InvalidClientCertError{}),
+		Entry("MissingScopeError", MissingScopeError{}),
+		Entry("InsufficientScopeError", InsufficientScopeError{}),
+		Entry("InsufficientScopeError with scopes", InsufficientScopeError{Scopes: []string{"scim.read"}}),
 
 		// Actor errors.
 		Entry("ApplicationNotFoundError", ApplicationNotFoundError{}),
@@ -50,6 +55,7 @@ var _ = Describe("Translatable Errors", func() {
 
 		// Parse errors.
 		Entry("ParseArgumentError", ParseArgumentError{}),
+		Entry("ArgumentCombinationError", ArgumentCombinationError{}),
 
 		// Version errors.
 		Entry("MinimumAPIVersionNotMetError", MinimumAPIVersionNotMetError{}),
@@ -4,6 +4,10 @@ type AppName struct {
 	AppName string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
 }
 
+type AppNames struct {
+	AppNames []string `positional-arg-name:"APP_NAME" description:"The application names"`
+}
+
 type Buildpack struct {
 	Buildpack string `positional-arg-name:"BUILDPACK" required:"true" description:"The buildpack"`
 }
@@ -90,9 +94,14 @@ type CreateUser struct {
 	Password *string `positional-arg-name:"PASSWORD" description:"The password"`
 }
 
+type SetUserPassword struct {
+	Username string  `positional-arg-name:"USERNAME" required:"true" description:"The username"`
+	Password *string `positional-arg-name:"PASSWORD" description:"The new password"`
+}
+
 type AppInstance struct {
 	AppName string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
-	Index   int    `positional-arg-name:"INDEX" required:"true" description:"The index of the application instance"`
+	Index   string `positional-arg-name:"INDEX" description:"The index of the application instance"`
 }
 
 type OrgSpace struct {
@@ -281,10 +290,10 @@ type InstallPluginArgs struct {
 
 type RunTaskArgs struct {
 	AppName string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
-	Command string `positional-arg-name:"COMMAND" required:"true" description:"The command to execute"`
+	Command string `positional-arg-name:"COMMAND" description:"The command to execute"`
 }
 
 type TerminateTaskArgs struct {
 	AppName    string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
-	SequenceID string `positional-arg-name:"TASK_ID" required:"true" description:"The task's unique sequence ID"`
+	SequenceID string `positional-arg-name:"TASK_ID" description:"The task's unique sequence ID"`
 }
@@ -0,0 +1,34 @@
+package flag
+
+import (
+	"strconv"
+	"time"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// Interval is a positive polling interval given as a duration, e.g. 10s or
+// 1m, or as a number of seconds.
+type Interval time.Duration
+
+func (i *Interval) UnmarshalFlag(val string) error {
+	interval, err := time.ParseDuration(val)
+	if err != nil {
+		seconds, atoiErr := strconv.Atoi(val)
+		if atoiErr != nil {
+			interval = 0
+		} else {
+			interval = time.Duration(seconds) * time.Second
+		}
+	}
+
+	if interval <= 0 {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `INTERVAL must be a positive duration like 10s or 1m, or a number of seconds`,
+		}
+	}
+
+	*i = Interval(interval)
+	return nil
+}
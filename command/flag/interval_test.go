@@ -0,0 +1,56 @@
+package flag_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/command/flag"
+
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Interval", func() {
+	var interval Interval
+
+	BeforeEach(func() {
+		interval = 0
+	})
+
+	Describe("UnmarshalFlag", func() {
+		Context("when passed a duration", func() {
+			It("sets the interval", func() {
+				err := interval.UnmarshalFlag("1m30s")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(interval).To(BeEquivalentTo(90 * time.Second))
+			})
+		})
+
+		Context("when passed a number of seconds", func() {
+			It("converts it to a duration", func() {
+				err := interval.UnmarshalFlag("30")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(interval).To(BeEquivalentTo(30 * time.Second))
+			})
+		})
+
+		Context("when passed an invalid value", func() {
+			It("returns an error", func() {
+				err := interval.UnmarshalFlag("soon")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `INTERVAL must be a positive duration like 10s or 1m, or a number of seconds`,
+				}))
+				Expect(interval).To(BeZero())
+			})
+		})
+
+		Context("when passed a zero or negative interval", func() {
+			It("returns an error", func() {
+				Expect(interval.UnmarshalFlag("0")).To(HaveOccurred())
+				Expect(interval.UnmarshalFlag("-5s")).To(HaveOccurred())
+				Expect(interval).To(BeZero())
+			})
+		})
+	})
+})
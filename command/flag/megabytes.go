@@ -0,0 +1,23 @@
+package flag
+
+import (
+	"github.com/cloudfoundry/bytefmt"
+	flags "github.com/jessevdk/go-flags"
+)
+
+// Megabytes is an amount of memory or disk given with a unit, e.g. 512M or
+// 1G, in megabytes.
+type Megabytes uint64
+
+func (m *Megabytes) UnmarshalFlag(val string) error {
+	size, err := bytefmt.ToMegabytes(val)
+	if err != nil || size == 0 {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `Byte quantity must be a positive integer with a unit of measurement like M, MB, G, or GB`,
+		}
+	}
+
+	*m = Megabytes(size)
+	return nil
+}
@@ -0,0 +1,53 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Megabytes", func() {
+	var megabytes Megabytes
+
+	BeforeEach(func() {
+		megabytes = 0
+	})
+
+	Describe("UnmarshalFlag", func() {
+		Context("when passed a size in megabytes", func() {
+			It("sets the size", func() {
+				err := megabytes.UnmarshalFlag("512M")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(megabytes).To(BeEquivalentTo(512))
+			})
+		})
+
+		Context("when passed a size in gigabytes", func() {
+			It("converts the size to megabytes", func() {
+				err := megabytes.UnmarshalFlag("2GB")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(megabytes).To(BeEquivalentTo(2048))
+			})
+		})
+
+		Context("when passed a size without a unit", func() {
+			It("returns an error", func() {
+				err := megabytes.UnmarshalFlag("512")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `Byte quantity must be a positive integer with a unit of measurement like M, MB, G, or GB`,
+				}))
+				Expect(megabytes).To(BeZero())
+			})
+		})
+
+		Context("when passed a zero size", func() {
+			It("returns an error", func() {
+				err := megabytes.UnmarshalFlag("0M")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
@@ -0,0 +1,28 @@
+package flag
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/util/pasttime"
+	flags "github.com/jessevdk/go-flags"
+)
+
+// PastTime is a point in time given either as a duration ago, e.g. 90m, 2h
+// or 7d, or as a date, e.g. 2017-05-04 or 2017-05-04T15:04:05Z. Dates
+// without a time zone are in the local time zone.
+type PastTime struct {
+	time.Time
+}
+
+func (t *PastTime) UnmarshalFlag(val string) error {
+	parsed, err := pasttime.Parse(val, time.Now())
+	if err != nil {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `TIME must be a duration ago like 90m, 2h or 7d, or a date like 2017-05-04 or 2017-05-04T15:04:05Z`,
+		}
+	}
+
+	t.Time = parsed
+	return nil
+}
@@ -0,0 +1,64 @@
+package flag_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/command/flag"
+
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PastTime", func() {
+	var pastTime PastTime
+
+	BeforeEach(func() {
+		pastTime = PastTime{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		Context("when passed a duration", func() {
+			It("sets the time to that long ago", func() {
+				err := pastTime.UnmarshalFlag("90m")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pastTime.Time).To(BeTemporally("~", time.Now().Add(-90*time.Minute), time.Second))
+			})
+		})
+
+		Context("when passed a number of days", func() {
+			It("sets the time to that many days ago", func() {
+				err := pastTime.UnmarshalFlag("7d")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pastTime.Time).To(BeTemporally("~", time.Now().AddDate(0, 0, -7), time.Second))
+			})
+		})
+
+		Context("when passed a date", func() {
+			It("sets the time to the start of that day in the local time zone", func() {
+				err := pastTime.UnmarshalFlag("2017-05-04")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pastTime.Time.Equal(time.Date(2017, 5, 4, 0, 0, 0, 0, time.Local))).To(BeTrue())
+			})
+		})
+
+		Context("when passed a timestamp", func() {
+			It("sets the time", func() {
+				err := pastTime.UnmarshalFlag("2017-05-04T15:04:05+02:00")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pastTime.Time.Equal(time.Date(2017, 5, 4, 13, 4, 5, 0, time.UTC))).To(BeTrue())
+			})
+		})
+
+		Context("when passed anything else", func() {
+			It("returns an error", func() {
+				err := pastTime.UnmarshalFlag("yesterday")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `TIME must be a duration ago like 90m, 2h or 7d, or a date like 2017-05-04 or 2017-05-04T15:04:05Z`,
+				}))
+				Expect(pastTime.Time.IsZero()).To(BeTrue())
+			})
+		})
+	})
+})
@@ -0,0 +1,49 @@
+package command
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/util/har"
+)
+
+// HARWriter stores requests and responses, with secrets redacted, in a HAR
+// file.
+type HARWriter struct {
+	ui   UI
+	file *har.File
+}
+
+// NewHARWriter returns a HARWriter that writes to filePath.
+func NewHARWriter(ui UI, filePath string) *HARWriter {
+	return &HARWriter{
+		ui:   ui,
+		file: har.NewFile(filePath, IsSecretHeader),
+	}
+}
+
+func (writer *HARWriter) HandleInternalError(err error) {
+	writer.ui.DisplayWarning(err.Error())
+}
+
+// RecordEntry adds the request and response, along with the timings measured
+// by timer, to the HAR file.
+func (writer *HARWriter) RecordEntry(request *http.Request, requestBody []byte, response *http.Response, responseBody []byte, timer *har.Timer) error {
+	started, elapsed, timings := timer.Stop()
+
+	sanitizedRequestBody, err := sanitizeBody(request.Header, requestBody)
+	if err != nil {
+		return err
+	}
+	sanitizedResponseBody, err := sanitizeBody(response.Header, responseBody)
+	if err != nil {
+		return err
+	}
+
+	return writer.file.AddEntry(har.Entry{
+		StartedDateTime: started,
+		Time:            elapsed,
+		Request:         har.NewRequest(request, sanitizedRequestBody),
+		Response:        har.NewResponse(response, sanitizedResponseBody),
+		Timings:         timings,
+	})
+}
@@ -0,0 +1,85 @@
+package command_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/har"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("HAR Writer", func() {
+	var (
+		testUI  *ui.UI
+		writer  *HARWriter
+		tmpdir  string
+		harPath string
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(NewBuffer(), NewBuffer(), NewBuffer())
+		var err error
+		tmpdir, err = ioutil.TempDir("", "har_writer")
+		Expect(err).ToNot(HaveOccurred())
+
+		harPath = filepath.Join(tmpdir, "trace.har")
+		writer = NewHARWriter(testUI, harPath)
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tmpdir)
+	})
+
+	Describe("RecordEntry", func() {
+		It("writes the entry to the HAR file with secrets redacted", func() {
+			request, err := http.NewRequest(http.MethodPost, "https://api.example.com/v2/apps", nil)
+			Expect(err).ToNot(HaveOccurred())
+			request.Header.Set("Authorization", "bearer some-token")
+			request.Header.Set("Content-Type", "application/json")
+
+			response := &http.Response{
+				StatusCode: http.StatusCreated,
+				Header:     http.Header{"Content-Type": {"application/json"}},
+			}
+
+			_, timer := har.StartTimer(request)
+			err = writer.RecordEntry(request,
+				[]byte(`{"name":"some-app","password":"some-password"}`),
+				response,
+				[]byte(`{"entity":{"access_token":"some-token"}}`),
+				timer)
+			Expect(err).ToNot(HaveOccurred())
+
+			raw, err := ioutil.ReadFile(harPath)
+			Expect(err).ToNot(HaveOccurred())
+			var archive har.Archive
+			Expect(json.Unmarshal(raw, &archive)).To(Succeed())
+
+			Expect(archive.Log.Entries).To(HaveLen(1))
+			entry := archive.Log.Entries[0]
+			Expect(entry.Time).To(BeNumerically(">=", 0))
+			Expect(entry.Request.Method).To(Equal(http.MethodPost))
+			Expect(entry.Request.URL).To(Equal("https://api.example.com/v2/apps"))
+			Expect(entry.Request.Headers).To(ContainElement(har.NameValue{Name: "Authorization", Value: har.RedactedValue}))
+			Expect(entry.Request.PostData.Text).To(MatchJSON(`{"name":"some-app","password":"[PRIVATE DATA HIDDEN]"}`))
+			Expect(entry.Response.Status).To(Equal(http.StatusCreated))
+			Expect(entry.Response.Content.Text).To(MatchJSON(`{"entity":{"access_token":"[PRIVATE DATA HIDDEN]"}}`))
+		})
+	})
+
+	Describe("HandleInternalError", func() {
+		It("displays the error as a warning", func() {
+			writer.HandleInternalError(errors.New("some-error"))
+			Expect(testUI.Err).To(Say("some-error"))
+		})
+	})
+})
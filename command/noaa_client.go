@@ -0,0 +1,50 @@
+package command
+
+import (
+	"crypto/tls"
+	"net/url"
+
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/util/har"
+	"code.cloudfoundry.org/cli/util/tlsconfig"
+	"github.com/cloudfoundry/noaa/consumer"
+)
+
+// NewNOAAClient returns a client for the logs of the apps in the targeted
+// Cloud Foundry. Streaming connections refresh the access token through UAA
+// when it expires, and websocket handshakes are recorded to the HAR trace
+// file, if one is set. Connections go through the proxy for the doppler
+// URL's scheme, which must be an HTTP proxy. An error is returned if the
+// certificate files in the config cannot be loaded.
+func NewNOAAClient(dopplerURL string, config Config, ui UI, uaaClient *uaa.Client) (*consumer.Consumer, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: config.SkipSSLValidation(),
+	}
+	err := tlsconfig.Apply(tlsConfig, config.CACertFile(), config.ClientCertFile(), config.ClientKeyFile())
+	if err != nil {
+		return nil, err
+	}
+
+	scheme := "wss"
+	if parsedURL, parseErr := url.Parse(dopplerURL); parseErr == nil && parsedURL.Scheme != "" {
+		scheme = parsedURL.Scheme
+	}
+	resolver := NewProxyResolver(config, ui)
+
+	client := consumer.New(dopplerURL, tlsConfig, resolver.ForScheme(scheme))
+	client.RefreshTokenFrom(tokenRefresher{uaaClient: uaaClient, config: config})
+	if harFile := config.TraceHARFile(); harFile != "" {
+		client.SetDebugPrinter(har.NewHandshakePrinter(har.NewFile(harFile, IsSecretHeader), nil))
+	}
+	return client, nil
+}
+
+// tokenRefresher refreshes the access token stored in the config.
+type tokenRefresher struct {
+	uaaClient *uaa.Client
+	config    Config
+}
+
+func (refresher tokenRefresher) RefreshAuthToken() (string, error) {
+	return uaa.RefreshStoredAccessToken(refresher.uaaClient, refresher.config)
+}
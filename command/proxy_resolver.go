@@ -0,0 +1,33 @@
+package command
+
+import "code.cloudfoundry.org/cli/util/proxy"
+
+// NewProxyResolver returns a proxy resolver for the proxy settings in
+// config. When verbose output is enabled, how connections to each host are
+// made is displayed.
+func NewProxyResolver(config Config, ui UI) *proxy.Resolver {
+	resolver := proxy.NewResolver(config.HTTPProxy(), config.HTTPSProxy(), config.NoProxy())
+	if verbose, _ := config.Verbose(); verbose {
+		resolver.AddLogger(NewProxyDecisionDisplay(ui))
+	}
+	return resolver
+}
+
+// ProxyDecisionDisplay displays proxy decisions in verbose output.
+type ProxyDecisionDisplay struct {
+	ui UI
+}
+
+// NewProxyDecisionDisplay returns a ProxyDecisionDisplay that outputs to ui.
+func NewProxyDecisionDisplay(ui UI) *ProxyDecisionDisplay {
+	return &ProxyDecisionDisplay{
+		ui: ui,
+	}
+}
+
+// LogProxyDecision displays how connections to the decision's host are made.
+func (display *ProxyDecisionDisplay) LogProxyDecision(decision proxy.Decision) {
+	display.ui.DisplayText("PROXY: {{.Decision}}", map[string]interface{}{
+		"Decision": decision.String(),
+	})
+}
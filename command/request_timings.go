@@ -0,0 +1,255 @@
+package command
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/har"
+	"github.com/cloudfoundry/bytefmt"
+)
+
+// slowestEndpointsDisplayed is the number of endpoints listed in the summary.
+const slowestEndpointsDisplayed = 5
+
+var guidSegment = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
+// RequestTiming is the measurement of a single request, in milliseconds.
+// Phases that were not reached, such as the DNS lookup for a request that
+// reused a connection, are -1.
+type RequestTiming struct {
+	Endpoint        string
+	Paginated       bool
+	Elapsed         float64
+	DNS             float64
+	Connect         float64
+	TLS             float64
+	TimeToFirstByte float64
+	BytesSent       int64
+	BytesReceived   int64
+}
+
+// RequestTimings collects the timings of requests made to the Cloud
+// Controller and UAA servers and summarizes them.
+type RequestTimings struct {
+	mutex   sync.Mutex
+	timings []RequestTiming
+}
+
+// NewRequestTimings returns an empty RequestTimings.
+func NewRequestTimings() *RequestTimings {
+	return &RequestTimings{}
+}
+
+// configWithRequestTimings is a Config whose RequestTimings collects the
+// timings of the running command.
+type configWithRequestTimings struct {
+	*configv3.Config
+	requestTimings *RequestTimings
+}
+
+// ConfigWithRequestTimings returns config with requestTimings as the
+// collector that clients created from it record their requests to when
+// timings are enabled.
+func ConfigWithRequestTimings(config *configv3.Config, requestTimings *RequestTimings) Config {
+	return configWithRequestTimings{
+		Config:         config,
+		requestTimings: requestTimings,
+	}
+}
+
+func (config configWithRequestTimings) RequestTimings() *RequestTimings {
+	return config.requestTimings
+}
+
+// RecordTiming stops timer and adds the measurement of request to the
+// collected timings.
+func (requestTimings *RequestTimings) RecordTiming(request *http.Request, response *http.Response, responseSize int, timer *har.Timer) {
+	_, elapsed, timings := timer.Stop()
+
+	timing := RequestTiming{
+		Endpoint:        endpoint(request),
+		Paginated:       isPaginated(request),
+		Elapsed:         elapsed,
+		DNS:             timings.DNS,
+		Connect:         timings.Connect,
+		TLS:             timings.SSL,
+		TimeToFirstByte: timer.TimeToFirstByte(),
+		BytesReceived:   int64(responseSize),
+	}
+	// The connect phase reported by the timer includes the TLS handshake.
+	if timing.Connect > 0 && timing.TLS > 0 {
+		timing.Connect -= timing.TLS
+	}
+	if request.ContentLength > 0 {
+		timing.BytesSent = request.ContentLength
+	}
+
+	requestTimings.mutex.Lock()
+	defer requestTimings.mutex.Unlock()
+	requestTimings.timings = append(requestTimings.timings, timing)
+}
+
+// Timings returns the collected timings in the order the requests were made.
+func (requestTimings *RequestTimings) Timings() []RequestTiming {
+	requestTimings.mutex.Lock()
+	defer requestTimings.mutex.Unlock()
+	return append([]RequestTiming{}, requestTimings.timings...)
+}
+
+// DisplaySummary outputs the number of requests, the time spent in each
+// phase, the bytes transferred, the time spent paginating and the slowest
+// endpoints. Nothing is displayed if no requests were made.
+func (requestTimings *RequestTimings) DisplaySummary(ui UI) {
+	timings := requestTimings.Timings()
+	if len(timings) == 0 {
+		return
+	}
+
+	var (
+		total, dns, connect, tls, firstByte float64
+		paginationTime                      float64
+		paginatedRequests                   int
+		bytesSent, bytesReceived            int64
+	)
+	endpoints := map[string]*endpointTiming{}
+	for _, timing := range timings {
+		total += timing.Elapsed
+		dns += phase(timing.DNS)
+		connect += phase(timing.Connect)
+		tls += phase(timing.TLS)
+		firstByte += phase(timing.TimeToFirstByte)
+		bytesSent += timing.BytesSent
+		bytesReceived += timing.BytesReceived
+
+		if timing.Paginated {
+			paginationTime += timing.Elapsed
+			paginatedRequests++
+		}
+
+		endpointTotals, ok := endpoints[timing.Endpoint]
+		if !ok {
+			endpointTotals = &endpointTiming{endpoint: timing.Endpoint}
+			endpoints[timing.Endpoint] = endpointTotals
+		}
+		endpointTotals.requests++
+		endpointTotals.total += timing.Elapsed
+		if timing.Elapsed > endpointTotals.slowest {
+			endpointTotals.slowest = timing.Elapsed
+		}
+	}
+
+	ui.DisplayNewline()
+	ui.DisplayHeader("Request timings:")
+	ui.DisplayTable("", [][]string{
+		{ui.TranslateText("requests:"), strconv.Itoa(len(timings))},
+		{ui.TranslateText("total time:"), seconds(total)},
+		{ui.TranslateText("DNS lookup:"), seconds(dns)},
+		{ui.TranslateText("TCP connect:"), seconds(connect)},
+		{ui.TranslateText("TLS handshake:"), seconds(tls)},
+		{ui.TranslateText("time to first byte:"), seconds(firstByte)},
+		{ui.TranslateText("bytes sent:"), bytefmt.ByteSize(uint64(bytesSent))},
+		{ui.TranslateText("bytes received:"), bytefmt.ByteSize(uint64(bytesReceived))},
+		{ui.TranslateText("pagination:"), ui.TranslateText("{{.Time}} over {{.Requests}} requests", map[string]interface{}{
+			"Time":     seconds(paginationTime),
+			"Requests": paginatedRequests,
+		})},
+	}, 3)
+
+	slowest := make(endpointTimings, 0, len(endpoints))
+	for _, endpointTotals := range endpoints {
+		slowest = append(slowest, endpointTotals)
+	}
+	sort.Sort(slowest)
+	if len(slowest) > slowestEndpointsDisplayed {
+		slowest = slowest[:slowestEndpointsDisplayed]
+	}
+
+	table := [][]string{
+		{
+			ui.TranslateText("endpoint"),
+			ui.TranslateText("requests"),
+			ui.TranslateText("total"),
+			ui.TranslateText("slowest"),
+		},
+	}
+	for _, endpointTotals := range slowest {
+		table = append(table, []string{
+			endpointTotals.endpoint,
+			strconv.Itoa(endpointTotals.requests),
+			seconds(endpointTotals.total),
+			seconds(endpointTotals.slowest),
+		})
+	}
+
+	ui.DisplayNewline()
+	ui.DisplayHeader("Slowest endpoints:")
+	ui.DisplayTable("", table, 3)
+}
+
+type endpointTiming struct {
+	endpoint string
+	requests int
+	total    float64
+	slowest  float64
+}
+
+// endpointTimings sorts endpoints by total time, slowest first.
+type endpointTimings []*endpointTiming
+
+func (timings endpointTimings) Len() int      { return len(timings) }
+func (timings endpointTimings) Swap(i, j int) { timings[i], timings[j] = timings[j], timings[i] }
+func (timings endpointTimings) Less(i, j int) bool {
+	if timings[i].total == timings[j].total {
+		return timings[i].endpoint < timings[j].endpoint
+	}
+	return timings[i].total > timings[j].total
+}
+
+// endpoint returns the method and path of request, with GUIDs replaced so
+// that requests for different resources of the same type are grouped.
+func endpoint(request *http.Request) string {
+	path := request.URL.Path
+	if path == "" {
+		path = "/"
+	}
+
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if guidSegment.MatchString(segment) {
+			segments[i] = ":guid"
+		}
+	}
+	return fmt.Sprintf("%s %s", request.Method, strings.Join(segments, "/"))
+}
+
+// isPaginated returns true if request fetches a page after the first, using
+// either the Cloud Controller's page parameter or UAA's startIndex.
+func isPaginated(request *http.Request) bool {
+	query := request.URL.Query()
+	if page, err := strconv.Atoi(query.Get("page")); err == nil && page > 1 {
+		return true
+	}
+	if startIndex, err := strconv.Atoi(query.Get("startIndex")); err == nil && startIndex > 1 {
+		return true
+	}
+	return false
+}
+
+// phase returns the duration of a phase, treating phases that were not
+// reached as taking no time.
+func phase(milliseconds float64) float64 {
+	if milliseconds < 0 {
+		return 0
+	}
+	return milliseconds
+}
+
+func seconds(milliseconds float64) string {
+	return fmt.Sprintf("%.3fs", milliseconds/1000)
+}
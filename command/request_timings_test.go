@@ -0,0 +1,119 @@
+package command_test
+
+import (
+	"bytes"
+	"net/http"
+	"time"
+
+	. "code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/har"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Request Timings", func() {
+	var (
+		testUI         *ui.UI
+		requestTimings *RequestTimings
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(NewBuffer(), NewBuffer(), NewBuffer())
+		requestTimings = NewRequestTimings()
+	})
+
+	record := func(method string, url string, body string, responseSize int, delay time.Duration) {
+		var request *http.Request
+		var err error
+		if body == "" {
+			request, err = http.NewRequest(method, url, nil)
+		} else {
+			request, err = http.NewRequest(method, url, bytes.NewBufferString(body))
+		}
+		Expect(err).ToNot(HaveOccurred())
+
+		_, timer := har.StartTimer(request)
+		time.Sleep(delay)
+		requestTimings.RecordTiming(request, &http.Response{StatusCode: http.StatusOK}, responseSize, timer)
+	}
+
+	Describe("RecordTiming", func() {
+		It("groups requests by endpoint and detects pagination", func() {
+			record(http.MethodGet, "https://api.example.com/v2/apps/4b1d3e7c-0f1a-4c3b-9a2e-1f5b8c0d6e7a/routes", "", 10, 0)
+			record(http.MethodGet, "https://api.example.com/v2/apps?page=2&results-per-page=50", "", 20, 0)
+			record(http.MethodGet, "https://uaa.example.com/Users?startIndex=101", "", 30, 0)
+			record(http.MethodPut, "https://api.example.com/v2/apps?page=1", `{"name":"some-app"}`, 0, 0)
+			record(http.MethodGet, "https://api.example.com", "", 0, 0)
+
+			timings := requestTimings.Timings()
+			Expect(timings).To(HaveLen(5))
+
+			Expect(timings[0].Endpoint).To(Equal("GET /v2/apps/:guid/routes"))
+			Expect(timings[0].Paginated).To(BeFalse())
+			Expect(timings[0].BytesReceived).To(BeEquivalentTo(10))
+			Expect(timings[0].DNS).To(Equal(float64(-1)))
+			Expect(timings[0].TimeToFirstByte).To(Equal(float64(-1)))
+
+			Expect(timings[1].Endpoint).To(Equal("GET /v2/apps"))
+			Expect(timings[1].Paginated).To(BeTrue())
+
+			Expect(timings[2].Endpoint).To(Equal("GET /Users"))
+			Expect(timings[2].Paginated).To(BeTrue())
+
+			Expect(timings[3].Endpoint).To(Equal("PUT /v2/apps"))
+			Expect(timings[3].Paginated).To(BeFalse())
+			Expect(timings[3].BytesSent).To(BeEquivalentTo(len(`{"name":"some-app"}`)))
+
+			Expect(timings[4].Endpoint).To(Equal("GET /"))
+		})
+	})
+
+	Describe("DisplaySummary", func() {
+		Context("when no requests were made", func() {
+			It("displays nothing", func() {
+				requestTimings.DisplaySummary(testUI)
+				Expect(testUI.Out.(*Buffer).Contents()).To(BeEmpty())
+			})
+		})
+
+		Context("when requests were made", func() {
+			BeforeEach(func() {
+				record(http.MethodGet, "https://api.example.com/v2/info", "", 1024, 0)
+				record(http.MethodGet, "https://api.example.com/v2/apps", "", 2048, 0)
+				record(http.MethodGet, "https://api.example.com/v2/apps?page=2", "", 2048, 20*time.Millisecond)
+			})
+
+			It("displays the totals, the pagination time and the slowest endpoints", func() {
+				requestTimings.DisplaySummary(testUI)
+
+				Expect(testUI.Out).To(Say("Request timings:"))
+				Expect(testUI.Out).To(Say(`requests:\s+3`))
+				Expect(testUI.Out).To(Say(`total time:\s+\d+\.\d{3}s`))
+				Expect(testUI.Out).To(Say(`DNS lookup:\s+0\.000s`))
+				Expect(testUI.Out).To(Say(`TCP connect:\s+0\.000s`))
+				Expect(testUI.Out).To(Say(`TLS handshake:\s+0\.000s`))
+				Expect(testUI.Out).To(Say(`time to first byte:\s+0\.000s`))
+				Expect(testUI.Out).To(Say(`bytes sent:\s+0`))
+				Expect(testUI.Out).To(Say(`bytes received:\s+5K`))
+				Expect(testUI.Out).To(Say(`pagination:\s+0\.0[2-9]\ds over 1 requests`))
+
+				Expect(testUI.Out).To(Say("Slowest endpoints:"))
+				Expect(testUI.Out).To(Say(`endpoint\s+requests\s+total\s+slowest`))
+				Expect(testUI.Out).To(Say(`GET /v2/apps\s+2\s+0\.0[2-9]\ds\s+0\.0[2-9]\ds`))
+				Expect(testUI.Out).To(Say(`GET /v2/info\s+1\s+0\.00\ds\s+0\.00\ds`))
+			})
+		})
+	})
+
+	Describe("ConfigWithRequestTimings", func() {
+		It("returns the config with the given collector", func() {
+			config := ConfigWithRequestTimings(&configv3.Config{Flags: configv3.FlagOverride{Timings: true}}, requestTimings)
+			Expect(config.RequestTimings()).To(BeIdenticalTo(requestTimings))
+			Expect(config.Timings()).To(BeTrue())
+		})
+	})
+})
@@ -3,13 +3,27 @@ package command
 import (
 	"bytes"
 	"encoding/json"
+	"net/http"
+	"net/url"
 	"regexp"
+	"strings"
 )
 
 const RedactedValue = "[PRIVATE DATA HIDDEN]"
 
 var keysToSanitize = regexp.MustCompile("(?i).*(?:token|password).*")
 
+// IsSecretHeader returns true if the header named name carries credentials:
+// the Authorization header, cookies, and headers that look like they carry a
+// token or password.
+func IsSecretHeader(name string) bool {
+	switch http.CanonicalHeaderKey(name) {
+	case "Authorization", "Cookie", "Set-Cookie":
+		return true
+	}
+	return keysToSanitize.MatchString(name)
+}
+
 func SanitizeJSON(raw []byte) (map[string]interface{}, error) {
 	var result map[string]interface{}
 	decoder := json.NewDecoder(bytes.NewBuffer(raw))
@@ -36,3 +50,43 @@ func iterateAndRedact(blob map[string]interface{}) map[string]interface{} {
 
 	return blob
 }
+
+// sanitizeBody redacts secrets from JSON and form encoded bodies, and hides
+// multipart bodies, which carry application bits. Other bodies are returned
+// as is.
+func sanitizeBody(header http.Header, body []byte) (string, error) {
+	if len(body) == 0 {
+		return "", nil
+	}
+
+	if strings.HasPrefix(header.Get("Content-Type"), "multipart/form-data") {
+		return RedactedValue, nil
+	}
+
+	if strings.HasPrefix(header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		values, err := url.ParseQuery(string(body))
+		if err != nil {
+			return "", err
+		}
+		for key := range values {
+			if keysToSanitize.MatchString(key) {
+				values.Set(key, RedactedValue)
+			}
+		}
+		return values.Encode(), nil
+	}
+
+	sanitized, err := SanitizeJSON(body)
+	if err != nil {
+		return string(body), nil
+	}
+
+	buff := new(bytes.Buffer)
+	encoder := json.NewEncoder(buff)
+	encoder.SetEscapeHTML(false)
+	err = encoder.Encode(sanitized)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(buff.String(), "\n"), nil
+}
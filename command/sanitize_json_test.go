@@ -4,6 +4,7 @@ import (
 	. "code.cloudfoundry.org/cli/command"
 
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 )
 
@@ -36,3 +37,17 @@ var _ = Describe("SanitizeJSON", func() {
 		Expect(redacted).To(Equal(expected))
 	})
 })
+
+var _ = DescribeTable("IsSecretHeader",
+	func(name string, expected bool) {
+		Expect(IsSecretHeader(name)).To(Equal(expected))
+	},
+
+	Entry("Authorization", "Authorization", true),
+	Entry("non-canonical Authorization", "authorization", true),
+	Entry("Cookie", "Cookie", true),
+	Entry("Set-Cookie", "Set-Cookie", true),
+	Entry("token headers", "X-Refresh-Token", true),
+	Entry("password headers", "X-Password", true),
+	Entry("other headers", "Content-Type", false),
+)
@@ -6,6 +6,7 @@ import "time"
 
 // UI is the interface to STDOUT
 type UI interface {
+	ClearScreen()
 	DisplayBoolPrompt(prompt string, defaultResponse bool) (bool, error)
 	DisplayError(err error)
 	DisplayHeader(text string)
@@ -14,6 +15,7 @@ type UI interface {
 	DisplayPair(attribute string, formattedString string, keys ...map[string]interface{})
 	DisplayTable(prefix string, table [][]string, padding int) error
 	DisplayText(template string, data ...map[string]interface{})
+	DisplayTextWithBold(text string, keys ...map[string]interface{})
 	DisplayTextWithFlavor(text string, keys ...map[string]interface{})
 	DisplayWarning(formattedString string, keys ...map[string]interface{})
 	DisplayWarnings(warnings []string)
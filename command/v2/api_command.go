@@ -2,6 +2,7 @@ package v2
 
 import (
 	"fmt"
+	"path/filepath"
 	"strings"
 
 	"code.cloudfoundry.org/cli/actor/v2action"
@@ -20,9 +21,12 @@ type APIActor interface {
 
 type ApiCommand struct {
 	OptionalArgs      flag.APITarget `positional-args:"yes"`
+	CACertFile        string         `long:"ca-cert" description:"Trust the certificate authorities in this PEM encoded file, in addition to the system's"`
+	ClientCertFile    string         `long:"client-cert" description:"Present the PEM encoded certificate in this file to servers that request one. Requires --client-key"`
+	ClientKeyFile     string         `long:"client-key" description:"Private key of the client certificate, PEM encoded. Requires --client-cert"`
 	SkipSSLValidation bool           `long:"skip-ssl-validation" description:"Skip verification of the API endpoint. Not recommended!"`
 	Unset             bool           `long:"unset" description:"Remove all api endpoint targeting"`
-	usage             interface{}    `usage:"CF_NAME api [URL]"`
+	usage             interface{}    `usage:"CF_NAME api [URL] [--ca-cert CA_CERT_FILE] [--client-cert CLIENT_CERT_FILE --client-key CLIENT_KEY_FILE]"`
 	relatedCommands   interface{}    `related_commands:"auth, login, target"`
 
 	UI     command.UI
@@ -90,10 +94,26 @@ func (cmd *ApiCommand) setAPI() error {
 
 	apiURL := processURL(cmd.OptionalArgs.URL)
 
-	_, err := cmd.Actor.SetTarget(cmd.Config, v2action.TargetSettings{
+	caCertFile, err := absolutePath(cmd.CACertFile)
+	if err != nil {
+		return err
+	}
+	clientCertFile, err := absolutePath(cmd.ClientCertFile)
+	if err != nil {
+		return err
+	}
+	clientKeyFile, err := absolutePath(cmd.ClientKeyFile)
+	if err != nil {
+		return err
+	}
+
+	_, err = cmd.Actor.SetTarget(cmd.Config, v2action.TargetSettings{
 		URL:               apiURL,
 		SkipSSLValidation: cmd.SkipSSLValidation,
 		DialTimeout:       cmd.Config.DialTimeout(),
+		CACertFile:        caCertFile,
+		ClientCertFile:    clientCertFile,
+		ClientKeyFile:     clientKeyFile,
 	})
 	if err != nil {
 		return shared.HandleError(err)
@@ -115,3 +135,12 @@ func processURL(apiURL string) string {
 	}
 	return apiURL
 }
+
+// absolutePath returns path relative to the working directory, so that the
+// certificate files persisted in the config can be found from anywhere.
+func absolutePath(path string) (string, error) {
+	if path == "" {
+		return "", nil
+	}
+	return filepath.Abs(path)
+}
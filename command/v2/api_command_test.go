@@ -2,6 +2,8 @@ package v2_test
 
 import (
 	"errors"
+	"os"
+	"path/filepath"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/command"
@@ -156,6 +158,44 @@ API version:    some-version`,
 			})
 		})
 
+		Context("when certificate files are provided", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs.URL = "https://api.foo.com"
+				cmd.CACertFile = "ca.pem"
+				cmd.ClientCertFile = "/some/client.pem"
+				cmd.ClientKeyFile = "client-key.pem"
+			})
+
+			It("sets the target with the absolute paths of the files", func() {
+				Expect(err).ToNot(HaveOccurred())
+
+				workingDir, wdErr := os.Getwd()
+				Expect(wdErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.SetTargetCallCount()).To(Equal(1))
+				_, settings := fakeActor.SetTargetArgsForCall(0)
+				Expect(settings.CACertFile).To(Equal(filepath.Join(workingDir, "ca.pem")))
+				Expect(settings.ClientCertFile).To(Equal("/some/client.pem"))
+				Expect(settings.ClientKeyFile).To(Equal(filepath.Join(workingDir, "client-key.pem")))
+			})
+		})
+
+		Context("when no certificate files are provided", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs.URL = "https://api.foo.com"
+			})
+
+			It("sets the target without certificate files", func() {
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(fakeActor.SetTargetCallCount()).To(Equal(1))
+				_, settings := fakeActor.SetTargetArgsForCall(0)
+				Expect(settings.CACertFile).To(BeEmpty())
+				Expect(settings.ClientCertFile).To(BeEmpty())
+				Expect(settings.ClientKeyFile).To(BeEmpty())
+			})
+		})
+
 		Context("when the API does not have SSL", func() {
 			var CCAPI string
 
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/cloudfoundry/bytefmt"
 
@@ -15,6 +16,10 @@ import (
 	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+// watchHistorySize is the number of polls drawn in the app --watch
+// sparklines.
+const watchHistorySize = 20
+
 //go:generate counterfeiter . AppActor
 
 type AppActor interface {
@@ -23,10 +28,11 @@ type AppActor interface {
 }
 
 type AppCommand struct {
-	RequiredArgs    flag.AppName `positional-args:"yes"`
-	GUID            bool         `long:"guid" description:"Retrieve and display the given app's guid.  All other health and status output for the app is suppressed."`
-	usage           interface{}  `usage:"CF_NAME app APP_NAME"`
-	relatedCommands interface{}  `related_commands:"apps, events, logs, map-route, unmap-route, push"`
+	RequiredArgs    flag.AppName  `positional-args:"yes"`
+	GUID            bool          `long:"guid" description:"Retrieve and display the given app's guid.  All other health and status output for the app is suppressed."`
+	Watch           flag.Interval `long:"watch" optional:"yes" optional-value:"5s" description:"Poll the app every INTERVAL (Default: 5s) and redraw its instances with their usage history until interrupted"`
+	usage           interface{}   `usage:"CF_NAME app APP_NAME [--guid | --watch[=INTERVAL]]\n\nEXAMPLES:\n   CF_NAME app my-app --watch\n   CF_NAME app my-app --watch=30s"`
+	relatedCommands interface{}   `related_commands:"apps, crashes, events, logs, map-route, unmap-route, push"`
 
 	Config      command.Config
 	SharedActor command.SharedActor
@@ -49,13 +55,22 @@ func (cmd *AppCommand) Setup(config command.Config, ui command.UI) error {
 }
 
 func (cmd AppCommand) Execute(args []string) error {
-	if cmd.Config.Experimental() == false {
+	if cmd.Config.Experimental() == false && cmd.Watch == 0 {
 		oldCmd.Main(os.Getenv("CF_TRACE"), os.Args)
 		return nil
 	}
 
-	cmd.UI.DisplayText(command.ExperimentalWarning)
-	cmd.UI.DisplayNewline()
+	if cmd.Config.Experimental() {
+		cmd.UI.DisplayText(command.ExperimentalWarning)
+		cmd.UI.DisplayNewline()
+	}
+
+	interval := time.Duration(cmd.Watch)
+	if cmd.GUID && interval > 0 {
+		return command.ArgumentCombinationError{
+			Args: []string{"--guid", "--watch"},
+		}
+	}
 
 	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
 	if err != nil {
@@ -80,6 +95,10 @@ func (cmd AppCommand) Execute(args []string) error {
 		return cmd.DisplayAppGUID()
 	}
 
+	if interval > 0 {
+		return cmd.WatchApp(interval)
+	}
+
 	appSummary, warnings, err := cmd.Actor.GetApplicationSummaryByNameAndSpace(
 		cmd.RequiredArgs.AppName,
 		cmd.Config.TargetedSpace().GUID,
@@ -113,7 +132,62 @@ func (cmd *AppCommand) DisplayAppGUID() error {
 	return nil
 }
 
+// WatchApp redraws the application summary and its instances every interval
+// until getting the summary fails.
+func (cmd *AppCommand) WatchApp(interval time.Duration) error {
+	history := shared.NewInstanceHistory(watchHistorySize)
+
+	for {
+		appSummary, warnings, err := cmd.Actor.GetApplicationSummaryByNameAndSpace(
+			cmd.RequiredArgs.AppName,
+			cmd.Config.TargetedSpace().GUID,
+		)
+
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+
+		cmd.UI.ClearScreen()
+		cmd.UI.DisplayText("Every {{.Interval}}, last updated {{.Time}}:", map[string]interface{}{
+			"Interval": interval,
+			"Time":     cmd.UI.UserFriendlyDate(time.Now()),
+		})
+		cmd.UI.DisplayNewline()
+
+		showAppSummary(appSummary, cmd.UI)
+		showInstanceDashboard(appSummary.RunningInstances, history, cmd.UI)
+		cmd.UI.DisplayNewline()
+
+		time.Sleep(interval)
+	}
+}
+
 func ShowApp(appSummary v2action.ApplicationSummary, ui command.UI) error {
+	showAppSummary(appSummary, ui)
+
+	// Instance List Table
+	table := [][]string{
+		{"", "State", "Since", "CPU", "Memory", "Disk"},
+	}
+
+	for _, instance := range appSummary.RunningInstances {
+		table = append(table,
+			[]string{
+				fmt.Sprintf("#%d", instance.ID),
+				ui.TranslateText(strings.ToLower(string(instance.State))),
+				ui.UserFriendlyDate(instance.StartTime()),
+				fmt.Sprintf("%.1f%%", instance.CPU*100),
+				fmt.Sprintf("%s of %s", bytefmt.ByteSize(uint64(instance.Memory)), bytefmt.ByteSize(uint64(instance.MemoryQuota))),
+				fmt.Sprintf("%s of %s", bytefmt.ByteSize(uint64(instance.Disk)), bytefmt.ByteSize(uint64(instance.DiskQuota))),
+			})
+	}
+	ui.DisplayTable("", table, 3)
+
+	return nil
+}
+
+func showAppSummary(appSummary v2action.ApplicationSummary, ui command.UI) {
 	// Application Summary Table
 	instances := fmt.Sprintf("%d/%d", len(appSummary.RunningInstances), appSummary.Instances)
 
@@ -141,24 +215,110 @@ func ShowApp(appSummary v2action.ApplicationSummary, ui command.UI) error {
 
 	ui.DisplayTable("", table, 3)
 	ui.DisplayNewline()
+}
 
-	// Instance List Table
-	table = [][]string{
-		{"", "State", "Since", "CPU", "Memory", "Disk"},
+// showInstanceDashboard displays the instances with the changes since the
+// previous poll and the history of their CPU and memory usage, followed by
+// the instances that changed state or are no longer reported.
+func showInstanceDashboard(instances []v2action.ApplicationInstance, history *shared.InstanceHistory, ui command.UI) {
+	table := [][]string{
+		{
+			"",
+			ui.TranslateText("State"),
+			ui.TranslateText("Since"),
+			ui.TranslateText("CPU"),
+			ui.TranslateText("Memory"),
+			ui.TranslateText("Disk"),
+			ui.TranslateText("CPU history"),
+			ui.TranslateText("Memory history"),
+		},
 	}
 
-	for _, instance := range appSummary.RunningInstances {
-		table = append(table,
-			[]string{
-				fmt.Sprintf("#%d", instance.ID),
-				ui.TranslateText(strings.ToLower(string(instance.State))),
-				ui.UserFriendlyDate(instance.StartTime()),
-				fmt.Sprintf("%.1f%%", instance.CPU*100),
-				fmt.Sprintf("%s of %s", bytefmt.ByteSize(uint64(instance.Memory)), bytefmt.ByteSize(uint64(instance.MemoryQuota))),
-				fmt.Sprintf("%s of %s", bytefmt.ByteSize(uint64(instance.Disk)), bytefmt.ByteSize(uint64(instance.DiskQuota))),
-			})
+	var transitions []map[string]interface{}
+	for _, instance := range instances {
+		state := ui.TranslateText(strings.ToLower(string(instance.State)))
+		cpu := fmt.Sprintf("%.1f%%", instance.CPU*100)
+		memory := fmt.Sprintf("%s of %s", bytefmt.ByteSize(uint64(instance.Memory)), bytefmt.ByteSize(uint64(instance.MemoryQuota)))
+		disk := fmt.Sprintf("%s of %s", bytefmt.ByteSize(uint64(instance.Disk)), bytefmt.ByteSize(uint64(instance.DiskQuota)))
+
+		previous, seen := history.Add(instance)
+		if seen {
+			if previous.State != instance.State {
+				previousState := ui.TranslateText(strings.ToLower(string(previous.State)))
+				transitions = append(transitions, map[string]interface{}{
+					"Index":         instance.ID,
+					"PreviousState": previousState,
+					"State":         state,
+				})
+				state = ui.TranslateText("{{.State}} (was {{.PreviousState}})", map[string]interface{}{
+					"State":         state,
+					"PreviousState": previousState,
+				})
+			}
+			cpu += cpuDelta(previous.CPU, instance.CPU)
+			memory += byteDelta(previous.Memory, instance.Memory)
+			disk += byteDelta(previous.Disk, instance.Disk)
+		}
+
+		var cpuSamples, memorySamples []float64
+		for _, sample := range history.Samples(instance.ID) {
+			cpuSamples = append(cpuSamples, sample.CPU)
+			memorySamples = append(memorySamples, float64(sample.Memory))
+		}
+
+		table = append(table, []string{
+			fmt.Sprintf("#%d", instance.ID),
+			state,
+			ui.UserFriendlyDate(instance.StartTime()),
+			cpu,
+			memory,
+			disk,
+			shared.Sparkline(cpuSamples, 0),
+			shared.Sparkline(memorySamples, float64(instance.MemoryQuota)),
+		})
 	}
-	ui.DisplayTable("", table, 3)
 
-	return nil
+	if len(instances) == 0 {
+		ui.DisplayText("There are no running instances of this app.")
+	} else {
+		ui.DisplayTable("", table, 3)
+	}
+
+	removed := history.Prune(instances)
+	if len(transitions) == 0 && len(removed) == 0 {
+		return
+	}
+
+	ui.DisplayNewline()
+	for _, transition := range transitions {
+		ui.DisplayTextWithBold("Instance #{{.Index}} changed from {{.PreviousState}} to {{.State}}", transition)
+	}
+	for _, instance := range removed {
+		ui.DisplayTextWithBold("Instance #{{.Index}} is no longer reported", map[string]interface{}{
+			"Index": instance.ID,
+		})
+	}
+}
+
+// cpuDelta formats the change in CPU usage in percentage points, or returns
+// an empty string when it is too small to be displayed.
+func cpuDelta(previous float64, current float64) string {
+	delta := (current - previous) * 100
+	if delta > -0.05 && delta < 0.05 {
+		return ""
+	}
+	return fmt.Sprintf(" (%+.1f)", delta)
+}
+
+// byteDelta formats the change in a byte count, or returns an empty string
+// when it did not change.
+func byteDelta(previous int, current int) string {
+	switch {
+	case current > previous:
+		return fmt.Sprintf(" (+%s)", bytefmt.ByteSize(uint64(current-previous)))
+	case current < previous:
+		return fmt.Sprintf(" (-%s)", bytefmt.ByteSize(uint64(previous-current)))
+	default:
+		return ""
+	}
 }
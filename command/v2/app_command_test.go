@@ -8,6 +8,7 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/v2"
 	"code.cloudfoundry.org/cli/command/v2/v2fakes"
 	"code.cloudfoundry.org/cli/util/configv3"
@@ -26,6 +27,7 @@ var _ = Describe("App Command", func() {
 		fakeActor       *v2fakes.FakeAppActor
 		fakeConfig      *commandfakes.FakeConfig
 		binaryName      string
+		args            []string
 		executeErr      error
 	)
 
@@ -46,10 +48,11 @@ var _ = Describe("App Command", func() {
 		fakeConfig.BinaryNameReturns(binaryName)
 
 		fakeConfig.ExperimentalReturns(true)
+		args = nil
 	})
 
 	JustBeforeEach(func() {
-		executeErr = cmd.Execute(nil)
+		executeErr = cmd.Execute(args)
 	})
 
 	Context("when checking target fails", func() {
@@ -227,6 +230,96 @@ var _ = Describe("App Command", func() {
 					//TODO: unknown buildpack
 				})
 			})
+
+			Context("when the --watch flag is passed", func() {
+				var pollErr error
+
+				BeforeEach(func() {
+					cmd.Watch = flag.Interval(time.Millisecond)
+					pollErr = v2action.ApplicationNotFoundError{Name: "some-app"}
+
+					summaries := []v2action.ApplicationSummary{
+						{
+							Application: v2action.Application{Name: "some-app", Instances: 2},
+							RunningInstances: []v2action.ApplicationInstance{
+								{ID: 0, State: ccv2.ApplicationInstanceRunning, CPU: 0.1, Memory: 100 * bytefmt.MEGABYTE, MemoryQuota: 128 * bytefmt.MEGABYTE, Disk: 50 * bytefmt.MEGABYTE, DiskQuota: 1024 * bytefmt.MEGABYTE},
+								{ID: 1, State: ccv2.ApplicationInstanceRunning, CPU: 0.2, Memory: 64 * bytefmt.MEGABYTE, MemoryQuota: 128 * bytefmt.MEGABYTE, Disk: 50 * bytefmt.MEGABYTE, DiskQuota: 1024 * bytefmt.MEGABYTE},
+							},
+						},
+						{
+							Application: v2action.Application{Name: "some-app", Instances: 2},
+							RunningInstances: []v2action.ApplicationInstance{
+								{ID: 0, State: ccv2.ApplicationInstanceCrashed, CPU: 0, Memory: 0, MemoryQuota: 128 * bytefmt.MEGABYTE, Disk: 50 * bytefmt.MEGABYTE, DiskQuota: 1024 * bytefmt.MEGABYTE},
+							},
+						},
+					}
+					fakeActor.GetApplicationSummaryByNameAndSpaceStub = func(string, string) (v2action.ApplicationSummary, v2action.Warnings, error) {
+						call := fakeActor.GetApplicationSummaryByNameAndSpaceCallCount() - 1
+						if call < len(summaries) {
+							return summaries[call], v2action.Warnings{"summary-warning"}, nil
+						}
+						return v2action.ApplicationSummary{}, nil, pollErr
+					}
+				})
+
+				It("redraws the app until polling fails", func() {
+					Expect(executeErr).To(MatchError(command.ApplicationNotFoundError{Name: "some-app"}))
+					Expect(fakeActor.GetApplicationSummaryByNameAndSpaceCallCount()).To(Equal(3))
+					Expect(testUI.Err).To(Say("summary-warning"))
+
+					Expect(testUI.Out).To(Say(`Every 1ms, last updated`))
+					Expect(testUI.Out).To(Say(`Name:\s+some-app`))
+					Expect(testUI.Out).To(Say(`State\s+Since\s+CPU\s+Memory\s+Disk\s+CPU history\s+Memory history`))
+					Expect(testUI.Out).To(Say(`#0\s+running\s+.*\s+10.0\x25\s+100M of 128M\s+50M of 1G\s+█\s+▆\n`))
+					Expect(testUI.Out).To(Say(`#1\s+running\s+.*\s+20.0\x25\s+64M of 128M`))
+
+					Expect(testUI.Out).To(Say(`Every 1ms, last updated`))
+					Expect(testUI.Out).To(Say(`#0\s+crashed \(was running\)\s+.*\s+0.0\x25 \(-10.0\)\s+0 of 128M \(-100M\)\s+50M of 1G\s+█▁\s+▆▁\n`))
+					Expect(testUI.Out).To(Say("Instance #0 changed from running to crashed"))
+					Expect(testUI.Out).To(Say("Instance #1 is no longer reported"))
+				})
+
+				It("does not clear the screen when the output is not a terminal", func() {
+					Expect(string(testUI.Out.(*Buffer).Contents())).NotTo(ContainSubstring("\x1b[2J"))
+				})
+
+				Context("when the output is a terminal", func() {
+					BeforeEach(func() {
+						testUI.IsTTY = true
+					})
+
+					It("clears the screen before every redraw", func() {
+						Expect(testUI.Out).To(Say("\x1b\\[H\x1b\\[2J"))
+						Expect(testUI.Out).To(Say("Every 1ms, last updated"))
+						Expect(testUI.Out).To(Say("\x1b\\[H\x1b\\[2J"))
+						Expect(testUI.Out).To(Say("Every 1ms, last updated"))
+					})
+				})
+
+				Context("when the --guid flag is also passed", func() {
+					BeforeEach(func() {
+						cmd.GUID = true
+					})
+
+					It("returns an ArgumentCombinationError", func() {
+						Expect(executeErr).To(MatchError(command.ArgumentCombinationError{
+							Args: []string{"--guid", "--watch"},
+						}))
+						Expect(fakeSharedActor.CheckTargetCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when the experimental flag is not set", func() {
+					BeforeEach(func() {
+						fakeConfig.ExperimentalReturns(false)
+					})
+
+					It("watches the app without the experimental warning", func() {
+						Expect(testUI.Out).NotTo(Say(command.ExperimentalWarning))
+						Expect(fakeActor.GetApplicationSummaryByNameAndSpaceCallCount()).To(Equal(3))
+					})
+				})
+			})
 		})
 	})
 })
@@ -0,0 +1,32 @@
+package v2
+
+import (
+	"os"
+
+	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/command"
+)
+
+type AuditEventsCommand struct {
+	Space           string      `long:"space" description:"Show the events of this space in the targeted org (Default: targeted space)"`
+	Org             string      `long:"org" description:"Show the events of every space in this org"`
+	Actor           string      `long:"actor" description:"Only show events caused by this user name or GUID; without --space or --org, events are shown from everywhere"`
+	Type            string      `long:"type" description:"Only show events of these comma-separated types, e.g. audit.app.update,audit.service_binding.create"`
+	Since           string      `long:"since" description:"Only show events at or after this time, given as a duration ago (e.g. 90m, 2h, 7d) or a date (e.g. 2017-05-04, 2017-05-04T15:04:05Z)"`
+	Until           string      `long:"until" description:"Only show events at or before this time, in the same formats as --since"`
+	Limit           int         `long:"limit" description:"Maximum number of events to show (Default: 50)"`
+	All             bool        `long:"all" description:"Show all matching events, fetching as many pages as needed"`
+	Event           string      `long:"event" description:"Show the details of the event with this GUID, including its request payload"`
+	Format          string      `long:"format" description:"Output format: table (default), json or csv"`
+	usage           interface{} `usage:"CF_NAME audit-events [--space SPACE | --org ORG] [--actor USER] [--type TYPES] [--since TIME] [--until TIME] [--limit NUMBER | --all] [--format FORMAT]\n\n   CF_NAME audit-events --event EVENT_GUID [--format FORMAT]\n\nEXAMPLES:\n   CF_NAME audit-events --since 7d --type audit.app.delete-request\n   CF_NAME audit-events --org my-org --actor admin --all --format csv > events.csv\n   CF_NAME audit-events --event 7c1fe5bb-8f36-4ac4-93b3-6d0fdbcb0e3f"`
+	relatedCommands interface{} `related_commands:"events, org-users, space-users"`
+}
+
+func (_ AuditEventsCommand) Setup(config command.Config, ui command.UI) error {
+	return nil
+}
+
+func (_ AuditEventsCommand) Execute(args []string) error {
+	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+	return nil
+}
@@ -0,0 +1,229 @@
+package v2
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+const (
+	// crashLogsBefore and crashLogsAfter delimit the log lines shown for a
+	// crash, relative to the time of the crash.
+	crashLogsBefore = 30 * time.Second
+	crashLogsAfter  = 5 * time.Second
+
+	maxCrashLogLines = 20
+)
+
+//go:generate counterfeiter . CrashesActor
+
+type CrashesActor interface {
+	GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
+	GetApplicationCrashes(appGUID string, since time.Time) ([]v2action.ApplicationCrash, v2action.Warnings, error)
+	GetApplicationInstancesByApplication(guid string) ([]v2action.ApplicationInstance, v2action.Warnings, error)
+	GetRecentLogsForApplication(appGUID string, client v2action.NOAAClient, config v2action.Config) ([]v2action.LogMessage, error)
+}
+
+type CrashesCommand struct {
+	RequiredArgs    flag.AppName  `positional-args:"yes"`
+	Since           time.Duration `long:"since" default:"24h" description:"Only report crashes that occurred within this duration, e.g. 30m or 72h"`
+	Limit           int           `long:"limit" default:"10" description:"Maximum number of crashes to report, newest first"`
+	usage           interface{}   `usage:"CF_NAME crashes APP_NAME [--since DURATION] [--limit NUMBER]\n\nEXAMPLES:\n   CF_NAME crashes my-app\n   CF_NAME crashes my-app --since 72h --limit 3"`
+	relatedCommands interface{}   `related_commands:"app, events, logs, restart-app-instance"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       CrashesActor
+	NOAAClient  v2action.NOAAClient
+}
+
+func (cmd *CrashesCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+	noaaClient, err := command.NewNOAAClient(ccClient.DopplerEndpoint(), config, ui, uaaClient)
+	if err != nil {
+		return err
+	}
+	cmd.NOAAClient = noaaClient
+
+	return nil
+}
+
+func (cmd CrashesCommand) Execute(args []string) error {
+	if cmd.Limit <= 0 {
+		return command.ParseArgumentError{
+			ArgumentName: "--limit",
+			ExpectedType: "positive integer",
+		}
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting crashes for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	crashes, warnings, err := cmd.Actor.GetApplicationCrashes(app.GUID, time.Now().Add(-cmd.Since))
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	var instances []v2action.ApplicationInstance
+	if app.Started() {
+		instances, warnings, err = cmd.Actor.GetApplicationInstancesByApplication(app.GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+	}
+	cmd.displayInstances(app, instances)
+
+	if len(crashes) == 0 {
+		cmd.UI.DisplayText("No crashes in the last {{.Since}}.", map[string]interface{}{
+			"Since": cmd.Since,
+		})
+		return nil
+	}
+
+	logMessages, err := cmd.Actor.GetRecentLogsForApplication(app.GUID, cmd.NOAAClient, cmd.Config)
+	if err != nil {
+		cmd.UI.DisplayWarning("Unable to retrieve the recent logs: {{.Error}}", map[string]interface{}{
+			"Error": err.Error(),
+		})
+	}
+
+	if len(crashes) > cmd.Limit {
+		crashes = crashes[:cmd.Limit]
+	}
+	for i, crash := range crashes {
+		cmd.UI.DisplayNewline()
+		cmd.displayCrash(i+1, len(crashes), crash, instances, logMessages, err == nil)
+	}
+
+	return nil
+}
+
+func (cmd CrashesCommand) displayInstances(app v2action.Application, instances []v2action.ApplicationInstance) {
+	if !app.Started() {
+		cmd.UI.DisplayText("The app is stopped.")
+		return
+	}
+	if len(instances) == 0 {
+		cmd.UI.DisplayText("There are no running instances of this app.")
+		return
+	}
+
+	table := [][]string{
+		{"", cmd.UI.TranslateText("State"), cmd.UI.TranslateText("Since"), cmd.UI.TranslateText("Uptime")},
+	}
+	for _, instance := range instances {
+		table = append(table, []string{
+			fmt.Sprintf("#%d", instance.ID),
+			cmd.UI.TranslateText(strings.ToLower(string(instance.State))),
+			cmd.UI.UserFriendlyDate(instance.StartTime()),
+			(time.Duration(instance.Uptime) * time.Second).String(),
+		})
+	}
+	cmd.UI.DisplayTable("", table, 3)
+}
+
+func (cmd CrashesCommand) displayCrash(number int, total int, crash v2action.ApplicationCrash, instances []v2action.ApplicationInstance, logMessages []v2action.LogMessage, haveLogs bool) {
+	cmd.UI.DisplayHeader(cmd.UI.TranslateText("Crash {{.Number}} of {{.Total}}", map[string]interface{}{
+		"Number": number,
+		"Total":  total,
+	}))
+
+	currentState := cmd.UI.TranslateText("no longer reported")
+	for _, instance := range instances {
+		if instance.ID == crash.InstanceIndex {
+			currentState = cmd.UI.TranslateText("{{.State}}, up {{.Uptime}}", map[string]interface{}{
+				"State":  cmd.UI.TranslateText(strings.ToLower(string(instance.State))),
+				"Uptime": time.Duration(instance.Uptime) * time.Second,
+			})
+		}
+	}
+
+	cmd.UI.DisplayTable("", [][]string{
+		{cmd.UI.TranslateText("Time:"), cmd.UI.UserFriendlyDate(crash.Timestamp)},
+		{cmd.UI.TranslateText("Instance:"), fmt.Sprintf("#%d (%s)", crash.InstanceIndex, currentState)},
+		{cmd.UI.TranslateText("Exit status:"), strconv.Itoa(crash.ExitStatus)},
+		{cmd.UI.TranslateText("Exit description:"), crash.ExitDescription},
+		{cmd.UI.TranslateText("Reason:"), crash.Reason},
+	}, 3)
+
+	if !haveLogs {
+		return
+	}
+
+	cmd.UI.DisplayText("Logs around the crash:")
+	lines := crashLogLines(crash, logMessages)
+	if len(lines) == 0 {
+		cmd.UI.DisplayText("   The logs of this crash are no longer available.")
+		return
+	}
+	for _, line := range lines {
+		cmd.UI.DisplayText("   {{.LogLine}}", map[string]interface{}{
+			"LogLine": line,
+		})
+	}
+}
+
+// crashLogLines formats the last maxCrashLogLines messages emitted shortly
+// before and after the crash.
+func crashLogLines(crash v2action.ApplicationCrash, logMessages []v2action.LogMessage) []string {
+	from := crash.Timestamp.Add(-crashLogsBefore)
+	to := crash.Timestamp.Add(crashLogsAfter)
+
+	var lines []string
+	for _, message := range logMessages {
+		if message.Timestamp().Before(from) || message.Timestamp().After(to) {
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("%s [%s/%s] %s %s",
+			message.Timestamp().Format("2006-01-02T15:04:05.00-0700"),
+			message.SourceType(),
+			message.SourceInstance(),
+			message.Type(),
+			strings.TrimRight(message.Message(), "\r\n"),
+		))
+	}
+
+	if len(lines) > maxCrashLogLines {
+		lines = lines[len(lines)-maxCrashLogLines:]
+	}
+	return lines
+}
@@ -0,0 +1,243 @@
+package v2_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("crashes Command", func() {
+	var (
+		cmd             v2.CrashesCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeCrashesActor
+		fakeNOAAClient  *v2actionfakes.FakeNOAAClient
+		binaryName      string
+		executeErr      error
+		crashTime       time.Time
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeCrashesActor)
+		fakeNOAAClient = new(v2actionfakes.FakeNOAAClient)
+
+		cmd = v2.CrashesCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			NOAAClient:  fakeNOAAClient,
+			Since:       24 * time.Hour,
+			Limit:       10,
+		}
+		cmd.RequiredArgs.AppName = "some-app"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		fakeActor.GetApplicationByNameAndSpaceReturns(
+			v2action.Application{GUID: "some-app-guid", Name: "some-app", State: ccv2.ApplicationStarted},
+			v2action.Warnings{"app-warning"},
+			nil,
+		)
+
+		crashTime = time.Now().Add(-time.Hour)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the limit is not positive", func() {
+		BeforeEach(func() {
+			cmd.Limit = 0
+		})
+
+		It("returns a ParseArgumentError", func() {
+			Expect(executeErr).To(MatchError(command.ParseArgumentError{
+				ArgumentName: "--limit",
+				ExpectedType: "positive integer",
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the app does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(v2action.Application{}, v2action.Warnings{"app-warning"}, v2action.ApplicationNotFoundError{Name: "some-app"})
+		})
+
+		It("returns an ApplicationNotFoundError and displays warnings", func() {
+			Expect(executeErr).To(MatchError(command.ApplicationNotFoundError{Name: "some-app"}))
+			Expect(testUI.Err).To(Say("app-warning"))
+		})
+	})
+
+	Context("when there are no crashes", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationCrashesReturns(nil, v2action.Warnings{"crashes-warning"}, nil)
+			fakeActor.GetApplicationInstancesByApplicationReturns([]v2action.ApplicationInstance{
+				{ID: 0, State: ccv2.ApplicationInstanceRunning, Uptime: 300},
+			}, v2action.Warnings{"instances-warning"}, nil)
+		})
+
+		It("displays the instances and says there were no crashes", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Getting crashes for app some-app in org some-org / space some-space as some-user..."))
+			Expect(testUI.Out).To(Say(`State\s+Since\s+Uptime`))
+			Expect(testUI.Out).To(Say(`#0\s+running\s+.*5m0s`))
+			Expect(testUI.Out).To(Say("No crashes in the last 24h0m0s."))
+			Expect(testUI.Err).To(Say("app-warning"))
+			Expect(testUI.Err).To(Say("crashes-warning"))
+			Expect(testUI.Err).To(Say("instances-warning"))
+
+			appGUID, since := fakeActor.GetApplicationCrashesArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(since).To(BeTemporally("~", time.Now().Add(-24*time.Hour), time.Minute))
+			Expect(fakeActor.GetRecentLogsForApplicationCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when the app has crashed", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationCrashesReturns([]v2action.ApplicationCrash{
+				{
+					GUID:            "crash-guid-2",
+					Timestamp:       crashTime,
+					InstanceIndex:   1,
+					ExitStatus:      137,
+					ExitDescription: "out of memory",
+					Reason:          "CRASHED",
+				},
+				{
+					GUID:            "crash-guid-1",
+					Timestamp:       crashTime.Add(-10 * time.Minute),
+					InstanceIndex:   3,
+					ExitStatus:      1,
+					ExitDescription: "exited",
+				},
+			}, nil, nil)
+			fakeActor.GetApplicationInstancesByApplicationReturns([]v2action.ApplicationInstance{
+				{ID: 0, State: ccv2.ApplicationInstanceRunning, Uptime: 7200},
+				{ID: 1, State: ccv2.ApplicationInstanceCrashed, Uptime: 0},
+			}, nil, nil)
+			fakeActor.GetRecentLogsForApplicationReturns([]v2action.LogMessage{
+				*sharedaction.NewLogMessage("too early", 0, crashTime.Add(-time.Minute), "APP", "1"),
+				*sharedaction.NewLogMessage("allocating memory\n", 0, crashTime.Add(-2*time.Second), "APP", "1"),
+				*sharedaction.NewLogMessage("Exit status 137", 0, crashTime.Add(time.Second), "CELL", "1"),
+			}, nil)
+		})
+
+		It("displays a report for each crash", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			appGUID, noaaClient, config := fakeActor.GetRecentLogsForApplicationArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(noaaClient).To(Equal(fakeNOAAClient))
+			Expect(config).To(Equal(fakeConfig))
+
+			Expect(testUI.Out).To(Say(`#1\s+crashed`))
+			Expect(testUI.Out).To(Say("Crash 1 of 2"))
+			Expect(testUI.Out).To(Say(`Instance:\s+#1 \(crashed, up 0s\)`))
+			Expect(testUI.Out).To(Say(`Exit status:\s+137`))
+			Expect(testUI.Out).To(Say(`Exit description:\s+out of memory`))
+			Expect(testUI.Out).To(Say(`Reason:\s+CRASHED`))
+			Expect(testUI.Out).To(Say("Logs around the crash:"))
+			Expect(testUI.Out).To(Say(`\[APP/1\] OUT allocating memory\n`))
+			Expect(testUI.Out).To(Say(`\[CELL/1\] OUT Exit status 137`))
+			Expect(testUI.Out).To(Say("Crash 2 of 2"))
+			Expect(testUI.Out).To(Say(`Instance:\s+#3 \(no longer reported\)`))
+			Expect(testUI.Out).To(Say("The logs of this crash are no longer available."))
+			Expect(testUI.Out).NotTo(Say("too early"))
+		})
+
+		Context("when there are more crashes than the limit", func() {
+			BeforeEach(func() {
+				cmd.Limit = 1
+			})
+
+			It("only reports the most recent ones", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Out).To(Say("Crash 1 of 1"))
+				Expect(testUI.Out).NotTo(Say("exited"))
+			})
+		})
+
+		Context("when the logs cannot be retrieved", func() {
+			BeforeEach(func() {
+				fakeActor.GetRecentLogsForApplicationReturns(nil, errors.New("doppler is down"))
+			})
+
+			It("warns and still reports the crashes", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Err).To(Say("Unable to retrieve the recent logs: doppler is down"))
+				Expect(testUI.Out).To(Say(`Exit status:\s+137`))
+				Expect(testUI.Out).NotTo(Say("Logs around the crash:"))
+			})
+		})
+
+		Context("when the app is stopped", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationByNameAndSpaceReturns(
+					v2action.Application{GUID: "some-app-guid", Name: "some-app", State: ccv2.ApplicationStopped},
+					nil,
+					nil,
+				)
+			})
+
+			It("does not get the instances", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(fakeActor.GetApplicationInstancesByApplicationCallCount()).To(BeZero())
+				Expect(testUI.Out).To(Say("The app is stopped."))
+				Expect(testUI.Out).To(Say(`Instance:\s+#1 \(no longer reported\)`))
+			})
+		})
+	})
+
+	Context("when getting the crashes fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationCrashesReturns(nil, v2action.Warnings{"crashes-warning"}, errors.New("events error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("events error"))
+			Expect(testUI.Err).To(Say("crashes-warning"))
+		})
+	})
+})
@@ -0,0 +1,111 @@
+package v2
+
+import (
+	"io"
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . DownloadDropletActor
+
+type DownloadDropletActor interface {
+	GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
+	DownloadApplicationDroplet(appGUID string, droplet io.Writer) (v2action.Warnings, error)
+}
+
+type DownloadDropletCommand struct {
+	RequiredArgs    flag.AppName `positional-args:"yes"`
+	Path            string       `short:"p" description:"Path of the file to write the droplet to (Default: APP_NAME.tgz in the current directory)"`
+	usage           interface{}  `usage:"CF_NAME download-droplet APP_NAME [-p PATH]\n\nEXAMPLES:\n   CF_NAME download-droplet my-app -p my-app.tgz\n   CF_NAME push my-app-production --droplet my-app.tgz"`
+	relatedCommands interface{}  `related_commands:"push, restage"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       DownloadDropletActor
+}
+
+func (cmd *DownloadDropletCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
+	return nil
+}
+
+func (cmd DownloadDropletCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	path := cmd.Path
+	if path == "" {
+		path = cmd.RequiredArgs.AppName + ".tgz"
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Downloading droplet of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}} to {{.Path}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+		"Path":      path,
+	})
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	err = cmd.downloadDroplet(app.GUID, path)
+	if err != nil {
+		if _, ok := err.(cloudcontroller.ResourceNotFoundError); ok {
+			return shared.DropletNotFoundError{AppName: cmd.RequiredArgs.AppName}
+		}
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+// downloadDroplet streams the droplet of the app into the file at path. The
+// file is removed if the download fails, so that no partial droplet is left
+// behind.
+func (cmd DownloadDropletCommand) downloadDroplet(appGUID string, path string) error {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+
+	warnings, err := cmd.Actor.DownloadApplicationDroplet(appGUID, file)
+	cmd.UI.DisplayWarnings(warnings)
+
+	closeErr := file.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(path)
+	}
+	return err
+}
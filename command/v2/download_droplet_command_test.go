@@ -0,0 +1,186 @@
+package v2_test
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("download-droplet Command", func() {
+	var (
+		cmd             v2.DownloadDropletCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeDownloadDropletActor
+		binaryName      string
+		tmpDir          string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeDownloadDropletActor)
+
+		var err error
+		tmpDir, err = ioutil.TempDir("", "download-droplet")
+		Expect(err).NotTo(HaveOccurred())
+
+		cmd = v2.DownloadDropletCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			Path:        filepath.Join(tmpDir, "droplet.tgz"),
+		}
+		cmd.RequiredArgs.AppName = "some-app"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		fakeActor.GetApplicationByNameAndSpaceReturns(
+			v2action.Application{GUID: "some-app-guid", Name: "some-app"},
+			v2action.Warnings{"app-warning"},
+			nil,
+		)
+		fakeActor.DownloadApplicationDropletStub = func(_ string, droplet io.Writer) (v2action.Warnings, error) {
+			_, err := droplet.Write([]byte("some-droplet"))
+			return v2action.Warnings{"droplet-warning"}, err
+		}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the app does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(v2action.Application{}, v2action.Warnings{"app-warning"}, v2action.ApplicationNotFoundError{Name: "some-app"})
+		})
+
+		It("returns an ApplicationNotFoundError and displays warnings", func() {
+			Expect(executeErr).To(MatchError(command.ApplicationNotFoundError{Name: "some-app"}))
+			Expect(testUI.Err).To(Say("app-warning"))
+			Expect(fakeActor.DownloadApplicationDropletCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the app has no droplet", func() {
+		BeforeEach(func() {
+			fakeActor.DownloadApplicationDropletStub = nil
+			fakeActor.DownloadApplicationDropletReturns(v2action.Warnings{"droplet-warning"}, cloudcontroller.ResourceNotFoundError{})
+		})
+
+		It("returns a DropletNotFoundError and displays warnings", func() {
+			Expect(executeErr).To(MatchError(shared.DropletNotFoundError{AppName: "some-app"}))
+			Expect(testUI.Err).To(Say("droplet-warning"))
+		})
+	})
+
+	Context("when downloading the droplet fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("download failed")
+			fakeActor.DownloadApplicationDropletStub = func(_ string, droplet io.Writer) (v2action.Warnings, error) {
+				_, err := droplet.Write([]byte("some-partial"))
+				Expect(err).NotTo(HaveOccurred())
+				return nil, expectedErr
+			}
+		})
+
+		It("returns the error and removes the partial file", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			_, err := os.Stat(cmd.Path)
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+	})
+
+	Context("when the droplet is downloaded", func() {
+		It("writes the droplet to the given path", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Downloading droplet of app some-app in org some-org / space some-space as some-user to %s...", regexp.QuoteMeta(cmd.Path)))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("app-warning"))
+			Expect(testUI.Err).To(Say("droplet-warning"))
+
+			Expect(fakeActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(1))
+			appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			appGUID, _ := fakeActor.DownloadApplicationDropletArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+
+			contents, err := ioutil.ReadFile(cmd.Path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(contents).To(Equal([]byte("some-droplet")))
+		})
+	})
+
+	Context("when no path is given", func() {
+		var workingDir string
+
+		BeforeEach(func() {
+			cmd.Path = ""
+
+			var err error
+			workingDir, err = os.Getwd()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(os.Chdir(tmpDir)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(os.Chdir(workingDir)).To(Succeed())
+		})
+
+		It("writes the droplet to APP_NAME.tgz in the current directory", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say(`to some-app\.tgz\.\.\.`))
+
+			contents, err := ioutil.ReadFile(filepath.Join(tmpDir, "some-app.tgz"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(contents).To(Equal([]byte("some-droplet")))
+		})
+	})
+})
@@ -10,7 +10,13 @@ import (
 
 type EventsCommand struct {
 	RequiredArgs flag.AppName `positional-args:"yes"`
-	usage        interface{}  `usage:"CF_NAME events APP_NAME"`
+	Since        string       `long:"since" description:"Only show events at or after this time, given as a duration ago (e.g. 90m, 2h, 7d) or a date (e.g. 2017-05-04, 2017-05-04T15:04:05Z)"`
+	Until        string       `long:"until" description:"Only show events at or before this time, in the same formats as --since"`
+	Type         string       `long:"type" description:"Only show events of these comma-separated types, e.g. app.crash,audit.app.update"`
+	Limit        int          `long:"limit" description:"Maximum number of events to show (Default: 50)"`
+	All          bool         `long:"all" description:"Show all matching events, fetching as many pages as needed"`
+	Follow       bool         `long:"follow" description:"Keep polling for new events and print them as they occur"`
+	usage        interface{}  `usage:"CF_NAME events APP_NAME [--since TIME] [--until TIME] [--type TYPES] [--limit NUMBER | --all] [--follow]\n\nEXAMPLES:\n   CF_NAME events my-app --since 2h --type app.crash\n   CF_NAME events my-app --since 2017-05-01 --until 2017-05-04 --all\n   CF_NAME events my-app --follow"`
 }
 
 func (_ EventsCommand) Setup(config command.Config, ui command.UI) error {
@@ -9,10 +9,19 @@ import (
 )
 
 type LogsCommand struct {
-	RequiredArgs    flag.AppName `positional-args:"yes"`
-	Recent          bool         `long:"recent" description:"Dump recent logs instead of tailing"`
-	usage           interface{}  `usage:"CF_NAME logs APP_NAME"`
-	relatedCommands interface{}  `related_commands:"app, apps, ssh"`
+	OptionalArgs    flag.AppNames `positional-args:"yes"`
+	Recent          bool          `long:"recent" description:"Dump recent logs instead of tailing"`
+	AllInSpace      bool          `long:"all-in-space" description:"Show logs for all apps in the targeted space"`
+	Source          string        `long:"source" description:"Only show logs from these comma-separated source types, e.g. APP,RTR,STG,API,CELL"`
+	Instance        string        `long:"instance" description:"Only show logs from these comma-separated instance indexes"`
+	Type            string        `long:"type" description:"Only show logs of this message type (stdout or stderr)"`
+	Grep            string        `long:"grep" description:"Only show log lines matching this regular expression"`
+	Format          string        `long:"format" description:"Output format: text (default) or json, which prints one JSON object per log message"`
+	Timezone        string        `long:"timezone" description:"Show text timestamps in this time zone, e.g. Europe/Berlin (default: local time)"`
+	UTC             bool          `long:"utc" description:"Show text timestamps in UTC"`
+	RTRStats        bool          `long:"rtr-stats" description:"Summarize router access logs instead of printing them: request rate, status codes, response time percentiles and slowest paths"`
+	usage           interface{}   `usage:"CF_NAME logs APP_NAME [APP_NAME...]\n\n   CF_NAME logs --all-in-space\n\nEXAMPLES:\n   CF_NAME logs my-app --recent --source APP,RTR\n   CF_NAME logs frontend backend worker\n   CF_NAME logs my-app --instance 0,1 --type stderr --grep 'timeout|refused'\n   CF_NAME logs my-app --recent --format json | jq .message\n   CF_NAME logs my-app --rtr-stats"`
+	relatedCommands interface{}   `related_commands:"app, apps, ssh"`
 }
 
 func (_ LogsCommand) Setup(config command.Config, ui command.UI) error {
@@ -2,21 +2,121 @@ package v2
 
 import (
 	"os"
+	"strings"
+	"time"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	oldCmd "code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . OauthTokenActor
+
+type OauthTokenActor interface {
+	DecodeAccessToken(accessToken string) (v2action.AccessToken, error)
+	RefreshAccessToken(config v2action.Config) (string, error)
+}
+
 type OauthTokenCommand struct {
-	usage           interface{} `usage:"CF_NAME oauth-token"`
+	Decode          bool        `long:"decode" description:"Display the user, client, scopes and lifetime of the token instead of the token"`
+	CheckScope      string      `long:"check-scope" description:"Fail if the token does not have this scope"`
+	usage           interface{} `usage:"CF_NAME oauth-token [--decode] [--check-scope SCOPE]\n\nEXAMPLES:\n   CF_NAME oauth-token --decode\n   CF_NAME oauth-token --check-scope cloud_controller.admin"`
 	relatedCommands interface{} `related_commands:"curl"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       OauthTokenActor
 }
 
-func (_ OauthTokenCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *OauthTokenCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	if cmd.legacy() {
+		return nil
+	}
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
 	return nil
 }
 
-func (_ OauthTokenCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd OauthTokenCommand) Execute(args []string) error {
+	if cmd.legacy() {
+		oldCmd.Main(os.Getenv("CF_TRACE"), os.Args)
+		return nil
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	accessToken, err := cmd.Actor.RefreshAccessToken(cmd.Config)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	token, err := cmd.Actor.DecodeAccessToken(accessToken)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if cmd.CheckScope != "" && !token.HasScope(cmd.CheckScope) {
+		return command.MissingScopeError{Scope: cmd.CheckScope}
+	}
+
+	if !cmd.Decode {
+		cmd.UI.DisplayText(accessToken)
+		return nil
+	}
+
+	cmd.displayToken(token)
 	return nil
 }
+
+// legacy reports whether only the token is displayed, which is still handled
+// by the legacy command.
+func (cmd OauthTokenCommand) legacy() bool {
+	return !cmd.Decode && cmd.CheckScope == ""
+}
+
+func (cmd OauthTokenCommand) displayToken(token v2action.AccessToken) {
+	expiresAt := ""
+	lifetime := ""
+	if !token.ExpiresAt.IsZero() {
+		expiresAt = cmd.UI.UserFriendlyDate(token.ExpiresAt)
+
+		remaining := token.ExpiresAt.Sub(time.Now())
+		if remaining > 0 {
+			lifetime = (remaining / time.Second * time.Second).String()
+		} else {
+			lifetime = cmd.UI.TranslateText("expired")
+		}
+	}
+
+	issuedAt := ""
+	if !token.IssuedAt.IsZero() {
+		issuedAt = cmd.UI.UserFriendlyDate(token.IssuedAt)
+	}
+
+	table := [][]string{
+		{cmd.UI.TranslateText("User:"), token.UserName},
+		{cmd.UI.TranslateText("User ID:"), token.UserID},
+		{cmd.UI.TranslateText("Client ID:"), token.ClientID},
+		{cmd.UI.TranslateText("Origin:"), token.Origin},
+		{cmd.UI.TranslateText("Scopes:"), strings.Join(token.Scopes, ", ")},
+		{cmd.UI.TranslateText("Issued at:"), issuedAt},
+		{cmd.UI.TranslateText("Expires at:"), expiresAt},
+		{cmd.UI.TranslateText("Remaining lifetime:"), lifetime},
+	}
+	cmd.UI.DisplayTable("", table, 3)
+}
@@ -0,0 +1,159 @@
+package v2_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("oauth-token Command", func() {
+	var (
+		cmd             v2.OauthTokenCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeOauthTokenActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeOauthTokenActor)
+
+		cmd = v2.OauthTokenCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			Decode:      true,
+		}
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeActor.RefreshAccessTokenReturns("bearer some-access-token", nil)
+		fakeActor.DecodeAccessTokenReturns(v2action.AccessToken{
+			UserName:  "some-user",
+			UserID:    "some-user-id",
+			ClientID:  "cf",
+			Origin:    "uaa",
+			Scopes:    []string{"cloud_controller.read", "cloud_controller.admin"},
+			IssuedAt:  time.Now().Add(-time.Minute),
+			ExpiresAt: time.Now().Add(10 * time.Minute),
+		}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: "faceman"}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when --decode is passed", func() {
+		It("refreshes the token and displays its claims", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.RefreshAccessTokenCallCount()).To(Equal(1))
+			Expect(fakeActor.RefreshAccessTokenArgsForCall(0)).To(Equal(fakeConfig))
+			Expect(fakeActor.DecodeAccessTokenArgsForCall(0)).To(Equal("bearer some-access-token"))
+
+			Expect(testUI.Out).To(Say(`User:\s+some-user`))
+			Expect(testUI.Out).To(Say(`User ID:\s+some-user-id`))
+			Expect(testUI.Out).To(Say(`Client ID:\s+cf`))
+			Expect(testUI.Out).To(Say(`Origin:\s+uaa`))
+			Expect(testUI.Out).To(Say(`Scopes:\s+cloud_controller.read, cloud_controller.admin`))
+			Expect(testUI.Out).To(Say(`Issued at:\s+\w+`))
+			Expect(testUI.Out).To(Say(`Expires at:\s+\w+`))
+			Expect(testUI.Out).To(Say(`Remaining lifetime:\s+9m5\ds`))
+			Expect(testUI.Out).ToNot(Say("some-access-token"))
+		})
+
+		Context("when the token has expired", func() {
+			BeforeEach(func() {
+				fakeActor.DecodeAccessTokenReturns(v2action.AccessToken{
+					ExpiresAt: time.Now().Add(-time.Minute),
+				}, nil)
+			})
+
+			It("displays that the token has expired", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`Remaining lifetime:\s+expired`))
+			})
+		})
+
+		Context("when refreshing the token fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("refresh failed")
+				fakeActor.RefreshAccessTokenReturns("", expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+			})
+		})
+
+		Context("when the token cannot be decoded", func() {
+			BeforeEach(func() {
+				fakeActor.DecodeAccessTokenReturns(v2action.AccessToken{}, uaa.InvalidAccessTokenError{})
+			})
+
+			It("returns an InvalidAccessTokenError", func() {
+				Expect(executeErr).To(MatchError(command.InvalidAccessTokenError{}))
+			})
+		})
+	})
+
+	Context("when --check-scope is passed", func() {
+		BeforeEach(func() {
+			cmd.Decode = false
+		})
+
+		Context("when the token has the scope", func() {
+			BeforeEach(func() {
+				cmd.CheckScope = "cloud_controller.admin"
+			})
+
+			It("displays the token", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("bearer some-access-token"))
+			})
+		})
+
+		Context("when the token does not have the scope", func() {
+			BeforeEach(func() {
+				cmd.CheckScope = "uaa.admin"
+			})
+
+			It("returns a MissingScopeError without displaying the token", func() {
+				Expect(executeErr).To(MatchError(command.MissingScopeError{Scope: "uaa.admin"}))
+				Expect(testUI.Out).ToNot(Say("some-access-token"))
+			})
+		})
+	})
+})
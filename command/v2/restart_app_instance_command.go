@@ -2,23 +2,282 @@ package v2
 
 import (
 	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	oldCmd "code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+// uptimePrecision is the precision of the uptime reported for instances, from
+// which their start times are derived.
+const uptimePrecision = time.Second
+
+// instanceStates are the instance states accepted by restart-app-instance
+// --state.
+var instanceStates = []ccv2.ApplicationInstanceStatusState{
+	ccv2.ApplicationInstanceCrashed,
+	ccv2.ApplicationInstanceDown,
+	ccv2.ApplicationInstanceRunning,
+	ccv2.ApplicationInstanceStarting,
+	ccv2.ApplicationInstanceUnknown,
+}
+
+//go:generate counterfeiter . RestartAppInstanceActor
+
+type RestartAppInstanceActor interface {
+	GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
+	GetApplicationInstancesByApplication(guid string) ([]v2action.ApplicationInstance, v2action.Warnings, error)
+	RestartApplicationInstance(appGUID string, index int) (v2action.Warnings, error)
+}
+
 type RestartAppInstanceCommand struct {
 	RequiredArgs    flag.AppInstance `positional-args:"yes"`
-	usage           interface{}      `usage:"CF_NAME restart-app-instance APP_NAME INDEX"`
-	relatedCommands interface{}      `related_commands:"restart"`
+	Crashed         bool             `long:"crashed" description:"Restart every crashed instance instead of the instance at INDEX"`
+	State           string           `long:"state" description:"Restart every instance in one of these comma-separated states: CRASHED, DOWN, RUNNING, STARTING or UNKNOWN"`
+	Wait            bool             `long:"wait" description:"Wait until the restarted instances are running again"`
+	usage           interface{}      `usage:"CF_NAME restart-app-instance APP_NAME INDEX\n   CF_NAME restart-app-instance APP_NAME (--crashed | --state STATES) [--wait]\n\nEXAMPLES:\n   CF_NAME restart-app-instance my-app 2\n   CF_NAME restart-app-instance my-app --crashed --wait\n   CF_NAME restart-app-instance my-app --state DOWN,CRASHED"`
+	relatedCommands interface{}      `related_commands:"crashes, restart"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       RestartAppInstanceActor
 }
 
-func (_ RestartAppInstanceCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *RestartAppInstanceCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	if cmd.legacy() {
+		return nil
+	}
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
 	return nil
 }
 
-func (_ RestartAppInstanceCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd RestartAppInstanceCommand) Execute(args []string) error {
+	if cmd.legacy() {
+		if cmd.Wait {
+			return command.ArgumentCombinationError{
+				Args: []string{"INDEX", "--wait"},
+			}
+		}
+		oldCmd.Main(os.Getenv("CF_TRACE"), os.Args)
+		return nil
+	}
+
+	states, err := cmd.states()
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Restarting {{.States}} instances of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"States":    joinStates(states),
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	instances, warnings, err := cmd.Actor.GetApplicationInstancesByApplication(app.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	// restarted maps the index of each restarted instance to its start time,
+	// or to the zero time if it was not running
+	restarted := map[int]time.Time{}
+	for _, instance := range instances {
+		if !hasState(states, instance.State) {
+			continue
+		}
+
+		cmd.UI.DisplayText("Restarting instance {{.Index}} ({{.State}})...", map[string]interface{}{
+			"Index": instance.ID,
+			"State": instance.State,
+		})
+		warnings, err = cmd.Actor.RestartApplicationInstance(app.GUID, instance.ID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+		if instance.State == ccv2.ApplicationInstanceRunning {
+			restarted[instance.ID] = instance.StartTime()
+		} else {
+			restarted[instance.ID] = time.Time{}
+		}
+	}
+
+	if len(restarted) == 0 {
+		cmd.UI.DisplayText("No instances of app {{.AppName}} are {{.States}}.", map[string]interface{}{
+			"AppName": cmd.RequiredArgs.AppName,
+			"States":  joinStates(states),
+		})
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	if cmd.Wait {
+		err = cmd.waitForInstances(app, restarted)
+		if err != nil {
+			return err
+		}
+	}
+
+	cmd.UI.DisplayOK()
 	return nil
 }
+
+// legacy reports whether a single instance is restarted by index, which is
+// still handled by the legacy command.
+func (cmd RestartAppInstanceCommand) legacy() bool {
+	return !cmd.Crashed && cmd.State == ""
+}
+
+// states returns the instance states selected by --crashed and --state.
+func (cmd RestartAppInstanceCommand) states() ([]ccv2.ApplicationInstanceStatusState, error) {
+	if cmd.RequiredArgs.Index != "" {
+		selector := "--state"
+		if cmd.Crashed {
+			selector = "--crashed"
+		}
+		return nil, command.ArgumentCombinationError{
+			Args: []string{"INDEX", selector},
+		}
+	}
+
+	if cmd.Crashed && cmd.State != "" {
+		return nil, command.ArgumentCombinationError{
+			Args: []string{"--crashed", "--state"},
+		}
+	}
+
+	if cmd.Crashed {
+		return []ccv2.ApplicationInstanceStatusState{ccv2.ApplicationInstanceCrashed}, nil
+	}
+
+	var states []ccv2.ApplicationInstanceStatusState
+	for _, name := range strings.Split(cmd.State, ",") {
+		state := ccv2.ApplicationInstanceStatusState(strings.ToUpper(strings.TrimSpace(name)))
+		if !hasState(instanceStates, state) {
+			return nil, command.ParseArgumentError{
+				ArgumentName: "--state",
+				ExpectedType: joinStates(instanceStates),
+			}
+		}
+		states = append(states, state)
+	}
+	return states, nil
+}
+
+// waitForInstances polls the instances of the app until the restarted
+// instances have been replaced by running ones, or until the startup timeout
+// is reached.
+func (cmd RestartAppInstanceCommand) waitForInstances(app v2action.Application, restarted map[int]time.Time) error {
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Waiting for the instances to be running...")
+
+	timeout := time.After(cmd.Config.StartupTimeout())
+	for {
+		instances, warnings, err := cmd.Actor.GetApplicationInstancesByApplication(app.GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+
+		pending := pendingInstances(instances, restarted)
+		if len(pending) == 0 {
+			return nil
+		}
+
+		select {
+		case <-timeout:
+			return shared.InstancesNotRunningError{
+				AppName:   app.Name,
+				Instances: strings.Join(pending, ", "),
+				Timeout:   cmd.Config.StartupTimeout(),
+			}
+		case <-time.After(cmd.Config.PollingInterval()):
+		}
+	}
+}
+
+// pendingInstances returns the indexes of the restarted instances that have
+// not been replaced by a running instance yet. Instances that were running
+// before the restart are replaced once the instance at their index started
+// later than they did.
+func pendingInstances(instances []v2action.ApplicationInstance, restarted map[int]time.Time) []string {
+	replaced := map[int]bool{}
+	for _, instance := range instances {
+		startTime, ok := restarted[instance.ID]
+		if !ok || instance.State != ccv2.ApplicationInstanceRunning {
+			continue
+		}
+		if startTime.IsZero() || instance.StartTime().After(startTime.Add(uptimePrecision)) {
+			replaced[instance.ID] = true
+		}
+	}
+
+	var indexes []int
+	for index := range restarted {
+		if !replaced[index] {
+			indexes = append(indexes, index)
+		}
+	}
+	sort.Ints(indexes)
+
+	pending := make([]string, 0, len(indexes))
+	for _, index := range indexes {
+		pending = append(pending, strconv.Itoa(index))
+	}
+	return pending
+}
+
+func hasState(states []ccv2.ApplicationInstanceStatusState, state ccv2.ApplicationInstanceStatusState) bool {
+	for _, s := range states {
+		if s == state {
+			return true
+		}
+	}
+	return false
+}
+
+func joinStates(states []ccv2.ApplicationInstanceStatusState) string {
+	names := make([]string, 0, len(states))
+	for _, state := range states {
+		names = append(names, string(state))
+	}
+	return strings.Join(names, ", ")
+}
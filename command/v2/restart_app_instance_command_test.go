@@ -0,0 +1,284 @@
+package v2_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("restart-app-instance Command", func() {
+	var (
+		cmd             v2.RestartAppInstanceCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeRestartAppInstanceActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeRestartAppInstanceActor)
+
+		cmd = v2.RestartAppInstanceCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			Crashed:     true,
+		}
+		cmd.RequiredArgs.AppName = "some-app"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.PollingIntervalReturns(time.Millisecond)
+		fakeConfig.StartupTimeoutReturns(50 * time.Millisecond)
+
+		fakeActor.GetApplicationByNameAndSpaceReturns(
+			v2action.Application{GUID: "some-app-guid", Name: "some-app", State: ccv2.ApplicationStarted},
+			v2action.Warnings{"app-warning"},
+			nil,
+		)
+		fakeActor.GetApplicationInstancesByApplicationReturns(
+			[]v2action.ApplicationInstance{
+				{ID: 0, State: ccv2.ApplicationInstanceRunning},
+				{ID: 1, State: ccv2.ApplicationInstanceCrashed},
+				{ID: 2, State: ccv2.ApplicationInstanceDown},
+				{ID: 3, State: ccv2.ApplicationInstanceCrashed},
+			},
+			v2action.Warnings{"instances-warning"},
+			nil,
+		)
+		fakeActor.RestartApplicationInstanceReturns(v2action.Warnings{"restart-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when an index is given with --wait", func() {
+		BeforeEach(func() {
+			cmd.Crashed = false
+			cmd.Wait = true
+			cmd.RequiredArgs.Index = "1"
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(command.ArgumentCombinationError{
+				Args: []string{"INDEX", "--wait"},
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when an index is given with --crashed", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Index = "1"
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(command.ArgumentCombinationError{
+				Args: []string{"INDEX", "--crashed"},
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when --crashed and --state are both given", func() {
+		BeforeEach(func() {
+			cmd.State = "DOWN"
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(command.ArgumentCombinationError{
+				Args: []string{"--crashed", "--state"},
+			}))
+		})
+	})
+
+	Context("when --state contains an unknown state", func() {
+		BeforeEach(func() {
+			cmd.Crashed = false
+			cmd.State = "DOWN,BROKEN"
+		})
+
+		It("returns a ParseArgumentError", func() {
+			Expect(executeErr).To(MatchError(command.ParseArgumentError{
+				ArgumentName: "--state",
+				ExpectedType: "CRASHED, DOWN, RUNNING, STARTING, UNKNOWN",
+			}))
+		})
+	})
+
+	Context("when checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+		})
+	})
+
+	Context("when the app does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(v2action.Application{}, v2action.Warnings{"app-warning"}, v2action.ApplicationNotFoundError{Name: "some-app"})
+		})
+
+		It("returns an ApplicationNotFoundError and displays warnings", func() {
+			Expect(executeErr).To(MatchError(command.ApplicationNotFoundError{Name: "some-app"}))
+			Expect(testUI.Err).To(Say("app-warning"))
+		})
+	})
+
+	Context("when --crashed is given", func() {
+		It("restarts only the crashed instances", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.GetApplicationInstancesByApplicationArgsForCall(0)).To(Equal("some-app-guid"))
+			Expect(fakeActor.RestartApplicationInstanceCallCount()).To(Equal(2))
+			appGUID, index := fakeActor.RestartApplicationInstanceArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(index).To(Equal(1))
+			_, index = fakeActor.RestartApplicationInstanceArgsForCall(1)
+			Expect(index).To(Equal(3))
+
+			Expect(testUI.Out).To(Say("Restarting CRASHED instances of app some-app in org some-org / space some-space as some-user..."))
+			Expect(testUI.Out).To(Say(`Restarting instance 1 \(CRASHED\)...`))
+			Expect(testUI.Out).To(Say(`Restarting instance 3 \(CRASHED\)...`))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(testUI.Err).To(Say("app-warning"))
+			Expect(testUI.Err).To(Say("instances-warning"))
+			Expect(testUI.Err).To(Say("restart-warning"))
+		})
+
+		Context("when restarting an instance fails", func() {
+			BeforeEach(func() {
+				fakeActor.RestartApplicationInstanceReturns(nil, errors.New("restart failed"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("restart failed"))
+				Expect(fakeActor.RestartApplicationInstanceCallCount()).To(Equal(1))
+			})
+		})
+	})
+
+	Context("when --state is given", func() {
+		BeforeEach(func() {
+			cmd.Crashed = false
+			cmd.State = "down, crashed"
+		})
+
+		It("restarts the instances in any of the states", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.RestartApplicationInstanceCallCount()).To(Equal(3))
+			Expect(testUI.Out).To(Say("Restarting DOWN, CRASHED instances of app some-app"))
+			Expect(testUI.Out).To(Say(`Restarting instance 1 \(CRASHED\)...`))
+			Expect(testUI.Out).To(Say(`Restarting instance 2 \(DOWN\)...`))
+			Expect(testUI.Out).To(Say(`Restarting instance 3 \(CRASHED\)...`))
+		})
+	})
+
+	Context("when no instance matches", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationInstancesByApplicationReturns(
+				[]v2action.ApplicationInstance{{ID: 0, State: ccv2.ApplicationInstanceRunning}},
+				nil,
+				nil,
+			)
+		})
+
+		It("says so and restarts nothing", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.RestartApplicationInstanceCallCount()).To(BeZero())
+			Expect(testUI.Out).To(Say("No instances of app some-app are CRASHED."))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+	})
+
+	Context("when --wait is given", func() {
+		BeforeEach(func() {
+			cmd.Wait = true
+		})
+
+		Context("when the instances come back", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationInstancesByApplicationStub = func(string) ([]v2action.ApplicationInstance, v2action.Warnings, error) {
+					if fakeActor.GetApplicationInstancesByApplicationCallCount() < 3 {
+						return []v2action.ApplicationInstance{
+							{ID: 0, State: ccv2.ApplicationInstanceRunning},
+							{ID: 1, State: ccv2.ApplicationInstanceCrashed},
+						}, nil, nil
+					}
+					return []v2action.ApplicationInstance{
+						{ID: 0, State: ccv2.ApplicationInstanceRunning},
+						{ID: 1, State: ccv2.ApplicationInstanceRunning},
+					}, nil, nil
+				}
+			})
+
+			It("waits until the restarted instances are running", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeActor.GetApplicationInstancesByApplicationCallCount()).To(Equal(3))
+				Expect(testUI.Out).To(Say("Waiting for the instances to be running..."))
+				Expect(testUI.Out).To(Say("OK"))
+			})
+		})
+
+		Context("when running instances are restarted", func() {
+			BeforeEach(func() {
+				cmd.Crashed = false
+				cmd.State = "RUNNING"
+				fakeActor.GetApplicationInstancesByApplicationStub = func(string) ([]v2action.ApplicationInstance, v2action.Warnings, error) {
+					if fakeActor.GetApplicationInstancesByApplicationCallCount() < 3 {
+						return []v2action.ApplicationInstance{
+							{ID: 0, State: ccv2.ApplicationInstanceRunning, Uptime: 3600},
+						}, nil, nil
+					}
+					return []v2action.ApplicationInstance{
+						{ID: 0, State: ccv2.ApplicationInstanceRunning, Uptime: 0},
+					}, nil, nil
+				}
+			})
+
+			It("waits until they are replaced by new running instances", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeActor.RestartApplicationInstanceCallCount()).To(Equal(1))
+				Expect(fakeActor.GetApplicationInstancesByApplicationCallCount()).To(Equal(3))
+				Expect(testUI.Out).To(Say("OK"))
+			})
+		})
+
+		Context("when the instances do not come back in time", func() {
+			It("returns an InstancesNotRunningError", func() {
+				Expect(executeErr).To(MatchError(shared.InstancesNotRunningError{
+					AppName:   "some-app",
+					Instances: "1, 3",
+					Timeout:   50 * time.Millisecond,
+				}))
+			})
+		})
+	})
+})
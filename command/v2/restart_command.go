@@ -10,7 +10,9 @@ import (
 
 type RestartCommand struct {
 	RequiredArgs        flag.AppName `positional-args:"yes"`
-	usage               interface{}  `usage:"CF_NAME restart APP_NAME"`
+	Strategy            string       `long:"strategy" description:"Restart the instances in batches instead of stopping the whole app first; the only strategy is 'rolling'"`
+	MaxInFlight         int          `long:"max-in-flight" description:"Number of instances to restart at a time with the rolling strategy (Default: 1)"`
+	usage               interface{}  `usage:"CF_NAME restart APP_NAME [--strategy rolling [--max-in-flight NUMBER]]\n\nEXAMPLES:\n   CF_NAME restart my-app --strategy rolling --max-in-flight 2"`
 	relatedCommands     interface{}  `related_commands:"restage, restart-app-instance"`
 	envCFStagingTimeout interface{}  `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
 	envCFStartupTimeout interface{}  `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
@@ -0,0 +1,102 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . SetUserPasswordActor
+
+type SetUserPasswordActor interface {
+	GetUAAUserByName(username string, origin string) (v2action.UAAUser, error)
+	ResetUAAUserPassword(user v2action.UAAUser) (string, error)
+	SetUAAUserPassword(user v2action.UAAUser, password string) error
+}
+
+type SetUserPasswordCommand struct {
+	Args            flag.SetUserPassword `positional-args:"yes"`
+	Origin          string               `long:"origin" description:"Origin of the user, if the username exists in several origins"`
+	Generate        bool                 `long:"generate" description:"Reset the password to a generated one, which is displayed once"`
+	usage           interface{}          `usage:"CF_NAME set-user-password USERNAME PASSWORD [--origin ORIGIN]\n   CF_NAME set-user-password USERNAME --generate [--origin ORIGIN]\n\nEXAMPLES:\n   CF_NAME set-user-password j.smith@example.com S3cr3t\n   CF_NAME set-user-password j.smith@example.com --generate"`
+	relatedCommands interface{}          `related_commands:"create-user, passwd, user"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       SetUserPasswordActor
+}
+
+func (cmd *SetUserPasswordCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
+	return nil
+}
+
+func (cmd SetUserPasswordCommand) Execute(args []string) error {
+	if cmd.Args.Password != nil && cmd.Generate {
+		return command.ArgumentCombinationError{
+			Args: []string{"PASSWORD", "--generate"},
+		}
+	}
+	if cmd.Args.Password == nil && !cmd.Generate {
+		return command.RequiredArgumentError{
+			ArgumentName: "PASSWORD",
+		}
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	currentUser, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Setting the password of user {{.User}} as {{.CurrentUser}}...", map[string]interface{}{
+		"User":        cmd.Args.Username,
+		"CurrentUser": currentUser.Name,
+	})
+
+	user, err := cmd.Actor.GetUAAUserByName(cmd.Args.Username, cmd.Origin)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if !cmd.Generate {
+		err = cmd.Actor.SetUAAUserPassword(user, *cmd.Args.Password)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	password, err := cmd.Actor.ResetUAAUserPassword(user)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("New password: {{.Password}}", map[string]interface{}{
+		"Password": password,
+	})
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("TIP: The password is not shown again. Ask the user to change it with '{{.BinaryName}} passwd' after logging in.", map[string]interface{}{
+		"BinaryName": cmd.Config.BinaryName(),
+	})
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package v2_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("set-user-password Command", func() {
+	var (
+		cmd             v2.SetUserPasswordCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeSetUserPasswordActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeSetUserPasswordActor)
+
+		cmd = v2.SetUserPasswordCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.Args.Username = "j.smith"
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "admin"}, nil)
+		fakeActor.GetUAAUserByNameReturns(v2action.UAAUser{ID: "user-id", Username: "j.smith"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when neither PASSWORD nor --generate is given", func() {
+		It("returns a RequiredArgumentError", func() {
+			Expect(executeErr).To(MatchError(command.RequiredArgumentError{ArgumentName: "PASSWORD"}))
+		})
+	})
+
+	Context("when both PASSWORD and --generate are given", func() {
+		BeforeEach(func() {
+			password := "S3cr3t"
+			cmd.Args.Password = &password
+			cmd.Generate = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(command.ArgumentCombinationError{
+				Args: []string{"PASSWORD", "--generate"},
+			}))
+		})
+	})
+
+	Context("when PASSWORD is given", func() {
+		BeforeEach(func() {
+			password := "S3cr3t"
+			cmd.Args.Password = &password
+		})
+
+		It("sets the password", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.SetUAAUserPasswordCallCount()).To(Equal(1))
+			user, password := fakeActor.SetUAAUserPasswordArgsForCall(0)
+			Expect(user.ID).To(Equal("user-id"))
+			Expect(password).To(Equal("S3cr3t"))
+
+			Expect(testUI.Out).To(Say("Setting the password of user j.smith as admin..."))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+
+		Context("when the token does not have the scope to set passwords", func() {
+			BeforeEach(func() {
+				fakeActor.SetUAAUserPasswordReturns(uaa.InsufficientScopeError{Scopes: []string{"uaa.admin"}})
+			})
+
+			It("returns an InsufficientScopeError", func() {
+				Expect(executeErr).To(MatchError(command.InsufficientScopeError{Scopes: []string{"uaa.admin"}}))
+			})
+		})
+	})
+
+	Context("when --generate is given", func() {
+		BeforeEach(func() {
+			cmd.Generate = true
+			fakeActor.ResetUAAUserPasswordReturns("generated-password", nil)
+		})
+
+		It("resets the password and displays it", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.ResetUAAUserPasswordCallCount()).To(Equal(1))
+			Expect(fakeActor.SetUAAUserPasswordCallCount()).To(Equal(0))
+
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say("New password: generated-password"))
+			Expect(testUI.Out).To(Say(`TIP: The password is not shown again. Ask the user to change it with 'faceman passwd' after logging in.`))
+		})
+	})
+})
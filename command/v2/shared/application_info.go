@@ -1,3 +1,107 @@
 package shared
 
-// func for displaying app info
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+)
+
+// sparklineTicks are the bars used by Sparkline, from lowest to highest.
+var sparklineTicks = []rune("▁▂▃▄▅▆▇█")
+
+// InstanceHistory keeps the most recent samples of every instance of an
+// application, so that successive polls can be compared.
+type InstanceHistory struct {
+	size    int
+	samples map[int][]v2action.ApplicationInstance
+}
+
+// NewInstanceHistory returns an InstanceHistory that keeps the last size
+// samples of each instance.
+func NewInstanceHistory(size int) *InstanceHistory {
+	return &InstanceHistory{
+		size:    size,
+		samples: map[int][]v2action.ApplicationInstance{},
+	}
+}
+
+// Add records a sample of the instance. It returns the previous sample of the
+// same instance and whether there was one.
+func (history *InstanceHistory) Add(instance v2action.ApplicationInstance) (v2action.ApplicationInstance, bool) {
+	var (
+		previous v2action.ApplicationInstance
+		seen     bool
+	)
+
+	samples := history.samples[instance.ID]
+	if len(samples) > 0 {
+		previous = samples[len(samples)-1]
+		seen = true
+	}
+
+	samples = append(samples, instance)
+	if len(samples) > history.size {
+		samples = samples[len(samples)-history.size:]
+	}
+	history.samples[instance.ID] = samples
+
+	return previous, seen
+}
+
+// Samples returns the recorded samples of the instance, oldest first.
+func (history *InstanceHistory) Samples(id int) []v2action.ApplicationInstance {
+	return history.samples[id]
+}
+
+// Prune forgets the instances that are not in current and returns their last
+// samples, ordered by instance ID.
+func (history *InstanceHistory) Prune(current []v2action.ApplicationInstance) []v2action.ApplicationInstance {
+	reported := map[int]bool{}
+	for _, instance := range current {
+		reported[instance.ID] = true
+	}
+
+	var ids []int
+	for id := range history.samples {
+		if !reported[id] {
+			ids = append(ids, id)
+		}
+	}
+	sort.Ints(ids)
+
+	var removed []v2action.ApplicationInstance
+	for _, id := range ids {
+		samples := history.samples[id]
+		removed = append(removed, samples[len(samples)-1])
+		delete(history.samples, id)
+	}
+	return removed
+}
+
+// Sparkline draws values as a line of bars scaled to max. When max is not
+// positive the values are scaled to the largest of them.
+func Sparkline(values []float64, max float64) string {
+	if max <= 0 {
+		for _, value := range values {
+			if value > max {
+				max = value
+			}
+		}
+	}
+
+	line := make([]rune, 0, len(values))
+	for _, value := range values {
+		tick := 0
+		if max > 0 {
+			tick = int(value/max*float64(len(sparklineTicks)-1) + 0.5)
+		}
+		if tick < 0 {
+			tick = 0
+		}
+		if tick >= len(sparklineTicks) {
+			tick = len(sparklineTicks) - 1
+		}
+		line = append(line, sparklineTicks[tick])
+	}
+	return string(line)
+}
@@ -0,0 +1,76 @@
+package shared_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "code.cloudfoundry.org/cli/command/v2/shared"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Application Info", func() {
+	Describe("InstanceHistory", func() {
+		var history *InstanceHistory
+
+		BeforeEach(func() {
+			history = NewInstanceHistory(2)
+		})
+
+		Describe("Add", func() {
+			It("returns the previous sample of the instance", func() {
+				_, seen := history.Add(v2action.ApplicationInstance{ID: 0, State: ccv2.ApplicationInstanceCrashed})
+				Expect(seen).To(BeFalse())
+
+				previous, seen := history.Add(v2action.ApplicationInstance{ID: 0, State: ccv2.ApplicationInstanceRunning})
+				Expect(seen).To(BeTrue())
+				Expect(previous.State).To(Equal(ccv2.ApplicationInstanceCrashed))
+
+				_, seen = history.Add(v2action.ApplicationInstance{ID: 1})
+				Expect(seen).To(BeFalse())
+			})
+
+			It("only keeps the most recent samples", func() {
+				history.Add(v2action.ApplicationInstance{ID: 0, Memory: 1})
+				history.Add(v2action.ApplicationInstance{ID: 0, Memory: 2})
+				history.Add(v2action.ApplicationInstance{ID: 0, Memory: 3})
+
+				Expect(history.Samples(0)).To(Equal([]v2action.ApplicationInstance{
+					{ID: 0, Memory: 2},
+					{ID: 0, Memory: 3},
+				}))
+			})
+		})
+
+		Describe("Prune", func() {
+			It("forgets and returns the instances that are no longer reported", func() {
+				history.Add(v2action.ApplicationInstance{ID: 2, Memory: 20})
+				history.Add(v2action.ApplicationInstance{ID: 0, Memory: 1})
+				history.Add(v2action.ApplicationInstance{ID: 1, Memory: 10})
+
+				removed := history.Prune([]v2action.ApplicationInstance{{ID: 0}})
+				Expect(removed).To(Equal([]v2action.ApplicationInstance{
+					{ID: 1, Memory: 10},
+					{ID: 2, Memory: 20},
+				}))
+				Expect(history.Samples(0)).To(HaveLen(1))
+				Expect(history.Samples(1)).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("Sparkline", func() {
+		It("scales the values to the maximum", func() {
+			Expect(Sparkline([]float64{0, 50, 100, 200}, 100)).To(Equal("▁▅██"))
+		})
+
+		Context("when the maximum is not positive", func() {
+			It("scales the values to the largest of them", func() {
+				Expect(Sparkline([]float64{1, 2, 4}, 0)).To(Equal("▃▅█"))
+			})
+
+			It("draws all zero values as the lowest bar", func() {
+				Expect(Sparkline([]float64{0, 0}, 0)).To(Equal("▁▁"))
+			})
+		})
+	})
+})
@@ -1,6 +1,55 @@
 package shared
 
-import "time"
+import (
+	"strings"
+	"time"
+)
+
+type DropletNotFoundError struct {
+	AppName string
+}
+
+func (e DropletNotFoundError) Error() string {
+	return "App {{.AppName}} has no droplet. Stage the app before downloading its droplet."
+}
+
+func (e DropletNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName": e.AppName,
+	})
+}
+
+type GroupNotFoundError struct {
+	Name string
+}
+
+func (e GroupNotFoundError) Error() string {
+	return "Group '{{.Name}}' not found."
+}
+
+func (e GroupNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
+
+type InstancesNotRunningError struct {
+	AppName   string
+	Instances string
+	Timeout   time.Duration
+}
+
+func (e InstancesNotRunningError) Error() string {
+	return "Instances {{.Instances}} of app {{.AppName}} did not report RUNNING within {{.Timeout}}."
+}
+
+func (e InstancesNotRunningError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName":   e.AppName,
+		"Instances": e.Instances,
+		"Timeout":   e.Timeout,
+	})
+}
 
 type JobFailedError struct {
 	JobGUID string
@@ -33,6 +82,22 @@ func (e JobTimeoutError) Translate(translate func(string, ...interface{}) string
 	})
 }
 
+type MultipleUsersFoundError struct {
+	Username string
+	Origins  []string
+}
+
+func (e MultipleUsersFoundError) Error() string {
+	return "User '{{.Username}}' exists in the origins {{.Origins}}. Use --origin to choose one."
+}
+
+func (e MultipleUsersFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Username": e.Username,
+		"Origins":  strings.Join(e.Origins, ", "),
+	})
+}
+
 type NoOrganizationTargetedError struct{}
 
 func (e NoOrganizationTargetedError) Error() string {
@@ -70,3 +135,38 @@ func (e SpaceNotFoundError) Translate(translate func(string, ...interface{}) str
 		"Name": e.Name,
 	})
 }
+
+type UserInheritsGroupError struct {
+	Username string
+	Group    string
+}
+
+func (e UserInheritsGroupError) Error() string {
+	return "User '{{.Username}}' is a member of group '{{.Group}}' through another group. Remove the user from that group instead."
+}
+
+func (e UserInheritsGroupError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Username": e.Username,
+		"Group":    e.Group,
+	})
+}
+
+type UserNotFoundError struct {
+	Username string
+	Origin   string
+}
+
+func (e UserNotFoundError) Error() string {
+	if e.Origin == "" {
+		return "User '{{.Username}}' not found."
+	}
+	return "User '{{.Username}}' with origin '{{.Origin}}' not found."
+}
+
+func (e UserNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Username": e.Username,
+		"Origin":   e.Origin,
+	})
+}
@@ -41,8 +41,15 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("JobTimeoutError", JobTimeoutError{}),
 
 		// Command errors.
+		Entry("DropletNotFoundError", DropletNotFoundError{}),
+		Entry("GroupNotFoundError", GroupNotFoundError{}),
+		Entry("InstancesNotRunningError", InstancesNotRunningError{}),
+		Entry("MultipleUsersFoundError", MultipleUsersFoundError{}),
 		Entry("NoOrgTargetedError", NoOrganizationTargetedError{}),
 		Entry("OrgNotFoundError", OrganizationNotFoundError{}),
 		Entry("SpaceNotFoundError", SpaceNotFoundError{}),
+		Entry("UserNotFoundError", UserNotFoundError{}),
+		Entry("UserNotFoundError with origin", UserNotFoundError{Origin: "ldap"}),
+		Entry("UserInheritsGroupError", UserInheritsGroupError{}),
 	)
 })
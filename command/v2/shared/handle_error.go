@@ -5,7 +5,9 @@ import (
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/tlsconfig"
 )
 
 func HandleError(err error) error {
@@ -24,6 +26,20 @@ func HandleError(err error) error {
 	case ccv2.JobTimeoutError:
 		return JobTimeoutError{JobGUID: e.JobGUID}
 
+	case uaa.UnverifiedServerError:
+		return command.InvalidSSLCertError{API: e.URL}
+	case uaa.InvalidAccessTokenError:
+		return command.InvalidAccessTokenError{}
+	case uaa.InsufficientScopeError:
+		return command.InsufficientScopeError{Scopes: e.Scopes}
+
+	case tlsconfig.InvalidCACertError:
+		return command.InvalidCACertError{Path: e.Path}
+	case tlsconfig.IncompleteClientCertError:
+		return command.IncompleteClientCertError{}
+	case tlsconfig.InvalidClientCertError:
+		return command.InvalidClientCertError{CertFile: e.CertFile, KeyFile: e.KeyFile, Err: e.Err}
+
 	case sharedaction.NotLoggedInError:
 		return command.NotLoggedInError{BinaryName: e.BinaryName}
 	case sharedaction.NoTargetedOrganizationError:
@@ -33,12 +49,20 @@ func HandleError(err error) error {
 
 	case v2action.ApplicationNotFoundError:
 		return command.ApplicationNotFoundError{Name: e.Name}
+	case v2action.GroupNotFoundError:
+		return GroupNotFoundError{Name: e.Name}
+	case v2action.MultipleUsersFoundError:
+		return MultipleUsersFoundError{Username: e.Username, Origins: e.Origins}
 	case v2action.OrganizationNotFoundError:
 		return OrganizationNotFoundError{Name: e.Name}
 	case v2action.ServiceInstanceNotFoundError:
 		return command.ServiceInstanceNotFoundError{Name: e.Name}
 	case v2action.SpaceNotFoundError:
 		return SpaceNotFoundError{Name: e.Name}
+	case v2action.UserInheritsGroupError:
+		return UserInheritsGroupError{Username: e.Username, Group: e.Group}
+	case v2action.UserNotFoundError:
+		return UserNotFoundError{Username: e.Username, Origin: e.Origin}
 	}
 
 	return err
@@ -7,8 +7,10 @@ import (
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/command"
 	. "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/tlsconfig"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -31,6 +33,30 @@ var _ = Describe("HandleError", func() {
 			cloudcontroller.UnverifiedServerError{URL: "some-url"},
 			command.InvalidSSLCertError{API: "some-url"}),
 
+		Entry("uaa.UnverifiedServerError -> InvalidSSLCertError",
+			uaa.UnverifiedServerError{URL: "some-url"},
+			command.InvalidSSLCertError{API: "some-url"}),
+
+		Entry("uaa.InvalidAccessTokenError -> InvalidAccessTokenError",
+			uaa.InvalidAccessTokenError{},
+			command.InvalidAccessTokenError{}),
+
+		Entry("uaa.InsufficientScopeError -> InsufficientScopeError",
+			uaa.InsufficientScopeError{Message: "Insufficient scope for this resource", Scopes: []string{"scim.read"}},
+			command.InsufficientScopeError{Scopes: []string{"scim.read"}}),
+
+		Entry("tlsconfig.InvalidCACertError -> InvalidCACertError",
+			tlsconfig.InvalidCACertError{Path: "some-path"},
+			command.InvalidCACertError{Path: "some-path"}),
+
+		Entry("tlsconfig.IncompleteClientCertError -> IncompleteClientCertError",
+			tlsconfig.IncompleteClientCertError{},
+			command.IncompleteClientCertError{}),
+
+		Entry("tlsconfig.InvalidClientCertError -> InvalidClientCertError",
+			tlsconfig.InvalidClientCertError{CertFile: "some-cert", KeyFile: "some-key", Err: err},
+			command.InvalidClientCertError{CertFile: "some-cert", KeyFile: "some-key", Err: err}),
+
 		Entry("cloudcontroller.SSLValidationHostnameError -> SSLCertErrorError",
 			cloudcontroller.SSLValidationHostnameError{Message: "some-message"},
 			command.SSLCertErrorError{Message: "some-message"}),
@@ -63,6 +89,22 @@ var _ = Describe("HandleError", func() {
 			v2action.SpaceNotFoundError{Name: "some-space"},
 			SpaceNotFoundError{Name: "some-space"}),
 
+		Entry("v2action.UserNotFoundError -> UserNotFoundError",
+			v2action.UserNotFoundError{Username: "some-user", Origin: "ldap"},
+			UserNotFoundError{Username: "some-user", Origin: "ldap"}),
+
+		Entry("v2action.UserInheritsGroupError -> UserInheritsGroupError",
+			v2action.UserInheritsGroupError{Username: "some-user", Group: "some-group"},
+			UserInheritsGroupError{Username: "some-user", Group: "some-group"}),
+
+		Entry("v2action.MultipleUsersFoundError -> MultipleUsersFoundError",
+			v2action.MultipleUsersFoundError{Username: "some-user", Origins: []string{"uaa", "ldap"}},
+			MultipleUsersFoundError{Username: "some-user", Origins: []string{"uaa", "ldap"}}),
+
+		Entry("v2action.GroupNotFoundError -> GroupNotFoundError",
+			v2action.GroupNotFoundError{Name: "some-group"},
+			GroupNotFoundError{Name: "some-group"}),
+
 		Entry("sharedaction.NotLoggedInError -> NotLoggedInError",
 			sharedaction.NotLoggedInError{BinaryName: "faceman"},
 			command.NotLoggedInError{BinaryName: "faceman"}),
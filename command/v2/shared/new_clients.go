@@ -17,30 +17,73 @@ func NewClients(config command.Config, ui command.UI) (*ccv2.Client, *uaa.Client
 		}
 	}
 
+	resolver := command.NewProxyResolver(config, ui)
+
 	ccClient := ccv2.NewClient(ccv2.Config{
 		AppName:            config.BinaryName(),
 		AppVersion:         config.BinaryVersion(),
 		JobPollingTimeout:  config.OverallPollingTimeout(),
 		JobPollingInterval: config.PollingInterval(),
 	})
+
+	var (
+		ccWrappers  []ccv2.ConnectionWrapper
+		uaaWrappers []uaa.ConnectionWrapper
+	)
+	if replayFile := config.ReplayFile(); replayFile != "" {
+		player, err := command.NewCassettePlayer(replayFile)
+		if err != nil {
+			return nil, nil, err
+		}
+		ccWrappers = append(ccWrappers, ccWrapper.NewRequestReplayer(player))
+		uaaWrappers = append(uaaWrappers, uaaWrapper.NewRequestReplayer(player))
+	}
+	if recordFile := config.RecordFile(); recordFile != "" {
+		recorder := command.NewCassetteRecorder(ui, recordFile)
+		ccWrappers = append(ccWrappers, ccWrapper.NewRequestRecorder(recorder))
+		uaaWrappers = append(uaaWrappers, uaaWrapper.NewRequestRecorder(recorder))
+	}
+	if harFile := config.TraceHARFile(); harFile != "" {
+		harWriter := command.NewHARWriter(ui, harFile)
+		ccWrappers = append(ccWrappers, ccWrapper.NewHARRecorder(harWriter))
+		uaaWrappers = append(uaaWrappers, uaaWrapper.NewHARRecorder(harWriter))
+	}
+	if config.Timings() {
+		ccWrappers = append(ccWrappers, ccWrapper.NewRequestTimer(config.RequestTimings()))
+		uaaWrappers = append(uaaWrappers, uaaWrapper.NewRequestTimer(config.RequestTimings()))
+	}
+
 	_, err := ccClient.TargetCF(ccv2.TargetSettings{
 		URL:               config.Target(),
 		SkipSSLValidation: config.SkipSSLValidation(),
 		DialTimeout:       config.DialTimeout(),
+		CACertFile:        config.CACertFile(),
+		ClientCertFile:    config.ClientCertFile(),
+		ClientKeyFile:     config.ClientKeyFile(),
+		Proxy:             resolver,
+		Wrappers:          ccWrappers,
 	})
 	if err != nil {
 		return nil, nil, err
 	}
 
-	uaaClient := uaa.NewClient(uaa.Config{
+	uaaClient, err := uaa.NewClient(uaa.Config{
 		AppName:           config.BinaryName(),
 		AppVersion:        config.BinaryVersion(),
 		ClientID:          config.UAAOAuthClient(),
 		ClientSecret:      config.UAAOAuthClientSecret(),
 		DialTimeout:       config.DialTimeout(),
 		SkipSSLValidation: config.SkipSSLValidation(),
+		CACertFile:        config.CACertFile(),
+		ClientCertFile:    config.ClientCertFile(),
+		ClientKeyFile:     config.ClientKeyFile(),
+		Proxy:             resolver,
+		Wrappers:          uaaWrappers,
 		URL:               ccClient.TokenEndpoint(),
 	})
+	if err != nil {
+		return nil, nil, err
+	}
 
 	verbose, location := config.Verbose()
 	if verbose {
@@ -52,11 +95,12 @@ func NewClients(config command.Config, ui command.UI) (*ccv2.Client, *uaa.Client
 		uaaClient.WrapConnection(uaaWrapper.NewRequestLogger(command.NewRequestLoggerFileWriter(ui, location)))
 	}
 
-	ccClient.WrapConnection(ccWrapper.NewUAAAuthentication(uaaClient, config))
-	ccClient.WrapConnection(ccWrapper.NewRetryRequest(2))
+	refreshSchedule := uaa.NewRefreshSchedule(config.TokenRefreshWindow())
+	ccClient.WrapConnection(ccWrapper.NewUAAAuthentication(uaaClient, config, refreshSchedule))
+	ccClient.WrapConnection(ccWrapper.NewRetryRequest(config.RequestRetries()))
 
-	uaaClient.WrapConnection(uaaWrapper.NewUAAAuthentication(uaaClient, config))
-	uaaClient.WrapConnection(uaaWrapper.NewRetryRequest(2))
+	uaaClient.WrapConnection(uaaWrapper.NewUAAAuthentication(uaaClient, config, refreshSchedule))
+	uaaClient.WrapConnection(uaaWrapper.NewRetryRequest(config.RequestRetries()))
 
 	return ccClient, uaaClient, err
 }
@@ -1,6 +1,9 @@
 package shared_test
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"runtime"
 	"time"
 
@@ -63,4 +66,60 @@ var _ = Describe("New Clients", func() {
 			Expect(err).To(HaveOccurred())
 		})
 	})
+
+	Context("when a replay cassette is set", func() {
+		var tmpdir string
+
+		BeforeEach(func() {
+			var err error
+			tmpdir, err = ioutil.TempDir("", "new_clients")
+			Expect(err).ToNot(HaveOccurred())
+
+			fakeConfig.TargetReturns("https://api.offline.invalid")
+			fakeConfig.ReplayFileReturns(filepath.Join(tmpdir, "cassette.json"))
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(tmpdir)
+		})
+
+		Context("when the cassette contains the info response", func() {
+			BeforeEach(func() {
+				cassette := `{"interactions":[{"request":{"method":"GET","url":"https://api.recorded.com/v2/info"},"response":{"status_code":200,"body":"{\"token_endpoint\":\"https://uaa.recorded.com\"}"}}]}`
+				Expect(ioutil.WriteFile(filepath.Join(tmpdir, "cassette.json"), []byte(cassette), 0600)).To(Succeed())
+			})
+
+			It("targets the API from the cassette without the network", func() {
+				ccClient, _, err := NewClients(fakeConfig, testUI)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ccClient.TokenEndpoint()).To(Equal("https://uaa.recorded.com"))
+			})
+
+			Context("when timings are enabled", func() {
+				var requestTimings *command.RequestTimings
+
+				BeforeEach(func() {
+					requestTimings = command.NewRequestTimings()
+					fakeConfig.TimingsReturns(true)
+					fakeConfig.RequestTimingsReturns(requestTimings)
+				})
+
+				It("records the timing of the info request", func() {
+					_, _, err := NewClients(fakeConfig, testUI)
+					Expect(err).ToNot(HaveOccurred())
+
+					timings := requestTimings.Timings()
+					Expect(timings).To(HaveLen(1))
+					Expect(timings[0].Endpoint).To(Equal("GET /v2/info"))
+				})
+			})
+		})
+
+		Context("when the cassette does not exist", func() {
+			It("returns an error", func() {
+				_, _, err := NewClients(fakeConfig, testUI)
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+		})
+	})
 })
@@ -0,0 +1,128 @@
+package v2
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . UserActor
+
+type UserActor interface {
+	GetUAAUserByName(username string, origin string) (v2action.UAAUser, error)
+	SetUAAUserActive(user v2action.UAAUser, active bool) (v2action.UAAUser, error)
+}
+
+type UserCommand struct {
+	RequiredArgs    flag.Username `positional-args:"yes"`
+	Origin          string        `long:"origin" description:"Origin of the user, if the username exists in several origins"`
+	Activate        bool          `long:"activate" description:"Allow the user to log in"`
+	Deactivate      bool          `long:"deactivate" description:"Prevent the user from logging in"`
+	usage           interface{}   `usage:"CF_NAME user USERNAME [--origin ORIGIN] [--activate | --deactivate]\n\nEXAMPLES:\n   CF_NAME user j.smith@example.com\n   CF_NAME user j.smith@example.com --origin ldap --deactivate"`
+	relatedCommands interface{}   `related_commands:"set-user-password, user-groups, users"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       UserActor
+}
+
+func (cmd *UserCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
+	return nil
+}
+
+func (cmd UserCommand) Execute(args []string) error {
+	if cmd.Activate && cmd.Deactivate {
+		return command.ArgumentCombinationError{
+			Args: []string{"--activate", "--deactivate"},
+		}
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	currentUser, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case cmd.Activate:
+		cmd.UI.DisplayTextWithFlavor("Activating user {{.User}} as {{.CurrentUser}}...", map[string]interface{}{
+			"User":        cmd.RequiredArgs.Username,
+			"CurrentUser": currentUser.Name,
+		})
+	case cmd.Deactivate:
+		cmd.UI.DisplayTextWithFlavor("Deactivating user {{.User}} as {{.CurrentUser}}...", map[string]interface{}{
+			"User":        cmd.RequiredArgs.Username,
+			"CurrentUser": currentUser.Name,
+		})
+	default:
+		cmd.UI.DisplayTextWithFlavor("Getting user {{.User}} as {{.CurrentUser}}...", map[string]interface{}{
+			"User":        cmd.RequiredArgs.Username,
+			"CurrentUser": currentUser.Name,
+		})
+	}
+
+	user, err := cmd.Actor.GetUAAUserByName(cmd.RequiredArgs.Username, cmd.Origin)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if cmd.Activate || cmd.Deactivate {
+		user, err = cmd.Actor.SetUAAUserActive(user, cmd.Activate)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+		cmd.UI.DisplayOK()
+	}
+	cmd.UI.DisplayNewline()
+
+	return cmd.displayUser(user)
+}
+
+func (cmd UserCommand) displayUser(user v2action.UAAUser) error {
+	groups := user.GroupNames()
+	sort.Strings(groups)
+
+	created := ""
+	if !user.Created.IsZero() {
+		created = cmd.UI.UserFriendlyDate(user.Created)
+	}
+	lastLogon := cmd.UI.TranslateText("never")
+	if !user.LastLogon.IsZero() {
+		lastLogon = cmd.UI.UserFriendlyDate(user.LastLogon)
+	}
+
+	table := [][]string{
+		{cmd.UI.TranslateText("Username:"), user.Username},
+		{cmd.UI.TranslateText("ID:"), user.ID},
+		{cmd.UI.TranslateText("Origin:"), user.Origin},
+		{cmd.UI.TranslateText("Name:"), strings.TrimSpace(user.GivenName + " " + user.FamilyName)},
+		{cmd.UI.TranslateText("Emails:"), strings.Join(user.Emails, ", ")},
+		{cmd.UI.TranslateText("Active:"), strconv.FormatBool(user.Active)},
+		{cmd.UI.TranslateText("Verified:"), strconv.FormatBool(user.Verified)},
+		{cmd.UI.TranslateText("Groups:"), strings.Join(groups, ", ")},
+		{cmd.UI.TranslateText("Created:"), created},
+		{cmd.UI.TranslateText("Last logon:"), lastLogon},
+	}
+	return cmd.UI.DisplayTable("", table, 3)
+}
@@ -0,0 +1,131 @@
+package v2_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("user Command", func() {
+	var (
+		cmd             v2.UserCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeUserActor
+		user            v2action.UAAUser
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeUserActor)
+
+		cmd = v2.UserCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.Username = "j.smith"
+		cmd.Origin = "ldap"
+
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "admin"}, nil)
+
+		user = v2action.UAAUser{
+			ID:         "user-id",
+			Username:   "j.smith",
+			Origin:     "ldap",
+			Active:     true,
+			GivenName:  "John",
+			FamilyName: "Smith",
+			Emails:     []string{"j.smith@example.com"},
+			Groups: []uaa.UserGroup{
+				{Name: "scim.read"},
+				{Name: "openid"},
+			},
+		}
+		fakeActor.GetUAAUserByNameReturns(user, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("displays the user", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		username, origin := fakeActor.GetUAAUserByNameArgsForCall(0)
+		Expect(username).To(Equal("j.smith"))
+		Expect(origin).To(Equal("ldap"))
+		Expect(fakeActor.SetUAAUserActiveCallCount()).To(Equal(0))
+
+		Expect(testUI.Out).To(Say("Getting user j.smith as admin..."))
+		Expect(testUI.Out).To(Say(`Username:\s+j.smith`))
+		Expect(testUI.Out).To(Say(`ID:\s+user-id`))
+		Expect(testUI.Out).To(Say(`Origin:\s+ldap`))
+		Expect(testUI.Out).To(Say(`Name:\s+John Smith`))
+		Expect(testUI.Out).To(Say(`Emails:\s+j.smith@example.com`))
+		Expect(testUI.Out).To(Say(`Active:\s+true`))
+		Expect(testUI.Out).To(Say(`Verified:\s+false`))
+		Expect(testUI.Out).To(Say(`Groups:\s+openid, scim.read`))
+		Expect(testUI.Out).To(Say(`Last logon:\s+never`))
+	})
+
+	Context("when the user does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetUAAUserByNameReturns(v2action.UAAUser{}, v2action.UserNotFoundError{Username: "j.smith", Origin: "ldap"})
+		})
+
+		It("returns a UserNotFoundError", func() {
+			Expect(executeErr).To(MatchError(shared.UserNotFoundError{Username: "j.smith", Origin: "ldap"}))
+		})
+	})
+
+	Context("when --deactivate is passed", func() {
+		BeforeEach(func() {
+			cmd.Deactivate = true
+			deactivated := user
+			deactivated.Active = false
+			fakeActor.SetUAAUserActiveReturns(deactivated, nil)
+		})
+
+		It("deactivates the user and displays it", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.SetUAAUserActiveCallCount()).To(Equal(1))
+			passedUser, active := fakeActor.SetUAAUserActiveArgsForCall(0)
+			Expect(passedUser.ID).To(Equal("user-id"))
+			Expect(active).To(BeFalse())
+
+			Expect(testUI.Out).To(Say("Deactivating user j.smith as admin..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`Active:\s+false`))
+		})
+	})
+
+	Context("when --activate and --deactivate are both passed", func() {
+		BeforeEach(func() {
+			cmd.Activate = true
+			cmd.Deactivate = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(command.ArgumentCombinationError{
+				Args: []string{"--activate", "--deactivate"},
+			}))
+			Expect(fakeActor.GetUAAUserByNameCallCount()).To(Equal(0))
+		})
+	})
+})
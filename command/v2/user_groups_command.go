@@ -0,0 +1,177 @@
+package v2
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . UserGroupsActor
+
+type UserGroupsActor interface {
+	AddUAAUserToGroup(user v2action.UAAUser, groupName string) error
+	GetUAAUserByName(username string, origin string) (v2action.UAAUser, error)
+	RemoveUAAUserFromGroup(user v2action.UAAUser, groupName string) error
+}
+
+type UserGroupsCommand struct {
+	RequiredArgs    flag.Username `positional-args:"yes"`
+	Origin          string        `long:"origin" description:"Origin of the user, if the username exists in several origins"`
+	Add             string        `long:"add" description:"Add the user to this group, which grants the scope of the same name"`
+	Remove          string        `long:"remove" description:"Remove the user from this group"`
+	usage           interface{}   `usage:"CF_NAME user-groups USERNAME [--origin ORIGIN] [--add GROUP | --remove GROUP]\n\nEXAMPLES:\n   CF_NAME user-groups j.smith@example.com\n   CF_NAME user-groups j.smith@example.com --add scim.read"`
+	relatedCommands interface{}   `related_commands:"oauth-token, user, users"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       UserGroupsActor
+}
+
+func (cmd *UserGroupsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
+	return nil
+}
+
+func (cmd UserGroupsCommand) Execute(args []string) error {
+	if cmd.Add != "" && cmd.Remove != "" {
+		return command.ArgumentCombinationError{
+			Args: []string{"--add", "--remove"},
+		}
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	currentUser, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case cmd.Add != "":
+		return cmd.addToGroup(currentUser.Name)
+	case cmd.Remove != "":
+		return cmd.removeFromGroup(currentUser.Name)
+	default:
+		return cmd.listGroups(currentUser.Name)
+	}
+}
+
+func (cmd UserGroupsCommand) listGroups(currentUser string) error {
+	cmd.UI.DisplayTextWithFlavor("Getting groups of user {{.User}} as {{.CurrentUser}}...", map[string]interface{}{
+		"User":        cmd.RequiredArgs.Username,
+		"CurrentUser": currentUser,
+	})
+	cmd.UI.DisplayNewline()
+
+	user, err := cmd.Actor.GetUAAUserByName(cmd.RequiredArgs.Username, cmd.Origin)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if len(user.Groups) == 0 {
+		cmd.UI.DisplayText("User {{.User}} is not a member of any groups.", map[string]interface{}{
+			"User": user.Username,
+		})
+		return nil
+	}
+
+	groups := groupsByName(user.Groups)
+	sort.Sort(groups)
+
+	table := [][]string{{cmd.UI.TranslateText("group"), cmd.UI.TranslateText("membership")}}
+	for _, group := range groups {
+		membership := cmd.UI.TranslateText("direct")
+		if group.Type == uaa.IndirectMembership {
+			membership = cmd.UI.TranslateText("inherited")
+		}
+		table = append(table, []string{group.Name, membership})
+	}
+	return cmd.UI.DisplayTable("", table, 3)
+}
+
+func (cmd UserGroupsCommand) addToGroup(currentUser string) error {
+	cmd.UI.DisplayTextWithFlavor("Adding user {{.User}} to group {{.Group}} as {{.CurrentUser}}...", map[string]interface{}{
+		"User":        cmd.RequiredArgs.Username,
+		"Group":       cmd.Add,
+		"CurrentUser": currentUser,
+	})
+
+	user, err := cmd.Actor.GetUAAUserByName(cmd.RequiredArgs.Username, cmd.Origin)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	err = cmd.Actor.AddUAAUserToGroup(user, cmd.Add)
+	if _, ok := err.(v2action.UserAlreadyInGroupError); ok {
+		cmd.UI.DisplayWarning("User {{.User}} is already a member of group {{.Group}}.", map[string]interface{}{
+			"User":  user.Username,
+			"Group": cmd.Add,
+		})
+		cmd.UI.DisplayOK()
+		return nil
+	}
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("TIP: The user gets the {{.Group}} scope the next time they log in.", map[string]interface{}{
+		"Group": cmd.Add,
+	})
+	return nil
+}
+
+func (cmd UserGroupsCommand) removeFromGroup(currentUser string) error {
+	cmd.UI.DisplayTextWithFlavor("Removing user {{.User}} from group {{.Group}} as {{.CurrentUser}}...", map[string]interface{}{
+		"User":        cmd.RequiredArgs.Username,
+		"Group":       cmd.Remove,
+		"CurrentUser": currentUser,
+	})
+
+	user, err := cmd.Actor.GetUAAUserByName(cmd.RequiredArgs.Username, cmd.Origin)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	err = cmd.Actor.RemoveUAAUserFromGroup(user, cmd.Remove)
+	if _, ok := err.(v2action.UserNotInGroupError); ok {
+		cmd.UI.DisplayWarning("User {{.User}} is not a member of group {{.Group}}.", map[string]interface{}{
+			"User":  user.Username,
+			"Group": cmd.Remove,
+		})
+		cmd.UI.DisplayOK()
+		return nil
+	}
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+// groupsByName sorts group memberships by the name of the group.
+type groupsByName []uaa.UserGroup
+
+func (groups groupsByName) Len() int           { return len(groups) }
+func (groups groupsByName) Less(i, j int) bool { return groups[i].Name < groups[j].Name }
+func (groups groupsByName) Swap(i, j int)      { groups[i], groups[j] = groups[j], groups[i] }
@@ -0,0 +1,168 @@
+package v2_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("user-groups Command", func() {
+	var (
+		cmd             v2.UserGroupsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeUserGroupsActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeUserGroupsActor)
+
+		cmd = v2.UserGroupsCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.Username = "j.smith"
+
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "admin"}, nil)
+		fakeActor.GetUAAUserByNameReturns(v2action.UAAUser{
+			ID:       "user-id",
+			Username: "j.smith",
+			Groups: []uaa.UserGroup{
+				{Name: "scim.read", Type: uaa.IndirectMembership},
+				{Name: "openid", Type: uaa.DirectMembership},
+			},
+		}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("lists the groups of the user", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(testUI.Out).To(Say("Getting groups of user j.smith as admin..."))
+		Expect(testUI.Out).To(Say(`group\s+membership`))
+		Expect(testUI.Out).To(Say(`openid\s+direct`))
+		Expect(testUI.Out).To(Say(`scim.read\s+inherited`))
+	})
+
+	Context("when the user is not a member of any groups", func() {
+		BeforeEach(func() {
+			fakeActor.GetUAAUserByNameReturns(v2action.UAAUser{Username: "j.smith"}, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("User j.smith is not a member of any groups."))
+		})
+	})
+
+	Context("when --add is passed", func() {
+		BeforeEach(func() {
+			cmd.Add = "scim.write"
+		})
+
+		It("adds the user to the group", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.AddUAAUserToGroupCallCount()).To(Equal(1))
+			user, group := fakeActor.AddUAAUserToGroupArgsForCall(0)
+			Expect(user.ID).To(Equal("user-id"))
+			Expect(group).To(Equal("scim.write"))
+
+			Expect(testUI.Out).To(Say("Adding user j.smith to group scim.write as admin..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say("TIP: The user gets the scim.write scope the next time they log in."))
+		})
+
+		Context("when the user is already a member of the group", func() {
+			BeforeEach(func() {
+				fakeActor.AddUAAUserToGroupReturns(v2action.UserAlreadyInGroupError{Username: "j.smith", Group: "scim.write"})
+			})
+
+			It("warns and succeeds", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Err).To(Say("User j.smith is already a member of group scim.write."))
+				Expect(testUI.Out).To(Say("OK"))
+			})
+		})
+
+		Context("when the group does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.AddUAAUserToGroupReturns(v2action.GroupNotFoundError{Name: "scim.write"})
+			})
+
+			It("returns a GroupNotFoundError", func() {
+				Expect(executeErr).To(MatchError(shared.GroupNotFoundError{Name: "scim.write"}))
+			})
+		})
+	})
+
+	Context("when --remove is passed", func() {
+		BeforeEach(func() {
+			cmd.Remove = "scim.read"
+		})
+
+		It("removes the user from the group", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.RemoveUAAUserFromGroupCallCount()).To(Equal(1))
+			_, group := fakeActor.RemoveUAAUserFromGroupArgsForCall(0)
+			Expect(group).To(Equal("scim.read"))
+
+			Expect(testUI.Out).To(Say("Removing user j.smith from group scim.read as admin..."))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+
+		Context("when the user is not a member of the group", func() {
+			BeforeEach(func() {
+				fakeActor.RemoveUAAUserFromGroupReturns(v2action.UserNotInGroupError{Username: "j.smith", Group: "scim.read"})
+			})
+
+			It("warns and succeeds", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Err).To(Say("User j.smith is not a member of group scim.read."))
+			})
+		})
+
+		Context("when the user is only a member of the group through another group", func() {
+			BeforeEach(func() {
+				fakeActor.RemoveUAAUserFromGroupReturns(v2action.UserInheritsGroupError{Username: "j.smith", Group: "scim.read"})
+			})
+
+			It("returns a UserInheritsGroupError", func() {
+				Expect(executeErr).To(MatchError(shared.UserInheritsGroupError{Username: "j.smith", Group: "scim.read"}))
+			})
+		})
+	})
+
+	Context("when --add and --remove are both passed", func() {
+		BeforeEach(func() {
+			cmd.Add = "scim.write"
+			cmd.Remove = "scim.read"
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(command.ArgumentCombinationError{
+				Args: []string{"--add", "--remove"},
+			}))
+		})
+	})
+})
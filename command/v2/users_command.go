@@ -0,0 +1,101 @@
+package v2
+
+import (
+	"strconv"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . UsersActor
+
+type UsersActor interface {
+	GetUAAUsers(filter v2action.UserFilter) ([]v2action.UAAUser, error)
+}
+
+type UsersCommand struct {
+	Search          string      `long:"search" description:"Only list users whose username contains this text"`
+	Origin          string      `long:"origin" description:"Only list users from this origin, e.g. uaa or ldap"`
+	Filter          string      `long:"filter" description:"Only list users that match this SCIM filter"`
+	usage           interface{} `usage:"CF_NAME users [--search TEXT] [--origin ORIGIN] [--filter SCIM_FILTER]\n\nEXAMPLES:\n   CF_NAME users --search smith\n   CF_NAME users --origin ldap --filter 'active eq false'"`
+	relatedCommands interface{} `related_commands:"create-user, org-users, space-users, user, user-groups"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       UsersActor
+}
+
+func (cmd *UsersCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
+	return nil
+}
+
+func (cmd UsersCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	currentUser, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting users as {{.CurrentUser}}...", map[string]interface{}{
+		"CurrentUser": currentUser.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	users, err := cmd.Actor.GetUAAUsers(v2action.UserFilter{
+		Search:     cmd.Search,
+		Origin:     cmd.Origin,
+		SCIMFilter: cmd.Filter,
+	})
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if len(users) == 0 {
+		cmd.UI.DisplayText("No users found.")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("username"),
+			cmd.UI.TranslateText("origin"),
+			cmd.UI.TranslateText("active"),
+			cmd.UI.TranslateText("verified"),
+			cmd.UI.TranslateText("last logon"),
+		},
+	}
+	for _, user := range users {
+		table = append(table, []string{
+			user.Username,
+			user.Origin,
+			strconv.FormatBool(user.Active),
+			strconv.FormatBool(user.Verified),
+			cmd.lastLogon(user),
+		})
+	}
+	return cmd.UI.DisplayTable("", table, 3)
+}
+
+func (cmd UsersCommand) lastLogon(user v2action.UAAUser) string {
+	if user.LastLogon.IsZero() {
+		return cmd.UI.TranslateText("never")
+	}
+	return cmd.UI.UserFriendlyDate(user.LastLogon)
+}
@@ -0,0 +1,109 @@
+package v2_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("users Command", func() {
+	var (
+		cmd             v2.UsersCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeUsersActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeUsersActor)
+
+		cmd = v2.UsersCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "admin"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: "faceman"}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when there are users", func() {
+		BeforeEach(func() {
+			cmd.Search = "smith"
+			cmd.Origin = "ldap"
+			cmd.Filter = "active eq true"
+			fakeActor.GetUAAUsersReturns([]v2action.UAAUser{
+				{Username: "anna.smith", Origin: "ldap", Active: true, Verified: true},
+				{Username: "john.smith", Origin: "ldap", Active: true},
+			}, nil)
+		})
+
+		It("lists the users that match the filters", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.GetUAAUsersArgsForCall(0)).To(Equal(v2action.UserFilter{
+				Search:     "smith",
+				Origin:     "ldap",
+				SCIMFilter: "active eq true",
+			}))
+
+			Expect(testUI.Out).To(Say("Getting users as admin..."))
+			Expect(testUI.Out).To(Say(`username\s+origin\s+active\s+verified\s+last logon`))
+			Expect(testUI.Out).To(Say(`anna.smith\s+ldap\s+true\s+true\s+never`))
+			Expect(testUI.Out).To(Say(`john.smith\s+ldap\s+true\s+false\s+never`))
+		})
+	})
+
+	Context("when there are no users", func() {
+		It("says so", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No users found."))
+		})
+	})
+
+	Context("when the token does not have the scope to read users", func() {
+		BeforeEach(func() {
+			fakeActor.GetUAAUsersReturns(nil, uaa.InsufficientScopeError{
+				Message: "Insufficient scope for this resource",
+				Scopes:  []string{"scim.read", "uaa.admin"},
+			})
+		})
+
+		It("returns an InsufficientScopeError naming the scopes", func() {
+			Expect(executeErr).To(MatchError(command.InsufficientScopeError{Scopes: []string{"scim.read", "uaa.admin"}}))
+		})
+	})
+})
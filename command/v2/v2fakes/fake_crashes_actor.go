@@ -0,0 +1,229 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeCrashesActor struct {
+	GetApplicationByNameAndSpaceStub        func(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
+	getApplicationByNameAndSpaceMutex       sync.RWMutex
+	getApplicationByNameAndSpaceArgsForCall []struct {
+		name      string
+		spaceGUID string
+	}
+	getApplicationByNameAndSpaceReturns struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetApplicationCrashesStub        func(appGUID string, since time.Time) ([]v2action.ApplicationCrash, v2action.Warnings, error)
+	getApplicationCrashesMutex       sync.RWMutex
+	getApplicationCrashesArgsForCall []struct {
+		appGUID string
+		since   time.Time
+	}
+	getApplicationCrashesReturns struct {
+		result1 []v2action.ApplicationCrash
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetApplicationInstancesByApplicationStub        func(guid string) ([]v2action.ApplicationInstance, v2action.Warnings, error)
+	getApplicationInstancesByApplicationMutex       sync.RWMutex
+	getApplicationInstancesByApplicationArgsForCall []struct {
+		guid string
+	}
+	getApplicationInstancesByApplicationReturns struct {
+		result1 []v2action.ApplicationInstance
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetRecentLogsForApplicationStub        func(appGUID string, client v2action.NOAAClient, config v2action.Config) ([]v2action.LogMessage, error)
+	getRecentLogsForApplicationMutex       sync.RWMutex
+	getRecentLogsForApplicationArgsForCall []struct {
+		appGUID string
+		client  v2action.NOAAClient
+		config  v2action.Config
+	}
+	getRecentLogsForApplicationReturns struct {
+		result1 []v2action.LogMessage
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeCrashesActor) GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error) {
+	fake.getApplicationByNameAndSpaceMutex.Lock()
+	fake.getApplicationByNameAndSpaceArgsForCall = append(fake.getApplicationByNameAndSpaceArgsForCall, struct {
+		name      string
+		spaceGUID string
+	}{name, spaceGUID})
+	fake.recordInvocation("GetApplicationByNameAndSpace", []interface{}{name, spaceGUID})
+	fake.getApplicationByNameAndSpaceMutex.Unlock()
+	if fake.GetApplicationByNameAndSpaceStub != nil {
+		return fake.GetApplicationByNameAndSpaceStub(name, spaceGUID)
+	} else {
+		return fake.getApplicationByNameAndSpaceReturns.result1, fake.getApplicationByNameAndSpaceReturns.result2, fake.getApplicationByNameAndSpaceReturns.result3
+	}
+}
+
+func (fake *FakeCrashesActor) GetApplicationByNameAndSpaceCallCount() int {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	return len(fake.getApplicationByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeCrashesActor) GetApplicationByNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	return fake.getApplicationByNameAndSpaceArgsForCall[i].name, fake.getApplicationByNameAndSpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeCrashesActor) GetApplicationByNameAndSpaceReturns(result1 v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationByNameAndSpaceStub = nil
+	fake.getApplicationByNameAndSpaceReturns = struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCrashesActor) GetApplicationCrashes(appGUID string, since time.Time) ([]v2action.ApplicationCrash, v2action.Warnings, error) {
+	fake.getApplicationCrashesMutex.Lock()
+	fake.getApplicationCrashesArgsForCall = append(fake.getApplicationCrashesArgsForCall, struct {
+		appGUID string
+		since   time.Time
+	}{appGUID, since})
+	fake.recordInvocation("GetApplicationCrashes", []interface{}{appGUID, since})
+	fake.getApplicationCrashesMutex.Unlock()
+	if fake.GetApplicationCrashesStub != nil {
+		return fake.GetApplicationCrashesStub(appGUID, since)
+	} else {
+		return fake.getApplicationCrashesReturns.result1, fake.getApplicationCrashesReturns.result2, fake.getApplicationCrashesReturns.result3
+	}
+}
+
+func (fake *FakeCrashesActor) GetApplicationCrashesCallCount() int {
+	fake.getApplicationCrashesMutex.RLock()
+	defer fake.getApplicationCrashesMutex.RUnlock()
+	return len(fake.getApplicationCrashesArgsForCall)
+}
+
+func (fake *FakeCrashesActor) GetApplicationCrashesArgsForCall(i int) (string, time.Time) {
+	fake.getApplicationCrashesMutex.RLock()
+	defer fake.getApplicationCrashesMutex.RUnlock()
+	return fake.getApplicationCrashesArgsForCall[i].appGUID, fake.getApplicationCrashesArgsForCall[i].since
+}
+
+func (fake *FakeCrashesActor) GetApplicationCrashesReturns(result1 []v2action.ApplicationCrash, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationCrashesStub = nil
+	fake.getApplicationCrashesReturns = struct {
+		result1 []v2action.ApplicationCrash
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCrashesActor) GetApplicationInstancesByApplication(guid string) ([]v2action.ApplicationInstance, v2action.Warnings, error) {
+	fake.getApplicationInstancesByApplicationMutex.Lock()
+	fake.getApplicationInstancesByApplicationArgsForCall = append(fake.getApplicationInstancesByApplicationArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("GetApplicationInstancesByApplication", []interface{}{guid})
+	fake.getApplicationInstancesByApplicationMutex.Unlock()
+	if fake.GetApplicationInstancesByApplicationStub != nil {
+		return fake.GetApplicationInstancesByApplicationStub(guid)
+	} else {
+		return fake.getApplicationInstancesByApplicationReturns.result1, fake.getApplicationInstancesByApplicationReturns.result2, fake.getApplicationInstancesByApplicationReturns.result3
+	}
+}
+
+func (fake *FakeCrashesActor) GetApplicationInstancesByApplicationCallCount() int {
+	fake.getApplicationInstancesByApplicationMutex.RLock()
+	defer fake.getApplicationInstancesByApplicationMutex.RUnlock()
+	return len(fake.getApplicationInstancesByApplicationArgsForCall)
+}
+
+func (fake *FakeCrashesActor) GetApplicationInstancesByApplicationArgsForCall(i int) string {
+	fake.getApplicationInstancesByApplicationMutex.RLock()
+	defer fake.getApplicationInstancesByApplicationMutex.RUnlock()
+	return fake.getApplicationInstancesByApplicationArgsForCall[i].guid
+}
+
+func (fake *FakeCrashesActor) GetApplicationInstancesByApplicationReturns(result1 []v2action.ApplicationInstance, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationInstancesByApplicationStub = nil
+	fake.getApplicationInstancesByApplicationReturns = struct {
+		result1 []v2action.ApplicationInstance
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCrashesActor) GetRecentLogsForApplication(appGUID string, client v2action.NOAAClient, config v2action.Config) ([]v2action.LogMessage, error) {
+	fake.getRecentLogsForApplicationMutex.Lock()
+	fake.getRecentLogsForApplicationArgsForCall = append(fake.getRecentLogsForApplicationArgsForCall, struct {
+		appGUID string
+		client  v2action.NOAAClient
+		config  v2action.Config
+	}{appGUID, client, config})
+	fake.recordInvocation("GetRecentLogsForApplication", []interface{}{appGUID, client, config})
+	fake.getRecentLogsForApplicationMutex.Unlock()
+	if fake.GetRecentLogsForApplicationStub != nil {
+		return fake.GetRecentLogsForApplicationStub(appGUID, client, config)
+	} else {
+		return fake.getRecentLogsForApplicationReturns.result1, fake.getRecentLogsForApplicationReturns.result2
+	}
+}
+
+func (fake *FakeCrashesActor) GetRecentLogsForApplicationCallCount() int {
+	fake.getRecentLogsForApplicationMutex.RLock()
+	defer fake.getRecentLogsForApplicationMutex.RUnlock()
+	return len(fake.getRecentLogsForApplicationArgsForCall)
+}
+
+func (fake *FakeCrashesActor) GetRecentLogsForApplicationArgsForCall(i int) (string, v2action.NOAAClient, v2action.Config) {
+	fake.getRecentLogsForApplicationMutex.RLock()
+	defer fake.getRecentLogsForApplicationMutex.RUnlock()
+	return fake.getRecentLogsForApplicationArgsForCall[i].appGUID, fake.getRecentLogsForApplicationArgsForCall[i].client, fake.getRecentLogsForApplicationArgsForCall[i].config
+}
+
+func (fake *FakeCrashesActor) GetRecentLogsForApplicationReturns(result1 []v2action.LogMessage, result2 error) {
+	fake.GetRecentLogsForApplicationStub = nil
+	fake.getRecentLogsForApplicationReturns = struct {
+		result1 []v2action.LogMessage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCrashesActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getApplicationCrashesMutex.RLock()
+	defer fake.getApplicationCrashesMutex.RUnlock()
+	fake.getApplicationInstancesByApplicationMutex.RLock()
+	defer fake.getApplicationInstancesByApplicationMutex.RUnlock()
+	fake.getRecentLogsForApplicationMutex.RLock()
+	defer fake.getRecentLogsForApplicationMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeCrashesActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.CrashesActor = new(FakeCrashesActor)
@@ -0,0 +1,131 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"io"
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeDownloadDropletActor struct {
+	GetApplicationByNameAndSpaceStub        func(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
+	getApplicationByNameAndSpaceMutex       sync.RWMutex
+	getApplicationByNameAndSpaceArgsForCall []struct {
+		name      string
+		spaceGUID string
+	}
+	getApplicationByNameAndSpaceReturns struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	DownloadApplicationDropletStub        func(appGUID string, droplet io.Writer) (v2action.Warnings, error)
+	downloadApplicationDropletMutex       sync.RWMutex
+	downloadApplicationDropletArgsForCall []struct {
+		appGUID string
+		droplet io.Writer
+	}
+	downloadApplicationDropletReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeDownloadDropletActor) GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error) {
+	fake.getApplicationByNameAndSpaceMutex.Lock()
+	fake.getApplicationByNameAndSpaceArgsForCall = append(fake.getApplicationByNameAndSpaceArgsForCall, struct {
+		name      string
+		spaceGUID string
+	}{name, spaceGUID})
+	fake.recordInvocation("GetApplicationByNameAndSpace", []interface{}{name, spaceGUID})
+	fake.getApplicationByNameAndSpaceMutex.Unlock()
+	if fake.GetApplicationByNameAndSpaceStub != nil {
+		return fake.GetApplicationByNameAndSpaceStub(name, spaceGUID)
+	} else {
+		return fake.getApplicationByNameAndSpaceReturns.result1, fake.getApplicationByNameAndSpaceReturns.result2, fake.getApplicationByNameAndSpaceReturns.result3
+	}
+}
+
+func (fake *FakeDownloadDropletActor) GetApplicationByNameAndSpaceCallCount() int {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	return len(fake.getApplicationByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeDownloadDropletActor) GetApplicationByNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	return fake.getApplicationByNameAndSpaceArgsForCall[i].name, fake.getApplicationByNameAndSpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeDownloadDropletActor) GetApplicationByNameAndSpaceReturns(result1 v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationByNameAndSpaceStub = nil
+	fake.getApplicationByNameAndSpaceReturns = struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDownloadDropletActor) DownloadApplicationDroplet(appGUID string, droplet io.Writer) (v2action.Warnings, error) {
+	fake.downloadApplicationDropletMutex.Lock()
+	fake.downloadApplicationDropletArgsForCall = append(fake.downloadApplicationDropletArgsForCall, struct {
+		appGUID string
+		droplet io.Writer
+	}{appGUID, droplet})
+	fake.recordInvocation("DownloadApplicationDroplet", []interface{}{appGUID, droplet})
+	fake.downloadApplicationDropletMutex.Unlock()
+	if fake.DownloadApplicationDropletStub != nil {
+		return fake.DownloadApplicationDropletStub(appGUID, droplet)
+	} else {
+		return fake.downloadApplicationDropletReturns.result1, fake.downloadApplicationDropletReturns.result2
+	}
+}
+
+func (fake *FakeDownloadDropletActor) DownloadApplicationDropletCallCount() int {
+	fake.downloadApplicationDropletMutex.RLock()
+	defer fake.downloadApplicationDropletMutex.RUnlock()
+	return len(fake.downloadApplicationDropletArgsForCall)
+}
+
+func (fake *FakeDownloadDropletActor) DownloadApplicationDropletArgsForCall(i int) (string, io.Writer) {
+	fake.downloadApplicationDropletMutex.RLock()
+	defer fake.downloadApplicationDropletMutex.RUnlock()
+	return fake.downloadApplicationDropletArgsForCall[i].appGUID, fake.downloadApplicationDropletArgsForCall[i].droplet
+}
+
+func (fake *FakeDownloadDropletActor) DownloadApplicationDropletReturns(result1 v2action.Warnings, result2 error) {
+	fake.DownloadApplicationDropletStub = nil
+	fake.downloadApplicationDropletReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDownloadDropletActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	fake.downloadApplicationDropletMutex.RLock()
+	defer fake.downloadApplicationDropletMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeDownloadDropletActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.DownloadDropletActor = new(FakeDownloadDropletActor)
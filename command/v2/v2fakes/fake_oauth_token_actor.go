@@ -0,0 +1,124 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeOauthTokenActor struct {
+	DecodeAccessTokenStub        func(accessToken string) (v2action.AccessToken, error)
+	decodeAccessTokenMutex       sync.RWMutex
+	decodeAccessTokenArgsForCall []struct {
+		accessToken string
+	}
+	decodeAccessTokenReturns struct {
+		result1 v2action.AccessToken
+		result2 error
+	}
+	RefreshAccessTokenStub        func(config v2action.Config) (string, error)
+	refreshAccessTokenMutex       sync.RWMutex
+	refreshAccessTokenArgsForCall []struct {
+		config v2action.Config
+	}
+	refreshAccessTokenReturns struct {
+		result1 string
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeOauthTokenActor) DecodeAccessToken(accessToken string) (v2action.AccessToken, error) {
+	fake.decodeAccessTokenMutex.Lock()
+	fake.decodeAccessTokenArgsForCall = append(fake.decodeAccessTokenArgsForCall, struct {
+		accessToken string
+	}{accessToken})
+	fake.recordInvocation("DecodeAccessToken", []interface{}{accessToken})
+	fake.decodeAccessTokenMutex.Unlock()
+	if fake.DecodeAccessTokenStub != nil {
+		return fake.DecodeAccessTokenStub(accessToken)
+	} else {
+		return fake.decodeAccessTokenReturns.result1, fake.decodeAccessTokenReturns.result2
+	}
+}
+
+func (fake *FakeOauthTokenActor) DecodeAccessTokenCallCount() int {
+	fake.decodeAccessTokenMutex.RLock()
+	defer fake.decodeAccessTokenMutex.RUnlock()
+	return len(fake.decodeAccessTokenArgsForCall)
+}
+
+func (fake *FakeOauthTokenActor) DecodeAccessTokenArgsForCall(i int) string {
+	fake.decodeAccessTokenMutex.RLock()
+	defer fake.decodeAccessTokenMutex.RUnlock()
+	return fake.decodeAccessTokenArgsForCall[i].accessToken
+}
+
+func (fake *FakeOauthTokenActor) DecodeAccessTokenReturns(result1 v2action.AccessToken, result2 error) {
+	fake.DecodeAccessTokenStub = nil
+	fake.decodeAccessTokenReturns = struct {
+		result1 v2action.AccessToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeOauthTokenActor) RefreshAccessToken(config v2action.Config) (string, error) {
+	fake.refreshAccessTokenMutex.Lock()
+	fake.refreshAccessTokenArgsForCall = append(fake.refreshAccessTokenArgsForCall, struct {
+		config v2action.Config
+	}{config})
+	fake.recordInvocation("RefreshAccessToken", []interface{}{config})
+	fake.refreshAccessTokenMutex.Unlock()
+	if fake.RefreshAccessTokenStub != nil {
+		return fake.RefreshAccessTokenStub(config)
+	} else {
+		return fake.refreshAccessTokenReturns.result1, fake.refreshAccessTokenReturns.result2
+	}
+}
+
+func (fake *FakeOauthTokenActor) RefreshAccessTokenCallCount() int {
+	fake.refreshAccessTokenMutex.RLock()
+	defer fake.refreshAccessTokenMutex.RUnlock()
+	return len(fake.refreshAccessTokenArgsForCall)
+}
+
+func (fake *FakeOauthTokenActor) RefreshAccessTokenArgsForCall(i int) v2action.Config {
+	fake.refreshAccessTokenMutex.RLock()
+	defer fake.refreshAccessTokenMutex.RUnlock()
+	return fake.refreshAccessTokenArgsForCall[i].config
+}
+
+func (fake *FakeOauthTokenActor) RefreshAccessTokenReturns(result1 string, result2 error) {
+	fake.RefreshAccessTokenStub = nil
+	fake.refreshAccessTokenReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeOauthTokenActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.decodeAccessTokenMutex.RLock()
+	defer fake.decodeAccessTokenMutex.RUnlock()
+	fake.refreshAccessTokenMutex.RLock()
+	defer fake.refreshAccessTokenMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeOauthTokenActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.OauthTokenActor = new(FakeOauthTokenActor)
@@ -0,0 +1,177 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeRestartAppInstanceActor struct {
+	GetApplicationByNameAndSpaceStub        func(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
+	getApplicationByNameAndSpaceMutex       sync.RWMutex
+	getApplicationByNameAndSpaceArgsForCall []struct {
+		name      string
+		spaceGUID string
+	}
+	getApplicationByNameAndSpaceReturns struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetApplicationInstancesByApplicationStub        func(guid string) ([]v2action.ApplicationInstance, v2action.Warnings, error)
+	getApplicationInstancesByApplicationMutex       sync.RWMutex
+	getApplicationInstancesByApplicationArgsForCall []struct {
+		guid string
+	}
+	getApplicationInstancesByApplicationReturns struct {
+		result1 []v2action.ApplicationInstance
+		result2 v2action.Warnings
+		result3 error
+	}
+	RestartApplicationInstanceStub        func(appGUID string, index int) (v2action.Warnings, error)
+	restartApplicationInstanceMutex       sync.RWMutex
+	restartApplicationInstanceArgsForCall []struct {
+		appGUID string
+		index   int
+	}
+	restartApplicationInstanceReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeRestartAppInstanceActor) GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error) {
+	fake.getApplicationByNameAndSpaceMutex.Lock()
+	fake.getApplicationByNameAndSpaceArgsForCall = append(fake.getApplicationByNameAndSpaceArgsForCall, struct {
+		name      string
+		spaceGUID string
+	}{name, spaceGUID})
+	fake.recordInvocation("GetApplicationByNameAndSpace", []interface{}{name, spaceGUID})
+	fake.getApplicationByNameAndSpaceMutex.Unlock()
+	if fake.GetApplicationByNameAndSpaceStub != nil {
+		return fake.GetApplicationByNameAndSpaceStub(name, spaceGUID)
+	} else {
+		return fake.getApplicationByNameAndSpaceReturns.result1, fake.getApplicationByNameAndSpaceReturns.result2, fake.getApplicationByNameAndSpaceReturns.result3
+	}
+}
+
+func (fake *FakeRestartAppInstanceActor) GetApplicationByNameAndSpaceCallCount() int {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	return len(fake.getApplicationByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeRestartAppInstanceActor) GetApplicationByNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	return fake.getApplicationByNameAndSpaceArgsForCall[i].name, fake.getApplicationByNameAndSpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeRestartAppInstanceActor) GetApplicationByNameAndSpaceReturns(result1 v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationByNameAndSpaceStub = nil
+	fake.getApplicationByNameAndSpaceReturns = struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRestartAppInstanceActor) GetApplicationInstancesByApplication(guid string) ([]v2action.ApplicationInstance, v2action.Warnings, error) {
+	fake.getApplicationInstancesByApplicationMutex.Lock()
+	fake.getApplicationInstancesByApplicationArgsForCall = append(fake.getApplicationInstancesByApplicationArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("GetApplicationInstancesByApplication", []interface{}{guid})
+	fake.getApplicationInstancesByApplicationMutex.Unlock()
+	if fake.GetApplicationInstancesByApplicationStub != nil {
+		return fake.GetApplicationInstancesByApplicationStub(guid)
+	} else {
+		return fake.getApplicationInstancesByApplicationReturns.result1, fake.getApplicationInstancesByApplicationReturns.result2, fake.getApplicationInstancesByApplicationReturns.result3
+	}
+}
+
+func (fake *FakeRestartAppInstanceActor) GetApplicationInstancesByApplicationCallCount() int {
+	fake.getApplicationInstancesByApplicationMutex.RLock()
+	defer fake.getApplicationInstancesByApplicationMutex.RUnlock()
+	return len(fake.getApplicationInstancesByApplicationArgsForCall)
+}
+
+func (fake *FakeRestartAppInstanceActor) GetApplicationInstancesByApplicationArgsForCall(i int) string {
+	fake.getApplicationInstancesByApplicationMutex.RLock()
+	defer fake.getApplicationInstancesByApplicationMutex.RUnlock()
+	return fake.getApplicationInstancesByApplicationArgsForCall[i].guid
+}
+
+func (fake *FakeRestartAppInstanceActor) GetApplicationInstancesByApplicationReturns(result1 []v2action.ApplicationInstance, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationInstancesByApplicationStub = nil
+	fake.getApplicationInstancesByApplicationReturns = struct {
+		result1 []v2action.ApplicationInstance
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRestartAppInstanceActor) RestartApplicationInstance(appGUID string, index int) (v2action.Warnings, error) {
+	fake.restartApplicationInstanceMutex.Lock()
+	fake.restartApplicationInstanceArgsForCall = append(fake.restartApplicationInstanceArgsForCall, struct {
+		appGUID string
+		index   int
+	}{appGUID, index})
+	fake.recordInvocation("RestartApplicationInstance", []interface{}{appGUID, index})
+	fake.restartApplicationInstanceMutex.Unlock()
+	if fake.RestartApplicationInstanceStub != nil {
+		return fake.RestartApplicationInstanceStub(appGUID, index)
+	} else {
+		return fake.restartApplicationInstanceReturns.result1, fake.restartApplicationInstanceReturns.result2
+	}
+}
+
+func (fake *FakeRestartAppInstanceActor) RestartApplicationInstanceCallCount() int {
+	fake.restartApplicationInstanceMutex.RLock()
+	defer fake.restartApplicationInstanceMutex.RUnlock()
+	return len(fake.restartApplicationInstanceArgsForCall)
+}
+
+func (fake *FakeRestartAppInstanceActor) RestartApplicationInstanceArgsForCall(i int) (string, int) {
+	fake.restartApplicationInstanceMutex.RLock()
+	defer fake.restartApplicationInstanceMutex.RUnlock()
+	return fake.restartApplicationInstanceArgsForCall[i].appGUID, fake.restartApplicationInstanceArgsForCall[i].index
+}
+
+func (fake *FakeRestartAppInstanceActor) RestartApplicationInstanceReturns(result1 v2action.Warnings, result2 error) {
+	fake.RestartApplicationInstanceStub = nil
+	fake.restartApplicationInstanceReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRestartAppInstanceActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getApplicationInstancesByApplicationMutex.RLock()
+	defer fake.getApplicationInstancesByApplicationMutex.RUnlock()
+	fake.restartApplicationInstanceMutex.RLock()
+	defer fake.restartApplicationInstanceMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeRestartAppInstanceActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.RestartAppInstanceActor = new(FakeRestartAppInstanceActor)
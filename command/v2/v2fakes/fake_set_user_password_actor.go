@@ -0,0 +1,171 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeSetUserPasswordActor struct {
+	GetUAAUserByNameStub        func(username string, origin string) (v2action.UAAUser, error)
+	getUAAUserByNameMutex       sync.RWMutex
+	getUAAUserByNameArgsForCall []struct {
+		username string
+		origin   string
+	}
+	getUAAUserByNameReturns struct {
+		result1 v2action.UAAUser
+		result2 error
+	}
+	ResetUAAUserPasswordStub        func(user v2action.UAAUser) (string, error)
+	resetUAAUserPasswordMutex       sync.RWMutex
+	resetUAAUserPasswordArgsForCall []struct {
+		user v2action.UAAUser
+	}
+	resetUAAUserPasswordReturns struct {
+		result1 string
+		result2 error
+	}
+	SetUAAUserPasswordStub        func(user v2action.UAAUser, password string) error
+	setUAAUserPasswordMutex       sync.RWMutex
+	setUAAUserPasswordArgsForCall []struct {
+		user     v2action.UAAUser
+		password string
+	}
+	setUAAUserPasswordReturns struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeSetUserPasswordActor) GetUAAUserByName(username string, origin string) (v2action.UAAUser, error) {
+	fake.getUAAUserByNameMutex.Lock()
+	fake.getUAAUserByNameArgsForCall = append(fake.getUAAUserByNameArgsForCall, struct {
+		username string
+		origin   string
+	}{username, origin})
+	fake.recordInvocation("GetUAAUserByName", []interface{}{username, origin})
+	fake.getUAAUserByNameMutex.Unlock()
+	if fake.GetUAAUserByNameStub != nil {
+		return fake.GetUAAUserByNameStub(username, origin)
+	} else {
+		return fake.getUAAUserByNameReturns.result1, fake.getUAAUserByNameReturns.result2
+	}
+}
+
+func (fake *FakeSetUserPasswordActor) GetUAAUserByNameCallCount() int {
+	fake.getUAAUserByNameMutex.RLock()
+	defer fake.getUAAUserByNameMutex.RUnlock()
+	return len(fake.getUAAUserByNameArgsForCall)
+}
+
+func (fake *FakeSetUserPasswordActor) GetUAAUserByNameArgsForCall(i int) (string, string) {
+	fake.getUAAUserByNameMutex.RLock()
+	defer fake.getUAAUserByNameMutex.RUnlock()
+	return fake.getUAAUserByNameArgsForCall[i].username, fake.getUAAUserByNameArgsForCall[i].origin
+}
+
+func (fake *FakeSetUserPasswordActor) GetUAAUserByNameReturns(result1 v2action.UAAUser, result2 error) {
+	fake.GetUAAUserByNameStub = nil
+	fake.getUAAUserByNameReturns = struct {
+		result1 v2action.UAAUser
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSetUserPasswordActor) ResetUAAUserPassword(user v2action.UAAUser) (string, error) {
+	fake.resetUAAUserPasswordMutex.Lock()
+	fake.resetUAAUserPasswordArgsForCall = append(fake.resetUAAUserPasswordArgsForCall, struct {
+		user v2action.UAAUser
+	}{user})
+	fake.recordInvocation("ResetUAAUserPassword", []interface{}{user})
+	fake.resetUAAUserPasswordMutex.Unlock()
+	if fake.ResetUAAUserPasswordStub != nil {
+		return fake.ResetUAAUserPasswordStub(user)
+	} else {
+		return fake.resetUAAUserPasswordReturns.result1, fake.resetUAAUserPasswordReturns.result2
+	}
+}
+
+func (fake *FakeSetUserPasswordActor) ResetUAAUserPasswordCallCount() int {
+	fake.resetUAAUserPasswordMutex.RLock()
+	defer fake.resetUAAUserPasswordMutex.RUnlock()
+	return len(fake.resetUAAUserPasswordArgsForCall)
+}
+
+func (fake *FakeSetUserPasswordActor) ResetUAAUserPasswordArgsForCall(i int) v2action.UAAUser {
+	fake.resetUAAUserPasswordMutex.RLock()
+	defer fake.resetUAAUserPasswordMutex.RUnlock()
+	return fake.resetUAAUserPasswordArgsForCall[i].user
+}
+
+func (fake *FakeSetUserPasswordActor) ResetUAAUserPasswordReturns(result1 string, result2 error) {
+	fake.ResetUAAUserPasswordStub = nil
+	fake.resetUAAUserPasswordReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSetUserPasswordActor) SetUAAUserPassword(user v2action.UAAUser, password string) error {
+	fake.setUAAUserPasswordMutex.Lock()
+	fake.setUAAUserPasswordArgsForCall = append(fake.setUAAUserPasswordArgsForCall, struct {
+		user     v2action.UAAUser
+		password string
+	}{user, password})
+	fake.recordInvocation("SetUAAUserPassword", []interface{}{user, password})
+	fake.setUAAUserPasswordMutex.Unlock()
+	if fake.SetUAAUserPasswordStub != nil {
+		return fake.SetUAAUserPasswordStub(user, password)
+	} else {
+		return fake.setUAAUserPasswordReturns.result1
+	}
+}
+
+func (fake *FakeSetUserPasswordActor) SetUAAUserPasswordCallCount() int {
+	fake.setUAAUserPasswordMutex.RLock()
+	defer fake.setUAAUserPasswordMutex.RUnlock()
+	return len(fake.setUAAUserPasswordArgsForCall)
+}
+
+func (fake *FakeSetUserPasswordActor) SetUAAUserPasswordArgsForCall(i int) (v2action.UAAUser, string) {
+	fake.setUAAUserPasswordMutex.RLock()
+	defer fake.setUAAUserPasswordMutex.RUnlock()
+	return fake.setUAAUserPasswordArgsForCall[i].user, fake.setUAAUserPasswordArgsForCall[i].password
+}
+
+func (fake *FakeSetUserPasswordActor) SetUAAUserPasswordReturns(result1 error) {
+	fake.SetUAAUserPasswordStub = nil
+	fake.setUAAUserPasswordReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSetUserPasswordActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getUAAUserByNameMutex.RLock()
+	defer fake.getUAAUserByNameMutex.RUnlock()
+	fake.resetUAAUserPasswordMutex.RLock()
+	defer fake.resetUAAUserPasswordMutex.RUnlock()
+	fake.setUAAUserPasswordMutex.RLock()
+	defer fake.setUAAUserPasswordMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeSetUserPasswordActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.SetUserPasswordActor = new(FakeSetUserPasswordActor)
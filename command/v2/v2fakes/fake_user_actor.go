@@ -0,0 +1,128 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeUserActor struct {
+	GetUAAUserByNameStub        func(username string, origin string) (v2action.UAAUser, error)
+	getUAAUserByNameMutex       sync.RWMutex
+	getUAAUserByNameArgsForCall []struct {
+		username string
+		origin   string
+	}
+	getUAAUserByNameReturns struct {
+		result1 v2action.UAAUser
+		result2 error
+	}
+	SetUAAUserActiveStub        func(user v2action.UAAUser, active bool) (v2action.UAAUser, error)
+	setUAAUserActiveMutex       sync.RWMutex
+	setUAAUserActiveArgsForCall []struct {
+		user   v2action.UAAUser
+		active bool
+	}
+	setUAAUserActiveReturns struct {
+		result1 v2action.UAAUser
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeUserActor) GetUAAUserByName(username string, origin string) (v2action.UAAUser, error) {
+	fake.getUAAUserByNameMutex.Lock()
+	fake.getUAAUserByNameArgsForCall = append(fake.getUAAUserByNameArgsForCall, struct {
+		username string
+		origin   string
+	}{username, origin})
+	fake.recordInvocation("GetUAAUserByName", []interface{}{username, origin})
+	fake.getUAAUserByNameMutex.Unlock()
+	if fake.GetUAAUserByNameStub != nil {
+		return fake.GetUAAUserByNameStub(username, origin)
+	} else {
+		return fake.getUAAUserByNameReturns.result1, fake.getUAAUserByNameReturns.result2
+	}
+}
+
+func (fake *FakeUserActor) GetUAAUserByNameCallCount() int {
+	fake.getUAAUserByNameMutex.RLock()
+	defer fake.getUAAUserByNameMutex.RUnlock()
+	return len(fake.getUAAUserByNameArgsForCall)
+}
+
+func (fake *FakeUserActor) GetUAAUserByNameArgsForCall(i int) (string, string) {
+	fake.getUAAUserByNameMutex.RLock()
+	defer fake.getUAAUserByNameMutex.RUnlock()
+	return fake.getUAAUserByNameArgsForCall[i].username, fake.getUAAUserByNameArgsForCall[i].origin
+}
+
+func (fake *FakeUserActor) GetUAAUserByNameReturns(result1 v2action.UAAUser, result2 error) {
+	fake.GetUAAUserByNameStub = nil
+	fake.getUAAUserByNameReturns = struct {
+		result1 v2action.UAAUser
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserActor) SetUAAUserActive(user v2action.UAAUser, active bool) (v2action.UAAUser, error) {
+	fake.setUAAUserActiveMutex.Lock()
+	fake.setUAAUserActiveArgsForCall = append(fake.setUAAUserActiveArgsForCall, struct {
+		user   v2action.UAAUser
+		active bool
+	}{user, active})
+	fake.recordInvocation("SetUAAUserActive", []interface{}{user, active})
+	fake.setUAAUserActiveMutex.Unlock()
+	if fake.SetUAAUserActiveStub != nil {
+		return fake.SetUAAUserActiveStub(user, active)
+	} else {
+		return fake.setUAAUserActiveReturns.result1, fake.setUAAUserActiveReturns.result2
+	}
+}
+
+func (fake *FakeUserActor) SetUAAUserActiveCallCount() int {
+	fake.setUAAUserActiveMutex.RLock()
+	defer fake.setUAAUserActiveMutex.RUnlock()
+	return len(fake.setUAAUserActiveArgsForCall)
+}
+
+func (fake *FakeUserActor) SetUAAUserActiveArgsForCall(i int) (v2action.UAAUser, bool) {
+	fake.setUAAUserActiveMutex.RLock()
+	defer fake.setUAAUserActiveMutex.RUnlock()
+	return fake.setUAAUserActiveArgsForCall[i].user, fake.setUAAUserActiveArgsForCall[i].active
+}
+
+func (fake *FakeUserActor) SetUAAUserActiveReturns(result1 v2action.UAAUser, result2 error) {
+	fake.SetUAAUserActiveStub = nil
+	fake.setUAAUserActiveReturns = struct {
+		result1 v2action.UAAUser
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getUAAUserByNameMutex.RLock()
+	defer fake.getUAAUserByNameMutex.RUnlock()
+	fake.setUAAUserActiveMutex.RLock()
+	defer fake.setUAAUserActiveMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeUserActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.UserActor = new(FakeUserActor)
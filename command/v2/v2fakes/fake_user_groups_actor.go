@@ -0,0 +1,171 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeUserGroupsActor struct {
+	AddUAAUserToGroupStub        func(user v2action.UAAUser, groupName string) error
+	addUAAUserToGroupMutex       sync.RWMutex
+	addUAAUserToGroupArgsForCall []struct {
+		user      v2action.UAAUser
+		groupName string
+	}
+	addUAAUserToGroupReturns struct {
+		result1 error
+	}
+	GetUAAUserByNameStub        func(username string, origin string) (v2action.UAAUser, error)
+	getUAAUserByNameMutex       sync.RWMutex
+	getUAAUserByNameArgsForCall []struct {
+		username string
+		origin   string
+	}
+	getUAAUserByNameReturns struct {
+		result1 v2action.UAAUser
+		result2 error
+	}
+	RemoveUAAUserFromGroupStub        func(user v2action.UAAUser, groupName string) error
+	removeUAAUserFromGroupMutex       sync.RWMutex
+	removeUAAUserFromGroupArgsForCall []struct {
+		user      v2action.UAAUser
+		groupName string
+	}
+	removeUAAUserFromGroupReturns struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeUserGroupsActor) AddUAAUserToGroup(user v2action.UAAUser, groupName string) error {
+	fake.addUAAUserToGroupMutex.Lock()
+	fake.addUAAUserToGroupArgsForCall = append(fake.addUAAUserToGroupArgsForCall, struct {
+		user      v2action.UAAUser
+		groupName string
+	}{user, groupName})
+	fake.recordInvocation("AddUAAUserToGroup", []interface{}{user, groupName})
+	fake.addUAAUserToGroupMutex.Unlock()
+	if fake.AddUAAUserToGroupStub != nil {
+		return fake.AddUAAUserToGroupStub(user, groupName)
+	} else {
+		return fake.addUAAUserToGroupReturns.result1
+	}
+}
+
+func (fake *FakeUserGroupsActor) AddUAAUserToGroupCallCount() int {
+	fake.addUAAUserToGroupMutex.RLock()
+	defer fake.addUAAUserToGroupMutex.RUnlock()
+	return len(fake.addUAAUserToGroupArgsForCall)
+}
+
+func (fake *FakeUserGroupsActor) AddUAAUserToGroupArgsForCall(i int) (v2action.UAAUser, string) {
+	fake.addUAAUserToGroupMutex.RLock()
+	defer fake.addUAAUserToGroupMutex.RUnlock()
+	return fake.addUAAUserToGroupArgsForCall[i].user, fake.addUAAUserToGroupArgsForCall[i].groupName
+}
+
+func (fake *FakeUserGroupsActor) AddUAAUserToGroupReturns(result1 error) {
+	fake.AddUAAUserToGroupStub = nil
+	fake.addUAAUserToGroupReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserGroupsActor) GetUAAUserByName(username string, origin string) (v2action.UAAUser, error) {
+	fake.getUAAUserByNameMutex.Lock()
+	fake.getUAAUserByNameArgsForCall = append(fake.getUAAUserByNameArgsForCall, struct {
+		username string
+		origin   string
+	}{username, origin})
+	fake.recordInvocation("GetUAAUserByName", []interface{}{username, origin})
+	fake.getUAAUserByNameMutex.Unlock()
+	if fake.GetUAAUserByNameStub != nil {
+		return fake.GetUAAUserByNameStub(username, origin)
+	} else {
+		return fake.getUAAUserByNameReturns.result1, fake.getUAAUserByNameReturns.result2
+	}
+}
+
+func (fake *FakeUserGroupsActor) GetUAAUserByNameCallCount() int {
+	fake.getUAAUserByNameMutex.RLock()
+	defer fake.getUAAUserByNameMutex.RUnlock()
+	return len(fake.getUAAUserByNameArgsForCall)
+}
+
+func (fake *FakeUserGroupsActor) GetUAAUserByNameArgsForCall(i int) (string, string) {
+	fake.getUAAUserByNameMutex.RLock()
+	defer fake.getUAAUserByNameMutex.RUnlock()
+	return fake.getUAAUserByNameArgsForCall[i].username, fake.getUAAUserByNameArgsForCall[i].origin
+}
+
+func (fake *FakeUserGroupsActor) GetUAAUserByNameReturns(result1 v2action.UAAUser, result2 error) {
+	fake.GetUAAUserByNameStub = nil
+	fake.getUAAUserByNameReturns = struct {
+		result1 v2action.UAAUser
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserGroupsActor) RemoveUAAUserFromGroup(user v2action.UAAUser, groupName string) error {
+	fake.removeUAAUserFromGroupMutex.Lock()
+	fake.removeUAAUserFromGroupArgsForCall = append(fake.removeUAAUserFromGroupArgsForCall, struct {
+		user      v2action.UAAUser
+		groupName string
+	}{user, groupName})
+	fake.recordInvocation("RemoveUAAUserFromGroup", []interface{}{user, groupName})
+	fake.removeUAAUserFromGroupMutex.Unlock()
+	if fake.RemoveUAAUserFromGroupStub != nil {
+		return fake.RemoveUAAUserFromGroupStub(user, groupName)
+	} else {
+		return fake.removeUAAUserFromGroupReturns.result1
+	}
+}
+
+func (fake *FakeUserGroupsActor) RemoveUAAUserFromGroupCallCount() int {
+	fake.removeUAAUserFromGroupMutex.RLock()
+	defer fake.removeUAAUserFromGroupMutex.RUnlock()
+	return len(fake.removeUAAUserFromGroupArgsForCall)
+}
+
+func (fake *FakeUserGroupsActor) RemoveUAAUserFromGroupArgsForCall(i int) (v2action.UAAUser, string) {
+	fake.removeUAAUserFromGroupMutex.RLock()
+	defer fake.removeUAAUserFromGroupMutex.RUnlock()
+	return fake.removeUAAUserFromGroupArgsForCall[i].user, fake.removeUAAUserFromGroupArgsForCall[i].groupName
+}
+
+func (fake *FakeUserGroupsActor) RemoveUAAUserFromGroupReturns(result1 error) {
+	fake.RemoveUAAUserFromGroupStub = nil
+	fake.removeUAAUserFromGroupReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserGroupsActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.addUAAUserToGroupMutex.RLock()
+	defer fake.addUAAUserToGroupMutex.RUnlock()
+	fake.getUAAUserByNameMutex.RLock()
+	defer fake.getUAAUserByNameMutex.RUnlock()
+	fake.removeUAAUserFromGroupMutex.RLock()
+	defer fake.removeUAAUserFromGroupMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeUserGroupsActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.UserGroupsActor = new(FakeUserGroupsActor)
@@ -0,0 +1,79 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeUsersActor struct {
+	GetUAAUsersStub        func(filter v2action.UserFilter) ([]v2action.UAAUser, error)
+	getUAAUsersMutex       sync.RWMutex
+	getUAAUsersArgsForCall []struct {
+		filter v2action.UserFilter
+	}
+	getUAAUsersReturns struct {
+		result1 []v2action.UAAUser
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeUsersActor) GetUAAUsers(filter v2action.UserFilter) ([]v2action.UAAUser, error) {
+	fake.getUAAUsersMutex.Lock()
+	fake.getUAAUsersArgsForCall = append(fake.getUAAUsersArgsForCall, struct {
+		filter v2action.UserFilter
+	}{filter})
+	fake.recordInvocation("GetUAAUsers", []interface{}{filter})
+	fake.getUAAUsersMutex.Unlock()
+	if fake.GetUAAUsersStub != nil {
+		return fake.GetUAAUsersStub(filter)
+	} else {
+		return fake.getUAAUsersReturns.result1, fake.getUAAUsersReturns.result2
+	}
+}
+
+func (fake *FakeUsersActor) GetUAAUsersCallCount() int {
+	fake.getUAAUsersMutex.RLock()
+	defer fake.getUAAUsersMutex.RUnlock()
+	return len(fake.getUAAUsersArgsForCall)
+}
+
+func (fake *FakeUsersActor) GetUAAUsersArgsForCall(i int) v2action.UserFilter {
+	fake.getUAAUsersMutex.RLock()
+	defer fake.getUAAUsersMutex.RUnlock()
+	return fake.getUAAUsersArgsForCall[i].filter
+}
+
+func (fake *FakeUsersActor) GetUAAUsersReturns(result1 []v2action.UAAUser, result2 error) {
+	fake.GetUAAUsersStub = nil
+	fake.getUAAUsersReturns = struct {
+		result1 []v2action.UAAUser
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUsersActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getUAAUsersMutex.RLock()
+	defer fake.getUAAUsersMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeUsersActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.UsersActor = new(FakeUsersActor)
@@ -1,6 +1,10 @@
 package v3
 
 import (
+	"os"
+	"strings"
+	"time"
+
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/command"
@@ -12,20 +16,29 @@ import (
 
 type RunTaskActor interface {
 	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
-	RunTask(appGUID string, command string, name string) (v3action.Task, v3action.Warnings, error)
+	RunTask(appGUID string, task v3action.Task) (v3action.Task, v3action.Warnings, error)
+	GetTask(taskGUID string) (v3action.Task, v3action.Warnings, error)
+	GetStreamingLogsForTasks(appGUID string, client v3action.NOAAClient, config v3action.Config) (<-chan v3action.LogMessage, <-chan error)
+	GetTaskTemplate(manifestPath string, appName string, templateName string) (v3action.Task, error)
 	CloudControllerAPIVersion() string
 }
 
 type RunTaskCommand struct {
 	RequiredArgs    flag.RunTaskArgs `positional-args:"yes"`
 	Name            string           `long:"name" description:"Name to give the task (generated if omitted)"`
-	usage           interface{}      `usage:"CF_NAME run-task APP_NAME COMMAND [--name TASK_NAME]\n\nTIP:\n   Use 'cf logs' to display the logs of the app and all its tasks. If your task name is unique, grep this command's output for the task name to view task-specific logs.\n\nEXAMPLES:\n   CF_NAME run-task my-app \"bundle exec rake db:migrate\" --name migrate"`
+	Memory          flag.Megabytes   `short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
+	Disk            flag.Megabytes   `short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
+	Wait            bool             `long:"wait" short:"w" description:"Display the task's logs and wait for it to finish; fail if the task fails"`
+	Template        string           `long:"template" description:"Run the task template with this name from the app's manifest; COMMAND and the other flags override its fields"`
+	PathToManifest  string           `short:"f" description:"Path to the manifest containing the task template (default: manifest in the current directory)"`
+	usage           interface{}      `usage:"CF_NAME run-task APP_NAME COMMAND [--name TASK_NAME] [-m MEMORY] [-k DISK] [--wait]\n   CF_NAME run-task APP_NAME [COMMAND] --template TEMPLATE_NAME [-f MANIFEST_PATH] [--name TASK_NAME] [-m MEMORY] [-k DISK] [--wait]\n\nTIP:\n   Use 'cf logs' to display the logs of the app and all its tasks. If your task name is unique, grep this command's output for the task name to view task-specific logs.\n\n   Use '--wait' to block until the task has finished, e.g. to run database migrations as a step of a deployment pipeline. The logs displayed with '--wait' are selected by task name, so give the task a name no other running task of the app uses.\n\n   Task templates are defined under 'tasks' for an application in its manifest, each with a name, a command and optionally memory and disk_quota.\n\nEXAMPLES:\n   CF_NAME run-task my-app \"bundle exec rake db:migrate\" --name migrate\n\n   CF_NAME run-task my-app \"bundle exec rake db:migrate\" --name migrate -m 1G --wait\n\n   CF_NAME run-task my-app --template migrate -f ./manifest.yml --wait"`
 	relatedCommands interface{}      `related_commands:"logs, tasks, terminate-task"`
 
 	UI          command.UI
 	Config      command.Config
 	SharedActor command.SharedActor
 	Actor       RunTaskActor
+	NOAAClient  v3action.NOAAClient
 }
 
 func (cmd *RunTaskCommand) Setup(config command.Config, ui command.UI) error {
@@ -33,16 +46,30 @@ func (cmd *RunTaskCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.Config = config
 	cmd.SharedActor = sharedaction.NewActor()
 
-	client, err := shared.NewClients(config, ui)
+	ccClient, uaaClient, err := shared.NewClients(config, ui)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient)
+
+	if !cmd.Wait {
+		return nil
+	}
+
+	noaaClient, err := command.NewNOAAClient(ccClient.Logging(), config, ui, uaaClient)
 	if err != nil {
 		return err
 	}
-	cmd.Actor = v3action.NewActor(client)
+	cmd.NOAAClient = noaaClient
 
 	return nil
 }
 
 func (cmd RunTaskCommand) Execute(args []string) error {
+	if cmd.RequiredArgs.Command == "" && cmd.Template == "" {
+		return command.RequiredArgumentError{ArgumentName: "COMMAND"}
+	}
+
 	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), "3.0.0")
 	if err != nil {
 		return err
@@ -53,6 +80,11 @@ func (cmd RunTaskCommand) Execute(args []string) error {
 		return shared.HandleError(err)
 	}
 
+	taskToRun, err := cmd.task()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
 	space := cmd.Config.TargetedSpace()
 
 	user, err := cmd.Config.CurrentUser()
@@ -73,7 +105,18 @@ func (cmd RunTaskCommand) Execute(args []string) error {
 		"CurrentUser": user.Name,
 	})
 
-	task, warnings, err := cmd.Actor.RunTask(application.GUID, cmd.RequiredArgs.Command, cmd.Name)
+	// Streaming starts before the task is created so that none of its logs
+	// are missed.
+	var (
+		messages <-chan v3action.LogMessage
+		logErrs  <-chan error
+	)
+	if cmd.Wait {
+		messages, logErrs = cmd.Actor.GetStreamingLogsForTasks(application.GUID, cmd.NOAAClient, cmd.Config)
+		defer cmd.NOAAClient.Close()
+	}
+
+	task, warnings, err := cmd.Actor.RunTask(application.GUID, taskToRun)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return shared.HandleError(err)
@@ -89,5 +132,134 @@ Task id:     {{.TaskSequenceID}}`,
 			"TaskSequenceID": task.SequenceID,
 		})
 
+	if !cmd.Wait {
+		return nil
+	}
+
+	return cmd.waitForTask(task, messages, logErrs)
+}
+
+// task returns the task given on the command line. When a template is given,
+// the fields that were not set on the command line are taken from it. The
+// template is looked up in the manifest given with -f, or in the manifest of
+// the current directory.
+func (cmd RunTaskCommand) task() (v3action.Task, error) {
+	task := v3action.Task{
+		Command:    cmd.RequiredArgs.Command,
+		Name:       cmd.Name,
+		MemoryInMB: uint64(cmd.Memory),
+		DiskInMB:   uint64(cmd.Disk),
+	}
+
+	if cmd.Template == "" {
+		return task, nil
+	}
+
+	path := cmd.PathToManifest
+	if path == "" {
+		var err error
+		path, err = os.Getwd()
+		if err != nil {
+			return v3action.Task{}, err
+		}
+	}
+
+	template, err := cmd.Actor.GetTaskTemplate(path, cmd.RequiredArgs.AppName, cmd.Template)
+	if err != nil {
+		return v3action.Task{}, err
+	}
+
+	if task.Command == "" {
+		task.Command = template.Command
+	}
+	if task.Name == "" {
+		task.Name = template.Name
+	}
+	if task.MemoryInMB == 0 {
+		task.MemoryInMB = template.MemoryInMB
+	}
+	if task.DiskInMB == 0 {
+		task.DiskInMB = template.DiskInMB
+	}
+
+	return task, nil
+}
+
+// waitForTask displays the logs of the task while polling it until it
+// succeeds or fails. Logs are drained for one more polling interval after the
+// task completes, since they can arrive after its state changes. Log
+// messages only identify a task by its name, so the logs of other running
+// tasks with the same name are displayed as well.
+func (cmd RunTaskCommand) waitForTask(task v3action.Task, messages <-chan v3action.LogMessage, logErrs <-chan error) error {
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTextWithFlavor("Waiting for task {{.TaskName}} to complete...", map[string]interface{}{
+		"TaskName": task.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	sourceType := v3action.TaskSourceType(task.Name)
+
+	ticker := time.NewTicker(cmd.Config.PollingInterval())
+	defer ticker.Stop()
+	poll := ticker.C
+
+	var (
+		completedTask *v3action.Task
+		drain         <-chan time.Time
+	)
+
+	for completedTask == nil || messages != nil || logErrs != nil {
+		select {
+		case message, ok := <-messages:
+			if !ok {
+				messages = nil
+				continue
+			}
+			if message.SourceType() != sourceType {
+				continue
+			}
+			cmd.UI.DisplayText("   {{.Timestamp}} [{{.SourceType}}/{{.SourceInstance}}] {{.Type}} {{.Message}}", map[string]interface{}{
+				"Timestamp":      message.Timestamp().Format("2006-01-02T15:04:05.00-0700"),
+				"SourceType":     message.SourceType(),
+				"SourceInstance": message.SourceInstance(),
+				"Type":           message.Type(),
+				"Message":        strings.TrimRight(message.Message(), "\r\n"),
+			})
+		case err, ok := <-logErrs:
+			if !ok {
+				logErrs = nil
+				continue
+			}
+			cmd.UI.DisplayWarning("Failed to retrieve the logs of the task: {{.Error}}", map[string]interface{}{
+				"Error": err.Error(),
+			})
+		case <-poll:
+			currentTask, warnings, err := cmd.Actor.GetTask(task.GUID)
+			cmd.UI.DisplayWarnings(warnings)
+			if err != nil {
+				return shared.HandleError(err)
+			}
+
+			if currentTask.State == v3action.TaskSucceeded || currentTask.State == v3action.TaskFailed {
+				completedTask = &currentTask
+				poll = nil
+				drain = time.After(cmd.Config.PollingInterval())
+			}
+		case <-drain:
+			messages, logErrs = nil, nil
+		}
+	}
+
+	if completedTask.State == v3action.TaskFailed {
+		return shared.TaskFailedError{
+			TaskName: task.Name,
+			Reason:   completedTask.Result.FailureReason,
+		}
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTextWithFlavor("Task {{.TaskName}} succeeded.", map[string]interface{}{
+		"TaskName": task.Name,
+	})
 	return nil
 }
@@ -2,13 +2,17 @@ package v3_test
 
 import (
 	"errors"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/shared"
 	"code.cloudfoundry.org/cli/command/v3/v3fakes"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
@@ -24,6 +28,7 @@ var _ = Describe("run-task Command", func() {
 		fakeConfig      *commandfakes.FakeConfig
 		fakeSharedActor *commandfakes.FakeSharedActor
 		fakeActor       *v3fakes.FakeRunTaskActor
+		fakeNOAAClient  *v3actionfakes.FakeNOAAClient
 		binaryName      string
 		executeErr      error
 	)
@@ -33,12 +38,14 @@ var _ = Describe("run-task Command", func() {
 		fakeConfig = new(commandfakes.FakeConfig)
 		fakeSharedActor = new(commandfakes.FakeSharedActor)
 		fakeActor = new(v3fakes.FakeRunTaskActor)
+		fakeNOAAClient = new(v3actionfakes.FakeNOAAClient)
 
 		cmd = v3.RunTaskCommand{
 			UI:          testUI,
 			Config:      fakeConfig,
 			SharedActor: fakeSharedActor,
 			Actor:       fakeActor,
+			NOAAClient:  fakeNOAAClient,
 		}
 
 		cmd.RequiredArgs.AppName = "some-app-name"
@@ -53,6 +60,17 @@ var _ = Describe("run-task Command", func() {
 		executeErr = cmd.Execute(nil)
 	})
 
+	Context("when neither a command nor a template is provided", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Command = ""
+		})
+
+		It("returns a RequiredArgumentError", func() {
+			Expect(executeErr).To(MatchError(command.RequiredArgumentError{ArgumentName: "COMMAND"}))
+			Expect(fakeActor.RunTaskCallCount()).To(BeZero())
+		})
+	})
+
 	Context("when the API version is below the minimum", func() {
 		BeforeEach(func() {
 			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
@@ -145,10 +163,11 @@ var _ = Describe("run-task Command", func() {
 						Expect(spaceGUID).To(Equal("some-space-guid"))
 
 						Expect(fakeActor.RunTaskCallCount()).To(Equal(1))
-						appGUID, command, name := fakeActor.RunTaskArgsForCall(0)
+						appGUID, task := fakeActor.RunTaskArgsForCall(0)
 						Expect(appGUID).To(Equal("some-app-guid"))
-						Expect(command).To(Equal("some command"))
-						Expect(name).To(Equal(""))
+						Expect(task).To(Equal(v3action.Task{
+							Command: "some command",
+						}))
 
 						Expect(testUI.Out).To(Say(`Creating task for app some-app-name in org some-org / space some-space as some-user...
 OK
@@ -184,10 +203,12 @@ get-application-warning-3`))
 						Expect(spaceGUID).To(Equal("some-space-guid"))
 
 						Expect(fakeActor.RunTaskCallCount()).To(Equal(1))
-						appGUID, command, name := fakeActor.RunTaskArgsForCall(0)
+						appGUID, task := fakeActor.RunTaskArgsForCall(0)
 						Expect(appGUID).To(Equal("some-app-guid"))
-						Expect(command).To(Equal("some command"))
-						Expect(name).To(Equal("some-task-name"))
+						Expect(task).To(Equal(v3action.Task{
+							Command: "some command",
+							Name:    "some-task-name",
+						}))
 
 						Expect(testUI.Out).To(Say(`Creating task for app some-app-name in org some-org / space some-space as some-user...
 OK
@@ -201,6 +222,242 @@ get-application-warning-2
 get-application-warning-3`))
 					})
 				})
+				Context("when memory and disk limits are provided", func() {
+					BeforeEach(func() {
+						cmd.Memory = 512
+						cmd.Disk = 2048
+						fakeActor.RunTaskReturns(v3action.Task{Name: "31337ddd", SequenceID: 3}, nil, nil)
+					})
+
+					It("creates the task with the limits", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						_, task := fakeActor.RunTaskArgsForCall(0)
+						Expect(task).To(Equal(v3action.Task{
+							Command:    "some command",
+							MemoryInMB: 512,
+							DiskInMB:   2048,
+						}))
+					})
+				})
+
+				Context("when a template is provided", func() {
+					BeforeEach(func() {
+						cmd.RequiredArgs.Command = ""
+						cmd.Template = "migrate"
+						cmd.PathToManifest = "/some/path/manifest.yml"
+
+						fakeActor.GetTaskTemplateReturns(v3action.Task{
+							Name:       "migrate",
+							Command:    "bundle exec rake db:migrate",
+							MemoryInMB: 1024,
+							DiskInMB:   512,
+						}, nil)
+						fakeActor.RunTaskReturns(v3action.Task{Name: "migrate", SequenceID: 3}, nil, nil)
+					})
+
+					It("creates the task from the app's template in the manifest", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(fakeActor.GetTaskTemplateCallCount()).To(Equal(1))
+						manifestPath, appName, templateName := fakeActor.GetTaskTemplateArgsForCall(0)
+						Expect(manifestPath).To(Equal("/some/path/manifest.yml"))
+						Expect(appName).To(Equal("some-app-name"))
+						Expect(templateName).To(Equal("migrate"))
+
+						_, task := fakeActor.RunTaskArgsForCall(0)
+						Expect(task).To(Equal(v3action.Task{
+							Name:       "migrate",
+							Command:    "bundle exec rake db:migrate",
+							MemoryInMB: 1024,
+							DiskInMB:   512,
+						}))
+					})
+
+					Context("when the command line overrides the template", func() {
+						BeforeEach(func() {
+							cmd.RequiredArgs.Command = "bundle exec rake db:migrate:status"
+							cmd.Name = "migrate-status"
+							cmd.Memory = 256
+						})
+
+						It("uses the command line values", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							_, task := fakeActor.RunTaskArgsForCall(0)
+							Expect(task).To(Equal(v3action.Task{
+								Name:       "migrate-status",
+								Command:    "bundle exec rake db:migrate:status",
+								MemoryInMB: 256,
+								DiskInMB:   512,
+							}))
+						})
+					})
+
+					Context("when the template does not exist", func() {
+						BeforeEach(func() {
+							fakeActor.GetTaskTemplateReturns(v3action.Task{}, v3action.TaskTemplateNotFoundError{
+								TemplateName: "seed",
+								AppName:      "some-app-name",
+								ManifestPath: "/some/path/manifest.yml",
+							})
+						})
+
+						It("returns a TaskTemplateNotFoundError", func() {
+							Expect(executeErr).To(MatchError(shared.TaskTemplateNotFoundError{
+								TemplateName: "seed",
+								AppName:      "some-app-name",
+								ManifestPath: "/some/path/manifest.yml",
+							}))
+							Expect(fakeActor.RunTaskCallCount()).To(BeZero())
+						})
+					})
+
+					Context("when reading the manifest fails", func() {
+						BeforeEach(func() {
+							fakeActor.GetTaskTemplateReturns(v3action.Task{}, errors.New("no manifest"))
+						})
+
+						It("returns the error", func() {
+							Expect(executeErr).To(MatchError("no manifest"))
+							Expect(fakeActor.RunTaskCallCount()).To(BeZero())
+						})
+					})
+				})
+
+				Context("when the --wait flag is provided", func() {
+					var (
+						messages chan v3action.LogMessage
+						logErrs  chan error
+					)
+
+					BeforeEach(func() {
+						cmd.Wait = true
+						fakeConfig.PollingIntervalReturns(time.Millisecond)
+						fakeActor.RunTaskReturns(v3action.Task{GUID: "task-guid", Name: "migrate", SequenceID: 3}, nil, nil)
+
+						messages = make(chan v3action.LogMessage, 10)
+						logErrs = make(chan error, 1)
+						fakeActor.GetStreamingLogsForTasksReturns(messages, logErrs)
+
+						states := []string{"RUNNING", v3action.TaskSucceeded}
+						fakeActor.GetTaskStub = func(string) (v3action.Task, v3action.Warnings, error) {
+							state := states[0]
+							if len(states) > 1 {
+								states = states[1:]
+							}
+							return v3action.Task{GUID: "task-guid", Name: "migrate", State: state}, v3action.Warnings{"get-task-warning"}, nil
+						}
+					})
+
+					Context("when the task succeeds", func() {
+						BeforeEach(func() {
+							messages <- *sharedaction.NewLogMessage("migrating\n", 0, time.Unix(0, 0), "APP/TASK/migrate", "0")
+						})
+
+						It("displays the task's logs until it succeeds", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(testUI.Out).To(Say("Task name:   migrate"))
+							Expect(testUI.Out).To(Say("Waiting for task migrate to complete..."))
+							Expect(testUI.Out).To(Say(`\[APP/TASK/migrate/0\] OUT migrating\n`))
+							Expect(testUI.Out).To(Say("Task migrate succeeded."))
+							Expect(testUI.Err).To(Say("get-task-warning"))
+
+							appGUID, noaaClient, config := fakeActor.GetStreamingLogsForTasksArgsForCall(0)
+							Expect(appGUID).To(Equal("some-app-guid"))
+							Expect(noaaClient).To(Equal(fakeNOAAClient))
+							Expect(config).To(Equal(fakeConfig))
+
+							Expect(fakeActor.GetTaskCallCount()).To(Equal(2))
+							Expect(fakeActor.GetTaskArgsForCall(0)).To(Equal("task-guid"))
+							Expect(fakeNOAAClient.CloseCallCount()).To(Equal(1))
+						})
+					})
+
+					Context("when the task creates logs right after it is created", func() {
+						BeforeEach(func() {
+							fakeActor.RunTaskStub = func(string, v3action.Task) (v3action.Task, v3action.Warnings, error) {
+								Expect(fakeActor.GetStreamingLogsForTasksCallCount()).To(Equal(1))
+								messages <- *sharedaction.NewLogMessage("starting\n", 0, time.Unix(0, 0), "APP/TASK/migrate", "0")
+								return v3action.Task{GUID: "task-guid", Name: "migrate", SequenceID: 3}, nil, nil
+							}
+						})
+
+						It("starts streaming the logs before creating the task", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+							Expect(testUI.Out).To(Say(`\[APP/TASK/migrate/0\] OUT starting\n`))
+						})
+					})
+
+					Context("when other tasks of the app create logs", func() {
+						BeforeEach(func() {
+							messages <- *sharedaction.NewLogMessage("seeding\n", 0, time.Unix(0, 0), "APP/TASK/seed", "0")
+						})
+
+						It("only displays the logs of the task", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+							Expect(testUI.Out).NotTo(Say("seeding"))
+						})
+					})
+
+					Context("when logs arrive after the task completes", func() {
+						BeforeEach(func() {
+							fakeActor.GetTaskStub = func(string) (v3action.Task, v3action.Warnings, error) {
+								messages <- *sharedaction.NewLogMessage("migrated\n", 0, time.Unix(0, 0), "APP/TASK/migrate", "0")
+								return v3action.Task{GUID: "task-guid", Name: "migrate", State: v3action.TaskSucceeded}, nil, nil
+							}
+						})
+
+						It("displays them before reporting the result", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+							Expect(testUI.Out).To(Say(`\[APP/TASK/migrate/0\] OUT migrated\n`))
+							Expect(testUI.Out).To(Say("Task migrate succeeded."))
+						})
+					})
+
+					Context("when the logs cannot be retrieved", func() {
+						BeforeEach(func() {
+							logErrs <- errors.New("websocket closed")
+						})
+
+						It("warns and keeps waiting for the task", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+							Expect(testUI.Err).To(Say("Failed to retrieve the logs of the task: websocket closed"))
+							Expect(testUI.Out).To(Say("Task migrate succeeded."))
+						})
+					})
+
+					Context("when the task fails", func() {
+						BeforeEach(func() {
+							fakeActor.GetTaskStub = nil
+							fakeActor.GetTaskReturns(v3action.Task{
+								State:  v3action.TaskFailed,
+								Result: ccv3.TaskResult{FailureReason: "Exited with status 1"},
+							}, nil, nil)
+						})
+
+						It("returns a TaskFailedError", func() {
+							Expect(executeErr).To(MatchError(shared.TaskFailedError{
+								TaskName: "migrate",
+								Reason:   "Exited with status 1",
+							}))
+							Expect(fakeNOAAClient.CloseCallCount()).To(Equal(1))
+						})
+					})
+
+					Context("when getting the task fails", func() {
+						BeforeEach(func() {
+							fakeActor.GetTaskStub = nil
+							fakeActor.GetTaskReturns(v3action.Task{}, v3action.Warnings{"get-task-warning"}, errors.New("get task error"))
+						})
+
+						It("returns the error and displays warnings", func() {
+							Expect(executeErr).To(MatchError("get task error"))
+							Expect(testUI.Err).To(Say("get-task-warning"))
+						})
+					})
+				})
 			})
 
 			Context("when there are errors", func() {
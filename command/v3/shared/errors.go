@@ -27,3 +27,53 @@ func (e ClientTargetError) Translate(translate func(string, ...interface{}) stri
 		"Message": e.Message,
 	})
 }
+
+type TaskFailedError struct {
+	TaskName string
+	Reason   string
+}
+
+func (e TaskFailedError) Error() string {
+	return "Task {{.TaskName}} failed: {{.Reason}}"
+}
+
+func (e TaskFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"TaskName": e.TaskName,
+		"Reason":   e.Reason,
+	})
+}
+
+type TerminateTasksError struct {
+	Failed int
+	Total  int
+}
+
+func (e TerminateTasksError) Error() string {
+	return "{{.Failed}} of {{.Total}} tasks could not be terminated."
+}
+
+func (e TerminateTasksError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Failed": e.Failed,
+		"Total":  e.Total,
+	})
+}
+
+type TaskTemplateNotFoundError struct {
+	TemplateName string
+	AppName      string
+	ManifestPath string
+}
+
+func (e TaskTemplateNotFoundError) Error() string {
+	return "Task template {{.TemplateName}} not found for app {{.AppName}} in manifest {{.ManifestPath}}"
+}
+
+func (e TaskTemplateNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"TemplateName": e.TemplateName,
+		"AppName":      e.AppName,
+		"ManifestPath": e.ManifestPath,
+	})
+}
@@ -38,6 +38,9 @@ var _ = Describe("Translatable Errors", func() {
 
 		// Actor errors.
 		Entry("RunTaskError", RunTaskError{}),
+		Entry("TaskFailedError", TaskFailedError{}),
+		Entry("TerminateTasksError", TerminateTasksError{}),
+		Entry("TaskTemplateNotFoundError", TaskTemplateNotFoundError{}),
 		Entry("ClientTargetError", ClientTargetError{}),
 	)
 })
@@ -4,7 +4,9 @@ import (
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/tlsconfig"
 )
 
 func HandleError(err error) error {
@@ -23,6 +25,16 @@ func HandleError(err error) error {
 	case cloudcontroller.UnverifiedServerError:
 		return command.InvalidSSLCertError{API: e.URL}
 
+	case uaa.UnverifiedServerError:
+		return command.InvalidSSLCertError{API: e.URL}
+
+	case tlsconfig.InvalidCACertError:
+		return command.InvalidCACertError{Path: e.Path}
+	case tlsconfig.IncompleteClientCertError:
+		return command.IncompleteClientCertError{}
+	case tlsconfig.InvalidClientCertError:
+		return command.InvalidClientCertError{CertFile: e.CertFile, KeyFile: e.KeyFile, Err: e.Err}
+
 	case sharedaction.NotLoggedInError:
 		return command.NotLoggedInError{BinaryName: e.BinaryName}
 	case sharedaction.NoTargetedOrganizationError:
@@ -34,6 +46,8 @@ func HandleError(err error) error {
 		return command.ApplicationNotFoundError{Name: e.Name}
 	case v3action.TaskWorkersUnavailableError:
 		return RunTaskError{Message: "Task workers are unavailable."}
+	case v3action.TaskTemplateNotFoundError:
+		return TaskTemplateNotFoundError{TemplateName: e.TemplateName, AppName: e.AppName, ManifestPath: e.ManifestPath}
 	}
 
 	return err
@@ -6,8 +6,10 @@ import (
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/command"
 	. "code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/tlsconfig"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -31,6 +33,22 @@ var _ = Describe("HandleError", func() {
 			cloudcontroller.UnverifiedServerError{URL: "some-url"},
 			command.InvalidSSLCertError{API: "some-url"}),
 
+		Entry("uaa.UnverifiedServerError -> InvalidSSLCertError",
+			uaa.UnverifiedServerError{URL: "some-url"},
+			command.InvalidSSLCertError{API: "some-url"}),
+
+		Entry("tlsconfig.InvalidCACertError -> InvalidCACertError",
+			tlsconfig.InvalidCACertError{Path: "some-path"},
+			command.InvalidCACertError{Path: "some-path"}),
+
+		Entry("tlsconfig.IncompleteClientCertError -> IncompleteClientCertError",
+			tlsconfig.IncompleteClientCertError{},
+			command.IncompleteClientCertError{}),
+
+		Entry("tlsconfig.InvalidClientCertError -> InvalidClientCertError",
+			tlsconfig.InvalidClientCertError{CertFile: "some-cert", KeyFile: "some-key", Err: err},
+			command.InvalidClientCertError{CertFile: "some-cert", KeyFile: "some-key", Err: err}),
+
 		Entry("cloudcontroller.SSLValidationHostnameError -> SSLCertErrorError",
 			cloudcontroller.SSLValidationHostnameError{Message: "some-message"},
 			command.SSLCertErrorError{Message: "some-message"}),
@@ -55,6 +73,10 @@ var _ = Describe("HandleError", func() {
 			v3action.TaskWorkersUnavailableError{Message: "fooo: Banana Pants"},
 			RunTaskError{Message: "Task workers are unavailable."}),
 
+		Entry("v3action.TaskTemplateNotFoundError -> TaskTemplateNotFoundError",
+			v3action.TaskTemplateNotFoundError{TemplateName: "migrate", AppName: "some-app", ManifestPath: "/some/manifest.yml"},
+			TaskTemplateNotFoundError{TemplateName: "migrate", AppName: "some-app", ManifestPath: "/some/manifest.yml"}),
+
 		Entry("sharedaction.NotLoggedInError -> NotLoggedInError",
 			sharedaction.NotLoggedInError{BinaryName: "faceman"},
 			command.NotLoggedInError{BinaryName: "faceman"}),
@@ -10,32 +10,74 @@ import (
 
 // NewClients creates a new V3 Cloud Controller client and UAA client using the
 // passed in config.
-func NewClients(config command.Config, ui command.UI) (*ccv3.Client, error) {
+func NewClients(config command.Config, ui command.UI) (*ccv3.Client, *uaa.Client, error) {
 	if config.Target() == "" {
-		return nil, command.NoAPISetError{
+		return nil, nil, command.NoAPISetError{
 			BinaryName: config.BinaryName(),
 		}
 	}
 
+	resolver := command.NewProxyResolver(config, ui)
+
+	var (
+		ccWrappers  []ccv3.ConnectionWrapper
+		uaaWrappers []uaa.ConnectionWrapper
+	)
+	if replayFile := config.ReplayFile(); replayFile != "" {
+		player, err := command.NewCassettePlayer(replayFile)
+		if err != nil {
+			return nil, nil, err
+		}
+		ccWrappers = append(ccWrappers, ccWrapper.NewRequestReplayer(player))
+		uaaWrappers = append(uaaWrappers, uaaWrapper.NewRequestReplayer(player))
+	}
+	if recordFile := config.RecordFile(); recordFile != "" {
+		recorder := command.NewCassetteRecorder(ui, recordFile)
+		ccWrappers = append(ccWrappers, ccWrapper.NewRequestRecorder(recorder))
+		uaaWrappers = append(uaaWrappers, uaaWrapper.NewRequestRecorder(recorder))
+	}
+	if harFile := config.TraceHARFile(); harFile != "" {
+		harWriter := command.NewHARWriter(ui, harFile)
+		ccWrappers = append(ccWrappers, ccWrapper.NewHARRecorder(harWriter))
+		uaaWrappers = append(uaaWrappers, uaaWrapper.NewHARRecorder(harWriter))
+	}
+	if config.Timings() {
+		ccWrappers = append(ccWrappers, ccWrapper.NewRequestTimer(config.RequestTimings()))
+		uaaWrappers = append(uaaWrappers, uaaWrapper.NewRequestTimer(config.RequestTimings()))
+	}
+
 	ccClient := ccv3.NewClient(config.BinaryName(), config.BinaryVersion())
 	_, err := ccClient.TargetCF(ccv3.TargetSettings{
 		URL:               config.Target(),
 		SkipSSLValidation: config.SkipSSLValidation(),
 		DialTimeout:       config.DialTimeout(),
+		CACertFile:        config.CACertFile(),
+		ClientCertFile:    config.ClientCertFile(),
+		ClientKeyFile:     config.ClientKeyFile(),
+		Proxy:             resolver,
+		Wrappers:          ccWrappers,
 	})
 	if err != nil {
-		return nil, ClientTargetError{Message: err.Error()}
+		return nil, nil, ClientTargetError{Message: err.Error()}
 	}
 
-	uaaClient := uaa.NewClient(uaa.Config{
+	uaaClient, err := uaa.NewClient(uaa.Config{
 		AppName:           config.BinaryName(),
 		AppVersion:        config.BinaryVersion(),
 		ClientID:          config.UAAOAuthClient(),
 		ClientSecret:      config.UAAOAuthClientSecret(),
 		DialTimeout:       config.DialTimeout(),
 		SkipSSLValidation: config.SkipSSLValidation(),
+		CACertFile:        config.CACertFile(),
+		ClientCertFile:    config.ClientCertFile(),
+		ClientKeyFile:     config.ClientKeyFile(),
+		Proxy:             resolver,
+		Wrappers:          uaaWrappers,
 		URL:               ccClient.UAA(),
 	})
+	if err != nil {
+		return nil, nil, err
+	}
 
 	verbose, location := config.Verbose()
 	if verbose {
@@ -47,8 +89,12 @@ func NewClients(config command.Config, ui command.UI) (*ccv3.Client, error) {
 		uaaClient.WrapConnection(uaaWrapper.NewRequestLogger(command.NewRequestLoggerFileWriter(ui, location)))
 	}
 
-	ccClient.WrapConnection(ccWrapper.NewUAAAuthentication(uaaClient, config))
-	ccClient.WrapConnection(ccWrapper.NewRetryRequest(2))
+	refreshSchedule := uaa.NewRefreshSchedule(config.TokenRefreshWindow())
+	ccClient.WrapConnection(ccWrapper.NewUAAAuthentication(uaaClient, config, refreshSchedule))
+	ccClient.WrapConnection(ccWrapper.NewRetryRequest(config.RequestRetries()))
+
+	uaaClient.WrapConnection(uaaWrapper.NewUAAAuthentication(uaaClient, config, refreshSchedule))
+	uaaClient.WrapConnection(uaaWrapper.NewRetryRequest(config.RequestRetries()))
 
-	return ccClient, nil
+	return ccClient, uaaClient, nil
 }
@@ -31,7 +31,7 @@ var _ = Describe("New Clients", func() {
 
 	Context("when the api endpoint is not set", func() {
 		It("returns the NoAPISetError", func() {
-			_, err := NewClients(fakeConfig, testUI)
+			_, _, err := NewClients(fakeConfig, testUI)
 			Expect(err).To(MatchError(command.NoAPISetError{
 				BinaryName: binaryName,
 			}))
@@ -44,7 +44,7 @@ var _ = Describe("New Clients", func() {
 		})
 
 		It("returns the ClientTargetError", func() {
-			_, err := NewClients(fakeConfig, testUI)
+			_, _, err := NewClients(fakeConfig, testUI)
 			Expect(err.Error()).To(MatchRegexp("Note that this command requires CF API version 3.0.0+."))
 		})
 	})
@@ -59,7 +59,7 @@ var _ = Describe("New Clients", func() {
 		})
 
 		It("passes the value to the target", func() {
-			_, err := NewClients(fakeConfig, testUI)
+			_, _, err := NewClients(fakeConfig, testUI)
 			if e, ok := err.(ClientTargetError); ok {
 				Expect(e.Message).To(MatchRegexp("https://potato.bananapants11122.co.uk: dial tcp.*i/o timeout"))
 			} else {
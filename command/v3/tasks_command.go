@@ -2,6 +2,7 @@ package v3
 
 import (
 	"strconv"
+	"strings"
 	"time"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
@@ -17,20 +18,28 @@ const (
 	cancelingState = "CANCELING"
 	pendingState   = "PENDING"
 	succeededState = "SUCCEEDED"
+	failedState    = "FAILED"
 )
 
+// taskStates are the states accepted by tasks --state.
+var taskStates = []string{pendingState, runningState, cancelingState, succeededState, failedState}
+
 //go:generate counterfeiter . TasksActor
 
 type TasksActor interface {
 	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
-	GetApplicationTasks(appGUID string, sortOrder v3action.SortOrder) ([]v3action.Task, v3action.Warnings, error)
+	GetApplicationTasks(appGUID string, sortOrder v3action.SortOrder, filter v3action.TaskFilter) ([]v3action.Task, v3action.Warnings, error)
 	CloudControllerAPIVersion() string
 }
 
 type TasksCommand struct {
-	RequiredArgs    flag.AppName `positional-args:"yes"`
-	usage           interface{}  `usage:"CF_NAME tasks APP_NAME"`
-	relatedCommands interface{}  `related_commands:"apps, logs, run-task, terminate-task"`
+	RequiredArgs    flag.AppName  `positional-args:"yes"`
+	State           string        `long:"state" description:"Only show tasks in these comma-separated states: PENDING, RUNNING, CANCELING, SUCCEEDED or FAILED"`
+	Name            string        `long:"name" description:"Only show tasks with this name"`
+	Since           flag.PastTime `long:"since" description:"Only show tasks created at or after this time, given as a duration ago (e.g. 90m, 2h, 7d) or a date (e.g. 2017-05-04, 2017-05-04T15:04:05Z)"`
+	Limit           int           `long:"limit" description:"Maximum number of tasks to show, newest first"`
+	usage           interface{}   `usage:"CF_NAME tasks APP_NAME [--state STATES] [--name TASK_NAME] [--since TIME] [--limit NUMBER]\n\nEXAMPLES:\n   CF_NAME tasks my-app --state RUNNING,PENDING\n   CF_NAME tasks my-app --name migrate --since 7d --limit 5"`
+	relatedCommands interface{}   `related_commands:"apps, logs, run-task, terminate-task"`
 
 	UI          command.UI
 	Config      command.Config
@@ -43,7 +52,7 @@ func (cmd *TasksCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.Config = config
 	cmd.SharedActor = sharedaction.NewActor()
 
-	client, err := shared.NewClients(config, ui)
+	client, _, err := shared.NewClients(config, ui)
 	if err != nil {
 		return err
 	}
@@ -53,7 +62,12 @@ func (cmd *TasksCommand) Setup(config command.Config, ui command.UI) error {
 }
 
 func (cmd TasksCommand) Execute(args []string) error {
-	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), "3.0.0")
+	filter, err := cmd.taskFilter()
+	if err != nil {
+		return err
+	}
+
+	err = command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), "3.0.0")
 	if err != nil {
 		return err
 	}
@@ -83,7 +97,7 @@ func (cmd TasksCommand) Execute(args []string) error {
 		"CurrentUser": user.Name,
 	})
 
-	tasks, warnings, err := cmd.Actor.GetApplicationTasks(application.GUID, v3action.Descending)
+	tasks, warnings, err := cmd.Actor.GetApplicationTasks(application.GUID, v3action.Descending, filter)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return shared.HandleError(err)
@@ -116,3 +130,45 @@ func (cmd TasksCommand) Execute(args []string) error {
 
 	return nil
 }
+
+// taskFilter returns the filter given by the command's flags.
+func (cmd TasksCommand) taskFilter() (v3action.TaskFilter, error) {
+	if cmd.Limit < 0 {
+		return v3action.TaskFilter{}, command.ParseArgumentError{
+			ArgumentName: "--limit",
+			ExpectedType: "positive integer",
+		}
+	}
+
+	filter := v3action.TaskFilter{
+		Since: cmd.Since.Time,
+		Limit: cmd.Limit,
+	}
+	if cmd.Name != "" {
+		filter.Names = []string{cmd.Name}
+	}
+
+	if cmd.State != "" {
+		for _, state := range strings.Split(cmd.State, ",") {
+			state = strings.ToUpper(strings.TrimSpace(state))
+			if !isTaskState(state) {
+				return v3action.TaskFilter{}, command.ParseArgumentError{
+					ArgumentName: "--state",
+					ExpectedType: strings.Join(taskStates, ", "),
+				}
+			}
+			filter.States = append(filter.States, state)
+		}
+	}
+
+	return filter, nil
+}
+
+func isTaskState(state string) bool {
+	for _, taskState := range taskStates {
+		if state == taskState {
+			return true
+		}
+	}
+	return false
+}
@@ -2,12 +2,14 @@ package v3_test
 
 import (
 	"errors"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/v3"
 	"code.cloudfoundry.org/cli/command/v3/v3fakes"
 	"code.cloudfoundry.org/cli/util/configv3"
@@ -162,9 +164,10 @@ var _ = Describe("tasks Command", func() {
 					Expect(spaceGUID).To(Equal("some-space-guid"))
 
 					Expect(fakeActor.GetApplicationTasksCallCount()).To(Equal(1))
-					guid, order := fakeActor.GetApplicationTasksArgsForCall(0)
+					guid, order, filter := fakeActor.GetApplicationTasksArgsForCall(0)
 					Expect(guid).To(Equal("some-app-guid"))
 					Expect(order).To(Equal(v3action.Descending))
+					Expect(filter).To(Equal(v3action.TaskFilter{}))
 
 					Expect(testUI.Out).To(Say(`Getting tasks for app some-app-name in org some-org / space some-space as some-user...
 OK
@@ -214,6 +217,57 @@ get-tasks-warning-1`))
 					})
 				})
 
+				Context("when filters are provided", func() {
+					var since time.Time
+
+					BeforeEach(func() {
+						since = time.Date(2017, 5, 4, 0, 0, 0, 0, time.UTC)
+						cmd.State = "running, Failed"
+						cmd.Name = "migrate"
+						cmd.Since = flag.PastTime{Time: since}
+						cmd.Limit = 5
+					})
+
+					It("passes them to the actor", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						_, _, filter := fakeActor.GetApplicationTasksArgsForCall(0)
+						Expect(filter).To(Equal(v3action.TaskFilter{
+							States: []string{"RUNNING", "FAILED"},
+							Names:  []string{"migrate"},
+							Since:  since,
+							Limit:  5,
+						}))
+					})
+				})
+
+				Context("when an unknown state is provided", func() {
+					BeforeEach(func() {
+						cmd.State = "RUNNING,EXPLODED"
+					})
+
+					It("returns a ParseArgumentError", func() {
+						Expect(executeErr).To(MatchError(command.ParseArgumentError{
+							ArgumentName: "--state",
+							ExpectedType: "PENDING, RUNNING, CANCELING, SUCCEEDED, FAILED",
+						}))
+						Expect(fakeActor.GetApplicationTasksCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when a negative limit is provided", func() {
+					BeforeEach(func() {
+						cmd.Limit = -1
+					})
+
+					It("returns a ParseArgumentError", func() {
+						Expect(executeErr).To(MatchError(command.ParseArgumentError{
+							ArgumentName: "--limit",
+							ExpectedType: "positive integer",
+						}))
+					})
+				})
+
 				Context("when there are no tasks associated with the application", func() {
 					BeforeEach(func() {
 						fakeActor.GetApplicationTasksReturns([]v3action.Task{}, nil, nil)
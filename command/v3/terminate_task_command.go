@@ -1,6 +1,8 @@
 package v3
 
 import (
+	"strconv"
+
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/command"
@@ -12,6 +14,7 @@ import (
 
 type TerminateTaskActor interface {
 	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	GetApplicationTasks(appGUID string, sortOrder v3action.SortOrder, filter v3action.TaskFilter) ([]v3action.Task, v3action.Warnings, error)
 	GetTaskBySequenceIDAndApplication(sequenceID int, appGUID string) (v3action.Task, v3action.Warnings, error)
 	TerminateTask(taskGUID string) (v3action.Task, v3action.Warnings, error)
 	CloudControllerAPIVersion() string
@@ -19,7 +22,10 @@ type TerminateTaskActor interface {
 
 type TerminateTaskCommand struct {
 	RequiredArgs    flag.TerminateTaskArgs `positional-args:"yes"`
-	usage           interface{}            `usage:"CF_NAME terminate-task APP_NAME TASK_ID\n\nEXAMPLES:\n   CF_NAME terminate-task my-app 3"`
+	AllRunning      bool                   `long:"all-running" description:"Terminate all running and pending tasks of the app"`
+	Name            string                 `long:"name" description:"Terminate the running and pending tasks of the app with this name"`
+	Force           bool                   `short:"f" description:"Terminate several tasks without asking for confirmation"`
+	usage           interface{}            `usage:"CF_NAME terminate-task APP_NAME TASK_ID\n   CF_NAME terminate-task APP_NAME (--all-running | --name TASK_NAME) [-f]\n\nEXAMPLES:\n   CF_NAME terminate-task my-app 3\n   CF_NAME terminate-task my-app --name migrate\n   CF_NAME terminate-task my-app --all-running -f"`
 	relatedCommands interface{}            `related_commands:"tasks"`
 
 	UI          command.UI
@@ -33,7 +39,7 @@ func (cmd *TerminateTaskCommand) Setup(config command.Config, ui command.UI) err
 	cmd.Config = config
 	cmd.SharedActor = sharedaction.NewActor()
 
-	client, err := shared.NewClients(config, ui)
+	client, _, err := shared.NewClients(config, ui)
 	if err != nil {
 		return err
 	}
@@ -43,12 +49,9 @@ func (cmd *TerminateTaskCommand) Setup(config command.Config, ui command.UI) err
 }
 
 func (cmd TerminateTaskCommand) Execute(args []string) error {
-	sequenceId, err := flag.ParseStringToInt(cmd.RequiredArgs.SequenceID)
+	err := cmd.validateArgs()
 	if err != nil {
-		return command.ParseArgumentError{
-			ArgumentName: "TASK_ID",
-			ExpectedType: "integer",
-		}
+		return err
 	}
 
 	err = command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), "3.0.0")
@@ -74,7 +77,12 @@ func (cmd TerminateTaskCommand) Execute(args []string) error {
 		return shared.HandleError(err)
 	}
 
-	task, warnings, err := cmd.Actor.GetTaskBySequenceIDAndApplication(sequenceId, application.GUID)
+	if cmd.RequiredArgs.SequenceID == "" {
+		return cmd.terminateTasks(application, space.Name, user.Name)
+	}
+
+	sequenceID, _ := flag.ParseStringToInt(cmd.RequiredArgs.SequenceID)
+	task, warnings, err := cmd.Actor.GetTaskBySequenceIDAndApplication(sequenceID, application.GUID)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return shared.HandleError(err)
@@ -99,3 +107,118 @@ func (cmd TerminateTaskCommand) Execute(args []string) error {
 
 	return nil
 }
+
+// validateArgs checks that exactly one of TASK_ID, --all-running and --name
+// selects the tasks to terminate.
+func (cmd TerminateTaskCommand) validateArgs() error {
+	var selectors []string
+	if cmd.RequiredArgs.SequenceID != "" {
+		selectors = append(selectors, "TASK_ID")
+	}
+	if cmd.AllRunning {
+		selectors = append(selectors, "--all-running")
+	}
+	if cmd.Name != "" {
+		selectors = append(selectors, "--name")
+	}
+
+	switch {
+	case len(selectors) == 0:
+		return command.RequiredArgumentError{ArgumentName: "TASK_ID"}
+	case len(selectors) > 1:
+		return command.ArgumentCombinationError{Args: selectors}
+	}
+
+	if cmd.RequiredArgs.SequenceID != "" {
+		if _, err := flag.ParseStringToInt(cmd.RequiredArgs.SequenceID); err != nil {
+			return command.ParseArgumentError{
+				ArgumentName: "TASK_ID",
+				ExpectedType: "integer",
+			}
+		}
+	}
+
+	return nil
+}
+
+// terminateTasks terminates the running and pending tasks selected by
+// --all-running or --name, after confirmation unless -f is given.
+func (cmd TerminateTaskCommand) terminateTasks(application v3action.Application, spaceName string, userName string) error {
+	filter := v3action.TaskFilter{
+		States: []string{runningState, pendingState},
+	}
+	if cmd.Name != "" {
+		filter.Names = []string{cmd.Name}
+		cmd.UI.DisplayTextWithFlavor("Terminating running tasks named {{.TaskName}} of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...",
+			map[string]interface{}{
+				"TaskName":    cmd.Name,
+				"AppName":     cmd.RequiredArgs.AppName,
+				"OrgName":     cmd.Config.TargetedOrganization().Name,
+				"SpaceName":   spaceName,
+				"CurrentUser": userName,
+			})
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Terminating all running tasks of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...",
+			map[string]interface{}{
+				"AppName":     cmd.RequiredArgs.AppName,
+				"OrgName":     cmd.Config.TargetedOrganization().Name,
+				"SpaceName":   spaceName,
+				"CurrentUser": userName,
+			})
+	}
+
+	tasks, warnings, err := cmd.Actor.GetApplicationTasks(application.GUID, v3action.Ascending, filter)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if len(tasks) == 0 {
+		cmd.UI.DisplayText("There are no running tasks to terminate.")
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	if !cmd.Force {
+		confirmed, promptErr := cmd.UI.DisplayBoolPrompt(cmd.UI.TranslateText("Really terminate {{.Count}} tasks of app {{.AppName}}?", map[string]interface{}{
+			"Count":   len(tasks),
+			"AppName": cmd.RequiredArgs.AppName,
+		}), false)
+		if promptErr != nil {
+			return promptErr
+		}
+		if !confirmed {
+			cmd.UI.DisplayText("Tasks have not been terminated.")
+			return nil
+		}
+	}
+
+	table := [][]string{{"id", "name", "result"}}
+	failed := 0
+	for _, task := range tasks {
+		result := cmd.UI.TranslateText("terminated")
+		_, warnings, err = cmd.Actor.TerminateTask(task.GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			result = cmd.UI.TranslateText("failed: {{.Error}}", map[string]interface{}{
+				"Error": err.Error(),
+			})
+			failed++
+		}
+		table = append(table, []string{strconv.Itoa(task.SequenceID), task.Name, result})
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTable("", table, 3)
+	cmd.UI.DisplayNewline()
+
+	if failed > 0 {
+		return shared.TerminateTasksError{
+			Failed: failed,
+			Total:  len(tasks),
+		}
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/shared"
 	"code.cloudfoundry.org/cli/command/v3/v3fakes"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
@@ -79,6 +80,28 @@ var _ = Describe("terminate-task Command", func() {
 		})
 	})
 
+	Context("when no task is selected", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.SequenceID = ""
+		})
+
+		It("returns a RequiredArgumentError", func() {
+			Expect(executeErr).To(MatchError(command.RequiredArgumentError{ArgumentName: "TASK_ID"}))
+		})
+	})
+
+	Context("when a task id and --all-running are both provided", func() {
+		BeforeEach(func() {
+			cmd.AllRunning = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(command.ArgumentCombinationError{
+				Args: []string{"TASK_ID", "--all-running"},
+			}))
+		})
+	})
+
 	Context("when checking target fails", func() {
 		BeforeEach(func() {
 			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
@@ -171,6 +194,151 @@ var _ = Describe("terminate-task Command", func() {
 				})
 			})
 
+			Context("when terminating several tasks", func() {
+				var input *Buffer
+
+				BeforeEach(func() {
+					input = NewBuffer()
+					testUI.In = input
+
+					cmd.RequiredArgs.SequenceID = ""
+					cmd.AllRunning = true
+
+					fakeActor.GetApplicationByNameAndSpaceReturns(
+						v3action.Application{GUID: "some-app-guid"},
+						nil,
+						nil)
+					fakeActor.GetApplicationTasksReturns(
+						[]v3action.Task{
+							{GUID: "task-1-guid", SequenceID: 1, Name: "migrate", State: "RUNNING"},
+							{GUID: "task-2-guid", SequenceID: 2, Name: "seed", State: "PENDING"},
+						},
+						v3action.Warnings{"get-tasks-warning"},
+						nil)
+					fakeActor.TerminateTaskReturns(
+						v3action.Task{},
+						v3action.Warnings{"terminate-task-warning"},
+						nil)
+				})
+
+				Context("when the user confirms", func() {
+					BeforeEach(func() {
+						input.Write([]byte("y\n"))
+					})
+
+					It("terminates every running and pending task and displays the results", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						appGUID, order, filter := fakeActor.GetApplicationTasksArgsForCall(0)
+						Expect(appGUID).To(Equal("some-app-guid"))
+						Expect(order).To(Equal(v3action.Ascending))
+						Expect(filter).To(Equal(v3action.TaskFilter{
+							States: []string{"RUNNING", "PENDING"},
+						}))
+
+						Expect(fakeActor.TerminateTaskCallCount()).To(Equal(2))
+						Expect(fakeActor.TerminateTaskArgsForCall(0)).To(Equal("task-1-guid"))
+						Expect(fakeActor.TerminateTaskArgsForCall(1)).To(Equal("task-2-guid"))
+						Expect(fakeActor.GetTaskBySequenceIDAndApplicationCallCount()).To(BeZero())
+
+						Expect(testUI.Out).To(Say("Terminating all running tasks of app some-app-name in org some-org / space some-space as some-user..."))
+						Expect(testUI.Out).To(Say(`Really terminate 2 tasks of app some-app-name\?`))
+						Expect(testUI.Out).To(Say(`id\s+name\s+result`))
+						Expect(testUI.Out).To(Say(`1\s+migrate\s+terminated`))
+						Expect(testUI.Out).To(Say(`2\s+seed\s+terminated`))
+						Expect(testUI.Out).To(Say("OK"))
+						Expect(testUI.Err).To(Say("get-tasks-warning"))
+						Expect(testUI.Err).To(Say("terminate-task-warning"))
+					})
+
+					Context("when terminating a task fails", func() {
+						BeforeEach(func() {
+							fakeActor.TerminateTaskStub = func(taskGUID string) (v3action.Task, v3action.Warnings, error) {
+								if taskGUID == "task-1-guid" {
+									return v3action.Task{}, nil, errors.New("task already finished")
+								}
+								return v3action.Task{}, nil, nil
+							}
+						})
+
+						It("terminates the other tasks and returns a TerminateTasksError", func() {
+							Expect(executeErr).To(MatchError(shared.TerminateTasksError{Failed: 1, Total: 2}))
+
+							Expect(fakeActor.TerminateTaskCallCount()).To(Equal(2))
+							Expect(testUI.Out).To(Say(`1\s+migrate\s+failed: task already finished`))
+							Expect(testUI.Out).To(Say(`2\s+seed\s+terminated`))
+						})
+					})
+				})
+
+				Context("when the user does not confirm", func() {
+					BeforeEach(func() {
+						input.Write([]byte("n\n"))
+					})
+
+					It("does not terminate any task", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(fakeActor.TerminateTaskCallCount()).To(BeZero())
+						Expect(testUI.Out).To(Say("Tasks have not been terminated."))
+					})
+				})
+
+				Context("when -f is provided", func() {
+					BeforeEach(func() {
+						cmd.Force = true
+					})
+
+					It("does not ask for confirmation", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(testUI.Out).NotTo(Say("Really terminate"))
+						Expect(fakeActor.TerminateTaskCallCount()).To(Equal(2))
+					})
+				})
+
+				Context("when --name is provided", func() {
+					BeforeEach(func() {
+						cmd.AllRunning = false
+						cmd.Name = "migrate"
+						cmd.Force = true
+					})
+
+					It("only terminates the running and pending tasks with that name", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						_, _, filter := fakeActor.GetApplicationTasksArgsForCall(0)
+						Expect(filter).To(Equal(v3action.TaskFilter{
+							States: []string{"RUNNING", "PENDING"},
+							Names:  []string{"migrate"},
+						}))
+						Expect(testUI.Out).To(Say("Terminating running tasks named migrate of app some-app-name in org some-org / space some-space as some-user..."))
+					})
+				})
+
+				Context("when there are no running tasks", func() {
+					BeforeEach(func() {
+						fakeActor.GetApplicationTasksReturns(nil, nil, nil)
+					})
+
+					It("says so and terminates nothing", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(testUI.Out).To(Say("There are no running tasks to terminate."))
+						Expect(testUI.Out).To(Say("OK"))
+						Expect(fakeActor.TerminateTaskCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when getting the tasks fails", func() {
+					BeforeEach(func() {
+						fakeActor.GetApplicationTasksReturns(nil, v3action.Warnings{"get-tasks-warning"}, errors.New("get tasks error"))
+					})
+
+					It("returns the error and displays warnings", func() {
+						Expect(executeErr).To(MatchError("get tasks error"))
+						Expect(testUI.Err).To(Say("get-tasks-warning"))
+					})
+				})
+			})
+
 			Context("when there are errors", func() {
 				Context("when the error is translatable", func() {
 					var (
@@ -20,18 +20,49 @@ type FakeRunTaskActor struct {
 		result2 v3action.Warnings
 		result3 error
 	}
-	RunTaskStub        func(appGUID string, command string, name string) (v3action.Task, v3action.Warnings, error)
+	RunTaskStub        func(appGUID string, task v3action.Task) (v3action.Task, v3action.Warnings, error)
 	runTaskMutex       sync.RWMutex
 	runTaskArgsForCall []struct {
 		appGUID string
-		command string
-		name    string
+		task    v3action.Task
 	}
 	runTaskReturns struct {
 		result1 v3action.Task
 		result2 v3action.Warnings
 		result3 error
 	}
+	GetTaskStub        func(taskGUID string) (v3action.Task, v3action.Warnings, error)
+	getTaskMutex       sync.RWMutex
+	getTaskArgsForCall []struct {
+		taskGUID string
+	}
+	getTaskReturns struct {
+		result1 v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}
+	GetStreamingLogsForTasksStub        func(appGUID string, client v3action.NOAAClient, config v3action.Config) (<-chan v3action.LogMessage, <-chan error)
+	getStreamingLogsForTasksMutex       sync.RWMutex
+	getStreamingLogsForTasksArgsForCall []struct {
+		appGUID string
+		client  v3action.NOAAClient
+		config  v3action.Config
+	}
+	getStreamingLogsForTasksReturns struct {
+		result1 <-chan v3action.LogMessage
+		result2 <-chan error
+	}
+	GetTaskTemplateStub        func(manifestPath string, appName string, templateName string) (v3action.Task, error)
+	getTaskTemplateMutex       sync.RWMutex
+	getTaskTemplateArgsForCall []struct {
+		manifestPath string
+		appName      string
+		templateName string
+	}
+	getTaskTemplateReturns struct {
+		result1 v3action.Task
+		result2 error
+	}
 	CloudControllerAPIVersionStub        func() string
 	cloudControllerAPIVersionMutex       sync.RWMutex
 	cloudControllerAPIVersionArgsForCall []struct{}
@@ -78,17 +109,16 @@ func (fake *FakeRunTaskActor) GetApplicationByNameAndSpaceReturns(result1 v3acti
 	}{result1, result2, result3}
 }
 
-func (fake *FakeRunTaskActor) RunTask(appGUID string, command string, name string) (v3action.Task, v3action.Warnings, error) {
+func (fake *FakeRunTaskActor) RunTask(appGUID string, task v3action.Task) (v3action.Task, v3action.Warnings, error) {
 	fake.runTaskMutex.Lock()
 	fake.runTaskArgsForCall = append(fake.runTaskArgsForCall, struct {
 		appGUID string
-		command string
-		name    string
-	}{appGUID, command, name})
-	fake.recordInvocation("RunTask", []interface{}{appGUID, command, name})
+		task    v3action.Task
+	}{appGUID, task})
+	fake.recordInvocation("RunTask", []interface{}{appGUID, task})
 	fake.runTaskMutex.Unlock()
 	if fake.RunTaskStub != nil {
-		return fake.RunTaskStub(appGUID, command, name)
+		return fake.RunTaskStub(appGUID, task)
 	} else {
 		return fake.runTaskReturns.result1, fake.runTaskReturns.result2, fake.runTaskReturns.result3
 	}
@@ -100,10 +130,10 @@ func (fake *FakeRunTaskActor) RunTaskCallCount() int {
 	return len(fake.runTaskArgsForCall)
 }
 
-func (fake *FakeRunTaskActor) RunTaskArgsForCall(i int) (string, string, string) {
+func (fake *FakeRunTaskActor) RunTaskArgsForCall(i int) (string, v3action.Task) {
 	fake.runTaskMutex.RLock()
 	defer fake.runTaskMutex.RUnlock()
-	return fake.runTaskArgsForCall[i].appGUID, fake.runTaskArgsForCall[i].command, fake.runTaskArgsForCall[i].name
+	return fake.runTaskArgsForCall[i].appGUID, fake.runTaskArgsForCall[i].task
 }
 
 func (fake *FakeRunTaskActor) RunTaskReturns(result1 v3action.Task, result2 v3action.Warnings, result3 error) {
@@ -115,6 +145,113 @@ func (fake *FakeRunTaskActor) RunTaskReturns(result1 v3action.Task, result2 v3ac
 	}{result1, result2, result3}
 }
 
+func (fake *FakeRunTaskActor) GetTask(taskGUID string) (v3action.Task, v3action.Warnings, error) {
+	fake.getTaskMutex.Lock()
+	fake.getTaskArgsForCall = append(fake.getTaskArgsForCall, struct {
+		taskGUID string
+	}{taskGUID})
+	fake.recordInvocation("GetTask", []interface{}{taskGUID})
+	fake.getTaskMutex.Unlock()
+	if fake.GetTaskStub != nil {
+		return fake.GetTaskStub(taskGUID)
+	} else {
+		return fake.getTaskReturns.result1, fake.getTaskReturns.result2, fake.getTaskReturns.result3
+	}
+}
+
+func (fake *FakeRunTaskActor) GetTaskCallCount() int {
+	fake.getTaskMutex.RLock()
+	defer fake.getTaskMutex.RUnlock()
+	return len(fake.getTaskArgsForCall)
+}
+
+func (fake *FakeRunTaskActor) GetTaskArgsForCall(i int) string {
+	fake.getTaskMutex.RLock()
+	defer fake.getTaskMutex.RUnlock()
+	return fake.getTaskArgsForCall[i].taskGUID
+}
+
+func (fake *FakeRunTaskActor) GetTaskReturns(result1 v3action.Task, result2 v3action.Warnings, result3 error) {
+	fake.GetTaskStub = nil
+	fake.getTaskReturns = struct {
+		result1 v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRunTaskActor) GetStreamingLogsForTasks(appGUID string, client v3action.NOAAClient, config v3action.Config) (<-chan v3action.LogMessage, <-chan error) {
+	fake.getStreamingLogsForTasksMutex.Lock()
+	fake.getStreamingLogsForTasksArgsForCall = append(fake.getStreamingLogsForTasksArgsForCall, struct {
+		appGUID string
+		client  v3action.NOAAClient
+		config  v3action.Config
+	}{appGUID, client, config})
+	fake.recordInvocation("GetStreamingLogsForTasks", []interface{}{appGUID, client, config})
+	fake.getStreamingLogsForTasksMutex.Unlock()
+	if fake.GetStreamingLogsForTasksStub != nil {
+		return fake.GetStreamingLogsForTasksStub(appGUID, client, config)
+	} else {
+		return fake.getStreamingLogsForTasksReturns.result1, fake.getStreamingLogsForTasksReturns.result2
+	}
+}
+
+func (fake *FakeRunTaskActor) GetStreamingLogsForTasksCallCount() int {
+	fake.getStreamingLogsForTasksMutex.RLock()
+	defer fake.getStreamingLogsForTasksMutex.RUnlock()
+	return len(fake.getStreamingLogsForTasksArgsForCall)
+}
+
+func (fake *FakeRunTaskActor) GetStreamingLogsForTasksArgsForCall(i int) (string, v3action.NOAAClient, v3action.Config) {
+	fake.getStreamingLogsForTasksMutex.RLock()
+	defer fake.getStreamingLogsForTasksMutex.RUnlock()
+	return fake.getStreamingLogsForTasksArgsForCall[i].appGUID, fake.getStreamingLogsForTasksArgsForCall[i].client, fake.getStreamingLogsForTasksArgsForCall[i].config
+}
+
+func (fake *FakeRunTaskActor) GetStreamingLogsForTasksReturns(result1 <-chan v3action.LogMessage, result2 <-chan error) {
+	fake.GetStreamingLogsForTasksStub = nil
+	fake.getStreamingLogsForTasksReturns = struct {
+		result1 <-chan v3action.LogMessage
+		result2 <-chan error
+	}{result1, result2}
+}
+
+func (fake *FakeRunTaskActor) GetTaskTemplate(manifestPath string, appName string, templateName string) (v3action.Task, error) {
+	fake.getTaskTemplateMutex.Lock()
+	fake.getTaskTemplateArgsForCall = append(fake.getTaskTemplateArgsForCall, struct {
+		manifestPath string
+		appName      string
+		templateName string
+	}{manifestPath, appName, templateName})
+	fake.recordInvocation("GetTaskTemplate", []interface{}{manifestPath, appName, templateName})
+	fake.getTaskTemplateMutex.Unlock()
+	if fake.GetTaskTemplateStub != nil {
+		return fake.GetTaskTemplateStub(manifestPath, appName, templateName)
+	} else {
+		return fake.getTaskTemplateReturns.result1, fake.getTaskTemplateReturns.result2
+	}
+}
+
+func (fake *FakeRunTaskActor) GetTaskTemplateCallCount() int {
+	fake.getTaskTemplateMutex.RLock()
+	defer fake.getTaskTemplateMutex.RUnlock()
+	return len(fake.getTaskTemplateArgsForCall)
+}
+
+func (fake *FakeRunTaskActor) GetTaskTemplateArgsForCall(i int) (string, string, string) {
+	fake.getTaskTemplateMutex.RLock()
+	defer fake.getTaskTemplateMutex.RUnlock()
+	return fake.getTaskTemplateArgsForCall[i].manifestPath, fake.getTaskTemplateArgsForCall[i].appName, fake.getTaskTemplateArgsForCall[i].templateName
+}
+
+func (fake *FakeRunTaskActor) GetTaskTemplateReturns(result1 v3action.Task, result2 error) {
+	fake.GetTaskTemplateStub = nil
+	fake.getTaskTemplateReturns = struct {
+		result1 v3action.Task
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeRunTaskActor) CloudControllerAPIVersion() string {
 	fake.cloudControllerAPIVersionMutex.Lock()
 	fake.cloudControllerAPIVersionArgsForCall = append(fake.cloudControllerAPIVersionArgsForCall, struct{}{})
@@ -147,6 +284,12 @@ func (fake *FakeRunTaskActor) Invocations() map[string][][]interface{} {
 	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
 	fake.runTaskMutex.RLock()
 	defer fake.runTaskMutex.RUnlock()
+	fake.getTaskMutex.RLock()
+	defer fake.getTaskMutex.RUnlock()
+	fake.getStreamingLogsForTasksMutex.RLock()
+	defer fake.getStreamingLogsForTasksMutex.RUnlock()
+	fake.getTaskTemplateMutex.RLock()
+	defer fake.getTaskTemplateMutex.RUnlock()
 	fake.cloudControllerAPIVersionMutex.RLock()
 	defer fake.cloudControllerAPIVersionMutex.RUnlock()
 	return fake.invocations
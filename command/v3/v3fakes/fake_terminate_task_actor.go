@@ -20,6 +20,18 @@ type FakeTerminateTaskActor struct {
 		result2 v3action.Warnings
 		result3 error
 	}
+	GetApplicationTasksStub        func(appGUID string, sortOrder v3action.SortOrder, filter v3action.TaskFilter) ([]v3action.Task, v3action.Warnings, error)
+	getApplicationTasksMutex       sync.RWMutex
+	getApplicationTasksArgsForCall []struct {
+		appGUID   string
+		sortOrder v3action.SortOrder
+		filter    v3action.TaskFilter
+	}
+	getApplicationTasksReturns struct {
+		result1 []v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}
 	GetTaskBySequenceIDAndApplicationStub        func(sequenceID int, appGUID string) (v3action.Task, v3action.Warnings, error)
 	getTaskBySequenceIDAndApplicationMutex       sync.RWMutex
 	getTaskBySequenceIDAndApplicationArgsForCall []struct {
@@ -87,6 +99,43 @@ func (fake *FakeTerminateTaskActor) GetApplicationByNameAndSpaceReturns(result1
 	}{result1, result2, result3}
 }
 
+func (fake *FakeTerminateTaskActor) GetApplicationTasks(appGUID string, sortOrder v3action.SortOrder, filter v3action.TaskFilter) ([]v3action.Task, v3action.Warnings, error) {
+	fake.getApplicationTasksMutex.Lock()
+	fake.getApplicationTasksArgsForCall = append(fake.getApplicationTasksArgsForCall, struct {
+		appGUID   string
+		sortOrder v3action.SortOrder
+		filter    v3action.TaskFilter
+	}{appGUID, sortOrder, filter})
+	fake.recordInvocation("GetApplicationTasks", []interface{}{appGUID, sortOrder, filter})
+	fake.getApplicationTasksMutex.Unlock()
+	if fake.GetApplicationTasksStub != nil {
+		return fake.GetApplicationTasksStub(appGUID, sortOrder, filter)
+	} else {
+		return fake.getApplicationTasksReturns.result1, fake.getApplicationTasksReturns.result2, fake.getApplicationTasksReturns.result3
+	}
+}
+
+func (fake *FakeTerminateTaskActor) GetApplicationTasksCallCount() int {
+	fake.getApplicationTasksMutex.RLock()
+	defer fake.getApplicationTasksMutex.RUnlock()
+	return len(fake.getApplicationTasksArgsForCall)
+}
+
+func (fake *FakeTerminateTaskActor) GetApplicationTasksArgsForCall(i int) (string, v3action.SortOrder, v3action.TaskFilter) {
+	fake.getApplicationTasksMutex.RLock()
+	defer fake.getApplicationTasksMutex.RUnlock()
+	return fake.getApplicationTasksArgsForCall[i].appGUID, fake.getApplicationTasksArgsForCall[i].sortOrder, fake.getApplicationTasksArgsForCall[i].filter
+}
+
+func (fake *FakeTerminateTaskActor) GetApplicationTasksReturns(result1 []v3action.Task, result2 v3action.Warnings, result3 error) {
+	fake.GetApplicationTasksStub = nil
+	fake.getApplicationTasksReturns = struct {
+		result1 []v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTerminateTaskActor) GetTaskBySequenceIDAndApplication(sequenceID int, appGUID string) (v3action.Task, v3action.Warnings, error) {
 	fake.getTaskBySequenceIDAndApplicationMutex.Lock()
 	fake.getTaskBySequenceIDAndApplicationArgsForCall = append(fake.getTaskBySequenceIDAndApplicationArgsForCall, struct {
@@ -188,6 +237,8 @@ func (fake *FakeTerminateTaskActor) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.getApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getApplicationTasksMutex.RLock()
+	defer fake.getApplicationTasksMutex.RUnlock()
 	fake.getTaskBySequenceIDAndApplicationMutex.RLock()
 	defer fake.getTaskBySequenceIDAndApplicationMutex.RUnlock()
 	fake.terminateTaskMutex.RLock()
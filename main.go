@@ -136,6 +136,7 @@ func isOption(s string) bool {
 func executionWrapper(cmd flags.Commander, args []string) error {
 	cfConfig, err := configv3.LoadConfig(configv3.FlagOverride{
 		Verbose: common.Commands.VerboseOrVersion,
+		Timings: common.Commands.Timings,
 	})
 	if err != nil {
 		return err
@@ -154,7 +155,12 @@ func executionWrapper(cmd flags.Commander, args []string) error {
 			return err
 		}
 
-		err = extendedCmd.Setup(cfConfig, commandUI)
+		requestTimings := command.NewRequestTimings()
+		if cfConfig.Timings() {
+			defer requestTimings.DisplaySummary(commandUI)
+		}
+
+		err = extendedCmd.Setup(command.ConfigWithRequestTimings(cfConfig, requestTimings), commandUI)
 		if err != nil {
 			return handleError(err, commandUI)
 		}
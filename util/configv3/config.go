@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"time"
 
+	"code.cloudfoundry.org/cli/util/credentialhelper"
 	"code.cloudfoundry.org/cli/version"
 )
 
@@ -23,6 +24,14 @@ const (
 	// DefaultDialTimeout is the default timeout for the dail.
 	DefaultDialTimeout = 5 * time.Second
 
+	// DefaultRequestRetries is the default number of times a failed request to
+	// the Cloud Controller or the UAA is retried.
+	DefaultRequestRetries = 2
+
+	// DefaultTokenRefreshWindow is the default time before an access token
+	// expires that it is refreshed.
+	DefaultTokenRefreshWindow = time.Minute
+
 	// DefaultOverallPollingTimeout is the default maximum time that the CLI will
 	// poll a job running on the Cloud Controller. By default it's infinit, which
 	// is represented by MaxInt64.
@@ -90,20 +99,35 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 			config.ConfigFile.UAAOAuthClient = DefaultUAAOAuthClient
 			config.ConfigFile.UAAOAuthClientSecret = DefaultUAAOAuthClientSecret
 		}
+
+		if config.ConfigFile.CredentialHelper != "" {
+			err = config.loadCredentials()
+			if err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	config.ENV = EnvOverride{
-		BinaryName:       filepath.Base(os.Args[0]),
-		CFColor:          os.Getenv("CF_COLOR"),
-		CFPluginHome:     os.Getenv("CF_PLUGIN_HOME"),
-		CFStagingTimeout: os.Getenv("CF_STAGING_TIMEOUT"),
-		CFStartupTimeout: os.Getenv("CF_STARTUP_TIMEOUT"),
-		CFTrace:          os.Getenv("CF_TRACE"),
-		HTTPSProxy:       os.Getenv("https_proxy"),
-		Lang:             os.Getenv("LANG"),
-		LCAll:            os.Getenv("LC_ALL"),
-		Experimental:     os.Getenv("CF_CLI_EXPERIMENTAL"),
-		CFDialTimeout:    os.Getenv("CF_DIAL_TIMEOUT"),
+		BinaryName:           filepath.Base(os.Args[0]),
+		CFColor:              os.Getenv("CF_COLOR"),
+		CFPluginHome:         os.Getenv("CF_PLUGIN_HOME"),
+		CFStagingTimeout:     os.Getenv("CF_STAGING_TIMEOUT"),
+		CFStartupTimeout:     os.Getenv("CF_STARTUP_TIMEOUT"),
+		CFTrace:              os.Getenv("CF_TRACE"),
+		CFTraceHAR:           os.Getenv("CF_TRACE_HAR"),
+		CFTimings:            os.Getenv("CF_TIMINGS"),
+		HTTPProxy:            getEnv("http_proxy", "HTTP_PROXY"),
+		HTTPSProxy:           getEnv("https_proxy", "HTTPS_PROXY"),
+		NoProxy:              getEnv("no_proxy", "NO_PROXY"),
+		Lang:                 os.Getenv("LANG"),
+		LCAll:                os.Getenv("LC_ALL"),
+		Experimental:         os.Getenv("CF_CLI_EXPERIMENTAL"),
+		CFDialTimeout:        os.Getenv("CF_DIAL_TIMEOUT"),
+		CFRequestRetries:     os.Getenv("CF_REQUEST_RETRIES"),
+		CFTokenRefreshWindow: os.Getenv("CF_TOKEN_REFRESH_WINDOW"),
+		CFRecord:             os.Getenv("CF_RECORD"),
+		CFReplay:             os.Getenv("CF_REPLAY"),
 	}
 
 	pluginFilePath := filepath.Join(config.PluginHome(), "config.json")
@@ -132,7 +156,17 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 // location of .cf directory is written in the same way LoadConfig reads .cf
 // directory.
 func WriteConfig(c *Config) error {
-	rawConfig, err := json.MarshalIndent(c.ConfigFile, "", "  ")
+	configFile := c.ConfigFile
+	if configFile.CredentialHelper != "" {
+		err := c.storeCredentials()
+		if err != nil {
+			return err
+		}
+		configFile.AccessToken = ""
+		configFile.RefreshToken = ""
+	}
+
+	rawConfig, err := json.MarshalIndent(configFile, "", "  ")
 	if err != nil {
 		return err
 	}
@@ -158,6 +192,10 @@ type Config struct {
 	Flags FlagOverride
 
 	pluginConfig PluginsConfig
+
+	// credentialStore keeps the tokens in the credential helper, if one is
+	// configured.
+	credentialStore *credentialhelper.Store
 }
 
 // CFConfig represents .cf/config.json
@@ -177,6 +215,9 @@ type CFConfig struct {
 	TargetedOrganization     Organization  `json:"OrganizationFields"`
 	TargetedSpace            Space         `json:"SpaceFields"`
 	SkipSSLValidation        bool          `json:"SSLDisabled"`
+	CACertFile               string        `json:"SSLCACertFile,omitempty"`
+	ClientCertFile           string        `json:"SSLClientCertFile,omitempty"`
+	ClientKeyFile            string        `json:"SSLClientKeyFile,omitempty"`
 	AsyncTimeout             int           `json:"AsyncTimeout"`
 	Trace                    string        `json:"Trace"`
 	ColorEnabled             string        `json:"ColorEnabled"`
@@ -184,6 +225,9 @@ type CFConfig struct {
 	PluginRepos              []PluginRepos `json:"PluginRepos"`
 	MinCLIVersion            string        `json:"MinCLIVersion"`
 	MinRecommendedCLIVersion string        `json:"MinRecommendedCLIVersion"`
+	RequestRetries           *int          `json:"RequestRetries,omitempty"`
+	TokenRefreshWindow       *int          `json:"TokenRefreshWindow,omitempty"`
+	CredentialHelper         string        `json:"CredentialHelper,omitempty"`
 }
 
 // Organization contains basic information about the targeted organization
@@ -215,23 +259,32 @@ type Space struct {
 
 // EnvOverride represents all the environment variables read by the CF CLI
 type EnvOverride struct {
-	BinaryName       string
-	CFColor          string
-	CFHome           string
-	CFPluginHome     string
-	CFStagingTimeout string
-	CFStartupTimeout string
-	CFTrace          string
-	HTTPSProxy       string
-	Lang             string
-	LCAll            string
-	Experimental     string
-	CFDialTimeout    string
+	BinaryName           string
+	CFColor              string
+	CFHome               string
+	CFPluginHome         string
+	CFStagingTimeout     string
+	CFStartupTimeout     string
+	CFTrace              string
+	CFTraceHAR           string
+	CFTimings            string
+	HTTPProxy            string
+	HTTPSProxy           string
+	NoProxy              string
+	Lang                 string
+	LCAll                string
+	Experimental         string
+	CFDialTimeout        string
+	CFRequestRetries     string
+	CFTokenRefreshWindow string
+	CFRecord             string
+	CFReplay             string
 }
 
 // FlagOverride represents all the global flags passed to the CF CLI
 type FlagOverride struct {
 	Verbose bool
+	Timings bool
 }
 
 // Target returns the CC API URL
@@ -261,6 +314,24 @@ func (config *Config) SkipSSLValidation() bool {
 	return config.ConfigFile.SkipSSLValidation
 }
 
+// CACertFile returns the path to the bundle of certificate authorities
+// trusted when targeting an API endpoint
+func (config *Config) CACertFile() string {
+	return config.ConfigFile.CACertFile
+}
+
+// ClientCertFile returns the path to the client certificate presented to the
+// API endpoint
+func (config *Config) ClientCertFile() string {
+	return config.ConfigFile.ClientCertFile
+}
+
+// ClientKeyFile returns the path to the key of the client certificate
+// presented to the API endpoint
+func (config *Config) ClientKeyFile() string {
+	return config.ConfigFile.ClientKeyFile
+}
+
 // AccessToken returns the access token for making authenticated API calls
 func (config *Config) AccessToken() string {
 	return config.ConfigFile.AccessToken
@@ -331,10 +402,20 @@ func (config *Config) StartupTimeout() time.Duration {
 	return DefaultStartupTimeout
 }
 
-// HTTPSProxy returns the proxy url that the CLI should use. The url is based
-// off of:
+// HTTPProxy returns the proxy url that the CLI should use for http
+// connections. The url is based off of:
+//   1. The $http_proxy environment variable if set
+//   2. The $HTTP_PROXY environment variable if set
+//   3. Defaults to the empty string
+func (config *Config) HTTPProxy() string {
+	return config.ENV.HTTPProxy
+}
+
+// HTTPSProxy returns the proxy url that the CLI should use for https,
+// websocket and SSH connections. The url is based off of:
 //   1. The $https_proxy environment variable if set
-//   2. Defaults to the empty string
+//   2. The $HTTPS_PROXY environment variable if set
+//   3. Defaults to the empty string
 func (config *Config) HTTPSProxy() string {
 	if config.ENV.HTTPSProxy != "" {
 		return config.ENV.HTTPSProxy
@@ -343,6 +424,15 @@ func (config *Config) HTTPSProxy() string {
 	return ""
 }
 
+// NoProxy returns the comma separated hosts and domains that the CLI should
+// connect to without a proxy. It is based off of:
+//   1. The $no_proxy environment variable if set
+//   2. The $NO_PROXY environment variable if set
+//   3. Defaults to the empty string
+func (config *Config) NoProxy() string {
+	return config.ENV.NoProxy
+}
+
 // BinaryName returns the running name of the CF CLI
 func (config *Config) BinaryName() string {
 	return config.ENV.BinaryName
@@ -398,6 +488,33 @@ func (config *Config) Verbose() (bool, []string) {
 	return verbose, filePath
 }
 
+// TraceHARFile returns the HAR file that API traffic is recorded to, based
+// off of the $CF_TRACE_HAR environment variable. It is empty if HAR tracing
+// is disabled.
+func (config *Config) TraceHARFile() string {
+	return config.ENV.CFTraceHAR
+}
+
+// Timings returns true if a summary of API request timings should be
+// displayed at the end of a command. This is based off of:
+//   - The '--timings' global flag
+//   - The $CF_TIMINGS environment variable if set
+//   - Defaults to false
+func (config *Config) Timings() bool {
+	if config.Flags.Timings {
+		return true
+	}
+
+	if config.ENV.CFTimings != "" {
+		envVal, err := strconv.ParseBool(config.ENV.CFTimings)
+		if err == nil {
+			return envVal
+		}
+	}
+
+	return false
+}
+
 // DialTimeout returns the timeout to use when dialing. This is based off of:
 //   1. The $CF_DIAL_TIMEOUT environment variable if set
 //   2. Defaults to 5 seconds
@@ -412,6 +529,60 @@ func (config *Config) DialTimeout() time.Duration {
 	return DefaultDialTimeout
 }
 
+// RequestRetries returns how many times a failed request is retried. This is
+// based off of:
+//   1. The $CF_REQUEST_RETRIES environment variable if set
+//   2. The RequestRetries value in the config file if set
+//   3. Defaults to 2
+func (config *Config) RequestRetries() int {
+	if config.ENV.CFRequestRetries != "" {
+		envVal, err := strconv.Atoi(config.ENV.CFRequestRetries)
+		if err == nil && envVal >= 0 {
+			return envVal
+		}
+	}
+
+	if config.ConfigFile.RequestRetries != nil && *config.ConfigFile.RequestRetries >= 0 {
+		return *config.ConfigFile.RequestRetries
+	}
+
+	return DefaultRequestRetries
+}
+
+// TokenRefreshWindow returns how long before it expires an access token is
+// refreshed. This is based off of:
+//   1. The $CF_TOKEN_REFRESH_WINDOW environment variable, in seconds, if set
+//   2. The TokenRefreshWindow value in the config file, in seconds, if set
+//   3. Defaults to 1 minute
+func (config *Config) TokenRefreshWindow() time.Duration {
+	if config.ENV.CFTokenRefreshWindow != "" {
+		envVal, err := strconv.Atoi(config.ENV.CFTokenRefreshWindow)
+		if err == nil && envVal >= 0 {
+			return time.Duration(envVal) * time.Second
+		}
+	}
+
+	if config.ConfigFile.TokenRefreshWindow != nil && *config.ConfigFile.TokenRefreshWindow >= 0 {
+		return time.Duration(*config.ConfigFile.TokenRefreshWindow) * time.Second
+	}
+
+	return DefaultTokenRefreshWindow
+}
+
+// RecordFile returns the cassette file that requests and responses are
+// recorded to, based off of the $CF_RECORD environment variable. It is empty
+// if recording is disabled.
+func (config *Config) RecordFile() string {
+	return config.ENV.CFRecord
+}
+
+// ReplayFile returns the cassette file that responses are served from instead
+// of the network, based off of the $CF_REPLAY environment variable. It is
+// empty if replay is disabled.
+func (config *Config) ReplayFile() string {
+	return config.ENV.CFReplay
+}
+
 func (config *Config) BinaryVersion() string {
 	return version.VersionString()
 }
@@ -456,7 +627,17 @@ func (config *Config) SetTargetInformation(api string, apiVersion string, auth s
 	config.UnsetSpaceInformation()
 }
 
-// SetTokenInformation sets the current token/user information
+// SetCertificateInformation sets the certificate files used to secure
+// connections to the API endpoint
+func (config *Config) SetCertificateInformation(caCertFile string, clientCertFile string, clientKeyFile string) {
+	config.ConfigFile.CACertFile = caCertFile
+	config.ConfigFile.ClientCertFile = clientCertFile
+	config.ConfigFile.ClientKeyFile = clientKeyFile
+}
+
+// SetTokenInformation sets the current token/user information. If a
+// credential helper is configured, the tokens are stored in the helper when
+// the config is written.
 func (config *Config) SetTokenInformation(accessToken string, refreshToken string, sshOAuthClient string) {
 	config.ConfigFile.AccessToken = accessToken
 	config.ConfigFile.RefreshToken = refreshToken
@@ -482,3 +663,14 @@ func (config *Config) UnsetSpaceInformation() {
 func (config *Config) UnsetOrganizationInformation() {
 	config.SetOrganizationInformation("", "")
 }
+
+// getEnv returns the value of the first of the environment variables that is
+// set.
+func getEnv(names ...string) string {
+	for _, name := range names {
+		if value := os.Getenv(name); value != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -7,6 +7,7 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gexec"
 
 	"testing"
 )
@@ -16,6 +17,18 @@ func TestConfig(t *testing.T) {
 	RunSpecs(t, "Config Suite")
 }
 
+var fileHelperPath string
+
+var _ = BeforeSuite(func() {
+	var err error
+	fileHelperPath, err = gexec.Build("code.cloudfoundry.org/cli/util/credentialhelper/cf-credential-file")
+	Expect(err).ToNot(HaveOccurred())
+})
+
+var _ = AfterSuite(func() {
+	gexec.CleanupBuildArtifacts()
+})
+
 func setup() string {
 	homeDir, err := ioutil.TempDir("", "cli-config-tests")
 	Expect(err).NotTo(HaveOccurred())
@@ -222,6 +222,26 @@ var _ = Describe("Config", func() {
 			})
 		})
 
+		Describe("CACertFile, ClientCertFile and ClientKeyFile", func() {
+			var config *Config
+
+			BeforeEach(func() {
+				rawConfig := `{ "SSLCACertFile":"/some/ca.pem", "SSLClientCertFile":"/some/cert.pem", "SSLClientKeyFile":"/some/key.pem" }`
+				setConfig(homeDir, rawConfig)
+
+				var err error
+				config, err = LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config).ToNot(BeNil())
+			})
+
+			It("returns fields directly from config", func() {
+				Expect(config.CACertFile()).To(Equal("/some/ca.pem"))
+				Expect(config.ClientCertFile()).To(Equal("/some/cert.pem"))
+				Expect(config.ClientKeyFile()).To(Equal("/some/key.pem"))
+			})
+		})
+
 		Describe("AccessToken", func() {
 			var config *Config
 
@@ -339,6 +359,9 @@ var _ = Describe("Config", func() {
 				originalCFStagingTimeout string
 				originalCFStartupTimeout string
 				originalHTTPSProxy       string
+				originalHTTPProxy        string
+				originalUpperHTTPProxy   string
+				originalNoProxy          string
 
 				config *Config
 			)
@@ -347,9 +370,15 @@ var _ = Describe("Config", func() {
 				originalCFStagingTimeout = os.Getenv("CF_STAGING_TIMEOUT")
 				originalCFStartupTimeout = os.Getenv("CF_STARTUP_TIMEOUT")
 				originalHTTPSProxy = os.Getenv("https_proxy")
+				originalHTTPProxy = os.Getenv("http_proxy")
+				originalUpperHTTPProxy = os.Getenv("HTTP_PROXY")
+				originalNoProxy = os.Getenv("no_proxy")
 				os.Setenv("CF_STAGING_TIMEOUT", "8675")
 				os.Setenv("CF_STARTUP_TIMEOUT", "309")
 				os.Setenv("https_proxy", "proxy.com")
+				os.Setenv("http_proxy", "")
+				os.Setenv("HTTP_PROXY", "socks5://proxy.com:1080")
+				os.Setenv("no_proxy", ".internal.com")
 
 				var err error
 				config, err = LoadConfig()
@@ -361,12 +390,17 @@ var _ = Describe("Config", func() {
 				os.Setenv("CF_STAGING_TIMEOUT", originalCFStagingTimeout)
 				os.Setenv("CF_STARTUP_TIMEOUT", originalCFStartupTimeout)
 				os.Setenv("https_proxy", originalHTTPSProxy)
+				os.Setenv("http_proxy", originalHTTPProxy)
+				os.Setenv("HTTP_PROXY", originalUpperHTTPProxy)
+				os.Setenv("no_proxy", originalNoProxy)
 			})
 
 			It("overrides specific config values", func() {
 				Expect(config.StagingTimeout()).To(Equal(time.Duration(8675) * time.Minute))
 				Expect(config.StartupTimeout()).To(Equal(time.Duration(309) * time.Minute))
 				Expect(config.HTTPSProxy()).To(Equal("proxy.com"))
+				Expect(config.HTTPProxy()).To(Equal("socks5://proxy.com:1080"))
+				Expect(config.NoProxy()).To(Equal(".internal.com"))
 			})
 		})
 
@@ -498,6 +532,140 @@ var _ = Describe("Config", func() {
 			})
 		})
 
+		DescribeTable("RequestRetries",
+			func(envVal string, configVal string, expected int) {
+				originalRequestRetries := os.Getenv("CF_REQUEST_RETRIES")
+				defer os.Setenv("CF_REQUEST_RETRIES", originalRequestRetries)
+				os.Setenv("CF_REQUEST_RETRIES", envVal)
+
+				rawConfig := "{}"
+				if configVal != "" {
+					rawConfig = fmt.Sprintf(`{ "RequestRetries":%s }`, configVal)
+				}
+				setConfig(homeDir, rawConfig)
+
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config).ToNot(BeNil())
+
+				Expect(config.RequestRetries()).To(Equal(expected))
+			},
+
+			Entry("nothing set: defaults to 2", "", "", DefaultRequestRetries),
+			Entry("config set: uses the config", "", "5", 5),
+			Entry("config set to 0: disables retries", "", "0", 0),
+			Entry("CF_REQUEST_RETRIES set: uses the env", "7", "", 7),
+			Entry("CF_REQUEST_RETRIES and config set: prefers the env", "7", "5", 7),
+			Entry("CF_REQUEST_RETRIES invalid: falls back to the config", "banana", "5", 5),
+			Entry("CF_REQUEST_RETRIES negative: falls back to the default", "-1", "", DefaultRequestRetries),
+		)
+
+		DescribeTable("TokenRefreshWindow",
+			func(envVal string, configVal string, expected time.Duration) {
+				originalTokenRefreshWindow := os.Getenv("CF_TOKEN_REFRESH_WINDOW")
+				defer os.Setenv("CF_TOKEN_REFRESH_WINDOW", originalTokenRefreshWindow)
+				os.Setenv("CF_TOKEN_REFRESH_WINDOW", envVal)
+
+				rawConfig := "{}"
+				if configVal != "" {
+					rawConfig = fmt.Sprintf(`{ "TokenRefreshWindow":%s }`, configVal)
+				}
+				setConfig(homeDir, rawConfig)
+
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config).ToNot(BeNil())
+
+				Expect(config.TokenRefreshWindow()).To(Equal(expected))
+			},
+
+			Entry("nothing set: defaults to 1 minute", "", "", DefaultTokenRefreshWindow),
+			Entry("config set: uses the config", "", "30", 30*time.Second),
+			Entry("CF_TOKEN_REFRESH_WINDOW set: uses the env", "120", "", 2*time.Minute),
+			Entry("CF_TOKEN_REFRESH_WINDOW and config set: prefers the env", "120", "30", 2*time.Minute),
+			Entry("CF_TOKEN_REFRESH_WINDOW invalid: falls back to the config", "banana", "30", 30*time.Second),
+		)
+
+		Describe("TraceHARFile", func() {
+			var originalTraceHAR string
+
+			BeforeEach(func() {
+				originalTraceHAR = os.Getenv("CF_TRACE_HAR")
+			})
+
+			AfterEach(func() {
+				os.Setenv("CF_TRACE_HAR", originalTraceHAR)
+			})
+
+			It("returns the HAR file from CF_TRACE_HAR", func() {
+				os.Setenv("CF_TRACE_HAR", "/some/trace.har")
+
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(config.TraceHARFile()).To(Equal("/some/trace.har"))
+			})
+		})
+
+		DescribeTable("Timings",
+			func(envVal string, flagVal bool, expected bool) {
+				originalTimings := os.Getenv("CF_TIMINGS")
+				defer os.Setenv("CF_TIMINGS", originalTimings)
+				os.Setenv("CF_TIMINGS", envVal)
+
+				config, err := LoadConfig(FlagOverride{Timings: flagVal})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config).ToNot(BeNil())
+
+				Expect(config.Timings()).To(Equal(expected))
+			},
+
+			Entry("nothing set: defaults to false", "", false, false),
+			Entry("--timings set: returns true", "", true, true),
+			Entry("CF_TIMINGS true: returns true", "true", false, true),
+			Entry("CF_TIMINGS false and --timings set: prefers the flag", "false", true, true),
+			Entry("CF_TIMINGS invalid: returns false", "banana", false, false),
+		)
+
+		Describe("RecordFile and ReplayFile", func() {
+			var (
+				originalRecord string
+				originalReplay string
+			)
+
+			BeforeEach(func() {
+				originalRecord = os.Getenv("CF_RECORD")
+				originalReplay = os.Getenv("CF_REPLAY")
+			})
+
+			AfterEach(func() {
+				os.Setenv("CF_RECORD", originalRecord)
+				os.Setenv("CF_REPLAY", originalReplay)
+			})
+
+			It("returns the cassette files from CF_RECORD and CF_REPLAY", func() {
+				os.Setenv("CF_RECORD", "/some/record.json")
+				os.Setenv("CF_REPLAY", "/some/replay.json")
+
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(config.RecordFile()).To(Equal("/some/record.json"))
+				Expect(config.ReplayFile()).To(Equal("/some/replay.json"))
+			})
+
+			It("returns empty paths when the variables are not set", func() {
+				os.Unsetenv("CF_RECORD")
+				os.Unsetenv("CF_REPLAY")
+
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(config.RecordFile()).To(BeEmpty())
+				Expect(config.ReplayFile()).To(BeEmpty())
+			})
+		})
+
 		Describe("BinaryVersion", func() {
 			It("returns back version.BinaryVersion", func() {
 				conf := Config{}
@@ -582,6 +750,17 @@ var _ = Describe("Config", func() {
 			})
 		})
 
+		Describe("SetCertificateInformation", func() {
+			It("sets the certificate files", func() {
+				var config Config
+				config.SetCertificateInformation("/some/ca.pem", "/some/cert.pem", "/some/key.pem")
+
+				Expect(config.ConfigFile.CACertFile).To(Equal("/some/ca.pem"))
+				Expect(config.ConfigFile.ClientCertFile).To(Equal("/some/cert.pem"))
+				Expect(config.ConfigFile.ClientKeyFile).To(Equal("/some/key.pem"))
+			})
+		})
+
 		Describe("SetTokenInformation", func() {
 			It("sets the authentication token information", func() {
 				var config Config
@@ -0,0 +1,40 @@
+package configv3
+
+import "code.cloudfoundry.org/cli/util/credentialhelper"
+
+// CredentialHelper returns the name or path of the credential helper that
+// stores the access and refresh tokens, or "" if they are stored in the
+// config file.
+func (config *Config) CredentialHelper() string {
+	return config.ConfigFile.CredentialHelper
+}
+
+// loadCredentials replaces the tokens read from the config file with the
+// tokens the credential helper holds for the target.
+func (config *Config) loadCredentials() error {
+	config.credentialStore = credentialhelper.NewStore(config.ConfigFile.CredentialHelper)
+	credentials, err := config.credentialStore.Load(config.currentCredentials())
+	if err != nil {
+		return err
+	}
+	config.ConfigFile.AccessToken = credentials.AccessToken
+	config.ConfigFile.RefreshToken = credentials.RefreshToken
+	return nil
+}
+
+// storeCredentials saves the tokens for the current target in the credential
+// helper.
+func (config *Config) storeCredentials() error {
+	if config.credentialStore == nil {
+		config.credentialStore = credentialhelper.NewStore(config.ConfigFile.CredentialHelper)
+	}
+	return config.credentialStore.Save(config.currentCredentials())
+}
+
+func (config *Config) currentCredentials() credentialhelper.Credentials {
+	return credentialhelper.Credentials{
+		ServerURL:    config.ConfigFile.Target,
+		AccessToken:  config.ConfigFile.AccessToken,
+		RefreshToken: config.ConfigFile.RefreshToken,
+	}
+}
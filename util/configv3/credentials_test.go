@@ -0,0 +1,125 @@
+package configv3_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/credentialhelper"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Credential helper", func() {
+	var (
+		homeDir string
+		helper  credentialhelper.Helper
+	)
+
+	readConfigFile := func() CFConfig {
+		rawConfig, err := ioutil.ReadFile(filepath.Join(homeDir, ".cf", "config.json"))
+		Expect(err).ToNot(HaveOccurred())
+		var configFile CFConfig
+		Expect(json.Unmarshal(rawConfig, &configFile)).To(Succeed())
+		return configFile
+	}
+
+	BeforeEach(func() {
+		homeDir = setup()
+		helper = credentialhelper.New(fileHelperPath)
+
+		setConfig(homeDir, fmt.Sprintf(`{
+			"ConfigVersion": 3,
+			"Target": "https://api.example.com",
+			"AccessToken": "bearer file-access-token",
+			"RefreshToken": "file-refresh-token",
+			"CredentialHelper": %q
+		}`, fileHelperPath))
+	})
+
+	AfterEach(func() {
+		teardown(homeDir)
+	})
+
+	Context("when the helper has no tokens for the target", func() {
+		It("moves the tokens from the config file to the helper when the config is written", func() {
+			config, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.CredentialHelper()).To(Equal(fileHelperPath))
+			Expect(config.AccessToken()).To(Equal("bearer file-access-token"))
+
+			Expect(WriteConfig(config)).To(Succeed())
+
+			configFile := readConfigFile()
+			Expect(configFile.AccessToken).To(BeEmpty())
+			Expect(configFile.RefreshToken).To(BeEmpty())
+
+			credentials, err := helper.Get("https://api.example.com")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(credentials.AccessToken).To(Equal("bearer file-access-token"))
+			Expect(credentials.RefreshToken).To(Equal("file-refresh-token"))
+		})
+	})
+
+	Context("when the helper cannot be run", func() {
+		BeforeEach(func() {
+			setConfig(homeDir, fmt.Sprintf(`{
+				"ConfigVersion": 3,
+				"Target": "https://api.example.com",
+				"AccessToken": "bearer file-access-token",
+				"CredentialHelper": %q
+			}`, filepath.Join(homeDir, "does-not-exist")))
+		})
+
+		It("returns the helper's error", func() {
+			_, err := LoadConfig()
+			Expect(err).To(BeAssignableToTypeOf(credentialhelper.HelperError{}))
+			Expect(err.Error()).To(ContainSubstring(filepath.Join(homeDir, "does-not-exist")))
+		})
+	})
+
+	Context("when the helper has tokens for the target", func() {
+		BeforeEach(func() {
+			err := helper.Store(credentialhelper.Credentials{
+				ServerURL:    "https://api.example.com",
+				AccessToken:  "bearer helper-access-token",
+				RefreshToken: "helper-refresh-token",
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("reads the tokens from the helper", func() {
+			config, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.AccessToken()).To(Equal("bearer helper-access-token"))
+			Expect(config.RefreshToken()).To(Equal("helper-refresh-token"))
+		})
+
+		It("stores new tokens in the helper", func() {
+			config, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			config.SetAccessToken("bearer new-access-token")
+			Expect(WriteConfig(config)).To(Succeed())
+
+			Expect(readConfigFile().AccessToken).To(BeEmpty())
+			credentials, err := helper.Get("https://api.example.com")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(credentials.AccessToken).To(Equal("bearer new-access-token"))
+			Expect(credentials.RefreshToken).To(Equal("helper-refresh-token"))
+		})
+
+		It("erases the tokens from the helper when they are cleared", func() {
+			config, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			config.SetTokenInformation("", "", "ssh-oauth-client-id")
+			Expect(WriteConfig(config)).To(Succeed())
+
+			credentials, err := helper.Get("https://api.example.com")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(credentials.Empty()).To(BeTrue())
+		})
+	})
+})
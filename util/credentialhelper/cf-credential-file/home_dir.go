@@ -0,0 +1,14 @@
+// +build !windows
+
+package main
+
+import "os"
+
+// homeDirectory returns the directory that holds the CLI's .cf directory,
+// found the same way as by the CLI.
+func homeDirectory() string {
+	if cfHome := os.Getenv("CF_HOME"); cfHome != "" {
+		return cfHome
+	}
+	return os.Getenv("HOME")
+}
@@ -0,0 +1,18 @@
+// +build windows
+
+package main
+
+import "os"
+
+// homeDirectory returns the directory that holds the CLI's .cf directory,
+// found the same way as by the CLI.
+func homeDirectory() string {
+	switch {
+	case os.Getenv("CF_HOME") != "":
+		return os.Getenv("CF_HOME")
+	case os.Getenv("HOMEDRIVE")+os.Getenv("HOMEPATH") != "":
+		return os.Getenv("HOMEDRIVE") + os.Getenv("HOMEPATH")
+	default:
+		return os.Getenv("USERPROFILE")
+	}
+}
@@ -0,0 +1,33 @@
+// cf-credential-file is the reference credential helper. It keeps tokens in
+// the file named by $CF_CREDENTIAL_FILE, or in credentials.json next to the
+// CLI's config file. It stores tokens in plain text, like the config file, and
+// is meant for testing and as an example for helpers backed by secret stores.
+//
+// Usage: cf-credential-file get|store|erase
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/util/credentialhelper"
+)
+
+func main() {
+	if len(os.Args) != 2 {
+		fmt.Fprintln(os.Stderr, "usage: cf-credential-file get|store|erase")
+		os.Exit(1)
+	}
+
+	path := os.Getenv("CF_CREDENTIAL_FILE")
+	if path == "" {
+		path = filepath.Join(homeDirectory(), ".cf", "credentials.json")
+	}
+
+	err := credentialhelper.NewFileStore(path).Serve(os.Args[1], os.Stdin, os.Stdout)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,136 @@
+// Package credentialhelper stores access and refresh tokens outside of the
+// CLI's config file, in an external credential helper.
+//
+// A credential helper is an executable that is run with one of the actions
+// "get", "store" or "erase" as its only argument. It reads a JSON encoded
+// Credentials object from stdin; for "get" and "erase" only the ServerURL is
+// set. For "get" it writes the Credentials stored for the ServerURL to
+// stdout, or an empty object if there are none. It exits non-zero, with a
+// message on stderr, if the action fails.
+//
+// A helper configured by name, such as "file", is run as the executable
+// "cf-credential-file" found on the PATH. A helper configured as a path is
+// run as is.
+package credentialhelper
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// GetAction gets the credentials stored for a server.
+	GetAction = "get"
+
+	// StoreAction stores the credentials for a server.
+	StoreAction = "store"
+
+	// EraseAction erases the credentials stored for a server.
+	EraseAction = "erase"
+
+	// ExecutablePrefix is prepended to the name of a helper to get the name
+	// of its executable.
+	ExecutablePrefix = "cf-credential-"
+)
+
+// Credentials are the tokens for a Cloud Foundry API.
+type Credentials struct {
+	ServerURL    string `json:"ServerURL"`
+	AccessToken  string `json:"AccessToken,omitempty"`
+	RefreshToken string `json:"RefreshToken,omitempty"`
+}
+
+// Empty returns true if there are no tokens in the credentials.
+func (credentials Credentials) Empty() bool {
+	return credentials.AccessToken == "" && credentials.RefreshToken == ""
+}
+
+// HelperError is returned when a credential helper fails.
+type HelperError struct {
+	Executable string
+	Action     string
+	Message    string
+}
+
+func (e HelperError) Error() string {
+	return fmt.Sprintf("credential helper %s failed to %s credentials: %s", e.Executable, e.Action, e.Message)
+}
+
+// Helper runs a credential helper.
+type Helper struct {
+	// Executable is the name or path of the helper's executable.
+	Executable string
+}
+
+// New returns a Helper for the helper configured as name.
+func New(name string) Helper {
+	executable := name
+	if filepath.Base(name) == name {
+		executable = ExecutablePrefix + name
+	}
+	return Helper{Executable: executable}
+}
+
+// Get returns the credentials stored for serverURL. The credentials are empty
+// if none are stored.
+func (helper Helper) Get(serverURL string) (Credentials, error) {
+	output, err := helper.run(GetAction, Credentials{ServerURL: serverURL})
+	if err != nil {
+		return Credentials{}, err
+	}
+
+	var credentials Credentials
+	err = json.Unmarshal(output, &credentials)
+	if err != nil {
+		return Credentials{}, HelperError{
+			Executable: helper.Executable,
+			Action:     GetAction,
+			Message:    fmt.Sprintf("invalid response: %s", err),
+		}
+	}
+	credentials.ServerURL = serverURL
+	return credentials, nil
+}
+
+// Store stores credentials for their ServerURL.
+func (helper Helper) Store(credentials Credentials) error {
+	_, err := helper.run(StoreAction, credentials)
+	return err
+}
+
+// Erase erases the credentials stored for serverURL.
+func (helper Helper) Erase(serverURL string) error {
+	_, err := helper.run(EraseAction, Credentials{ServerURL: serverURL})
+	return err
+}
+
+func (helper Helper) run(action string, input Credentials) ([]byte, error) {
+	rawInput, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(helper.Executable, action)
+	cmd.Stdin = bytes.NewReader(rawInput)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err = cmd.Run()
+	if err != nil {
+		message := strings.TrimSpace(stderr.String())
+		if message == "" {
+			message = err.Error()
+		}
+		return nil, HelperError{
+			Executable: helper.Executable,
+			Action:     action,
+			Message:    message,
+		}
+	}
+	return stdout.Bytes(), nil
+}
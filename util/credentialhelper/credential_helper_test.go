@@ -0,0 +1,101 @@
+package credentialhelper_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/credentialhelper"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Helper", func() {
+	var (
+		tempDir string
+		helper  Helper
+	)
+
+	BeforeEach(func() {
+		var err error
+		tempDir, err = ioutil.TempDir("", "cli-credential-helper")
+		Expect(err).ToNot(HaveOccurred())
+		os.Setenv("CF_CREDENTIAL_FILE", filepath.Join(tempDir, "credentials.json"))
+
+		helper = New(fileHelperPath)
+	})
+
+	AfterEach(func() {
+		os.Unsetenv("CF_CREDENTIAL_FILE")
+		os.RemoveAll(tempDir)
+	})
+
+	Describe("New", func() {
+		It("runs a helper configured by name as cf-credential-<name>", func() {
+			Expect(New("file").Executable).To(Equal("cf-credential-file"))
+		})
+
+		It("runs a helper configured as a path as is", func() {
+			Expect(New("/usr/local/bin/keychain").Executable).To(Equal("/usr/local/bin/keychain"))
+		})
+	})
+
+	It("stores, gets and erases the credentials for a server", func() {
+		credentials, err := helper.Get("https://api.example.com")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(credentials).To(Equal(Credentials{ServerURL: "https://api.example.com"}))
+		Expect(credentials.Empty()).To(BeTrue())
+
+		err = helper.Store(Credentials{
+			ServerURL:    "https://api.example.com",
+			AccessToken:  "bearer some-access-token",
+			RefreshToken: "some-refresh-token",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		err = helper.Store(Credentials{
+			ServerURL:   "https://api.other.example.com",
+			AccessToken: "bearer other-access-token",
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		credentials, err = helper.Get("https://api.example.com")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(credentials).To(Equal(Credentials{
+			ServerURL:    "https://api.example.com",
+			AccessToken:  "bearer some-access-token",
+			RefreshToken: "some-refresh-token",
+		}))
+
+		err = helper.Erase("https://api.example.com")
+		Expect(err).ToNot(HaveOccurred())
+
+		credentials, err = helper.Get("https://api.example.com")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(credentials.Empty()).To(BeTrue())
+
+		credentials, err = helper.Get("https://api.other.example.com")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(credentials.AccessToken).To(Equal("bearer other-access-token"))
+	})
+
+	Context("when the helper fails", func() {
+		It("returns a HelperError with the helper's message", func() {
+			err := helper.Store(Credentials{AccessToken: "bearer some-access-token"})
+			Expect(err).To(MatchError(HelperError{
+				Executable: fileHelperPath,
+				Action:     StoreAction,
+				Message:    "invalid request: ServerURL is required",
+			}))
+		})
+	})
+
+	Context("when the helper cannot be run", func() {
+		It("returns a HelperError", func() {
+			helper = New(filepath.Join(tempDir, "does-not-exist"))
+			_, err := helper.Get("https://api.example.com")
+			Expect(err).To(BeAssignableToTypeOf(HelperError{}))
+			Expect(err.Error()).To(HavePrefix("credential helper " + helper.Executable + " failed to get credentials: "))
+		})
+	})
+})
@@ -0,0 +1,26 @@
+package credentialhelper_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gexec"
+
+	"testing"
+)
+
+func TestCredentialHelper(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Credential Helper Suite")
+}
+
+var fileHelperPath string
+
+var _ = BeforeSuite(func() {
+	var err error
+	fileHelperPath, err = gexec.Build("code.cloudfoundry.org/cli/util/credentialhelper/cf-credential-file")
+	Expect(err).ToNot(HaveOccurred())
+})
+
+var _ = AfterSuite(func() {
+	gexec.CleanupBuildArtifacts()
+})
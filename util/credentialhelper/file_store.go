@@ -0,0 +1,109 @@
+package credentialhelper
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// FileStore keeps credentials in a JSON file, readable only by its owner. It
+// implements the credential helper protocol for the reference "file" helper,
+// which is meant for testing and as an example for helpers backed by real
+// secret stores.
+type FileStore struct {
+	Path string
+}
+
+// NewFileStore returns a FileStore that keeps credentials in path.
+func NewFileStore(path string) FileStore {
+	return FileStore{Path: path}
+}
+
+// Serve performs action with the request read from input, writing the
+// response, if any, to output.
+func (store FileStore) Serve(action string, input io.Reader, output io.Writer) error {
+	var request Credentials
+	err := json.NewDecoder(input).Decode(&request)
+	if err != nil {
+		return fmt.Errorf("invalid request: %s", err)
+	}
+	if request.ServerURL == "" {
+		return fmt.Errorf("invalid request: ServerURL is required")
+	}
+
+	allCredentials, err := store.read()
+	if err != nil {
+		return err
+	}
+
+	switch action {
+	case GetAction:
+		return json.NewEncoder(output).Encode(allCredentials[request.ServerURL])
+	case StoreAction:
+		allCredentials[request.ServerURL] = request
+		return store.write(allCredentials)
+	case EraseAction:
+		delete(allCredentials, request.ServerURL)
+		return store.write(allCredentials)
+	default:
+		return fmt.Errorf("unknown action %q", action)
+	}
+}
+
+func (store FileStore) read() (map[string]Credentials, error) {
+	allCredentials := map[string]Credentials{}
+
+	rawCredentials, err := ioutil.ReadFile(store.Path)
+	if os.IsNotExist(err) {
+		return allCredentials, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(rawCredentials, &allCredentials)
+	if err != nil {
+		return nil, fmt.Errorf("invalid credentials file %s: %s", store.Path, err)
+	}
+	return allCredentials, nil
+}
+
+// write replaces the file with allCredentials. The credentials are written to
+// a temporary file, which is only readable by its owner, and renamed over the
+// file, so that an existing file with looser permissions is not reused and a
+// failed write does not lose the stored credentials.
+func (store FileStore) write(allCredentials map[string]Credentials) error {
+	rawCredentials, err := json.MarshalIndent(allCredentials, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(store.Path)
+	err = os.MkdirAll(dir, 0700)
+	if err != nil {
+		return err
+	}
+
+	tempFile, err := ioutil.TempFile(dir, filepath.Base(store.Path))
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tempFile.Name())
+
+	_, err = tempFile.Write(rawCredentials)
+	if closeErr := tempFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	err = os.Chmod(tempFile.Name(), 0600)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tempFile.Name(), store.Path)
+}
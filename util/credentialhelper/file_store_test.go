@@ -0,0 +1,88 @@
+package credentialhelper_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "code.cloudfoundry.org/cli/util/credentialhelper"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("FileStore", func() {
+	var (
+		tempDir string
+		store   FileStore
+		output  *bytes.Buffer
+	)
+
+	BeforeEach(func() {
+		var err error
+		tempDir, err = ioutil.TempDir("", "cli-credential-file-store")
+		Expect(err).ToNot(HaveOccurred())
+
+		store = NewFileStore(filepath.Join(tempDir, "cf", "credentials.json"))
+		output = new(bytes.Buffer)
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tempDir)
+	})
+
+	It("keeps the credentials in a file readable only by its owner", func() {
+		err := store.Serve(StoreAction, strings.NewReader(`{"ServerURL":"https://api.example.com","AccessToken":"bearer some-access-token"}`), output)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(output.Len()).To(BeZero())
+
+		info, err := os.Stat(store.Path)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+
+		err = store.Serve(GetAction, strings.NewReader(`{"ServerURL":"https://api.example.com"}`), output)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(output).To(MatchJSON(`{"ServerURL":"https://api.example.com","AccessToken":"bearer some-access-token"}`))
+	})
+
+	Context("when the file is readable by others", func() {
+		BeforeEach(func() {
+			Expect(os.MkdirAll(filepath.Dir(store.Path), 0700)).To(Succeed())
+			Expect(ioutil.WriteFile(store.Path, []byte(`{}`), 0644)).To(Succeed())
+			Expect(os.Chmod(store.Path, 0644)).To(Succeed())
+		})
+
+		It("makes it readable only by its owner when credentials are stored", func() {
+			err := store.Serve(StoreAction, strings.NewReader(`{"ServerURL":"https://api.example.com","AccessToken":"bearer some-access-token"}`), output)
+			Expect(err).ToNot(HaveOccurred())
+
+			info, err := os.Stat(store.Path)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+		})
+	})
+
+	Context("when no credentials are stored for the server", func() {
+		It("writes empty credentials", func() {
+			err := store.Serve(GetAction, strings.NewReader(`{"ServerURL":"https://api.example.com"}`), output)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(output).To(MatchJSON(`{"ServerURL":""}`))
+		})
+	})
+
+	Context("when the request is invalid", func() {
+		It("returns an error", func() {
+			err := store.Serve(GetAction, strings.NewReader(`not-json`), output)
+			Expect(err).To(MatchError(HavePrefix("invalid request: ")))
+		})
+	})
+
+	Context("when the action is unknown", func() {
+		It("returns an error", func() {
+			err := store.Serve("list", strings.NewReader(`{"ServerURL":"https://api.example.com"}`), output)
+			Expect(err).To(MatchError(`unknown action "list"`))
+		})
+	})
+})
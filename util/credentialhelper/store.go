@@ -0,0 +1,58 @@
+package credentialhelper
+
+// Store keeps the tokens of a CLI config in a credential helper. It remembers
+// the credentials the helper holds, so that the helper is only run to store
+// or erase them when they change.
+type Store struct {
+	helper Helper
+	stored Credentials
+}
+
+// NewStore returns a Store for the helper configured as name.
+func NewStore(name string) *Store {
+	return &Store{helper: New(name)}
+}
+
+// Load returns the tokens the helper holds for the ServerURL of current,
+// which are the tokens found in the config file. If the helper holds no
+// tokens for the server, current is returned, so that tokens left in the
+// config file are moved to the helper the next time they are saved. A
+// HelperError is returned if the helper cannot be read.
+func (store *Store) Load(current Credentials) (Credentials, error) {
+	credentials, err := store.helper.Get(current.ServerURL)
+	if err != nil {
+		return Credentials{}, err
+	}
+
+	store.stored = credentials
+	if credentials.Empty() {
+		return current, nil
+	}
+	return credentials, nil
+}
+
+// Save stores current in the helper, unless the helper already holds them.
+// Tokens that have been cleared, or that belong to the previous server, are
+// erased from the helper.
+func (store *Store) Save(current Credentials) error {
+	if current == store.stored {
+		return nil
+	}
+
+	stored := store.stored
+	if !stored.Empty() && (current.Empty() || stored.ServerURL != current.ServerURL) {
+		err := store.helper.Erase(stored.ServerURL)
+		if err != nil {
+			return err
+		}
+	}
+	if !current.Empty() {
+		err := store.helper.Store(current)
+		if err != nil {
+			return err
+		}
+	}
+
+	store.stored = current
+	return nil
+}
@@ -0,0 +1,118 @@
+package credentialhelper_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/credentialhelper"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Store", func() {
+	var (
+		tempDir     string
+		helper      Helper
+		store       *Store
+		fileTokens  Credentials
+		helperCreds Credentials
+	)
+
+	BeforeEach(func() {
+		var err error
+		tempDir, err = ioutil.TempDir("", "cli-credential-store")
+		Expect(err).ToNot(HaveOccurred())
+		os.Setenv("CF_CREDENTIAL_FILE", filepath.Join(tempDir, "credentials.json"))
+
+		helper = New(fileHelperPath)
+		store = NewStore(fileHelperPath)
+
+		fileTokens = Credentials{
+			ServerURL:    "https://api.example.com",
+			AccessToken:  "bearer file-access-token",
+			RefreshToken: "file-refresh-token",
+		}
+		helperCreds = Credentials{
+			ServerURL:    "https://api.example.com",
+			AccessToken:  "bearer helper-access-token",
+			RefreshToken: "helper-refresh-token",
+		}
+	})
+
+	AfterEach(func() {
+		os.Unsetenv("CF_CREDENTIAL_FILE")
+		os.RemoveAll(tempDir)
+	})
+
+	Describe("Load", func() {
+		Context("when the helper holds tokens for the server", func() {
+			BeforeEach(func() {
+				Expect(helper.Store(helperCreds)).To(Succeed())
+			})
+
+			It("returns the helper's tokens", func() {
+				credentials, err := store.Load(fileTokens)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(credentials).To(Equal(helperCreds))
+			})
+		})
+
+		Context("when the helper holds no tokens for the server", func() {
+			It("returns the tokens from the config file and moves them to the helper when saved", func() {
+				credentials, err := store.Load(fileTokens)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(credentials).To(Equal(fileTokens))
+
+				Expect(store.Save(fileTokens)).To(Succeed())
+				Expect(helper.Get(fileTokens.ServerURL)).To(Equal(fileTokens))
+			})
+		})
+
+		Context("when the helper cannot be run", func() {
+			BeforeEach(func() {
+				store = NewStore(filepath.Join(tempDir, "does-not-exist"))
+			})
+
+			It("returns a HelperError naming the helper", func() {
+				_, err := store.Load(fileTokens)
+				Expect(err).To(BeAssignableToTypeOf(HelperError{}))
+				Expect(err.(HelperError).Executable).To(Equal(filepath.Join(tempDir, "does-not-exist")))
+			})
+		})
+	})
+
+	Describe("Save", func() {
+		BeforeEach(func() {
+			Expect(helper.Store(helperCreds)).To(Succeed())
+			_, err := store.Load(fileTokens)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("stores changed tokens", func() {
+			newCreds := helperCreds
+			newCreds.AccessToken = "bearer new-access-token"
+			Expect(store.Save(newCreds)).To(Succeed())
+			Expect(helper.Get(newCreds.ServerURL)).To(Equal(newCreds))
+		})
+
+		It("erases cleared tokens", func() {
+			Expect(store.Save(Credentials{ServerURL: helperCreds.ServerURL})).To(Succeed())
+
+			credentials, err := helper.Get(helperCreds.ServerURL)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(credentials.Empty()).To(BeTrue())
+		})
+
+		It("erases the tokens of the previous server when the target changes", func() {
+			otherCreds := Credentials{ServerURL: "https://api.other.com", AccessToken: "bearer other-access-token"}
+			Expect(store.Save(otherCreds)).To(Succeed())
+
+			credentials, err := helper.Get(helperCreds.ServerURL)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(credentials.Empty()).To(BeTrue())
+			Expect(helper.Get(otherCreds.ServerURL)).To(Equal(otherCreds))
+		})
+	})
+})
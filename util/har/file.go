@@ -0,0 +1,146 @@
+package har
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"sync"
+
+	"code.cloudfoundry.org/cli/version"
+)
+
+// tailSize is how much of the end of an existing archive is read to find
+// where its entries end.
+const tailSize = 64
+
+// ErrNotArchive is returned when an existing file does not end like a HAR
+// archive, so entries cannot be appended to it.
+var ErrNotArchive = errors.New("not a HAR archive")
+
+// writeMutex serializes writes from every File, since the Cloud Controller,
+// UAA and logging clients of a command record to the same path concurrently.
+var writeMutex sync.Mutex
+
+// File appends entries to a HAR file on disk. If the file already exists,
+// new entries are added to the entries it contains, so that several commands
+// can be traced to the same file. Only the end of the file is rewritten for
+// each entry, and the file is a complete archive after every entry.
+type File struct {
+	path           string
+	isSecretHeader func(name string) bool
+}
+
+// NewFile returns a File that writes to path. The values of headers for which
+// isSecretHeader returns true are redacted.
+func NewFile(path string, isSecretHeader func(name string) bool) *File {
+	return &File{
+		path:           path,
+		isSecretHeader: isSecretHeader,
+	}
+}
+
+// AddEntry appends entry to the HAR file.
+func (file *File) AddEntry(entry Entry) error {
+	file.redact(entry.Request.Headers)
+	file.redact(entry.Response.Headers)
+
+	rawEntry, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	writeMutex.Lock()
+	defer writeMutex.Unlock()
+
+	harFile, err := os.OpenFile(file.path, os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return err
+	}
+	defer harFile.Close()
+
+	info, err := harFile.Stat()
+	if err != nil {
+		return err
+	}
+	if info.Size() == 0 {
+		err = writeEmptyArchive(harFile)
+		if err != nil {
+			return err
+		}
+	}
+
+	offset, empty, err := entriesEnd(harFile)
+	if err != nil {
+		return err
+	}
+
+	separator := ",\n"
+	if empty {
+		separator = "\n"
+	}
+
+	_, err = harFile.WriteAt([]byte(separator+string(rawEntry)+"\n]}}\n"), offset)
+	return err
+}
+
+func (file *File) redact(headers []NameValue) {
+	if file.isSecretHeader == nil {
+		return
+	}
+	for i, header := range headers {
+		if file.isSecretHeader(header.Name) {
+			headers[i].Value = RedactedValue
+		}
+	}
+}
+
+func writeEmptyArchive(harFile *os.File) error {
+	raw, err := json.Marshal(Archive{
+		Log: Log{
+			Version: Version,
+			Creator: Creator{Name: "cf", Version: version.VersionString()},
+			Entries: []Entry{},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = harFile.Write(raw)
+	return err
+}
+
+// entriesEnd returns the offset of the bracket that closes the entries of the
+// archive, which must be followed by nothing but the end of the log and of
+// the archive, and whether the archive has no entries yet.
+func entriesEnd(harFile *os.File) (int64, bool, error) {
+	info, err := harFile.Stat()
+	if err != nil {
+		return 0, false, err
+	}
+
+	start := info.Size() - tailSize
+	if start < 0 {
+		start = 0
+	}
+	tail := make([]byte, info.Size()-start)
+	_, err = harFile.ReadAt(tail, start)
+	if err != nil && err != io.EOF {
+		return 0, false, err
+	}
+
+	end := len(tail)
+	for _, closing := range []byte("}}]") {
+		end = len(bytes.TrimRight(tail[:end], " \t\r\n"))
+		if end == 0 || tail[end-1] != closing {
+			return 0, false, ErrNotArchive
+		}
+		end--
+	}
+
+	trimmed := bytes.TrimRight(tail[:end], " \t\r\n")
+	empty := len(trimmed) > 0 && trimmed[len(trimmed)-1] == '['
+	return start + int64(end), empty, nil
+}
@@ -0,0 +1,126 @@
+package har_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/har"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("File", func() {
+	var (
+		tmpdir  string
+		harPath string
+		file    *File
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpdir, err = ioutil.TempDir("", "har_file")
+		Expect(err).ToNot(HaveOccurred())
+
+		harPath = filepath.Join(tmpdir, "trace.har")
+		file = NewFile(harPath, func(name string) bool {
+			return name == "Authorization"
+		})
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tmpdir)
+	})
+
+	readArchive := func() Archive {
+		raw, err := ioutil.ReadFile(harPath)
+		Expect(err).ToNot(HaveOccurred())
+
+		var archive Archive
+		Expect(json.Unmarshal(raw, &archive)).To(Succeed())
+		return archive
+	}
+
+	Describe("AddEntry", func() {
+		It("creates a HAR 1.2 archive containing the entry", func() {
+			Expect(file.AddEntry(Entry{Comment: "first"})).To(Succeed())
+
+			archive := readArchive()
+			Expect(archive.Log.Version).To(Equal("1.2"))
+			Expect(archive.Log.Creator.Name).To(Equal("cf"))
+			Expect(archive.Log.Creator.Version).ToNot(BeEmpty())
+			Expect(archive.Log.Entries).To(HaveLen(1))
+			Expect(archive.Log.Entries[0].Comment).To(Equal("first"))
+		})
+
+		It("appends entries to an existing archive", func() {
+			Expect(file.AddEntry(Entry{Comment: "first"})).To(Succeed())
+			Expect(readArchive().Log.Entries).To(HaveLen(1))
+			Expect(NewFile(harPath, nil).AddEntry(Entry{Comment: "second"})).To(Succeed())
+			Expect(file.AddEntry(Entry{Comment: "third"})).To(Succeed())
+
+			entries := readArchive().Log.Entries
+			Expect(entries).To(HaveLen(3))
+			Expect(entries[0].Comment).To(Equal("first"))
+			Expect(entries[1].Comment).To(Equal("second"))
+			Expect(entries[2].Comment).To(Equal("third"))
+		})
+
+		It("redacts the values of secret headers", func() {
+			Expect(file.AddEntry(Entry{
+				Request: Request{Headers: []NameValue{
+					{Name: "Authorization", Value: "bearer some-token"},
+					{Name: "Content-Type", Value: "application/json"},
+				}},
+				Response: Response{Headers: []NameValue{
+					{Name: "Authorization", Value: "some-other-token"},
+				}},
+			})).To(Succeed())
+
+			entry := readArchive().Log.Entries[0]
+			Expect(entry.Request.Headers).To(Equal([]NameValue{
+				{Name: "Authorization", Value: RedactedValue},
+				{Name: "Content-Type", Value: "application/json"},
+			}))
+			Expect(entry.Response.Headers).To(Equal([]NameValue{
+				{Name: "Authorization", Value: RedactedValue},
+			}))
+		})
+
+		Context("when the existing archive is indented", func() {
+			BeforeEach(func() {
+				raw, err := json.MarshalIndent(Archive{Log: Log{
+					Version: Version,
+					Entries: []Entry{{Comment: "first"}},
+				}}, "", "  ")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ioutil.WriteFile(harPath, append(raw, '\n'), 0600)).To(Succeed())
+			})
+
+			It("appends to its entries", func() {
+				Expect(file.AddEntry(Entry{Comment: "second"})).To(Succeed())
+
+				entries := readArchive().Log.Entries
+				Expect(entries).To(HaveLen(2))
+				Expect(entries[0].Comment).To(Equal("first"))
+				Expect(entries[1].Comment).To(Equal("second"))
+			})
+		})
+
+		Context("when the existing file is not a HAR archive", func() {
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(harPath, []byte("not-json"), 0600)).To(Succeed())
+			})
+
+			It("returns an error and leaves the file alone", func() {
+				Expect(file.AddEntry(Entry{})).To(MatchError(ErrNotArchive))
+
+				raw, err := ioutil.ReadFile(harPath)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(raw)).To(Equal("not-json"))
+			})
+		})
+	})
+})
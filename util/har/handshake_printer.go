@@ -0,0 +1,135 @@
+package har
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DebugPrinter is the interface NOAA consumers use to print their websocket
+// handshakes.
+type DebugPrinter interface {
+	Print(title, dump string)
+}
+
+// HandshakePrinter is a DebugPrinter that records the websocket handshakes
+// printed by a NOAA consumer to a HAR file, and passes everything on to the
+// wrapped printer.
+type HandshakePrinter struct {
+	file    *File
+	printer DebugPrinter
+
+	mutex   sync.Mutex
+	request *http.Request
+	started time.Time
+}
+
+// NewHandshakePrinter returns a HandshakePrinter that records to file. The
+// wrapped printer can be nil.
+func NewHandshakePrinter(file *File, printer DebugPrinter) *HandshakePrinter {
+	return &HandshakePrinter{
+		file:    file,
+		printer: printer,
+	}
+}
+
+// Print records handshake requests and responses and passes the dump on to
+// the wrapped printer.
+func (printer *HandshakePrinter) Print(title, dump string) {
+	if printer.printer != nil {
+		printer.printer.Print(title, dump)
+	}
+
+	printer.mutex.Lock()
+	defer printer.mutex.Unlock()
+
+	switch title {
+	case "WEBSOCKET REQUEST:":
+		printer.request = parseHandshakeRequest(dump)
+		printer.started = time.Now()
+	case "WEBSOCKET RESPONSE:":
+		if printer.request == nil {
+			return
+		}
+		elapsed := milliseconds(time.Since(printer.started))
+
+		// Printing cannot fail, so the handshake is left out of the archive if
+		// it cannot be written.
+		printer.file.AddEntry(Entry{
+			StartedDateTime: printer.started,
+			Time:            elapsed,
+			Request:         NewRequest(printer.request, ""),
+			Response:        NewResponse(parseHandshakeResponse(dump), ""),
+			Timings: Timings{
+				Blocked: -1,
+				DNS:     -1,
+				Connect: -1,
+				Wait:    elapsed,
+				SSL:     -1,
+			},
+			Comment: "websocket handshake",
+		})
+		printer.request = nil
+	}
+}
+
+// parseHandshakeRequest parses the request dump of a NOAA consumer, whose Host
+// line holds the full traffic controller URL.
+func parseHandshakeRequest(dump string) *http.Request {
+	lines := strings.Split(strings.TrimSpace(dump), "\n")
+	requestLine := strings.SplitN(lines[0], " ", 3)
+
+	request := &http.Request{
+		Method: requestLine[0],
+		Proto:  "HTTP/1.1",
+		Header: parseHeaders(lines[1:]),
+	}
+	path := ""
+	if len(requestLine) > 1 {
+		path = requestLine[1]
+	}
+	if len(requestLine) > 2 {
+		request.Proto = requestLine[2]
+	}
+
+	host := request.Header.Get("Host")
+	request.Header.Del("Host")
+	requestURL, err := url.Parse(host + path)
+	if err != nil {
+		requestURL = &url.URL{Path: path}
+	}
+	request.URL = requestURL
+	request.Header.Set("Host", requestURL.Host)
+
+	return request
+}
+
+func parseHandshakeResponse(dump string) *http.Response {
+	lines := strings.Split(strings.TrimSpace(dump), "\n")
+	statusLine := strings.SplitN(lines[0], " ", 3)
+
+	response := &http.Response{
+		Proto:  statusLine[0],
+		Header: parseHeaders(lines[1:]),
+	}
+	if len(statusLine) > 1 {
+		response.StatusCode, _ = strconv.Atoi(statusLine[1])
+	}
+
+	return response
+}
+
+func parseHeaders(lines []string) http.Header {
+	header := http.Header{}
+	for _, line := range lines {
+		parts := strings.SplitN(line, ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		header.Add(strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1]))
+	}
+	return header
+}
@@ -0,0 +1,104 @@
+package har_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/har"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type printedDump struct {
+	title string
+	dump  string
+}
+
+type recordingPrinter struct {
+	printed []printedDump
+}
+
+func (printer *recordingPrinter) Print(title, dump string) {
+	printer.printed = append(printer.printed, printedDump{title: title, dump: dump})
+}
+
+var _ = Describe("HandshakePrinter", func() {
+	var (
+		tmpdir  string
+		harPath string
+		wrapped *recordingPrinter
+		printer *HandshakePrinter
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpdir, err = ioutil.TempDir("", "har_handshake")
+		Expect(err).ToNot(HaveOccurred())
+
+		harPath = filepath.Join(tmpdir, "trace.har")
+		wrapped = &recordingPrinter{}
+		isAuthorization := func(name string) bool { return name == "Authorization" }
+		printer = NewHandshakePrinter(NewFile(harPath, isAuthorization), wrapped)
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tmpdir)
+	})
+
+	readEntries := func() []Entry {
+		raw, err := ioutil.ReadFile(harPath)
+		Expect(err).ToNot(HaveOccurred())
+
+		var archive Archive
+		Expect(json.Unmarshal(raw, &archive)).To(Succeed())
+		return archive.Log.Entries
+	}
+
+	It("records the handshake and passes the dumps on", func() {
+		printer.Print("WEBSOCKET REQUEST:", "GET /apps/some-app-guid/stream HTTP/1.1\n"+
+			"Host: wss://doppler.example.com:443\n"+
+			"Upgrade: websocket\nConnection: Upgrade\nSec-WebSocket-Version: 13\nSec-WebSocket-Key: [HIDDEN]\n"+
+			"Authorization: bearer some-token\n")
+		printer.Print("WEBSOCKET RESPONSE:", "HTTP/1.1 101 Switching Protocols\nUpgrade: websocket\n")
+
+		Expect(wrapped.printed).To(HaveLen(2))
+		Expect(wrapped.printed[0].title).To(Equal("WEBSOCKET REQUEST:"))
+		Expect(wrapped.printed[1].title).To(Equal("WEBSOCKET RESPONSE:"))
+
+		entries := readEntries()
+		Expect(entries).To(HaveLen(1))
+		Expect(entries[0].Comment).To(Equal("websocket handshake"))
+		Expect(entries[0].Timings.Wait).To(BeNumerically(">=", 0))
+
+		request := entries[0].Request
+		Expect(request.Method).To(Equal(http.MethodGet))
+		Expect(request.URL).To(Equal("wss://doppler.example.com:443/apps/some-app-guid/stream"))
+		Expect(request.Headers).To(ContainElement(NameValue{Name: "Authorization", Value: RedactedValue}))
+		Expect(request.Headers).To(ContainElement(NameValue{Name: "Host", Value: "doppler.example.com:443"}))
+
+		response := entries[0].Response
+		Expect(response.Status).To(Equal(http.StatusSwitchingProtocols))
+		Expect(response.Headers).To(Equal([]NameValue{{Name: "Upgrade", Value: "websocket"}}))
+	})
+
+	It("does not record a response without a request", func() {
+		printer.Print("WEBSOCKET RESPONSE:", "HTTP/1.1 101 Switching Protocols\n")
+		printer.Print("WEBSOCKET ERROR", "some-error. Retrying...")
+
+		_, err := os.Stat(harPath)
+		Expect(os.IsNotExist(err)).To(BeTrue())
+		Expect(wrapped.printed).To(HaveLen(2))
+	})
+
+	It("works without a wrapped printer", func() {
+		printer = NewHandshakePrinter(NewFile(harPath, nil), nil)
+		printer.Print("WEBSOCKET REQUEST:", "GET /apps/some-app-guid/stream HTTP/1.1\nHost: wss://doppler.example.com:443\n")
+		printer.Print("WEBSOCKET RESPONSE:", "HTTP/1.1 101 Switching Protocols\n")
+
+		Expect(readEntries()).To(HaveLen(1))
+	})
+})
@@ -0,0 +1,192 @@
+// Package har records HTTP traffic as HTTP Archive (HAR) 1.2 files, which can
+// be opened in browser developer tools.
+package har
+
+import (
+	"net/http"
+	"net/url"
+	"sort"
+	"time"
+)
+
+// Version is the HAR specification version written to archives.
+const Version = "1.2"
+
+// RedactedValue replaces the value of headers that carry secrets.
+const RedactedValue = "[PRIVATE DATA HIDDEN]"
+
+// Archive is the root of a HAR file.
+type Archive struct {
+	Log Log `json:"log"`
+}
+
+// Log contains the recorded entries.
+type Log struct {
+	Version string  `json:"version"`
+	Creator Creator `json:"creator"`
+	Entries []Entry `json:"entries"`
+}
+
+// Creator identifies the application that recorded the archive.
+type Creator struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// Entry is a single request and its response.
+type Entry struct {
+	StartedDateTime time.Time `json:"startedDateTime"`
+	// Time is the total elapsed time of the request in milliseconds.
+	Time     float64  `json:"time"`
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+	Cache    struct{} `json:"cache"`
+	Timings  Timings  `json:"timings"`
+	Comment  string   `json:"comment,omitempty"`
+}
+
+// Request is a recorded request.
+type Request struct {
+	Method      string      `json:"method"`
+	URL         string      `json:"url"`
+	HTTPVersion string      `json:"httpVersion"`
+	Cookies     []NameValue `json:"cookies"`
+	Headers     []NameValue `json:"headers"`
+	QueryString []NameValue `json:"queryString"`
+	PostData    *PostData   `json:"postData,omitempty"`
+	HeadersSize int         `json:"headersSize"`
+	BodySize    int         `json:"bodySize"`
+}
+
+// Response is a recorded response.
+type Response struct {
+	Status      int         `json:"status"`
+	StatusText  string      `json:"statusText"`
+	HTTPVersion string      `json:"httpVersion"`
+	Cookies     []NameValue `json:"cookies"`
+	Headers     []NameValue `json:"headers"`
+	Content     Content     `json:"content"`
+	RedirectURL string      `json:"redirectURL"`
+	HeadersSize int         `json:"headersSize"`
+	BodySize    int         `json:"bodySize"`
+}
+
+// NameValue is a header, cookie or query string parameter.
+type NameValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// PostData is the body of a request.
+type PostData struct {
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+}
+
+// Content is the body of a response.
+type Content struct {
+	Size     int    `json:"size"`
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text,omitempty"`
+}
+
+// Timings break the elapsed time of a request down into phases, in
+// milliseconds. Phases that do not apply to a request are -1.
+type Timings struct {
+	Blocked float64 `json:"blocked"`
+	DNS     float64 `json:"dns"`
+	Connect float64 `json:"connect"`
+	Send    float64 `json:"send"`
+	Wait    float64 `json:"wait"`
+	Receive float64 `json:"receive"`
+	SSL     float64 `json:"ssl"`
+}
+
+// NewRequest converts request into a HAR Request with the given, already
+// sanitized, body. Header values that carry secrets are redacted by the File
+// the entry is added to.
+func NewRequest(request *http.Request, body string) Request {
+	harRequest := Request{
+		Method:      request.Method,
+		URL:         request.URL.String(),
+		HTTPVersion: httpVersion(request.Proto),
+		Cookies:     []NameValue{},
+		Headers:     headers(request.Header),
+		QueryString: queryString(request.URL.Query()),
+		HeadersSize: -1,
+		BodySize:    len(body),
+	}
+
+	if body != "" {
+		harRequest.PostData = &PostData{
+			MimeType: request.Header.Get("Content-Type"),
+			Text:     body,
+		}
+	}
+
+	return harRequest
+}
+
+// NewResponse converts response into a HAR Response with the given, already
+// sanitized, body. Header values that carry secrets are redacted by the File
+// the entry is added to.
+func NewResponse(response *http.Response, body string) Response {
+	return Response{
+		Status:      response.StatusCode,
+		StatusText:  http.StatusText(response.StatusCode),
+		HTTPVersion: httpVersion(response.Proto),
+		Cookies:     []NameValue{},
+		Headers:     headers(response.Header),
+		Content: Content{
+			Size:     len(body),
+			MimeType: response.Header.Get("Content-Type"),
+			Text:     body,
+		},
+		RedirectURL: response.Header.Get("Location"),
+		HeadersSize: -1,
+		BodySize:    len(body),
+	}
+}
+
+func headers(header http.Header) []NameValue {
+	names := make([]string, 0, len(header))
+	for name := range header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	harHeaders := []NameValue{}
+	for _, name := range names {
+		for _, value := range header[name] {
+			harHeaders = append(harHeaders, NameValue{Name: name, Value: value})
+		}
+	}
+	return harHeaders
+}
+
+func queryString(values url.Values) []NameValue {
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	harValues := []NameValue{}
+	for _, name := range names {
+		for _, value := range values[name] {
+			harValues = append(harValues, NameValue{Name: name, Value: value})
+		}
+	}
+	return harValues
+}
+
+func httpVersion(proto string) string {
+	if proto == "" {
+		return "HTTP/1.1"
+	}
+	return proto
+}
+
+func milliseconds(duration time.Duration) float64 {
+	return float64(duration) / float64(time.Millisecond)
+}
@@ -0,0 +1,13 @@
+package har_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestHAR(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "HAR Suite")
+}
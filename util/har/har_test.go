@@ -0,0 +1,85 @@
+package har_test
+
+import (
+	"net/http"
+
+	. "code.cloudfoundry.org/cli/util/har"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("HAR", func() {
+	Describe("NewRequest", func() {
+		var request *http.Request
+
+		BeforeEach(func() {
+			var err error
+			request, err = http.NewRequest(http.MethodPost, "https://api.example.com/v2/apps?q=name:some-app&async=true", nil)
+			Expect(err).ToNot(HaveOccurred())
+			request.Header.Set("Content-Type", "application/json")
+			request.Header.Set("Authorization", "bearer some-token")
+			request.Header.Set("X-Refresh-Token", "some-refresh-token")
+		})
+
+		It("converts the request", func() {
+			harRequest := NewRequest(request, `{"name":"some-app"}`)
+
+			Expect(harRequest.Method).To(Equal(http.MethodPost))
+			Expect(harRequest.URL).To(Equal("https://api.example.com/v2/apps?q=name:some-app&async=true"))
+			Expect(harRequest.HTTPVersion).To(Equal("HTTP/1.1"))
+			Expect(harRequest.Cookies).To(BeEmpty())
+			Expect(harRequest.Headers).To(Equal([]NameValue{
+				{Name: "Authorization", Value: "bearer some-token"},
+				{Name: "Content-Type", Value: "application/json"},
+				{Name: "X-Refresh-Token", Value: "some-refresh-token"},
+			}))
+			Expect(harRequest.QueryString).To(Equal([]NameValue{
+				{Name: "async", Value: "true"},
+				{Name: "q", Value: "name:some-app"},
+			}))
+			Expect(harRequest.PostData).To(Equal(&PostData{
+				MimeType: "application/json",
+				Text:     `{"name":"some-app"}`,
+			}))
+			Expect(harRequest.HeadersSize).To(Equal(-1))
+			Expect(harRequest.BodySize).To(Equal(19))
+		})
+
+		It("does not set post data without a body", func() {
+			Expect(NewRequest(request, "").PostData).To(BeNil())
+		})
+	})
+
+	Describe("NewResponse", func() {
+		It("converts the response", func() {
+			response := &http.Response{
+				StatusCode: http.StatusFound,
+				Proto:      "HTTP/1.1",
+				Header: http.Header{
+					"Content-Type": {"text/plain"},
+					"Location":     {"https://login.example.com"},
+					"Set-Cookie":   {"session=some-session"},
+				},
+			}
+
+			harResponse := NewResponse(response, "some-body")
+
+			Expect(harResponse.Status).To(Equal(http.StatusFound))
+			Expect(harResponse.StatusText).To(Equal("Found"))
+			Expect(harResponse.HTTPVersion).To(Equal("HTTP/1.1"))
+			Expect(harResponse.Headers).To(Equal([]NameValue{
+				{Name: "Content-Type", Value: "text/plain"},
+				{Name: "Location", Value: "https://login.example.com"},
+				{Name: "Set-Cookie", Value: "session=some-session"},
+			}))
+			Expect(harResponse.Content).To(Equal(Content{
+				Size:     9,
+				MimeType: "text/plain",
+				Text:     "some-body",
+			}))
+			Expect(harResponse.RedirectURL).To(Equal("https://login.example.com"))
+			Expect(harResponse.BodySize).To(Equal(9))
+		})
+	})
+})
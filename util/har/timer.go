@@ -0,0 +1,145 @@
+package har
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/http/httptrace"
+	"sync"
+	"time"
+)
+
+// Timer measures the phases of a request using httptrace.
+type Timer struct {
+	mutex sync.Mutex
+
+	start        time.Time
+	getConn      time.Time
+	dnsStart     time.Time
+	dnsDone      time.Time
+	connectStart time.Time
+	connectDone  time.Time
+	tlsStart     time.Time
+	tlsDone      time.Time
+	gotConn      time.Time
+	wroteRequest time.Time
+	firstByte    time.Time
+}
+
+// StartTimer returns a copy of request that reports its progress to the
+// returned Timer.
+func StartTimer(request *http.Request) (*http.Request, *Timer) {
+	timer := &Timer{
+		start: time.Now(),
+	}
+
+	trace := &httptrace.ClientTrace{
+		GetConn: func(string) {
+			timer.mark(&timer.getConn)
+		},
+		DNSStart: func(httptrace.DNSStartInfo) {
+			timer.mark(&timer.dnsStart)
+		},
+		DNSDone: func(httptrace.DNSDoneInfo) {
+			timer.mark(&timer.dnsDone)
+		},
+		ConnectStart: func(string, string) {
+			timer.mark(&timer.connectStart)
+		},
+		ConnectDone: func(string, string, error) {
+			timer.mark(&timer.connectDone)
+		},
+		TLSHandshakeStart: func() {
+			timer.mark(&timer.tlsStart)
+		},
+		TLSHandshakeDone: func(tls.ConnectionState, error) {
+			timer.mark(&timer.tlsDone)
+		},
+		GotConn: func(httptrace.GotConnInfo) {
+			timer.mark(&timer.gotConn)
+		},
+		WroteRequest: func(httptrace.WroteRequestInfo) {
+			timer.mark(&timer.wroteRequest)
+		},
+		GotFirstResponseByte: func() {
+			timer.mark(&timer.firstByte)
+		},
+	}
+
+	return request.WithContext(httptrace.WithClientTrace(request.Context(), trace)), timer
+}
+
+// mark records the first time a phase is reached. A request that is retried
+// or redirected keeps the times of its first attempt.
+func (timer *Timer) mark(phase *time.Time) {
+	timer.mutex.Lock()
+	defer timer.mutex.Unlock()
+
+	if phase.IsZero() {
+		*phase = time.Now()
+	}
+}
+
+// Stop ends the measurement and returns the start time, the total elapsed
+// time in milliseconds and the breakdown into phases.
+func (timer *Timer) Stop() (time.Time, float64, Timings) {
+	end := time.Now()
+
+	timer.mutex.Lock()
+	defer timer.mutex.Unlock()
+
+	timings := Timings{
+		Blocked: -1,
+		DNS:     -1,
+		Connect: -1,
+		SSL:     -1,
+	}
+
+	// The connection is ready once the DNS lookup and dial are done, or
+	// immediately if an idle connection was reused.
+	if !timer.getConn.IsZero() {
+		ready := timer.gotConn
+		for _, phase := range []time.Time{timer.connectStart, timer.dnsStart} {
+			if !phase.IsZero() {
+				ready = phase
+			}
+		}
+		if !ready.IsZero() {
+			timings.Blocked = milliseconds(ready.Sub(timer.getConn))
+		}
+	}
+	if !timer.dnsStart.IsZero() && !timer.dnsDone.IsZero() {
+		timings.DNS = milliseconds(timer.dnsDone.Sub(timer.dnsStart))
+	}
+	// The connect phase includes the TLS handshake, which takes place between
+	// dialing and the connection being handed to the request.
+	if !timer.connectStart.IsZero() && !timer.gotConn.IsZero() {
+		timings.Connect = milliseconds(timer.gotConn.Sub(timer.connectStart))
+	}
+	if !timer.tlsStart.IsZero() && !timer.tlsDone.IsZero() {
+		timings.SSL = milliseconds(timer.tlsDone.Sub(timer.tlsStart))
+	}
+	if !timer.gotConn.IsZero() && !timer.wroteRequest.IsZero() {
+		timings.Send = milliseconds(timer.wroteRequest.Sub(timer.gotConn))
+	}
+	if !timer.wroteRequest.IsZero() && !timer.firstByte.IsZero() {
+		timings.Wait = milliseconds(timer.firstByte.Sub(timer.wroteRequest))
+	}
+	if !timer.firstByte.IsZero() {
+		timings.Receive = milliseconds(end.Sub(timer.firstByte))
+	}
+
+	return timer.start, milliseconds(end.Sub(timer.start)), timings
+}
+
+// TimeToFirstByte returns the time in milliseconds from the start of the
+// request until the first byte of the response was received, or -1 if no
+// response was received.
+func (timer *Timer) TimeToFirstByte() float64 {
+	timer.mutex.Lock()
+	defer timer.mutex.Unlock()
+
+	if timer.firstByte.IsZero() {
+		return -1
+	}
+	return milliseconds(timer.firstByte.Sub(timer.start))
+}
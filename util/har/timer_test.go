@@ -0,0 +1,84 @@
+package har_test
+
+import (
+	"crypto/tls"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/har"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Timer", func() {
+	var server *httptest.Server
+
+	BeforeEach(func() {
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			time.Sleep(10 * time.Millisecond)
+			w.Write([]byte("some-body"))
+		}))
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("measures the phases of a request", func() {
+		request, err := http.NewRequest(http.MethodGet, server.URL, nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		timedRequest, timer := StartTimer(request)
+		before := time.Now()
+		response, err := http.DefaultTransport.RoundTrip(timedRequest)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = ioutil.ReadAll(response.Body)
+		Expect(err).ToNot(HaveOccurred())
+		response.Body.Close()
+
+		started, elapsed, timings := timer.Stop()
+		Expect(started).To(BeTemporally("<=", before))
+		Expect(elapsed).To(BeNumerically(">=", 10))
+		Expect(timings.Connect).To(BeNumerically(">=", 0))
+		Expect(timings.Send).To(BeNumerically(">=", 0))
+		Expect(timings.Wait).To(BeNumerically(">=", 10))
+		Expect(timings.Receive).To(BeNumerically(">=", 0))
+		Expect(timings.SSL).To(Equal(float64(-1)))
+		Expect(timings.Blocked + timings.Connect + timings.Send + timings.Wait + timings.Receive).To(BeNumerically("<=", elapsed+1))
+		Expect(timer.TimeToFirstByte()).To(BeNumerically(">=", timings.Wait))
+		Expect(timer.TimeToFirstByte()).To(BeNumerically("<=", elapsed))
+	})
+
+	It("measures the TLS handshake of an HTTPS request", func() {
+		tlsServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.Write([]byte("some-body"))
+		}))
+		defer tlsServer.Close()
+
+		request, err := http.NewRequest(http.MethodGet, tlsServer.URL, nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		timedRequest, timer := StartTimer(request)
+		transport := &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}
+		response, err := transport.RoundTrip(timedRequest)
+		Expect(err).ToNot(HaveOccurred())
+		response.Body.Close()
+
+		_, _, timings := timer.Stop()
+		Expect(timings.SSL).To(BeNumerically(">=", 0))
+		Expect(timings.SSL).To(BeNumerically("<=", timings.Connect))
+	})
+
+	It("leaves the phases that were not reached unset", func() {
+		request, err := http.NewRequest(http.MethodGet, server.URL, nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		_, timer := StartTimer(request)
+		_, _, timings := timer.Stop()
+		Expect(timings).To(Equal(Timings{Blocked: -1, DNS: -1, Connect: -1, SSL: -1}))
+		Expect(timer.TimeToFirstByte()).To(Equal(float64(-1)))
+	})
+})
@@ -0,0 +1,43 @@
+// Package pasttime parses points in time given on the command line, either as
+// a duration before now or as a date.
+package pasttime
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidTime is returned when a value is neither a duration nor a date.
+var ErrInvalidTime = errors.New("time must be a duration or a date")
+
+var layouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+// Parse accepts either a duration before now, where a "d" suffix stands for
+// days, e.g. 90m, 2h or 7d, or a date, e.g. 2017-05-04 or
+// 2017-05-04T15:04:05Z. Dates without a time zone are in the local time zone.
+func Parse(value string, now time.Time) (time.Time, error) {
+	if strings.HasSuffix(value, "d") {
+		if days, err := strconv.Atoi(strings.TrimSuffix(value, "d")); err == nil && days >= 0 {
+			return now.AddDate(0, 0, -days), nil
+		}
+	}
+
+	if duration, err := time.ParseDuration(value); err == nil && duration >= 0 {
+		return now.Add(-duration), nil
+	}
+
+	for _, layout := range layouts {
+		if t, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, ErrInvalidTime
+}
@@ -0,0 +1,13 @@
+package pasttime_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestPastTime(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Past Time Suite")
+}
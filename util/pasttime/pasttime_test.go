@@ -0,0 +1,53 @@
+package pasttime_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/pasttime"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Parse", func() {
+	var now time.Time
+
+	BeforeEach(func() {
+		now = time.Date(2017, 5, 10, 12, 0, 0, 0, time.UTC)
+	})
+
+	It("subtracts a duration from now", func() {
+		t, err := Parse("90m", now)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(t).To(Equal(now.Add(-90 * time.Minute)))
+	})
+
+	It("subtracts a number of days from now", func() {
+		t, err := Parse("7d", now)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(t).To(Equal(now.AddDate(0, 0, -7)))
+	})
+
+	It("parses dates without a time zone in the local time zone", func() {
+		t, err := Parse("2017-05-04", now)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(t.Equal(time.Date(2017, 5, 4, 0, 0, 0, 0, time.Local))).To(BeTrue())
+
+		t, err = Parse("2017-05-04 15:04:05", now)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(t.Equal(time.Date(2017, 5, 4, 15, 4, 5, 0, time.Local))).To(BeTrue())
+	})
+
+	It("parses timestamps with a time zone", func() {
+		t, err := Parse("2017-05-04T15:04:05+02:00", now)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(t.Equal(time.Date(2017, 5, 4, 13, 4, 5, 0, time.UTC))).To(BeTrue())
+	})
+
+	It("rejects negative durations and other values", func() {
+		for _, value := range []string{"-2h", "-1d", "yesterday"} {
+			_, err := Parse(value, now)
+			Expect(err).To(Equal(ErrInvalidTime))
+		}
+	})
+})
@@ -0,0 +1,115 @@
+package proxy
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+
+	netproxy "golang.org/x/net/proxy"
+)
+
+// dialThrough connects to addr through proxyURL, or directly if proxyURL is
+// nil.
+func dialThrough(ctx context.Context, dialer *net.Dialer, proxyURL *url.URL, network string, addr string) (net.Conn, error) {
+	switch {
+	case proxyURL == nil:
+		return dialer.DialContext(ctx, network, addr)
+	case isSOCKS(proxyURL):
+		return dialSOCKS5(ctx, dialer, proxyURL, network, addr)
+	default:
+		return dialConnect(ctx, dialer, proxyURL, network, addr)
+	}
+}
+
+// dialConnect tunnels a connection to addr through an HTTP or HTTPS proxy
+// with a CONNECT request.
+func dialConnect(ctx context.Context, dialer *net.Dialer, proxyURL *url.URL, network string, addr string) (net.Conn, error) {
+	proxyAddr := canonicalAddr(proxyURL.Scheme, proxyURL.Host)
+	conn, err := dialer.DialContext(ctx, network, proxyAddr)
+	if err != nil {
+		return nil, err
+	}
+	if proxyURL.Scheme == "https" {
+		proxyHost, _, _ := net.SplitHostPort(proxyAddr)
+		conn = tls.Client(conn, &tls.Config{ServerName: proxyHost})
+	}
+
+	connectRequest := &http.Request{
+		Method: "CONNECT",
+		URL:    &url.URL{Opaque: addr},
+		Host:   addr,
+		Header: http.Header{},
+	}
+	if user := proxyURL.User; user != nil {
+		password, _ := user.Password()
+		credentials := base64.StdEncoding.EncodeToString([]byte(user.Username() + ":" + password))
+		connectRequest.Header.Set("Proxy-Authorization", "Basic "+credentials)
+	}
+
+	err = connectRequest.Write(conn)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	reader := bufio.NewReader(conn)
+	response, err := http.ReadResponse(reader, connectRequest)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		conn.Close()
+		return nil, fmt.Errorf("proxy %s refused to connect to %s: %s", redact(proxyURL), addr, response.Status)
+	}
+
+	// Servers that speak first, such as SSH servers, may have sent data that
+	// was read along with the response.
+	if reader.Buffered() > 0 {
+		return bufferedConn{Conn: conn, reader: reader}, nil
+	}
+	return conn, nil
+}
+
+// bufferedConn is a connection whose first bytes have been read into reader.
+type bufferedConn struct {
+	net.Conn
+	reader *bufio.Reader
+}
+
+func (conn bufferedConn) Read(p []byte) (int, error) {
+	return conn.reader.Read(p)
+}
+
+// dialSOCKS5 tunnels a connection to addr through a SOCKS5 proxy,
+// authenticating with the proxy's user name and password, if it has them.
+// Host names are resolved by the proxy.
+func dialSOCKS5(ctx context.Context, dialer *net.Dialer, proxyURL *url.URL, network string, addr string) (net.Conn, error) {
+	var auth *netproxy.Auth
+	if user := proxyURL.User; user != nil {
+		password, _ := user.Password()
+		auth = &netproxy.Auth{User: user.Username(), Password: password}
+	}
+
+	socksDialer, err := netproxy.SOCKS5("tcp", canonicalAddr(proxyURL.Scheme, proxyURL.Host), auth, dialer)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := socksDialer.(netproxy.ContextDialer).DialContext(ctx, network, addr)
+	if err != nil {
+		// The error already names the proxy and addr, which are named again
+		// below without the proxy's password.
+		if opErr, ok := err.(*net.OpError); ok {
+			err = opErr.Err
+		}
+		return nil, fmt.Errorf("SOCKS5 proxy %s failed to connect to %s: %s", redact(proxyURL), addr, err)
+	}
+	return conn, nil
+}
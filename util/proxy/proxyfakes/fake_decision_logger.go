@@ -0,0 +1,64 @@
+// This file was generated by counterfeiter
+package proxyfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/util/proxy"
+)
+
+type FakeDecisionLogger struct {
+	LogProxyDecisionStub        func(decision proxy.Decision)
+	logProxyDecisionMutex       sync.RWMutex
+	logProxyDecisionArgsForCall []struct {
+		decision proxy.Decision
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeDecisionLogger) LogProxyDecision(decision proxy.Decision) {
+	fake.logProxyDecisionMutex.Lock()
+	fake.logProxyDecisionArgsForCall = append(fake.logProxyDecisionArgsForCall, struct {
+		decision proxy.Decision
+	}{decision})
+	fake.recordInvocation("LogProxyDecision", []interface{}{decision})
+	fake.logProxyDecisionMutex.Unlock()
+	if fake.LogProxyDecisionStub != nil {
+		fake.LogProxyDecisionStub(decision)
+	}
+}
+
+func (fake *FakeDecisionLogger) LogProxyDecisionCallCount() int {
+	fake.logProxyDecisionMutex.RLock()
+	defer fake.logProxyDecisionMutex.RUnlock()
+	return len(fake.logProxyDecisionArgsForCall)
+}
+
+func (fake *FakeDecisionLogger) LogProxyDecisionArgsForCall(i int) proxy.Decision {
+	fake.logProxyDecisionMutex.RLock()
+	defer fake.logProxyDecisionMutex.RUnlock()
+	return fake.logProxyDecisionArgsForCall[i].decision
+}
+
+func (fake *FakeDecisionLogger) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.logProxyDecisionMutex.RLock()
+	defer fake.logProxyDecisionMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeDecisionLogger) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ proxy.DecisionLogger = new(FakeDecisionLogger)
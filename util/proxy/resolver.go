@@ -0,0 +1,303 @@
+// Package proxy decides how connections to Cloud Foundry are made, using the
+// http_proxy, https_proxy and no_proxy settings, and tunnels connections
+// through HTTP and SOCKS5 proxies.
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+)
+
+// Decision is how connections to a host are made.
+type Decision struct {
+	// Host is the host and port connected to.
+	Host string
+
+	// Proxy is the proxy connections are made through, or nil if they are made
+	// directly.
+	Proxy *url.URL
+
+	// NoProxy is true when the host is excluded from proxying, by no_proxy or
+	// because it is a local address.
+	NoProxy bool
+}
+
+// String describes the decision, without the proxy's password.
+func (decision Decision) String() string {
+	switch {
+	case decision.Proxy != nil:
+		return fmt.Sprintf("%s via %s", decision.Host, redact(decision.Proxy))
+	case decision.NoProxy:
+		return fmt.Sprintf("%s direct (no_proxy)", decision.Host)
+	default:
+		return fmt.Sprintf("%s direct", decision.Host)
+	}
+}
+
+//go:generate counterfeiter . DecisionLogger
+
+// DecisionLogger is told how connections to each host are made, the first
+// time a connection to the host is made.
+type DecisionLogger interface {
+	LogProxyDecision(decision Decision)
+}
+
+// Resolver decides which proxy, if any, connections to a host go through.
+// Hosts matching no_proxy, localhost and loopback addresses are connected to
+// directly. HTTPS and secure websocket connections use https_proxy, and other
+// HTTP connections use http_proxy. Proxies can be HTTP, HTTPS or SOCKS5
+// URLs; a proxy without a scheme is an HTTP proxy.
+type Resolver struct {
+	httpProxy  string
+	httpsProxy string
+	noProxy    string
+
+	mutex        sync.Mutex
+	loggers      []DecisionLogger
+	logged       map[string]bool
+	socksProxies map[string]*url.URL
+}
+
+// NewResolver returns a Resolver for the given proxy settings. Empty
+// settings disable proxying for the corresponding scheme.
+func NewResolver(httpProxy string, httpsProxy string, noProxy string) *Resolver {
+	return &Resolver{
+		httpProxy:    httpProxy,
+		httpsProxy:   httpsProxy,
+		noProxy:      noProxy,
+		logged:       map[string]bool{},
+		socksProxies: map[string]*url.URL{},
+	}
+}
+
+// FromEnvironment returns a Resolver for the http_proxy, https_proxy and
+// no_proxy environment variables, or their upper case forms.
+func FromEnvironment() *Resolver {
+	return NewResolver(
+		getEnv("http_proxy", "HTTP_PROXY"),
+		getEnv("https_proxy", "HTTPS_PROXY"),
+		getEnv("no_proxy", "NO_PROXY"),
+	)
+}
+
+// AddLogger adds a logger that is told how connections to each host are
+// made.
+func (resolver *Resolver) AddLogger(logger DecisionLogger) {
+	resolver.mutex.Lock()
+	defer resolver.mutex.Unlock()
+	resolver.loggers = append(resolver.loggers, logger)
+}
+
+// Proxy returns the proxy for request, for use as an http.Transport's Proxy.
+// SOCKS5 proxies are not returned, since transports cannot use them; the
+// connection is tunnelled through them by DialContext instead.
+func (resolver *Resolver) Proxy(request *http.Request) (*url.URL, error) {
+	addr := canonicalAddr(request.URL.Scheme, request.URL.Host)
+	proxyURL, err := resolver.resolve(request.URL.Scheme, addr)
+	if err != nil || proxyURL == nil {
+		return nil, err
+	}
+
+	if isSOCKS(proxyURL) {
+		resolver.mutex.Lock()
+		resolver.socksProxies[addr] = proxyURL
+		resolver.mutex.Unlock()
+		return nil, nil
+	}
+	return proxyURL, nil
+}
+
+// DialContext returns a function, for use as an http.Transport's
+// DialContext, that tunnels connections through the SOCKS5 proxies chosen by
+// Proxy, and otherwise dials with dialer.
+func (resolver *Resolver) DialContext(dialer *net.Dialer) func(ctx context.Context, network string, addr string) (net.Conn, error) {
+	return func(ctx context.Context, network string, addr string) (net.Conn, error) {
+		resolver.mutex.Lock()
+		proxyURL := resolver.socksProxies[addr]
+		resolver.mutex.Unlock()
+
+		if proxyURL == nil {
+			return dialer.DialContext(ctx, network, addr)
+		}
+		return dialSOCKS5(ctx, dialer, proxyURL, network, addr)
+	}
+}
+
+// Dial makes a TCP connection to addr, such as an SSH connection, through
+// the https_proxy unless addr is excluded from proxying.
+func (resolver *Resolver) Dial(dialer *net.Dialer, network string, addr string) (net.Conn, error) {
+	proxyURL, err := resolver.resolve("https", addr)
+	if err != nil {
+		return nil, err
+	}
+	return dialThrough(context.Background(), dialer, proxyURL, network, addr)
+}
+
+// ForScheme returns a proxy function for clients that look up the proxy for
+// every host as an "http" URL and can only tunnel through HTTP proxies, such
+// as the log streaming consumer. The proxy is looked up for scheme instead,
+// and an error is returned if it is an HTTPS or SOCKS5 proxy.
+func (resolver *Resolver) ForScheme(scheme string) func(*http.Request) (*url.URL, error) {
+	return func(request *http.Request) (*url.URL, error) {
+		addr := canonicalAddr(scheme, request.URL.Host)
+		proxyURL, err := resolver.resolve(scheme, addr)
+		if err != nil || proxyURL == nil {
+			return nil, err
+		}
+
+		if proxyURL.Scheme != "http" {
+			return nil, fmt.Errorf("connections to %s can only go through an HTTP proxy, not %s", addr, redact(proxyURL))
+		}
+		return proxyURL, nil
+	}
+}
+
+// resolve returns the proxy that connections to addr, for the given scheme,
+// go through, and logs the decision the first time addr is resolved.
+func (resolver *Resolver) resolve(scheme string, addr string) (*url.URL, error) {
+	decision := Decision{Host: addr}
+
+	if resolver.useProxy(addr) {
+		rawProxy := resolver.httpProxy
+		switch strings.ToLower(scheme) {
+		case "https", "wss":
+			rawProxy = resolver.httpsProxy
+		}
+
+		if rawProxy != "" {
+			proxyURL, err := parseProxy(rawProxy)
+			if err != nil {
+				return nil, err
+			}
+			decision.Proxy = proxyURL
+		}
+	} else {
+		decision.NoProxy = true
+	}
+
+	resolver.log(decision)
+	return decision.Proxy, nil
+}
+
+func (resolver *Resolver) log(decision Decision) {
+	resolver.mutex.Lock()
+	if resolver.logged[decision.Host] {
+		resolver.mutex.Unlock()
+		return
+	}
+	resolver.logged[decision.Host] = true
+	loggers := resolver.loggers
+	resolver.mutex.Unlock()
+
+	for _, logger := range loggers {
+		logger.LogProxyDecision(decision)
+	}
+}
+
+// useProxy returns false if addr matches no_proxy, or is localhost or a
+// loopback address.
+func (resolver *Resolver) useProxy(addr string) bool {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return true
+	}
+	if host == "localhost" {
+		return false
+	}
+	if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+		return false
+	}
+
+	addr = strings.ToLower(addr)
+	host = strings.ToLower(host)
+	for _, pattern := range strings.Split(resolver.noProxy, ",") {
+		pattern = strings.ToLower(strings.TrimSpace(pattern))
+		if pattern == "" {
+			continue
+		}
+		if pattern == "*" {
+			return false
+		}
+
+		target := host
+		if _, _, err := net.SplitHostPort(pattern); err == nil {
+			target = addr
+		}
+		if target == pattern {
+			return false
+		}
+
+		// ".example.com" and "example.com" both match example.com and its
+		// subdomains.
+		domain := strings.TrimPrefix(pattern, ".")
+		if target == domain || strings.HasSuffix(target, "."+domain) {
+			return false
+		}
+	}
+	return true
+}
+
+// parseProxy parses a proxy setting, which may omit the "http://" scheme.
+func parseProxy(rawProxy string) (*url.URL, error) {
+	proxyURL, err := url.Parse(rawProxy)
+	if err != nil || proxyURL.Scheme == "" || proxyURL.Host == "" {
+		proxyURL, err = url.Parse("http://" + rawProxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy address %q: %s", rawProxy, err)
+		}
+	}
+
+	switch proxyURL.Scheme {
+	case "http", "https", "socks5", "socks5h":
+		return proxyURL, nil
+	default:
+		return nil, fmt.Errorf("unsupported proxy scheme %q in %q", proxyURL.Scheme, rawProxy)
+	}
+}
+
+func isSOCKS(proxyURL *url.URL) bool {
+	return proxyURL.Scheme == "socks5" || proxyURL.Scheme == "socks5h"
+}
+
+// canonicalAddr returns host with the default port for scheme added if it
+// has none.
+func canonicalAddr(scheme string, host string) string {
+	if _, _, err := net.SplitHostPort(host); err == nil {
+		return host
+	}
+
+	port := "80"
+	switch strings.ToLower(scheme) {
+	case "https", "wss":
+		port = "443"
+	case "socks5", "socks5h":
+		port = "1080"
+	}
+	return net.JoinHostPort(strings.Trim(host, "[]"), port)
+}
+
+// redact returns proxyURL without its password.
+func redact(proxyURL *url.URL) string {
+	redacted := *proxyURL
+	if redacted.User != nil {
+		if _, hasPassword := redacted.User.Password(); hasPassword {
+			redacted.User = url.UserPassword(redacted.User.Username(), "xxxxx")
+		}
+	}
+	return redacted.String()
+}
+
+func getEnv(names ...string) string {
+	for _, name := range names {
+		if value := os.Getenv(name); value != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,169 @@
+package proxy_test
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+)
+
+// fakeProxy is a SOCKS5 or HTTP CONNECT proxy that tunnels every connection
+// to backendAddr and records the addresses clients asked for.
+type fakeProxy struct {
+	listener    net.Listener
+	backendAddr string
+
+	mutex    sync.Mutex
+	targets  []string
+	username string
+	password string
+}
+
+func newFakeProxy(backendAddr string, serve func(proxy *fakeProxy, conn net.Conn)) *fakeProxy {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		panic(err)
+	}
+
+	proxy := &fakeProxy{listener: listener, backendAddr: backendAddr}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go serve(proxy, conn)
+		}
+	}()
+	return proxy
+}
+
+func (proxy *fakeProxy) Addr() string {
+	return proxy.listener.Addr().String()
+}
+
+func (proxy *fakeProxy) Close() {
+	proxy.listener.Close()
+}
+
+func (proxy *fakeProxy) Targets() []string {
+	proxy.mutex.Lock()
+	defer proxy.mutex.Unlock()
+	return append([]string{}, proxy.targets...)
+}
+
+func (proxy *fakeProxy) record(target string) {
+	proxy.mutex.Lock()
+	defer proxy.mutex.Unlock()
+	proxy.targets = append(proxy.targets, target)
+}
+
+func (proxy *fakeProxy) pipe(client io.Reader, conn net.Conn) {
+	backend, err := net.Dial("tcp", proxy.backendAddr)
+	if err != nil {
+		return
+	}
+	defer backend.Close()
+
+	go io.Copy(backend, client)
+	io.Copy(conn, backend)
+}
+
+func serveSOCKS5(proxy *fakeProxy, conn net.Conn) {
+	defer conn.Close()
+
+	header := make([]byte, 2)
+	if _, err := io.ReadFull(conn, header); err != nil {
+		return
+	}
+	methods := make([]byte, header[1])
+	if _, err := io.ReadFull(conn, methods); err != nil {
+		return
+	}
+
+	if proxy.username == "" {
+		conn.Write([]byte{0x05, 0x00})
+	} else {
+		conn.Write([]byte{0x05, 0x02})
+		version := make([]byte, 2)
+		if _, err := io.ReadFull(conn, version); err != nil {
+			return
+		}
+		username := make([]byte, version[1])
+		io.ReadFull(conn, username)
+		length := make([]byte, 1)
+		io.ReadFull(conn, length)
+		password := make([]byte, length[0])
+		io.ReadFull(conn, password)
+		if string(username) != proxy.username || string(password) != proxy.password {
+			conn.Write([]byte{0x01, 0x01})
+			return
+		}
+		conn.Write([]byte{0x01, 0x00})
+	}
+
+	request := make([]byte, 4)
+	if _, err := io.ReadFull(conn, request); err != nil {
+		return
+	}
+	var host string
+	switch request[3] {
+	case 0x01:
+		ip := make([]byte, net.IPv4len)
+		io.ReadFull(conn, ip)
+		host = net.IP(ip).String()
+	case 0x03:
+		length := make([]byte, 1)
+		io.ReadFull(conn, length)
+		name := make([]byte, length[0])
+		io.ReadFull(conn, name)
+		host = string(name)
+	}
+	port := make([]byte, 2)
+	io.ReadFull(conn, port)
+	proxy.record(net.JoinHostPort(host, strconv.Itoa(int(binary.BigEndian.Uint16(port)))))
+
+	conn.Write([]byte{0x05, 0x00, 0x00, 0x01, 127, 0, 0, 1, 0, 0})
+	proxy.pipe(conn, conn)
+}
+
+func serveCONNECT(proxy *fakeProxy, conn net.Conn) {
+	defer conn.Close()
+
+	reader := bufio.NewReader(conn)
+	request, err := http.ReadRequest(reader)
+	if err != nil || request.Method != "CONNECT" {
+		return
+	}
+	proxy.record(request.Host)
+
+	io.WriteString(conn, "HTTP/1.1 200 Connection Established\r\n\r\n")
+	proxy.pipe(reader, conn)
+}
+
+// newGreetingServer returns a server that, like an SSH server, writes a line
+// as soon as a connection is made, and then echoes what it reads.
+func newGreetingServer() net.Listener {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		panic(err)
+	}
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				io.WriteString(conn, "hello\n")
+				io.Copy(conn, conn)
+			}()
+		}
+	}()
+	return listener
+}
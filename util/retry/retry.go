@@ -0,0 +1,163 @@
+// Package retry decides whether a failed HTTP request is worth repeating and
+// how long to wait before repeating it. It is shared by the retry wrappers of
+// the Cloud Controller and UAA clients.
+package retry
+
+import (
+	"context"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+const (
+	// DefaultBaseDelay is the delay before the first retry. The delay doubles
+	// with every following retry.
+	DefaultBaseDelay = 500 * time.Millisecond
+
+	// DefaultMaxDelay is the longest a Policy waits between two attempts,
+	// including waits requested by a Retry-After header.
+	DefaultMaxDelay = 30 * time.Second
+)
+
+type safeKey struct{}
+
+// MarkSafe returns a copy of the request that may be repeated even though its
+// method is not idempotent.
+func MarkSafe(request *http.Request) *http.Request {
+	return request.WithContext(context.WithValue(request.Context(), safeKey{}, true))
+}
+
+// IsSafe returns true if the request was marked with MarkSafe.
+func IsSafe(request *http.Request) bool {
+	safe, _ := request.Context().Value(safeKey{}).(bool)
+	return safe
+}
+
+// Policy retries requests that failed with a 429 or 5XX status code, or
+// because the connection broke before a response was received. Retries are
+// spaced out with exponential backoff and jitter, or by the delay requested in
+// the Retry-After header of the response.
+type Policy struct {
+	// BaseDelay is the delay before the first retry.
+	BaseDelay time.Duration
+	// MaxDelay caps the delay between two attempts.
+	MaxDelay time.Duration
+}
+
+// NewPolicy returns a Policy with the default delays.
+func NewPolicy() Policy {
+	return Policy{
+		BaseDelay: DefaultBaseDelay,
+		MaxDelay:  DefaultMaxDelay,
+	}
+}
+
+// Retryable returns true if the failed attempt is worth repeating. response is
+// nil if no response was received, in which case networkErr is the error the
+// connection failed with.
+//
+// A 429 means the server did not process the request, so it is retried for
+// every method. Otherwise POST requests are only retried when they are marked
+// with MarkSafe, since the server may already have acted on them.
+func (Policy) Retryable(request *http.Request, response *http.Response, networkErr error) bool {
+	if response != nil && response.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+
+	if request.Method == http.MethodPost && !IsSafe(request) {
+		return false
+	}
+
+	if response == nil {
+		return isTransientNetworkError(networkErr)
+	}
+
+	switch response.StatusCode {
+	case http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// Delay returns how long to wait before the next attempt. A Retry-After
+// header takes precedence over the exponential backoff.
+func (policy Policy) Delay(attempt int, response *http.Response) time.Duration {
+	if response != nil {
+		if wait, ok := retryAfter(response.Header.Get("Retry-After")); ok {
+			if wait > policy.MaxDelay {
+				return policy.MaxDelay
+			}
+			return wait
+		}
+	}
+
+	backoff := policy.BaseDelay << uint(attempt)
+	if backoff > policy.MaxDelay || backoff < policy.BaseDelay {
+		backoff = policy.MaxDelay
+	}
+
+	// Waiting a random duration between half and all of the backoff keeps
+	// concurrent clients from retrying in lockstep.
+	half := int64(backoff / 2)
+	return time.Duration(half + rand.Int63n(int64(backoff)-half+1))
+}
+
+// retryAfter parses the value of a Retry-After header, which is either a
+// number of seconds or an HTTP date.
+func retryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		wait := date.Sub(time.Now())
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+
+	return 0, false
+}
+
+// isTransientNetworkError returns true if the connection was reset or closed
+// by the server, or timed out.
+func isTransientNetworkError(err error) bool {
+	if urlErr, ok := err.(*url.Error); ok {
+		err = urlErr.Err
+	}
+
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return true
+	}
+
+	if opErr, ok := err.(*net.OpError); ok {
+		cause := opErr.Err
+		if syscallErr, ok := cause.(*os.SyscallError); ok {
+			cause = syscallErr.Err
+		}
+		if cause == syscall.ECONNRESET {
+			return true
+		}
+	}
+
+	netErr, ok := err.(net.Error)
+	return ok && netErr.Timeout()
+}
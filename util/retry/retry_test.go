@@ -0,0 +1,168 @@
+package retry_test
+
+import (
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"syscall"
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/retry"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Policy", func() {
+	var policy Policy
+
+	BeforeEach(func() {
+		policy = NewPolicy()
+	})
+
+	Describe("Retryable", func() {
+		DescribeTable("responses",
+			func(requestMethod string, markSafe bool, responseStatusCode int, expected bool) {
+				request, err := http.NewRequest(requestMethod, "https://foo.bar.com/banana", nil)
+				Expect(err).NotTo(HaveOccurred())
+				if markSafe {
+					request = MarkSafe(request)
+				}
+
+				response := &http.Response{StatusCode: responseStatusCode}
+				Expect(policy.Retryable(request, response, nil)).To(Equal(expected))
+			},
+
+			Entry("GET (429) Too Many Requests", http.MethodGet, false, http.StatusTooManyRequests, true),
+			Entry("GET (500) Internal Server Error", http.MethodGet, false, http.StatusInternalServerError, true),
+			Entry("GET (502) Bad Gateway", http.MethodGet, false, http.StatusBadGateway, true),
+			Entry("GET (503) Service Unavailable", http.MethodGet, false, http.StatusServiceUnavailable, true),
+			Entry("GET (504) Gateway Timeout", http.MethodGet, false, http.StatusGatewayTimeout, true),
+			Entry("GET 4XX Errors", http.MethodGet, false, http.StatusNotFound, false),
+			Entry("PUT (503) Service Unavailable", http.MethodPut, false, http.StatusServiceUnavailable, true),
+			Entry("DELETE (502) Bad Gateway", http.MethodDelete, false, http.StatusBadGateway, true),
+
+			Entry("POST (429) Too Many Requests", http.MethodPost, false, http.StatusTooManyRequests, true),
+			Entry("POST (500) Internal Server Error", http.MethodPost, false, http.StatusInternalServerError, false),
+			Entry("POST (502) Bad Gateway", http.MethodPost, false, http.StatusBadGateway, false),
+			Entry("POST (503) Service Unavailable", http.MethodPost, false, http.StatusServiceUnavailable, false),
+			Entry("POST (504) Gateway Timeout", http.MethodPost, false, http.StatusGatewayTimeout, false),
+
+			Entry("safe POST (500) Internal Server Error", http.MethodPost, true, http.StatusInternalServerError, true),
+			Entry("safe POST (502) Bad Gateway", http.MethodPost, true, http.StatusBadGateway, true),
+			Entry("safe POST 4XX Errors", http.MethodPost, true, http.StatusBadRequest, false),
+		)
+
+		DescribeTable("failures before a response is received",
+			func(requestMethod string, markSafe bool, networkErr error, expected bool) {
+				request, err := http.NewRequest(requestMethod, "https://foo.bar.com/banana", nil)
+				Expect(err).NotTo(HaveOccurred())
+				if markSafe {
+					request = MarkSafe(request)
+				}
+
+				wrappedErr := &url.Error{Op: "Get", URL: "https://foo.bar.com/banana", Err: networkErr}
+				Expect(policy.Retryable(request, nil, wrappedErr)).To(Equal(expected))
+			},
+
+			Entry("connection reset", http.MethodGet, false, &net.OpError{Op: "read", Net: "tcp", Err: os.NewSyscallError("read", syscall.ECONNRESET)}, true),
+			Entry("connection closed by the server", http.MethodGet, false, io.EOF, true),
+			Entry("connection closed mid response", http.MethodGet, false, io.ErrUnexpectedEOF, true),
+			Entry("timeout", http.MethodGet, false, &net.OpError{Op: "dial", Net: "tcp", Err: timeoutError{}}, true),
+			Entry("host does not exist", http.MethodGet, false, &net.OpError{Op: "dial", Net: "tcp", Err: &net.DNSError{Err: "no such host", Name: "foo.bar.com"}}, false),
+			Entry("connection refused", http.MethodGet, false, &net.OpError{Op: "dial", Net: "tcp", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}, false),
+			Entry("other errors", http.MethodGet, false, errors.New("banana"), false),
+			Entry("POST connection reset", http.MethodPost, false, &net.OpError{Op: "read", Net: "tcp", Err: os.NewSyscallError("read", syscall.ECONNRESET)}, false),
+			Entry("safe POST connection reset", http.MethodPost, true, &net.OpError{Op: "read", Net: "tcp", Err: os.NewSyscallError("read", syscall.ECONNRESET)}, true),
+		)
+
+		It("does not retry when there is neither a response nor an error", func() {
+			request, err := http.NewRequest(http.MethodGet, "https://foo.bar.com/banana", nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(policy.Retryable(request, nil, nil)).To(BeFalse())
+		})
+	})
+
+	Describe("Delay", func() {
+		var response *http.Response
+
+		BeforeEach(func() {
+			policy.BaseDelay = 100 * time.Millisecond
+			policy.MaxDelay = time.Second
+			response = &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}
+		})
+
+		Context("when there is no response", func() {
+			It("backs off exponentially from the base delay with jitter", func() {
+				Expect(policy.Delay(0, nil)).To(BeNumerically("~", 75*time.Millisecond, 25*time.Millisecond))
+				Expect(policy.Delay(1, nil)).To(BeNumerically("~", 150*time.Millisecond, 50*time.Millisecond))
+				Expect(policy.Delay(2, nil)).To(BeNumerically("~", 300*time.Millisecond, 100*time.Millisecond))
+			})
+
+			It("caps the backoff at the max delay", func() {
+				Expect(policy.Delay(10, nil)).To(BeNumerically("~", 750*time.Millisecond, 250*time.Millisecond))
+				Expect(policy.Delay(100, nil)).To(BeNumerically("~", 750*time.Millisecond, 250*time.Millisecond))
+			})
+		})
+
+		Context("when the response has no Retry-After header", func() {
+			It("backs off from the base delay", func() {
+				Expect(policy.Delay(0, response)).To(BeNumerically("~", 75*time.Millisecond, 25*time.Millisecond))
+			})
+		})
+
+		Context("when the response has a Retry-After header in seconds", func() {
+			It("waits for the requested time", func() {
+				response.Header.Set("Retry-After", "0")
+				Expect(policy.Delay(3, response)).To(Equal(time.Duration(0)))
+			})
+
+			It("caps the wait at the max delay", func() {
+				response.Header.Set("Retry-After", "120")
+				Expect(policy.Delay(0, response)).To(Equal(time.Second))
+			})
+		})
+
+		Context("when the response has a Retry-After header with a date", func() {
+			It("waits until the date, capped at the max delay", func() {
+				response.Header.Set("Retry-After", time.Now().Add(time.Hour).UTC().Format(http.TimeFormat))
+				Expect(policy.Delay(0, response)).To(Equal(time.Second))
+			})
+
+			It("does not wait for a date in the past", func() {
+				response.Header.Set("Retry-After", time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat))
+				Expect(policy.Delay(0, response)).To(Equal(time.Duration(0)))
+			})
+		})
+
+		Context("when the Retry-After header is invalid", func() {
+			It("backs off from the base delay", func() {
+				response.Header.Set("Retry-After", "-5")
+				Expect(policy.Delay(0, response)).To(BeNumerically("~", 75*time.Millisecond, 25*time.Millisecond))
+
+				response.Header.Set("Retry-After", "banana")
+				Expect(policy.Delay(0, response)).To(BeNumerically("~", 75*time.Millisecond, 25*time.Millisecond))
+			})
+		})
+	})
+})
+
+var _ = Describe("MarkSafe", func() {
+	It("marks a copy of the request", func() {
+		request, err := http.NewRequest(http.MethodPost, "https://foo.bar.com/banana", nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		safeRequest := MarkSafe(request)
+		Expect(IsSafe(safeRequest)).To(BeTrue())
+		Expect(IsSafe(request)).To(BeFalse())
+	})
+})
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
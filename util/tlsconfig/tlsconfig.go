@@ -0,0 +1,84 @@
+// Package tlsconfig loads the PEM encoded certificate files that secure
+// connections to Cloud Foundry into a tls.Config.
+package tlsconfig
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+)
+
+// InvalidCACertError is returned when a CA bundle contains no certificates.
+type InvalidCACertError struct {
+	Path string
+}
+
+func (e InvalidCACertError) Error() string {
+	return fmt.Sprintf("no PEM encoded certificates found in %s", e.Path)
+}
+
+// IncompleteClientCertError is returned when only one of the client
+// certificate and the client key is given.
+type IncompleteClientCertError struct{}
+
+func (IncompleteClientCertError) Error() string {
+	return "a client certificate and a client key must be given together"
+}
+
+// InvalidClientCertError is returned when the client certificate and key
+// cannot be loaded.
+type InvalidClientCertError struct {
+	CertFile string
+	KeyFile  string
+	Err      error
+}
+
+func (e InvalidClientCertError) Error() string {
+	return fmt.Sprintf("unable to load client certificate %s and key %s: %s", e.CertFile, e.KeyFile, e.Err)
+}
+
+// Apply adds the certificate authorities in caCertFile to the roots trusted
+// by tlsConfig, and sets the client certificate presented to servers that
+// request one from clientCertFile and clientKeyFile. The certificate
+// authorities are trusted in addition to the system's, or on their own if
+// the system's cannot be loaded. Empty paths are ignored.
+func Apply(tlsConfig *tls.Config, caCertFile string, clientCertFile string, clientKeyFile string) error {
+	if caCertFile != "" {
+		pemCerts, err := ioutil.ReadFile(caCertFile)
+		if err != nil {
+			return err
+		}
+
+		pool := tlsConfig.RootCAs
+		if pool == nil {
+			pool, err = x509.SystemCertPool()
+			if err != nil {
+				pool = x509.NewCertPool()
+			}
+		}
+		if !pool.AppendCertsFromPEM(pemCerts) {
+			return InvalidCACertError{Path: caCertFile}
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if clientCertFile == "" && clientKeyFile == "" {
+		return nil
+	}
+	if clientCertFile == "" || clientKeyFile == "" {
+		return IncompleteClientCertError{}
+	}
+
+	certificate, err := tls.LoadX509KeyPair(clientCertFile, clientKeyFile)
+	if err != nil {
+		return InvalidClientCertError{
+			CertFile: clientCertFile,
+			KeyFile:  clientKeyFile,
+			Err:      err,
+		}
+	}
+	tlsConfig.Certificates = append(tlsConfig.Certificates, certificate)
+
+	return nil
+}
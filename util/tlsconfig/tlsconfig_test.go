@@ -0,0 +1,139 @@
+package tlsconfig_test
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/tlsconfig"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Apply", func() {
+	var (
+		tmpdir    string
+		tlsConfig *tls.Config
+
+		certPath string
+		keyPath  string
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpdir, err = ioutil.TempDir("", "tlsconfig")
+		Expect(err).ToNot(HaveOccurred())
+
+		cert, key := generateCertificate()
+		certPath = filepath.Join(tmpdir, "cert.pem")
+		keyPath = filepath.Join(tmpdir, "key.pem")
+		Expect(ioutil.WriteFile(certPath, cert, 0600)).To(Succeed())
+		Expect(ioutil.WriteFile(keyPath, key, 0600)).To(Succeed())
+
+		tlsConfig = &tls.Config{}
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tmpdir)
+	})
+
+	Context("when no files are given", func() {
+		It("leaves the config unchanged", func() {
+			Expect(Apply(tlsConfig, "", "", "")).To(Succeed())
+			Expect(tlsConfig).To(Equal(&tls.Config{}))
+		})
+	})
+
+	Context("when a CA bundle is given", func() {
+		var server *httptest.Server
+
+		BeforeEach(func() {
+			serverCert, err := tls.LoadX509KeyPair(certPath, keyPath)
+			Expect(err).ToNot(HaveOccurred())
+
+			server = httptest.NewUnstartedServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+			server.TLS = &tls.Config{Certificates: []tls.Certificate{serverCert}}
+			server.StartTLS()
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("trusts the certificate authorities in the bundle", func() {
+			Expect(Apply(tlsConfig, certPath, "", "")).To(Succeed())
+
+			client := &http.Client{Transport: &http.Transport{TLSClientConfig: tlsConfig}}
+			response, err := client.Get(server.URL)
+			Expect(err).ToNot(HaveOccurred())
+			response.Body.Close()
+		})
+
+		It("adds to the certificate authorities already trusted by the config", func() {
+			tlsConfig.RootCAs = x509.NewCertPool()
+			Expect(Apply(tlsConfig, certPath, "", "")).To(Succeed())
+			Expect(tlsConfig.RootCAs.Subjects()).To(HaveLen(1))
+		})
+	})
+
+	Context("when the CA bundle does not contain any certificates", func() {
+		It("returns an InvalidCACertError", func() {
+			Expect(Apply(tlsConfig, keyPath, "", "")).To(MatchError(InvalidCACertError{Path: keyPath}))
+		})
+	})
+
+	Context("when the CA bundle does not exist", func() {
+		It("returns the error", func() {
+			err := Apply(tlsConfig, filepath.Join(tmpdir, "missing.pem"), "", "")
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+	})
+
+	Context("when a client certificate and key are given", func() {
+		It("presents the client certificate to servers that request one", func() {
+			Expect(Apply(tlsConfig, certPath, certPath, keyPath)).To(Succeed())
+			Expect(tlsConfig.Certificates).To(HaveLen(1))
+
+			clientCAs := x509.NewCertPool()
+			clientCert, err := ioutil.ReadFile(certPath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(clientCAs.AppendCertsFromPEM(clientCert)).To(BeTrue())
+			serverCert, err := tls.LoadX509KeyPair(certPath, keyPath)
+			Expect(err).ToNot(HaveOccurred())
+
+			server := httptest.NewUnstartedServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+			server.TLS = &tls.Config{
+				Certificates: []tls.Certificate{serverCert},
+				ClientAuth:   tls.RequireAndVerifyClientCert,
+				ClientCAs:    clientCAs,
+			}
+			server.StartTLS()
+			defer server.Close()
+
+			client := &http.Client{Transport: &http.Transport{TLSClientConfig: tlsConfig}}
+			response, err := client.Get(server.URL)
+			Expect(err).ToNot(HaveOccurred())
+			response.Body.Close()
+		})
+	})
+
+	Context("when only one of the client certificate and key is given", func() {
+		It("returns an IncompleteClientCertError", func() {
+			Expect(Apply(tlsConfig, "", certPath, "")).To(MatchError(IncompleteClientCertError{}))
+			Expect(Apply(tlsConfig, "", "", keyPath)).To(MatchError(IncompleteClientCertError{}))
+		})
+	})
+
+	Context("when the client certificate and key cannot be loaded", func() {
+		It("returns an InvalidClientCertError", func() {
+			err := Apply(tlsConfig, "", keyPath, keyPath)
+			Expect(err).To(BeAssignableToTypeOf(InvalidClientCertError{}))
+			Expect(err.Error()).To(ContainSubstring(keyPath))
+		})
+	})
+})
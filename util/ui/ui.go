@@ -17,6 +17,7 @@ import (
 	"github.com/fatih/color"
 	"github.com/nicksnyder/go-i18n/i18n"
 	"github.com/vito/go-interact/interact"
+	"golang.org/x/crypto/ssh/terminal"
 )
 
 const (
@@ -57,6 +58,8 @@ type UI struct {
 	Out io.Writer
 	// Err is the error buffer
 	Err io.Writer
+	// IsTTY is true when Out is a terminal that supports redrawing
+	IsTTY bool
 
 	colorEnabled configv3.ColorSetting
 	translate    i18n.TranslateFunc
@@ -74,6 +77,7 @@ func NewUI(c Config) (*UI, error) {
 		In:           os.Stdin,
 		Out:          color.Output,
 		Err:          os.Stderr,
+		IsTTY:        terminal.IsTerminal(int(os.Stdout.Fd())),
 		colorEnabled: c.ColorEnabled(),
 		translate:    translateFunc,
 	}, nil
@@ -108,6 +112,15 @@ func (ui *UI) DisplayOK() {
 	fmt.Fprintf(ui.Out, "%s\n", ui.addFlavor(ui.TranslateText("OK"), green, true))
 }
 
+// ClearScreen clears UI.Out and moves the cursor to the top left corner when
+// UI.Out is a terminal. Otherwise it does nothing, so that redrawn output is
+// appended instead.
+func (ui *UI) ClearScreen() {
+	if ui.IsTTY {
+		fmt.Fprint(ui.Out, "\033[H\033[2J")
+	}
+}
+
 // DisplayNewline outputs a newline to UI.Out.
 func (ui *UI) DisplayNewline() {
 	fmt.Fprintf(ui.Out, "\n")
@@ -167,6 +180,17 @@ func (ui *UI) DisplayTextWithFlavor(template string, templateValues ...map[strin
 	fmt.Fprintf(ui.Out, "%s\n", ui.TranslateText(template, firstTemplateValues))
 }
 
+// DisplayTextWithBold translates the template, bolds the templateValues,
+// substitutes templateValues into the template, and outputs the result to
+// ui.Out. Only the first map in templateValues is used.
+func (ui *UI) DisplayTextWithBold(template string, templateValues ...map[string]interface{}) {
+	firstTemplateValues := getFirstSet(templateValues)
+	for key, value := range firstTemplateValues {
+		firstTemplateValues[key] = ui.addFlavor(fmt.Sprint(value), defaultFgColor, true)
+	}
+	fmt.Fprintf(ui.Out, "%s\n", ui.TranslateText(template, firstTemplateValues))
+}
+
 // DisplayWarning translates the warning, substitutes in templateValues, and
 // outputs to ui.Err. Only the first map in templateValues is used.
 func (ui *UI) DisplayWarning(template string, templateValues ...map[string]interface{}) {
@@ -96,6 +96,30 @@ var _ = Describe("UI", func() {
 		})
 	})
 
+	Describe("ClearScreen", func() {
+		Context("when the output is a terminal", func() {
+			BeforeEach(func() {
+				ui.IsTTY = true
+			})
+
+			It("clears the screen and moves the cursor home", func() {
+				ui.ClearScreen()
+				Expect(ui.Out).To(Say("\x1b\\[H\x1b\\[2J"))
+			})
+		})
+
+		Context("when the output is not a terminal", func() {
+			BeforeEach(func() {
+				ui.IsTTY = false
+			})
+
+			It("displays nothing", func() {
+				ui.ClearScreen()
+				Expect(ui.Out.(*Buffer).Contents()).To(BeEmpty())
+			})
+		})
+	})
+
 	Describe("DisplayBoolPrompt", func() {
 		var inBuffer *Buffer
 
@@ -272,6 +296,17 @@ some-prefixgg          hh            ii`))
 		})
 	})
 
+	Describe("DisplayTextWithBold", func() {
+		It("displays the template with map values bolded and substituted in to ui.Out", func() {
+			ui.DisplayTextWithBold(
+				"template with {{.SomeMapValue}}",
+				map[string]interface{}{
+					"SomeMapValue": "map-value",
+				})
+			Expect(ui.Out).To(Say("template with \x1b\\[38;1mmap-value\x1b\\[0m"))
+		})
+	})
+
 	Describe("DisplayTextWithFlavor", func() {
 		It("displays the template to ui.Out", func() {
 			ui.DisplayTextWithFlavor("some-template")